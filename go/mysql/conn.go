@@ -182,6 +182,13 @@ type PrepareData struct {
 	ParamsType  []int32
 	ColumnNames []string
 	BindVars    map[string]*querypb.BindVariable
+	// CursorType is the cursor type of the last ComStmtExecute.
+	// The rows of a CursorTypeReadOnly cursor are sent by
+	// ComStmtFetch, so the handler should stream them.
+	CursorType byte
+
+	// cursor is the cursor opened by the last ComStmtExecute.
+	cursor *cursor
 }
 
 // bufPool is used to allocate and free buffers in an efficient way.
@@ -807,18 +814,25 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 						log.Errorf("Conn %v: Error writing query error: %v", c, werr)
 						return werr
 					}
+					timings.Record(queryTimingKey, queryStart)
+					return nil
 				}
 			} else {
 				queries = []string{query}
 			}
+			// The queries are executed in order, until one of them fails.
 			for index, sql := range queries {
 				more := false
 				if index != len(queries)-1 {
 					more = true
 				}
-				if err := c.execQuery(sql, handler, more); err != nil {
+				ok, err := c.execQuery(sql, handler, more)
+				if err != nil {
 					return err
 				}
+				if !ok {
+					break
+				}
 			}
 
 			timings.Record(queryTimingKey, queryStart)
@@ -953,7 +967,7 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 				}
 			}()
 			queryStart := time.Now()
-			stmtID, _, err := c.parseComStmtExecute(c.PrepareData, data)
+			stmtID, cursorType, err := c.parseComStmtExecute(c.PrepareData, data)
 			c.recycleReadPacket()
 
 			if stmtID != uint32(0) {
@@ -973,10 +987,21 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 				return nil
 			}
 
+			prepare := c.PrepareData[stmtID]
+			prepare.closeCursor()
+			prepare.CursorType = cursorType
+			if cursorType&CursorTypeReadOnly != 0 {
+				if err := c.execCursor(handler, prepare); err != nil {
+					log.Errorf("Error writing cursor result to %s: %v", c, err)
+					return err
+				}
+				timings.Record(queryTimingKey, queryStart)
+				return nil
+			}
+
 			fieldSent := false
 			// sendFinished is set if the response should just be an OK packet.
 			sendFinished := false
-			prepare := c.PrepareData[stmtID]
 			err = handler.ComStmtExecute(c, prepare, func(qr *sqltypes.Result) error {
 				if sendFinished {
 					// Failsafe: Unreachable if server is well-behaved.
@@ -1068,10 +1093,33 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 		} else {
 			prepare.BindVars[key] = sqltypes.BytesBindVariable(chunk)
 		}
+	case ComStmtFetch:
+		err := func() error {
+			c.startWriterBuffering()
+			defer func() {
+				if err := c.endWriterBuffering(); err != nil {
+					log.Errorf("conn %v: flush() failed: %v", c.ID(), err)
+				}
+			}()
+			stmtID, numRows, ok := c.parseComStmtFetch(data)
+			c.recycleReadPacket()
+			if !ok {
+				log.Errorf("Got unhandled packet from client %v, returning error: %v", c.ConnectionID, data)
+				return c.writeErrorPacket(ERUnknownComError, SSUnknownComError, "error handling packet: %v", data)
+			}
+			return c.fetchCursor(handler, stmtID, numRows)
+		}()
+		if err != nil {
+			log.Errorf("Error writing ComStmtFetch result to %s: %v", c, err)
+			return err
+		}
 	case ComStmtClose:
 		stmtID, ok := c.parseComStmtClose(data)
 		c.recycleReadPacket()
 		if ok {
+			if prepare, ok := c.PrepareData[stmtID]; ok {
+				prepare.closeCursor()
+			}
 			delete(c.PrepareData, stmtID)
 		}
 	case ComStmtReset:
@@ -1094,6 +1142,7 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 			}
 		}
 
+		prepare.closeCursor()
 		if prepare.BindVars != nil {
 			for k := range prepare.BindVars {
 				prepare.BindVars[k] = nil
@@ -1110,6 +1159,7 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 		c.recycleReadPacket()
 		handler.ComResetConnection(c)
 		// Reset prepared statements
+		c.closeCursors()
 		c.PrepareData = make(map[uint32]*PrepareData)
 		err = c.writeOKPacket(0, 0, 0, 0)
 		if err != nil {
@@ -1128,7 +1178,11 @@ func (c *Conn) handleNextCommand(handler Handler) error {
 	return nil
 }
 
-func (c *Conn) execQuery(query string, handler Handler, more bool) error {
+// execQuery executes the query, and sends its result. It returns false
+// if the query failed, in which case the error was sent instead of the
+// result, and the queries that follow it in a multi-statement query
+// must not be executed. It returns an error if the result can't be sent.
+func (c *Conn) execQuery(query string, handler Handler, more bool) (bool, error) {
 	fieldSent := false
 	// sendFinished is set if the response should just be an OK packet.
 	sendFinished := false
@@ -1174,28 +1228,29 @@ func (c *Conn) execQuery(query string, handler Handler, more bool) error {
 		if werr := c.writeErrorPacketFromError(err); werr != nil {
 			// If we can't even write the error, we're done.
 			log.Errorf("Error writing query error to %s: %v", c, werr)
-			return werr
-		}
-	} else {
-		if err != nil {
-			// We can't send an error in the middle of a stream.
-			// All we can do is abort the send, which will cause a 2013.
-			log.Errorf("Error in the middle of a stream to %s: %v", c, err)
-			return err
+			return false, werr
 		}
+		return false, nil
+	}
 
-		// Send the end packet only sendFinished is false (results were streamed).
-		// In this case the affectedRows and lastInsertID are always 0 since it
-		// was a read operation.
-		if !sendFinished {
-			if err := c.writeEndResult(more, 0, 0, handler.WarningCount(c)); err != nil {
-				log.Errorf("Error writing result to %s: %v", c, err)
-				return err
-			}
+	if err != nil {
+		// We can't send an error in the middle of a stream.
+		// All we can do is abort the send, which will cause a 2013.
+		log.Errorf("Error in the middle of a stream to %s: %v", c, err)
+		return false, err
+	}
+
+	// Send the end packet only sendFinished is false (results were streamed).
+	// In this case the affectedRows and lastInsertID are always 0 since it
+	// was a read operation.
+	if !sendFinished {
+		if err := c.writeEndResult(more, 0, 0, handler.WarningCount(c)); err != nil {
+			log.Errorf("Error writing result to %s: %v", c, err)
+			return false, err
 		}
 	}
 
-	return nil
+	return true, nil
 }
 
 //
@@ -168,7 +168,7 @@ const (
 	// ComStmtReset is COM_STMT_RESET
 	ComStmtReset = 0x1a
 
-	//ComStmtFetch is COM_STMT_FETCH
+	// ComStmtFetch is COM_STMT_FETCH
 	ComStmtFetch = 0x1c
 
 	// ComSetOption is COM_SET_OPTION
@@ -331,6 +331,7 @@ const (
 	ERFeatureDisabled               = 1289
 	EROptionPreventsStatement       = 1290
 	ERDuplicatedValueInType         = 1291
+	ERStmtHasNoOpenCursor           = 1421
 	ERRowIsReferenced2              = 1451
 	ErNoReferencedRow2              = 1452
 
@@ -488,6 +489,9 @@ const (
 
 	// SSLockDeadlock is ER_LOCK_DEADLOCK
 	SSLockDeadlock = "40001"
+
+	// SSWrongNumberOfColumns is ER_WRONG_NUMBER_OF_COLUMNS_IN_SELECT
+	SSWrongNumberOfColumns = "21000"
 )
 
 // Status flags. They are returned by the server in a few cases.
@@ -499,6 +503,24 @@ const (
 
 	// ServerMoreResultsExists is SERVER_MORE_RESULTS_EXISTS
 	ServerMoreResultsExists = 0x0008
+
+	// ServerStatusCursorExists is SERVER_STATUS_CURSOR_EXISTS.
+	// It's set while the rows of a cursor can be fetched.
+	ServerStatusCursorExists = 0x0040
+
+	// ServerStatusLastRowSent is SERVER_STATUS_LAST_ROW_SENT.
+	// It's set when the last row of a cursor was fetched.
+	ServerStatusLastRowSent = 0x0080
+)
+
+// Cursor types of ComStmtExecute.
+// Originally found in include/mysql/mysql_com.h
+const (
+	// CursorTypeNoCursor is CURSOR_TYPE_NO_CURSOR.
+	CursorTypeNoCursor = 0x00
+
+	// CursorTypeReadOnly is CURSOR_TYPE_READ_ONLY.
+	CursorTypeReadOnly = 0x01
 )
 
 // A few interesting character set values.
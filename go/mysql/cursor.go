@@ -0,0 +1,218 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"errors"
+	"io"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/tb"
+	"vitess.io/vitess/go/vt/log"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// cursor holds a statement executed with a read-only cursor, until
+// its rows are fetched with ComStmtFetch. The statement is executed
+// by the handler in its own goroutine. The goroutine only runs while
+// the connection waits for the next result, so the handler is never
+// called concurrently for the same connection.
+type cursor struct {
+	fields []*querypb.Field
+	// rows are the rows of the last result that weren't fetched yet.
+	rows [][]sqltypes.Value
+
+	// results receives the results sent by the handler. It's closed
+	// when the handler returns, after err is set.
+	results chan *sqltypes.Result
+	// next lets the handler send the next result.
+	next chan struct{}
+	// done is closed when the cursor is closed.
+	done chan struct{}
+	err  error
+
+	// waiting is set while the handler waits on next.
+	waiting bool
+	// ended is set once results is closed.
+	ended bool
+}
+
+// openCursor executes the statement in a new goroutine. The handler
+// gets a copy of prepare, because the connection resets its bind
+// variables once ComStmtExecute returns.
+func openCursor(c *Conn, handler Handler, prepare *PrepareData) *cursor {
+	cur := &cursor{
+		results: make(chan *sqltypes.Result),
+		next:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	stmt := *prepare
+	go func() {
+		defer close(cur.results)
+		defer func() {
+			if x := recover(); x != nil {
+				log.Errorf("mysql_server caught panic in cursor:\n%v\n%s", x, tb.Stack(4))
+				cur.err = NewSQLError(ERUnknownError, SSUnknownSQLState, "internal error: %v", x)
+			}
+		}()
+		cur.err = handler.ComStmtExecute(c, &stmt, cur.send)
+	}()
+	return cur
+}
+
+// send is the callback of the handler. It hands the result over
+// to the connection, and waits until the next one is needed.
+func (cur *cursor) send(qr *sqltypes.Result) error {
+	select {
+	case cur.results <- qr:
+	case <-cur.done:
+		return io.EOF
+	}
+	select {
+	case <-cur.next:
+		return nil
+	case <-cur.done:
+		return io.EOF
+	}
+}
+
+// receive returns the next result of the handler, or false
+// once the handler has returned.
+func (cur *cursor) receive() (*sqltypes.Result, bool) {
+	if cur.ended {
+		return nil, false
+	}
+	if cur.waiting {
+		cur.next <- struct{}{}
+	}
+	qr, ok := <-cur.results
+	cur.waiting = ok
+	cur.ended = !ok
+	return qr, ok
+}
+
+// fill receives results until there are rows to fetch. It returns
+// false once all the rows were fetched, or if the handler failed.
+func (cur *cursor) fill() (bool, error) {
+	for len(cur.rows) == 0 {
+		qr, ok := cur.receive()
+		if !ok {
+			if cur.err == io.EOF {
+				return false, nil
+			}
+			return false, cur.err
+		}
+		cur.rows = qr.Rows
+	}
+	return true, nil
+}
+
+// close stops the handler, and waits until it returns.
+func (cur *cursor) close() {
+	close(cur.done)
+	for range cur.results {
+	}
+	cur.ended = true
+}
+
+// closeCursor closes the cursor of the statement, if any.
+func (prepare *PrepareData) closeCursor() {
+	if prepare.cursor != nil {
+		prepare.cursor.close()
+		prepare.cursor = nil
+	}
+}
+
+// closeCursors closes the cursors of all the statements.
+func (c *Conn) closeCursors() {
+	for _, prepare := range c.PrepareData {
+		prepare.closeCursor()
+	}
+}
+
+// execCursor executes the statement with a read-only cursor. Only the
+// fields are sent: the rows are sent by ComStmtFetch. A statement that
+// doesn't return rows doesn't open a cursor, and gets an OK packet.
+// It returns an error only if the response can't be sent.
+func (c *Conn) execCursor(handler Handler, prepare *PrepareData) error {
+	cur := openCursor(c, handler, prepare)
+	qr, ok := cur.receive()
+	if !ok {
+		err := cur.err
+		if err == nil || err == io.EOF {
+			// This is just a failsafe. Should never happen.
+			err = NewSQLErrorFromError(errors.New("unexpected: query ended without no results and no error"))
+		}
+		return c.writeErrorPacketFromError(err)
+	}
+	if len(qr.Fields) == 0 {
+		cur.close()
+		return c.writeOKPacket(qr.RowsAffected, qr.InsertID, c.StatusFlags, 0)
+	}
+
+	cur.fields = qr.Fields
+	cur.rows = qr.Rows
+	prepare.cursor = cur
+	if err := c.sendColumnCount(uint64(len(qr.Fields))); err != nil {
+		return err
+	}
+	for _, field := range qr.Fields {
+		if err := c.writeColumnDefinition(field); err != nil {
+			return err
+		}
+	}
+	return c.writeCursorEnd(ServerStatusCursorExists, 0)
+}
+
+// fetchCursor sends up to numRows rows of the cursor of the statement.
+// The cursor is closed once the last row was sent. It returns an error
+// only if the response can't be sent.
+func (c *Conn) fetchCursor(handler Handler, stmtID, numRows uint32) error {
+	prepare, ok := c.PrepareData[stmtID]
+	if !ok || prepare.cursor == nil {
+		return c.writeErrorPacket(ERStmtHasNoOpenCursor, SSUnknownSQLState, "The statement (%v) has no open cursor.", stmtID)
+	}
+	cur := prepare.cursor
+
+	sent := uint32(0)
+	more, err := cur.fill()
+	for ; more && sent < numRows; sent++ {
+		if err := c.writeBinaryRow(cur.fields, cur.rows[0]); err != nil {
+			return err
+		}
+		cur.rows = cur.rows[1:]
+		more, err = cur.fill()
+	}
+	if err != nil {
+		prepare.closeCursor()
+		if sent != 0 {
+			// We can't send an error in the middle of a stream.
+			// All we can do is abort the send, which will cause a 2013.
+			log.Errorf("Error in the middle of a cursor fetch to %s: %v", c, err)
+			return err
+		}
+		return c.writeErrorPacketFromError(err)
+	}
+
+	flags := uint16(ServerStatusCursorExists)
+	if !more {
+		prepare.closeCursor()
+		flags = ServerStatusLastRowSent
+	}
+	return c.writeCursorEnd(flags, handler.WarningCount(c))
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// cursorHandler streams results, and records how
+// many were sent and how ComStmtExecute returned.
+type cursorHandler struct {
+	testHandler
+	results []*sqltypes.Result
+	sent    int
+	err     chan error
+}
+
+func (h *cursorHandler) ComStmtExecute(c *Conn, prepare *PrepareData, callback func(*sqltypes.Result) error) error {
+	var err error
+	for _, qr := range h.results {
+		h.sent++
+		if err = callback(qr); err != nil {
+			break
+		}
+	}
+	h.err <- err
+	return err
+}
+
+func TestCursor(t *testing.T) {
+	listener, sConn, cConn := createSocketPair(t)
+	defer func() {
+		listener.Close()
+		sConn.Close()
+		cConn.Close()
+	}()
+
+	row := func(id string) []sqltypes.Value {
+		return []sqltypes.Value{sqltypes.MakeTrusted(querypb.Type_INT32, []byte(id))}
+	}
+	h := &cursorHandler{
+		results: []*sqltypes.Result{
+			{Fields: []*querypb.Field{{Name: "id", Type: querypb.Type_INT32}}},
+			{Rows: [][]sqltypes.Value{row("1"), row("2"), row("3")}},
+			{Rows: [][]sqltypes.Value{row("4")}},
+		},
+		err: make(chan error, 1),
+	}
+	sConn.PrepareData = map[uint32]*PrepareData{
+		1: {StatementID: 1, PrepareStmt: "select id from t", BindVars: map[string]*querypb.BindVariable{}},
+	}
+
+	command := func(data ...byte) {
+		cConn.sequence = 0
+		require.NoError(t, cConn.writePacket(data))
+		require.NoError(t, sConn.handleNextCommand(h))
+	}
+	execute := func() {
+		command(ComStmtExecute, 1, 0, 0, 0, CursorTypeReadOnly, 1, 0, 0, 0)
+	}
+	fetch := func(numRows byte) {
+		command(ComStmtFetch, 1, 0, 0, 0, numRows, 0, 0, 0)
+	}
+	// readRows reads the rows up to the EOF packet,
+	// and returns their count and the status flags.
+	readRows := func() (int, uint16) {
+		count := 0
+		for {
+			data, err := cConn.ReadPacket()
+			require.NoError(t, err)
+			if isEOFPacket(data) {
+				flags, _, ok := readUint16(data, 3)
+				require.True(t, ok)
+				return count, flags
+			}
+			require.False(t, isErrorPacket(data), "unexpected error: %v", ParseErrorPacket(data))
+			count++
+		}
+	}
+
+	// Only the fields are sent on execute.
+	execute()
+	data, err := cConn.ReadPacket()
+	require.NoError(t, err)
+	require.Equal(t, []byte{1}, data)
+	count, flags := readRows()
+	require.Equal(t, 1, count, "column definitions")
+	require.Equal(t, uint16(ServerStatusCursorExists), flags&ServerStatusCursorExists)
+	require.Equal(t, 1, h.sent)
+
+	fetch(2)
+	count, flags = readRows()
+	require.Equal(t, 2, count)
+	require.Equal(t, uint16(ServerStatusCursorExists), flags)
+	require.Equal(t, 2, h.sent)
+
+	fetch(5)
+	count, flags = readRows()
+	require.Equal(t, 2, count)
+	require.Equal(t, uint16(ServerStatusLastRowSent), flags)
+	require.NoError(t, <-h.err)
+
+	fetch(1)
+	data, err = cConn.ReadPacket()
+	require.NoError(t, err)
+	require.EqualError(t, ParseErrorPacket(data), "The statement (1) has no open cursor. (errno 1421) (sqlstate HY000)")
+
+	// Closing the statement stops the handler.
+	h.sent = 0
+	execute()
+	_, _ = cConn.ReadPacket()
+	_, _ = readRows()
+	fetch(1)
+	count, _ = readRows()
+	require.Equal(t, 1, count)
+	command(ComStmtClose, 1, 0, 0, 0)
+	require.EqualError(t, <-h.err, "EOF")
+	require.Equal(t, 2, h.sent)
+	require.Empty(t, sConn.PrepareData)
+}
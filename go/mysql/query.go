@@ -855,6 +855,15 @@ func (c *Conn) parseComStmtReset(data []byte) (uint32, bool) {
 	return val, ok
 }
 
+func (c *Conn) parseComStmtFetch(data []byte) (uint32, uint32, bool) {
+	stmtID, pos, ok := readUint32(data, 1)
+	if !ok {
+		return 0, 0, false
+	}
+	numRows, _, ok := readUint32(data, pos)
+	return stmtID, numRows, ok
+}
+
 func (c *Conn) parseComInitDB(data []byte) string {
 	return string(data[1:])
 }
@@ -1001,6 +1010,16 @@ func (c *Conn) writeEndResult(more bool, affectedRows, lastInsertID uint64, warn
 	return nil
 }
 
+// writeCursorEnd is like writeEndResult, for the fields of a cursor
+// and the rows fetched from it. The flags are the status of the cursor.
+func (c *Conn) writeCursorEnd(flags uint16, warnings uint16) error {
+	flags |= c.StatusFlags
+	if c.Capabilities&CapabilityClientDeprecateEOF == 0 {
+		return c.writeEOFPacket(flags, warnings)
+	}
+	return c.writeOKPacketWithEOFHeader(0, 0, flags, warnings)
+}
+
 // writePrepare writes a prepare query response to the wire.
 func (c *Conn) writePrepare(fld []*querypb.Field, prepare *PrepareData) error {
 	paramsCount := prepare.ParamsCount
@@ -105,7 +105,10 @@ type Handler interface {
 	ComPrepare(c *Conn, query string) ([]*querypb.Field, error)
 
 	// ComStmtExecute is called when a connection receives a statement
-	// execute query.
+	// execute query. If prepare.CursorType is CursorTypeReadOnly, it's
+	// called in its own goroutine, and the callback blocks until the
+	// client fetches the rows that were sent. The handler should
+	// stream the rows in that case.
 	ComStmtExecute(c *Conn, prepare *PrepareData, callback func(*sqltypes.Result) error) error
 
 	// WarningCount is called at the end of each query to obtain
@@ -289,6 +292,8 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 	// Tell the handler about the connection coming and going.
 	l.handler.NewConnection(c)
 	defer l.handler.ConnectionClosed(c)
+	// Cursors are closed before, because they may still use the handler.
+	defer c.closeCursors()
 
 	// Adjust the count of open connections
 	defer connCount.Add(-1)
@@ -494,6 +494,52 @@ func TestClientFoundRows(t *testing.T) {
 	c.Close()
 }
 
+func TestMultiStatements(t *testing.T) {
+	th := &testHandler{}
+
+	authServer := NewAuthServerStatic("", "", 0)
+	authServer.entries["user1"] = []*AuthServerStaticEntry{{
+		Password: "password1",
+		UserData: "userData1",
+	}}
+	defer authServer.close()
+	l, err := NewListener("tcp", ":0", authServer, th, 0, 0, false)
+	require.NoError(t, err)
+	defer l.Close()
+	go l.Accept()
+
+	host, port := getHostPort(t, l.Addr())
+	params := &ConnParams{
+		Host:  host,
+		Port:  port,
+		Uname: "user1",
+		Pass:  "password1",
+	}
+	c, err := Connect(context.Background(), params)
+	require.NoError(t, err)
+	defer c.Close()
+
+	result, more, err := c.ExecuteFetchMulti("select rows;insert", 100, true)
+	require.NoError(t, err)
+	require.True(t, more)
+	require.Equal(t, selectRowsResult.Rows, result.Rows)
+	result, more, _, err = c.ReadQueryResult(100, true)
+	require.NoError(t, err)
+	require.False(t, more)
+	require.EqualValues(t, 123, result.RowsAffected)
+
+	// The queries that follow an error are not executed.
+	th.SetErr(NewSQLError(ERUnknownComError, SSUnknownComError, "forced query error"))
+	_, more, err = c.ExecuteFetchMulti("insert;error;insert", 100, true)
+	require.NoError(t, err)
+	require.True(t, more)
+	_, _, _, err = c.ReadQueryResult(100, true)
+	require.EqualError(t, err, "forced query error (errno 1047) (sqlstate 08S01)")
+	result, err = c.ExecuteFetch("select rows", 100, true)
+	require.NoError(t, err)
+	require.Equal(t, selectRowsResult.Rows, result.Rows)
+}
+
 func TestConnCounts(t *testing.T) {
 	th := &testHandler{}
 
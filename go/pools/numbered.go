@@ -140,6 +140,18 @@ func (nu *Numbered) Put(id int64) {
 	}
 }
 
+// SetEnforceTimeout changes whether GetOutdated returns the resource.
+// The age of the resource is reset, so that it's measured from now.
+// If the resource is not present, it's ignored.
+func (nu *Numbered) SetEnforceTimeout(id int64, enforceTimeout bool) {
+	nu.mu.Lock()
+	defer nu.mu.Unlock()
+	if nw, ok := nu.resources[id]; ok {
+		nw.enforceTimeout = enforceTimeout
+		nw.timeCreated = time.Now()
+	}
+}
+
 // GetAll returns the list of all resources in the pool.
 func (nu *Numbered) GetAll() (vals []interface{}) {
 	nu.mu.Lock()
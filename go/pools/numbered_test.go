@@ -97,6 +97,17 @@ func TestNumbered(t *testing.T) {
 	if p.Size() != 3 {
 		t.Errorf("want 3, got %v", p.Size())
 	}
+
+	// Enforcing the timeout of 2 resets its age.
+	p.SetEnforceTimeout(2, true)
+	if vals = p.GetOutdated(200*time.Millisecond, "by outdated"); len(vals) != 2 {
+		t.Errorf("want 2, got %v", len(vals))
+	}
+	for _, v := range vals {
+		p.Put(v.(int64))
+	}
+	p.SetEnforceTimeout(2, false)
+
 	go func() {
 		p.Unregister(0, "test")
 		p.Unregister(1, "test")
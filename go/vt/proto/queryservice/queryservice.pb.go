@@ -30,44 +30,47 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("queryservice.proto", fileDescriptor_4bd2dde8711f22e3) }
 
 var fileDescriptor_4bd2dde8711f22e3 = []byte{
-	// 582 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0xdf, 0x6f, 0xd3, 0x30,
-	0x10, 0xc7, 0xe1, 0x61, 0x2b, 0xba, 0x96, 0x32, 0x3c, 0x06, 0x2c, 0x1b, 0xdd, 0x8f, 0x37, 0x84,
-	0xd4, 0x22, 0x40, 0x42, 0x9a, 0xc4, 0xc3, 0x5a, 0x31, 0x81, 0x26, 0x7e, 0xb5, 0x6c, 0x42, 0x20,
-	0x21, 0xb9, 0xa9, 0x55, 0xa2, 0xa5, 0x71, 0x16, 0x3b, 0x1d, 0xfc, 0x37, 0xfc, 0xa9, 0xd3, 0xe2,
-	0xdc, 0xc5, 0x76, 0x93, 0xbd, 0xcd, 0xdf, 0xef, 0xdd, 0x67, 0x17, 0x5f, 0xef, 0x0c, 0xec, 0x32,
-	0x17, 0xd9, 0x3f, 0x25, 0xb2, 0x65, 0x14, 0x8a, 0x7e, 0x9a, 0x49, 0x2d, 0x59, 0xc7, 0xd6, 0x82,
-	0x76, 0x71, 0x32, 0x56, 0xb0, 0x31, 0x8d, 0x92, 0x58, 0xce, 0x67, 0x5c, 0x73, 0xa3, 0xbc, 0xfa,
-	0xdf, 0x85, 0xb5, 0x6f, 0x37, 0x11, 0xec, 0x08, 0x5a, 0xef, 0xff, 0x8a, 0x30, 0xd7, 0x82, 0x6d,
-	0xf5, 0x4d, 0x52, 0x79, 0x1e, 0x8b, 0xcb, 0x5c, 0x28, 0x1d, 0x3c, 0xf6, 0x65, 0x95, 0xca, 0x44,
-	0x89, 0xc3, 0x3b, 0xec, 0x23, 0x74, 0x4a, 0x71, 0xc8, 0x75, 0xf8, 0x87, 0x05, 0x6e, 0x64, 0x21,
-	0x22, 0x65, 0xa7, 0xd6, 0x23, 0xd4, 0x67, 0xb8, 0x3f, 0xd1, 0x99, 0xe0, 0x0b, 0x2c, 0x06, 0xe3,
-	0x1d, 0x15, 0x61, 0xbb, 0xf5, 0x26, 0xd2, 0x5e, 0xde, 0x65, 0x6f, 0x60, 0x6d, 0x28, 0xe6, 0x51,
-	0xc2, 0x36, 0xcb, 0xd0, 0xe2, 0x84, 0xf9, 0x8f, 0x5c, 0x91, 0xaa, 0x78, 0x0b, 0xeb, 0x23, 0xb9,
-	0x58, 0x44, 0x9a, 0x61, 0x84, 0x39, 0x62, 0xde, 0x96, 0xa7, 0x52, 0xe2, 0x3b, 0xb8, 0x37, 0x96,
-	0x71, 0x3c, 0xe5, 0xe1, 0x05, 0xc3, 0xfb, 0x42, 0x01, 0x93, 0x9f, 0xac, 0xe8, 0x94, 0x7e, 0x04,
-	0xad, 0xaf, 0x99, 0x48, 0x79, 0x56, 0x35, 0xa1, 0x3c, 0xfb, 0x4d, 0x20, 0x99, 0x72, 0xbf, 0x40,
-	0xd7, 0x94, 0x53, 0x5a, 0x33, 0xb6, 0xeb, 0x54, 0x89, 0x32, 0x92, 0x9e, 0x35, 0xb8, 0x04, 0x3c,
-	0x83, 0x0d, 0x2c, 0x91, 0x90, 0x3d, 0xaf, 0x76, 0x1f, 0xba, 0xd7, 0xe8, 0x13, 0xf6, 0x07, 0x3c,
-	0x1c, 0x65, 0x82, 0x6b, 0xf1, 0x3d, 0xe3, 0x89, 0xe2, 0xa1, 0x8e, 0x64, 0xc2, 0x30, 0x6f, 0xc5,
-	0x41, 0xf0, 0x7e, 0x73, 0x00, 0x91, 0x4f, 0xa0, 0x3d, 0xd1, 0x3c, 0xd3, 0x65, 0xeb, 0xb6, 0xe9,
-	0xc7, 0x41, 0x1a, 0xd2, 0x82, 0x3a, 0xcb, 0xe1, 0x08, 0x4d, 0x7d, 0x24, 0x4e, 0xa5, 0xad, 0x70,
-	0x6c, 0x8b, 0x38, 0xbf, 0x61, 0x73, 0x24, 0x93, 0x30, 0xce, 0x67, 0xce, 0xb7, 0x1e, 0xd0, 0xc5,
-	0xaf, 0x78, 0xc8, 0x3d, 0xbc, 0x2d, 0x84, 0xf8, 0x63, 0x78, 0x30, 0x16, 0x7c, 0x66, 0xb3, 0xb1,
-	0xa9, 0x9e, 0x8e, 0xdc, 0x5e, 0x93, 0x6d, 0x8f, 0x72, 0x31, 0x0c, 0x38, 0x7e, 0x81, 0x3d, 0x21,
-	0xde, 0xf4, 0xed, 0xd4, 0x7a, 0x76, 0xa3, 0x6d, 0xc7, 0xac, 0x86, 0xbd, 0x9a, 0x1c, 0x67, 0x3f,
-	0xec, 0x37, 0x07, 0xd8, 0x4b, 0xe2, 0x93, 0x50, 0x8a, 0xcf, 0x85, 0x19, 0x7c, 0x5a, 0x12, 0x8e,
-	0xea, 0x2f, 0x09, 0xcf, 0xb4, 0x96, 0xc4, 0x08, 0xa0, 0x34, 0x8f, 0xc3, 0x0b, 0xf6, 0xd4, 0x8d,
-	0x3f, 0xae, 0xda, 0xbd, 0x5d, 0xe3, 0x50, 0x51, 0x23, 0x80, 0x49, 0x1a, 0x47, 0xda, 0xac, 0x53,
-	0x84, 0x54, 0x92, 0x0f, 0xb1, 0x1d, 0x82, 0x9c, 0x42, 0xc7, 0xd4, 0xf7, 0x41, 0xf0, 0x58, 0x57,
-	0x9b, 0xd4, 0x16, 0xfd, 0xeb, 0x77, 0x3d, 0xeb, 0xb3, 0x4e, 0xa1, 0x73, 0x96, 0xce, 0xb8, 0xc6,
-	0x5b, 0x42, 0x98, 0x2d, 0xfa, 0x30, 0xd7, 0xb3, 0x60, 0x27, 0xd0, 0x3a, 0x27, 0x8e, 0xf5, 0x8e,
-	0x9c, 0xfb, 0x9c, 0x3a, 0xcf, 0xe2, 0x8c, 0xa1, 0x8d, 0xb2, 0xbc, 0x52, 0xac, 0x57, 0x17, 0x2f,
-	0xaf, 0x54, 0xb5, 0x50, 0x9a, 0x7c, 0x8b, 0xf9, 0x0b, 0xba, 0xd5, 0xbf, 0xca, 0x63, 0xad, 0xd8,
-	0x41, 0x7d, 0x19, 0x37, 0x5e, 0x35, 0x63, 0xb7, 0x84, 0x54, 0xf0, 0xe1, 0x8b, 0x9f, 0xcf, 0x97,
-	0x91, 0x16, 0x4a, 0xf5, 0x23, 0x39, 0x30, 0x7f, 0x0d, 0xe6, 0x72, 0xb0, 0xd4, 0x83, 0xe2, 0x09,
-	0x1d, 0xd8, 0xcf, 0xed, 0x74, 0xbd, 0xd0, 0x5e, 0x5f, 0x07, 0x00, 0x00, 0xff, 0xff, 0xbe, 0xb4,
-	0xc0, 0x34, 0x99, 0x07, 0x00, 0x00,
+	// 627 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x96, 0x51, 0x6f, 0x12, 0x41,
+	0x10, 0xc7, 0xf5, 0xa1, 0xc5, 0x0c, 0x88, 0xb8, 0xb5, 0x6a, 0xaf, 0x48, 0x0b, 0x6f, 0xc6, 0x04,
+	0x8c, 0x9a, 0x98, 0x34, 0xf1, 0xa1, 0x10, 0x1b, 0x4d, 0xa3, 0xd5, 0xc3, 0x36, 0x46, 0x13, 0x93,
+	0xe5, 0xd8, 0xe0, 0xa5, 0xc7, 0x2d, 0xbd, 0x5d, 0xa8, 0x7e, 0x08, 0xbf, 0xb3, 0xe1, 0xee, 0x66,
+	0x6e, 0x77, 0xb9, 0xe3, 0xad, 0xfb, 0xff, 0xcf, 0xfc, 0x3a, 0xec, 0x30, 0xb3, 0x00, 0xbb, 0x59,
+	0x8a, 0xe4, 0xaf, 0x12, 0xc9, 0x2a, 0x0c, 0x44, 0x7f, 0x91, 0x48, 0x2d, 0x59, 0xc3, 0xd4, 0xbc,
+	0x7a, 0x7a, 0xca, 0x2c, 0xaf, 0x35, 0x09, 0xe3, 0x48, 0xce, 0xa6, 0x5c, 0xf3, 0x4c, 0x79, 0xf5,
+	0xaf, 0x05, 0x3b, 0x5f, 0xd7, 0x11, 0xec, 0x04, 0x6a, 0xef, 0xff, 0x88, 0x60, 0xa9, 0x05, 0xdb,
+	0xef, 0x67, 0x49, 0xf9, 0xd9, 0x17, 0x37, 0x4b, 0xa1, 0xb4, 0xf7, 0xd8, 0x95, 0xd5, 0x42, 0xc6,
+	0x4a, 0xf4, 0xee, 0xb0, 0x8f, 0xd0, 0xc8, 0xc5, 0x21, 0xd7, 0xc1, 0x6f, 0xe6, 0xd9, 0x91, 0xa9,
+	0x88, 0x94, 0xc3, 0x52, 0x8f, 0x50, 0x9f, 0xe1, 0xfe, 0x58, 0x27, 0x82, 0xcf, 0xb1, 0x18, 0x8c,
+	0xb7, 0x54, 0x84, 0xb5, 0xcb, 0x4d, 0xa4, 0xbd, 0xbc, 0xcb, 0xde, 0xc0, 0xce, 0x50, 0xcc, 0xc2,
+	0x98, 0xed, 0xe5, 0xa1, 0xe9, 0x09, 0xf3, 0x1f, 0xd9, 0x22, 0x55, 0xf1, 0x16, 0x76, 0x47, 0x72,
+	0x3e, 0x0f, 0x35, 0xc3, 0x88, 0xec, 0x88, 0x79, 0xfb, 0x8e, 0x4a, 0x89, 0xef, 0xe0, 0x9e, 0x2f,
+	0xa3, 0x68, 0xc2, 0x83, 0x6b, 0x86, 0xf7, 0x85, 0x02, 0x26, 0x3f, 0xd9, 0xd0, 0x29, 0xfd, 0x04,
+	0x6a, 0x5f, 0x12, 0xb1, 0xe0, 0x49, 0xd1, 0x84, 0xfc, 0xec, 0x36, 0x81, 0x64, 0xca, 0xbd, 0x80,
+	0x66, 0x56, 0x4e, 0x6e, 0x4d, 0x59, 0xdb, 0xaa, 0x12, 0x65, 0x24, 0x3d, 0xab, 0x70, 0x09, 0x78,
+	0x09, 0x2d, 0x2c, 0x91, 0x90, 0x1d, 0xa7, 0x76, 0x17, 0x7a, 0x54, 0xe9, 0x13, 0xf6, 0x3b, 0x3c,
+	0x1c, 0x25, 0x82, 0x6b, 0xf1, 0x2d, 0xe1, 0xb1, 0xe2, 0x81, 0x0e, 0x65, 0xcc, 0x30, 0x6f, 0xc3,
+	0x41, 0xf0, 0x71, 0x75, 0x00, 0x91, 0xcf, 0xa0, 0x3e, 0xd6, 0x3c, 0xd1, 0x79, 0xeb, 0x0e, 0xe8,
+	0xcb, 0x41, 0x1a, 0xd2, 0xbc, 0x32, 0xcb, 0xe2, 0x08, 0x4d, 0x7d, 0x24, 0x4e, 0xa1, 0x6d, 0x70,
+	0x4c, 0x8b, 0x38, 0xbf, 0x60, 0x6f, 0x24, 0xe3, 0x20, 0x5a, 0x4e, 0xad, 0xcf, 0xda, 0xa5, 0x8b,
+	0xdf, 0xf0, 0x90, 0xdb, 0xdb, 0x16, 0x42, 0x7c, 0x1f, 0x1e, 0xf8, 0x82, 0x4f, 0x4d, 0x36, 0x36,
+	0xd5, 0xd1, 0x91, 0xdb, 0xa9, 0xb2, 0xcd, 0x51, 0x4e, 0x87, 0x01, 0xc7, 0xcf, 0x33, 0x27, 0xc4,
+	0x99, 0xbe, 0xc3, 0x52, 0xcf, 0x6c, 0xb4, 0xe9, 0x64, 0xab, 0xe1, 0xa8, 0x24, 0xc7, 0xda, 0x0f,
+	0xc7, 0xd5, 0x01, 0xe6, 0x92, 0xf8, 0x24, 0x94, 0xe2, 0x33, 0x91, 0x0d, 0x3e, 0x2d, 0x09, 0x4b,
+	0x75, 0x97, 0x84, 0x63, 0x1a, 0x4b, 0x62, 0x04, 0x90, 0x9b, 0xa7, 0xc1, 0x35, 0x7b, 0x6a, 0xc7,
+	0x9f, 0x16, 0xed, 0x3e, 0x28, 0x71, 0xa8, 0xa8, 0x11, 0xc0, 0x78, 0x11, 0x85, 0x3a, 0x5b, 0xa7,
+	0x08, 0x29, 0x24, 0x17, 0x62, 0x3a, 0x04, 0x39, 0x87, 0x46, 0x56, 0xdf, 0x07, 0xc1, 0x23, 0x5d,
+	0x6c, 0x52, 0x53, 0x74, 0xaf, 0xdf, 0xf6, 0x8c, 0x8f, 0x75, 0x0e, 0x8d, 0xcb, 0xc5, 0x94, 0x6b,
+	0xbc, 0x25, 0x84, 0x99, 0xa2, 0x0b, 0xb3, 0x3d, 0x03, 0x76, 0x06, 0xb5, 0x2b, 0xe2, 0x18, 0xef,
+	0xc8, 0x95, 0xcb, 0x29, 0xf3, 0x0c, 0x8e, 0x0f, 0x75, 0x94, 0xe5, 0xad, 0x62, 0x9d, 0xb2, 0x78,
+	0x79, 0xab, 0x8a, 0x85, 0x52, 0xe5, 0x1b, 0xcc, 0x9f, 0xd0, 0x2c, 0xfe, 0xd5, 0x32, 0xd2, 0x8a,
+	0x75, 0xcb, 0xcb, 0x58, 0x7b, 0xc5, 0x8c, 0x6d, 0x09, 0x31, 0xe0, 0x17, 0xd0, 0xf4, 0xc5, 0xfa,
+	0x39, 0x15, 0x38, 0x13, 0x6d, 0x9a, 0x22, 0x53, 0x76, 0xf7, 0xaa, 0xeb, 0x9a, 0x6b, 0x21, 0xf7,
+	0xac, 0x49, 0xeb, 0xda, 0x79, 0x65, 0x03, 0xd7, 0xdb, 0x16, 0x62, 0x3e, 0x22, 0xbe, 0x88, 0x04,
+	0x57, 0xc5, 0x23, 0x92, 0x9f, 0xdd, 0x47, 0x84, 0x64, 0xcc, 0x1d, 0xbe, 0xf8, 0xf1, 0x7c, 0x15,
+	0x6a, 0xa1, 0x54, 0x3f, 0x94, 0x83, 0xec, 0xaf, 0xc1, 0x4c, 0x0e, 0x56, 0x7a, 0x90, 0xfe, 0x5e,
+	0x18, 0x98, 0xbf, 0x2d, 0x26, 0xbb, 0xa9, 0xf6, 0xfa, 0xff, 0x00, 0x7c, 0x9d, 0xc8, 0x2f, 0x86,
+	0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -137,6 +140,12 @@ type QueryClient interface {
 	VStreamRows(ctx context.Context, in *binlogdata.VStreamRowsRequest, opts ...grpc.CallOption) (Query_VStreamRowsClient, error)
 	// VStreamResults streams results along with the gtid of the snapshot.
 	VStreamResults(ctx context.Context, in *binlogdata.VStreamResultsRequest, opts ...grpc.CallOption) (Query_VStreamResultsClient, error)
+	// ReserveExecute reserves a connection and executes the specified SQL query on it.
+	ReserveExecute(ctx context.Context, in *query.ReserveExecuteRequest, opts ...grpc.CallOption) (*query.ReserveExecuteResponse, error)
+	// ReserveBeginExecute begins a transaction on a reserved connection and executes the specified SQL query in it.
+	ReserveBeginExecute(ctx context.Context, in *query.ReserveBeginExecuteRequest, opts ...grpc.CallOption) (*query.ReserveBeginExecuteResponse, error)
+	// Release releases a reserved connection.
+	Release(ctx context.Context, in *query.ReleaseRequest, opts ...grpc.CallOption) (*query.ReleaseResponse, error)
 }
 
 type queryClient struct {
@@ -524,6 +533,33 @@ func (x *queryVStreamResultsClient) Recv() (*binlogdata.VStreamResultsResponse,
 	return m, nil
 }
 
+func (c *queryClient) ReserveExecute(ctx context.Context, in *query.ReserveExecuteRequest, opts ...grpc.CallOption) (*query.ReserveExecuteResponse, error) {
+	out := new(query.ReserveExecuteResponse)
+	err := c.cc.Invoke(ctx, "/queryservice.Query/ReserveExecute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ReserveBeginExecute(ctx context.Context, in *query.ReserveBeginExecuteRequest, opts ...grpc.CallOption) (*query.ReserveBeginExecuteResponse, error) {
+	out := new(query.ReserveBeginExecuteResponse)
+	err := c.cc.Invoke(ctx, "/queryservice.Query/ReserveBeginExecute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Release(ctx context.Context, in *query.ReleaseRequest, opts ...grpc.CallOption) (*query.ReleaseResponse, error) {
+	out := new(query.ReleaseResponse)
+	err := c.cc.Invoke(ctx, "/queryservice.Query/Release", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Execute executes the specified SQL query (might be in a
@@ -581,6 +617,12 @@ type QueryServer interface {
 	VStreamRows(*binlogdata.VStreamRowsRequest, Query_VStreamRowsServer) error
 	// VStreamResults streams results along with the gtid of the snapshot.
 	VStreamResults(*binlogdata.VStreamResultsRequest, Query_VStreamResultsServer) error
+	// ReserveExecute reserves a connection and executes the specified SQL query on it.
+	ReserveExecute(context.Context, *query.ReserveExecuteRequest) (*query.ReserveExecuteResponse, error)
+	// ReserveBeginExecute begins a transaction on a reserved connection and executes the specified SQL query in it.
+	ReserveBeginExecute(context.Context, *query.ReserveBeginExecuteRequest) (*query.ReserveBeginExecuteResponse, error)
+	// Release releases a reserved connection.
+	Release(context.Context, *query.ReleaseRequest) (*query.ReleaseResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -659,6 +701,15 @@ func (*UnimplementedQueryServer) VStreamRows(req *binlogdata.VStreamRowsRequest,
 func (*UnimplementedQueryServer) VStreamResults(req *binlogdata.VStreamResultsRequest, srv Query_VStreamResultsServer) error {
 	return status.Errorf(codes.Unimplemented, "method VStreamResults not implemented")
 }
+func (*UnimplementedQueryServer) ReserveExecute(ctx context.Context, req *query.ReserveExecuteRequest) (*query.ReserveExecuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveExecute not implemented")
+}
+func (*UnimplementedQueryServer) ReserveBeginExecute(ctx context.Context, req *query.ReserveBeginExecuteRequest) (*query.ReserveBeginExecuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveBeginExecute not implemented")
+}
+func (*UnimplementedQueryServer) Release(ctx context.Context, req *query.ReleaseRequest) (*query.ReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Release not implemented")
+}
 
 func RegisterQueryServer(s *grpc.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1117,6 +1168,60 @@ func (x *queryVStreamResultsServer) Send(m *binlogdata.VStreamResultsResponse) e
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Query_ReserveExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(query.ReserveExecuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReserveExecute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queryservice.Query/ReserveExecute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReserveExecute(ctx, req.(*query.ReserveExecuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ReserveBeginExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(query.ReserveBeginExecuteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReserveBeginExecute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queryservice.Query/ReserveBeginExecute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReserveBeginExecute(ctx, req.(*query.ReserveBeginExecuteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Release_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(query.ReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Release(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/queryservice.Query/Release",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Release(ctx, req.(*query.ReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "queryservice.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1189,6 +1294,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SplitQuery",
 			Handler:    _Query_SplitQuery_Handler,
 		},
+		{
+			MethodName: "ReserveExecute",
+			Handler:    _Query_ReserveExecute_Handler,
+		},
+		{
+			MethodName: "ReserveBeginExecute",
+			Handler:    _Query_ReserveBeginExecute_Handler,
+		},
+		{
+			MethodName: "Release",
+			Handler:    _Query_Release_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
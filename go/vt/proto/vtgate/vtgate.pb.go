@@ -139,7 +139,26 @@ type Session struct {
 	// last_insert_id keeps track of the last seen insert_id for this session
 	LastInsertId uint64 `protobuf:"varint,11,opt,name=last_insert_id,json=lastInsertId,proto3" json:"last_insert_id,omitempty"`
 	// found_rows keeps track of how many rows the last query returned
-	FoundRows            uint64   `protobuf:"varint,11,opt,name=found_rows,json=foundRows,proto3" json:"found_rows,omitempty"`
+	FoundRows uint64 `protobuf:"varint,11,opt,name=found_rows,json=foundRows,proto3" json:"found_rows,omitempty"`
+	// user_defined_variables contains the values of the user-defined
+	// variables (@var) set in the session, keyed by lower case name.
+	UserDefinedVariables map[string]*query.BindVariable `protobuf:"bytes,12,rep,name=user_defined_variables,json=userDefinedVariables,proto3" json:"user_defined_variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// savepoints contains the names of the savepoints set in the
+	// current transaction, in the order they were set.
+	Savepoints []string `protobuf:"bytes,13,rep,name=savepoints,proto3" json:"savepoints,omitempty"`
+	// in_reserved_conn is set to true if the session needs state that
+	// can't be pooled, like a temporary table or a named lock. The
+	// queries of such a session are executed on reserved connections.
+	InReservedConn bool `protobuf:"varint,14,opt,name=in_reserved_conn,json=inReservedConn,proto3" json:"in_reserved_conn,omitempty"`
+	// system_variables contains the values of the system variables
+	// set in the session, which are set again on every new reserved
+	// connection, keyed by lower case name.
+	SystemVariables map[string]string `protobuf:"bytes,15,rep,name=system_variables,json=systemVariables,proto3" json:"system_variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// unreplayable_state is set to true if the reserved connections
+	// hold state that can't be restored on new connections, like a
+	// temporary table or a named lock. The loss of a reserved
+	// connection can then not be recovered from.
+	UnreplayableState    bool     `protobuf:"varint,16,opt,name=unreplayable_state,json=unreplayableState,proto3" json:"unreplayable_state,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -247,12 +266,51 @@ func (m *Session) GetLastInsertId() uint64 {
 	return 0
 }
 
+func (m *Session) GetUserDefinedVariables() map[string]*query.BindVariable {
+	if m != nil {
+		return m.UserDefinedVariables
+	}
+	return nil
+}
+
+func (m *Session) GetSavepoints() []string {
+	if m != nil {
+		return m.Savepoints
+	}
+	return nil
+}
+
+func (m *Session) GetInReservedConn() bool {
+	if m != nil {
+		return m.InReservedConn
+	}
+	return false
+}
+
+func (m *Session) GetSystemVariables() map[string]string {
+	if m != nil {
+		return m.SystemVariables
+	}
+	return nil
+}
+
+func (m *Session) GetUnreplayableState() bool {
+	if m != nil {
+		return m.UnreplayableState
+	}
+	return false
+}
+
 type Session_ShardSession struct {
-	Target               *query.Target `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
-	TransactionId        int64         `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Target        *query.Target `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	TransactionId int64         `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	// reserved_id is set if a connection is reserved for the
+	// session on the shard. It's equal to transaction_id if a
+	// transaction is open on the reserved connection.
+	ReservedId           int64    `protobuf:"varint,3,opt,name=reserved_id,json=reservedId,proto3" json:"reserved_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Session_ShardSession) Reset()         { *m = Session_ShardSession{} }
@@ -294,6 +352,13 @@ func (m *Session_ShardSession) GetTransactionId() int64 {
 	return 0
 }
 
+func (m *Session_ShardSession) GetReservedId() int64 {
+	if m != nil {
+		return m.ReservedId
+	}
+	return 0
+}
+
 // ExecuteRequest is the payload to Execute.
 type ExecuteRequest struct {
 	// caller_id identifies the caller. This is the effective caller ID,
@@ -3642,6 +3707,8 @@ func init() {
 	proto.RegisterEnum("vtgate.CommitOrder", CommitOrder_name, CommitOrder_value)
 	proto.RegisterType((*Session)(nil), "vtgate.Session")
 	proto.RegisterType((*Session_ShardSession)(nil), "vtgate.Session.ShardSession")
+	proto.RegisterMapType((map[string]*query.BindVariable)(nil), "vtgate.Session.UserDefinedVariablesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "vtgate.Session.SystemVariablesEntry")
 	proto.RegisterType((*ExecuteRequest)(nil), "vtgate.ExecuteRequest")
 	proto.RegisterType((*ExecuteResponse)(nil), "vtgate.ExecuteResponse")
 	proto.RegisterType((*ExecuteShardsRequest)(nil), "vtgate.ExecuteShardsRequest")
@@ -3697,135 +3764,147 @@ func init() {
 func init() { proto.RegisterFile("vtgate.proto", fileDescriptor_aab96496ceaf1ebb) }
 
 var fileDescriptor_aab96496ceaf1ebb = []byte{
-	// 2067 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x8f, 0x23, 0x47,
-	0x15, 0x4f, 0x77, 0xfb, 0xf3, 0xf9, 0x73, 0x6b, 0xbc, 0xbb, 0x8e, 0x33, 0xec, 0x38, 0x9d, 0x8c,
-	0xd6, 0xd9, 0xac, 0x3c, 0xc4, 0x81, 0x80, 0x50, 0x50, 0x98, 0xf1, 0x4e, 0x56, 0x56, 0x76, 0x3e,
-	0x28, 0x7b, 0x67, 0x01, 0x11, 0xb5, 0x7a, 0xec, 0xc2, 0xdb, 0x8c, 0xdd, 0xed, 0x74, 0x95, 0xbd,
-	0x0c, 0x07, 0x94, 0x0b, 0xe7, 0x88, 0x03, 0x12, 0x8a, 0x90, 0x10, 0x12, 0x12, 0x27, 0xae, 0x48,
-	0xc0, 0x85, 0x1b, 0x12, 0x17, 0xc4, 0x89, 0x3b, 0xff, 0x00, 0x12, 0x7f, 0x41, 0xd4, 0x55, 0xd5,
-	0x1f, 0xf6, 0x7c, 0x79, 0x3c, 0x3b, 0x23, 0xef, 0xc5, 0xea, 0xaa, 0xf7, 0xea, 0xd5, 0xab, 0xdf,
-	0xfb, 0xd5, 0xab, 0xd7, 0xe5, 0x86, 0xec, 0x84, 0xf5, 0x4d, 0x46, 0xea, 0x23, 0xd7, 0x61, 0x0e,
-	0x4a, 0x88, 0x56, 0xa5, 0x78, 0x68, 0xd9, 0x03, 0xa7, 0xdf, 0x33, 0x99, 0x29, 0x24, 0x95, 0xcc,
-	0x67, 0x63, 0xe2, 0x1e, 0xcb, 0x46, 0x9e, 0x39, 0x23, 0x27, 0x2a, 0x9c, 0x30, 0x77, 0xd4, 0x15,
-	0x0d, 0xfd, 0x97, 0x71, 0x48, 0xb6, 0x09, 0xa5, 0x96, 0x63, 0xa3, 0x75, 0xc8, 0x5b, 0xb6, 0xc1,
-	0x5c, 0xd3, 0xa6, 0x66, 0x97, 0x59, 0x8e, 0x5d, 0x56, 0xaa, 0x4a, 0x2d, 0x85, 0x73, 0x96, 0xdd,
-	0x09, 0x3b, 0x51, 0x13, 0xf2, 0xf4, 0xb9, 0xe9, 0xf6, 0x0c, 0x2a, 0xc6, 0xd1, 0xb2, 0x5a, 0xd5,
-	0x6a, 0x99, 0xc6, 0x6a, 0x5d, 0x7a, 0x27, 0xed, 0xd5, 0xdb, 0x9e, 0x96, 0x6c, 0xe0, 0x1c, 0x8d,
-	0xb4, 0x28, 0x7a, 0x03, 0xd2, 0xd4, 0xb2, 0xfb, 0x03, 0x62, 0xf4, 0x0e, 0xcb, 0x1a, 0x9f, 0x26,
-	0x25, 0x3a, 0x1e, 0x1d, 0xa2, 0x7b, 0x00, 0xe6, 0x98, 0x39, 0x5d, 0x67, 0x38, 0xb4, 0x58, 0x39,
-	0xc6, 0xa5, 0x91, 0x1e, 0xf4, 0x16, 0xe4, 0x98, 0xe9, 0xf6, 0x09, 0x33, 0x28, 0x73, 0x2d, 0xbb,
-	0x5f, 0x8e, 0x57, 0x95, 0x5a, 0x1a, 0x67, 0x45, 0x67, 0x9b, 0xf7, 0xa1, 0x0d, 0x48, 0x3a, 0x23,
-	0xc6, 0xfd, 0x4b, 0x54, 0x95, 0x5a, 0xa6, 0x71, 0xbb, 0x2e, 0x50, 0xd9, 0xfe, 0x19, 0xe9, 0x8e,
-	0x19, 0xd9, 0x13, 0x42, 0xec, 0x6b, 0xa1, 0x2d, 0x28, 0x46, 0xd6, 0x6e, 0x0c, 0x9d, 0x1e, 0x29,
-	0x27, 0xab, 0x4a, 0x2d, 0xdf, 0xb8, 0xeb, 0xaf, 0x2c, 0x02, 0xc3, 0x8e, 0xd3, 0x23, 0xb8, 0xc0,
-	0xa6, 0x3b, 0xd0, 0x06, 0xa4, 0x5e, 0x98, 0xae, 0x6d, 0xd9, 0x7d, 0x5a, 0x4e, 0x71, 0x54, 0x56,
-	0xe4, 0xac, 0xdf, 0xf7, 0x7e, 0x9f, 0x09, 0x19, 0x0e, 0x94, 0xd0, 0x47, 0x90, 0x1d, 0xb9, 0x24,
-	0x84, 0x32, 0x3d, 0x07, 0x94, 0x99, 0x91, 0x4b, 0x02, 0x20, 0x37, 0x21, 0x37, 0x72, 0x28, 0x0b,
-	0x2d, 0xc0, 0x1c, 0x16, 0xb2, 0xde, 0x90, 0xc0, 0xc4, 0xdb, 0x90, 0x1f, 0x98, 0x94, 0x19, 0x96,
-	0x4d, 0x89, 0xcb, 0x0c, 0xab, 0x57, 0xce, 0x54, 0x95, 0x5a, 0x0c, 0x67, 0xbd, 0xde, 0x16, 0xef,
-	0x6c, 0xf5, 0x2a, 0x3f, 0x86, 0x6c, 0xd4, 0x06, 0x5a, 0x87, 0x84, 0xc0, 0x9b, 0xb3, 0x24, 0xd3,
-	0xc8, 0xc9, 0x85, 0x76, 0x78, 0x27, 0x96, 0x42, 0x8f, 0x54, 0x51, 0x54, 0xad, 0x5e, 0x59, 0xad,
-	0x2a, 0x35, 0x0d, 0xe7, 0x22, 0xbd, 0xad, 0x9e, 0xfe, 0x2f, 0x15, 0xf2, 0x32, 0x30, 0x98, 0x7c,
-	0x36, 0x26, 0x94, 0xa1, 0x87, 0x90, 0xee, 0x9a, 0x83, 0x01, 0x71, 0xbd, 0x41, 0x62, 0x8e, 0x42,
-	0x5d, 0x70, 0xb7, 0xc9, 0xfb, 0x5b, 0x8f, 0x70, 0x4a, 0x68, 0xb4, 0x7a, 0xe8, 0x1d, 0x48, 0x4a,
-	0x08, 0xf8, 0x04, 0x42, 0x37, 0x8a, 0x00, 0xf6, 0xe5, 0xe8, 0x3e, 0xc4, 0xb9, 0xab, 0x9c, 0x77,
-	0x99, 0xc6, 0x2d, 0xe9, 0xf8, 0x96, 0x33, 0xb6, 0x7b, 0x3c, 0x4c, 0x58, 0xc8, 0xd1, 0x37, 0x21,
-	0xc3, 0xcc, 0xc3, 0x01, 0x61, 0x06, 0x3b, 0x1e, 0x11, 0x4e, 0xc4, 0x7c, 0xa3, 0x54, 0x0f, 0xf6,
-	0x53, 0x87, 0x0b, 0x3b, 0xc7, 0x23, 0x82, 0x81, 0x05, 0xcf, 0xe8, 0x21, 0x20, 0xdb, 0xf1, 0xe0,
-	0x9c, 0xda, 0x4b, 0x71, 0x4e, 0xe3, 0xa2, 0xed, 0xb0, 0xd6, 0xd4, 0x76, 0x5a, 0x87, 0xfc, 0x11,
-	0x39, 0xa6, 0x23, 0xb3, 0x4b, 0x0c, 0xbe, 0x47, 0x38, 0x5d, 0xd3, 0x38, 0xe7, 0xf7, 0x72, 0xd4,
-	0xa3, 0x74, 0x4e, 0xce, 0x43, 0x67, 0xfd, 0x0b, 0x05, 0x0a, 0x01, 0xa2, 0x74, 0xe4, 0xd8, 0x94,
-	0xa0, 0x75, 0x88, 0x13, 0xd7, 0x75, 0xdc, 0x19, 0x38, 0xf1, 0x7e, 0x73, 0xdb, 0xeb, 0xc6, 0x42,
-	0x7a, 0x19, 0x2c, 0x1f, 0x40, 0xc2, 0x25, 0x74, 0x3c, 0x60, 0x12, 0x4c, 0x14, 0xa5, 0x3b, 0xe6,
-	0x12, 0x2c, 0x35, 0xf4, 0xff, 0xaa, 0x50, 0x92, 0x1e, 0xf1, 0x35, 0xd1, 0xe5, 0x89, 0x74, 0x05,
-	0x52, 0x3e, 0xdc, 0x3c, 0xcc, 0x69, 0x1c, 0xb4, 0xd1, 0x1d, 0x48, 0xf0, 0xb8, 0xd0, 0x72, 0xbc,
-	0xaa, 0xd5, 0xd2, 0x58, 0xb6, 0x66, 0xd9, 0x91, 0xb8, 0x12, 0x3b, 0x92, 0x67, 0xb0, 0x23, 0x12,
-	0xf6, 0xd4, 0x5c, 0x61, 0xff, 0xb5, 0x02, 0xb7, 0x67, 0x40, 0x5e, 0x8a, 0xe0, 0xff, 0x5f, 0x85,
-	0xd7, 0xa5, 0x5f, 0x9f, 0x48, 0x64, 0x5b, 0xaf, 0x0a, 0x03, 0xde, 0x84, 0x6c, 0xb0, 0x45, 0x2d,
-	0xc9, 0x83, 0x2c, 0xce, 0x1c, 0x85, 0xeb, 0x58, 0x52, 0x32, 0x7c, 0xa9, 0x40, 0xe5, 0x34, 0xd0,
-	0x97, 0x82, 0x11, 0x9f, 0x6b, 0x70, 0x37, 0x74, 0x0e, 0x9b, 0x76, 0x9f, 0xbc, 0x22, 0x7c, 0x78,
-	0x0f, 0xe0, 0x88, 0x1c, 0x1b, 0x2e, 0x77, 0x99, 0xb3, 0xc1, 0x5b, 0x69, 0x10, 0x6b, 0x7f, 0x35,
-	0x38, 0x7d, 0xe4, 0xaf, 0x6b, 0x49, 0xf9, 0xf1, 0x1b, 0x05, 0xca, 0x27, 0x43, 0xb0, 0x14, 0xec,
-	0xf8, 0x4b, 0x2c, 0x60, 0xc7, 0xb6, 0xcd, 0x2c, 0x76, 0xfc, 0xca, 0x64, 0x8b, 0x87, 0x80, 0x08,
-	0xf7, 0xd8, 0xe8, 0x3a, 0x83, 0xf1, 0xd0, 0x36, 0x6c, 0x73, 0x48, 0x64, 0x89, 0x5a, 0x14, 0x92,
-	0x26, 0x17, 0xec, 0x9a, 0x43, 0x82, 0x7e, 0x00, 0x2b, 0x52, 0x7b, 0x2a, 0xc5, 0x24, 0x38, 0xa9,
-	0x6a, 0xbe, 0xa7, 0x67, 0x20, 0x51, 0xf7, 0x3b, 0xf0, 0x2d, 0x61, 0xe4, 0x93, 0xb3, 0x53, 0x52,
-	0xf2, 0x4a, 0x94, 0x4b, 0x5d, 0x4c, 0xb9, 0xf4, 0x3c, 0x94, 0xab, 0x1c, 0x42, 0xca, 0x77, 0x1a,
-	0xad, 0x41, 0x8c, 0xbb, 0xa6, 0x70, 0xd7, 0x32, 0x7e, 0x01, 0xe9, 0x79, 0xc4, 0x05, 0xa8, 0x04,
-	0xf1, 0x89, 0x39, 0x18, 0x13, 0x1e, 0xb8, 0x2c, 0x16, 0x0d, 0xb4, 0x06, 0x99, 0x08, 0x56, 0x3c,
-	0x56, 0x59, 0x0c, 0x61, 0x36, 0x8e, 0xd2, 0x3a, 0x82, 0xd8, 0x52, 0xd0, 0xfa, 0xdf, 0x2a, 0xac,
-	0x48, 0xd7, 0xb6, 0x4c, 0xd6, 0x7d, 0x7e, 0xed, 0x94, 0x7e, 0x17, 0x92, 0x9e, 0x37, 0x16, 0xa1,
-	0x65, 0x8d, 0x73, 0xea, 0x14, 0x52, 0xfb, 0x1a, 0x8b, 0x16, 0xbc, 0xeb, 0x90, 0x37, 0xe9, 0x29,
-	0xc5, 0x6e, 0xce, 0xa4, 0x37, 0x51, 0xe9, 0x7e, 0xa9, 0x04, 0x75, 0xa5, 0xc4, 0xf4, 0xda, 0x42,
-	0xfd, 0x75, 0x48, 0x8a, 0x40, 0xfa, 0x68, 0xde, 0x91, 0xbe, 0x89, 0x30, 0x3f, 0xb3, 0xd8, 0x73,
-	0x61, 0xda, 0x57, 0xd3, 0x6d, 0x28, 0x70, 0xa4, 0xf9, 0xda, 0x38, 0xdc, 0x61, 0x96, 0x51, 0x2e,
-	0x91, 0x65, 0xd4, 0x33, 0xab, 0x52, 0x2d, 0x5a, 0x95, 0xea, 0x7f, 0x0e, 0xeb, 0x2c, 0x0e, 0xc6,
-	0x0d, 0x55, 0xda, 0xef, 0xcd, 0xd2, 0x2c, 0x78, 0x67, 0x9e, 0x59, 0xfd, 0x4d, 0x91, 0xed, 0xb2,
-	0xaf, 0xff, 0xfa, 0x6f, 0xc3, 0x5a, 0x69, 0x0a, 0xb8, 0x6b, 0xe3, 0xd2, 0xc3, 0x59, 0x2e, 0x9d,
-	0x96, 0x37, 0x02, 0x1e, 0xfd, 0x02, 0x4a, 0x1c, 0xc9, 0x30, 0xc3, 0xbf, 0x44, 0x32, 0xcd, 0x16,
-	0xb8, 0xda, 0x89, 0x02, 0x57, 0xff, 0xbb, 0x0a, 0xf7, 0xa2, 0xf0, 0xdc, 0x64, 0x11, 0xff, 0xc1,
-	0x2c, 0xb9, 0x56, 0xa7, 0xc8, 0x35, 0x03, 0xc9, 0xd2, 0x32, 0xec, 0xf7, 0x0a, 0xac, 0x9d, 0x09,
-	0xe1, 0x92, 0xd0, 0xec, 0x8f, 0x2a, 0x94, 0xda, 0xcc, 0x25, 0xe6, 0xf0, 0x4a, 0xb7, 0x31, 0x01,
-	0x2b, 0xd5, 0xcb, 0x5d, 0xb1, 0x68, 0xf3, 0x87, 0x68, 0xe6, 0x28, 0x89, 0x5d, 0x70, 0x94, 0xc4,
-	0xe7, 0xba, 0x03, 0x8c, 0xe0, 0x9a, 0x38, 0x1f, 0x57, 0xbd, 0x09, 0xb7, 0x67, 0x80, 0x92, 0x21,
-	0x0c, 0xcb, 0x01, 0xe5, 0xc2, 0x72, 0xe0, 0x0b, 0x15, 0x2a, 0x53, 0x56, 0xae, 0x92, 0xae, 0xe7,
-	0x06, 0x3d, 0x9a, 0x0a, 0xb4, 0x33, 0xcf, 0x95, 0xd8, 0x79, 0xb7, 0x1d, 0xf1, 0x39, 0x03, 0x75,
-	0xe9, 0x4d, 0xd2, 0x82, 0x37, 0x4e, 0x05, 0x64, 0x01, 0x70, 0x7f, 0xa7, 0xc2, 0xda, 0x94, 0xad,
-	0x2b, 0xe7, 0xac, 0x97, 0x82, 0xf0, 0x6c, 0xb2, 0x8d, 0x5d, 0x78, 0x9b, 0x70, 0x6d, 0x60, 0xef,
-	0x42, 0xf5, 0x6c, 0x80, 0x16, 0x40, 0xfc, 0x4f, 0x2a, 0x7c, 0x6d, 0xd6, 0xe0, 0x55, 0x5e, 0xec,
-	0x5f, 0x0a, 0xde, 0xd3, 0x6f, 0xeb, 0xb1, 0x05, 0xde, 0xd6, 0xaf, 0x0d, 0xff, 0x27, 0x70, 0xef,
-	0x2c, 0xb8, 0x16, 0x40, 0xff, 0x87, 0x90, 0xdd, 0x22, 0x7d, 0xcb, 0x5e, 0x0c, 0xeb, 0xa9, 0x7f,
-	0x64, 0xd4, 0xe9, 0x7f, 0x64, 0xf4, 0xef, 0x40, 0x4e, 0x9a, 0x96, 0x7e, 0x45, 0x12, 0xa5, 0x72,
-	0x41, 0xa2, 0xfc, 0x5c, 0x81, 0x5c, 0x93, 0xff, 0x71, 0x73, 0xed, 0x85, 0xc2, 0x1d, 0x48, 0x98,
-	0xcc, 0x19, 0x5a, 0x5d, 0xf9, 0x97, 0x92, 0x6c, 0xe9, 0x45, 0xc8, 0xfb, 0x1e, 0x08, 0xff, 0xf5,
-	0x9f, 0x42, 0x01, 0x3b, 0x83, 0xc1, 0xa1, 0xd9, 0x3d, 0xba, 0x6e, 0xaf, 0x74, 0x04, 0xc5, 0x70,
-	0x2e, 0x39, 0xff, 0xa7, 0xf0, 0x3a, 0x26, 0xd4, 0x19, 0x4c, 0x48, 0xa4, 0xa4, 0x58, 0xcc, 0x13,
-	0x04, 0xb1, 0x1e, 0x93, 0xff, 0xab, 0xa4, 0x31, 0x7f, 0xd6, 0xff, 0xa6, 0x40, 0x69, 0x87, 0x50,
-	0x6a, 0xf6, 0x89, 0x20, 0xd8, 0x62, 0xa6, 0xcf, 0xab, 0x19, 0x4b, 0x10, 0x17, 0x27, 0xaf, 0xd8,
-	0x6f, 0xa2, 0x81, 0x36, 0x20, 0x1d, 0x6c, 0x36, 0x7e, 0x26, 0x9f, 0xbe, 0xd7, 0x52, 0xfe, 0x5e,
-	0xf3, 0xbc, 0x8f, 0xdc, 0x8f, 0xf0, 0x67, 0xfd, 0x57, 0x0a, 0xdc, 0x92, 0xde, 0x6f, 0x2e, 0x1a,
-	0x9f, 0xf3, 0x5c, 0xf7, 0xe7, 0xd4, 0xc2, 0x39, 0xd1, 0x3d, 0xd0, 0xfc, 0x64, 0x9c, 0x69, 0x64,
-	0xe5, 0x2e, 0x3b, 0x30, 0x07, 0x63, 0x82, 0x3d, 0x81, 0xbe, 0x03, 0xd9, 0x56, 0xa4, 0xd2, 0x44,
-	0xab, 0xa0, 0x06, 0x6e, 0x4c, 0xab, 0xab, 0x56, 0x6f, 0xf6, 0x8a, 0x42, 0x3d, 0x71, 0x45, 0xf1,
-	0x57, 0x05, 0x56, 0xc3, 0x25, 0x5e, 0xf9, 0x60, 0xba, 0xec, 0x6a, 0x3f, 0x84, 0x82, 0xd5, 0x33,
-	0x4e, 0x1c, 0x43, 0x99, 0x46, 0xc9, 0x67, 0x71, 0x74, 0xb1, 0x38, 0x67, 0x45, 0x5a, 0x54, 0x5f,
-	0x85, 0xca, 0x69, 0xe4, 0x95, 0xd4, 0xfe, 0x9f, 0x0a, 0xb7, 0xda, 0xa3, 0x81, 0xc5, 0x64, 0x8e,
-	0x7a, 0xd9, 0xeb, 0x99, 0xfb, 0x92, 0xee, 0x4d, 0xc8, 0x52, 0xcf, 0x0f, 0x79, 0x0f, 0x27, 0x0b,
-	0x9a, 0x0c, 0xef, 0x13, 0x37, 0x70, 0x5e, 0x9c, 0x7c, 0x95, 0xb1, 0xcd, 0x38, 0x09, 0x35, 0x0c,
-	0x52, 0x63, 0x6c, 0x33, 0xf4, 0x0d, 0xb8, 0x6b, 0x8f, 0x87, 0x86, 0xeb, 0xbc, 0xa0, 0xc6, 0x88,
-	0xb8, 0x06, 0xb7, 0x6c, 0x8c, 0x4c, 0x97, 0xf1, 0x14, 0xaf, 0xe1, 0x15, 0x7b, 0x3c, 0xc4, 0xce,
-	0x0b, 0xba, 0x4f, 0x5c, 0x3e, 0xf9, 0xbe, 0xe9, 0x32, 0xf4, 0x3d, 0x48, 0x9b, 0x83, 0xbe, 0xe3,
-	0x5a, 0xec, 0xf9, 0x50, 0x5e, 0xbc, 0xe9, 0xd2, 0xcd, 0x13, 0xc8, 0xd4, 0x37, 0x7d, 0x4d, 0x1c,
-	0x0e, 0x42, 0xef, 0x02, 0x1a, 0x53, 0x62, 0x08, 0xe7, 0xc4, 0xa4, 0x93, 0x86, 0xbc, 0x85, 0x2b,
-	0x8c, 0x29, 0x09, 0xcd, 0x1c, 0x34, 0xf4, 0x7f, 0x68, 0x80, 0xa2, 0x76, 0x65, 0x8e, 0xfe, 0x16,
-	0x24, 0xf8, 0x78, 0x5a, 0x56, 0x78, 0x6c, 0xd7, 0x82, 0x0c, 0x75, 0x42, 0xb7, 0xee, 0xb9, 0x8d,
-	0xa5, 0x7a, 0xe5, 0x53, 0xc8, 0xfa, 0x3b, 0x95, 0x2f, 0x27, 0x1a, 0x0d, 0xe5, 0xdc, 0xd3, 0x55,
-	0x9d, 0xe3, 0x74, 0xad, 0x7c, 0x04, 0x69, 0x5e, 0xd5, 0x5d, 0x68, 0x3b, 0xac, 0x45, 0xd5, 0x68,
-	0x2d, 0x5a, 0xf9, 0x8f, 0x02, 0x31, 0x3e, 0x78, 0xee, 0x97, 0xdf, 0x1d, 0xfe, 0xbe, 0x20, 0xbc,
-	0x14, 0xd1, 0x13, 0x49, 0xfb, 0xfe, 0x39, 0x90, 0x44, 0x21, 0xc0, 0xd9, 0xa3, 0x28, 0x20, 0x4d,
-	0x00, 0xf1, 0x09, 0x04, 0x37, 0x25, 0x78, 0xf8, 0xf6, 0x39, 0xa6, 0x82, 0xe5, 0xe2, 0x34, 0x0d,
-	0x56, 0x8e, 0x20, 0x46, 0xad, 0x9f, 0x8b, 0x2c, 0xa9, 0x61, 0xfe, 0xac, 0xbf, 0x0f, 0xb7, 0x1f,
-	0x13, 0xd6, 0x76, 0x27, 0xfe, 0x76, 0xf3, 0xb7, 0xcf, 0x39, 0x30, 0xe9, 0x18, 0xee, 0xcc, 0x0e,
-	0x92, 0x0c, 0xf8, 0x36, 0x64, 0xa9, 0x3b, 0x31, 0xa6, 0x46, 0x7a, 0x55, 0x49, 0x10, 0x9e, 0xe8,
-	0xa0, 0x0c, 0x0d, 0x1b, 0xfa, 0x3f, 0x15, 0xc8, 0x1f, 0x5c, 0xe5, 0xe8, 0x98, 0x29, 0xa1, 0xd4,
-	0x39, 0x4b, 0xa8, 0xfb, 0x10, 0x9f, 0xf4, 0x99, 0xbc, 0xd5, 0xf5, 0x22, 0x1a, 0xf9, 0xb6, 0xe5,
-	0xe0, 0x31, 0xb3, 0x7a, 0x58, 0xc8, 0xbd, 0xc2, 0xe8, 0x27, 0xd6, 0x80, 0x11, 0x37, 0x38, 0x65,
-	0x22, 0x9a, 0x1f, 0x73, 0x09, 0x96, 0x1a, 0xfa, 0x77, 0xa1, 0x10, 0xac, 0x25, 0xac, 0xab, 0xc8,
-	0x84, 0xd8, 0xc1, 0xde, 0x98, 0x1a, 0x7e, 0xb0, 0xed, 0x89, 0xb0, 0xd4, 0xd0, 0xff, 0xa0, 0xc2,
-	0xca, 0xd3, 0x51, 0xcf, 0x64, 0xcb, 0x7e, 0x96, 0x2e, 0x58, 0xb6, 0xae, 0x42, 0x9a, 0x59, 0x43,
-	0x42, 0x99, 0x39, 0x1c, 0xc9, 0xac, 0x16, 0x76, 0x78, 0x11, 0xe1, 0x38, 0xc8, 0xcb, 0x58, 0x7f,
-	0x8f, 0x71, 0x88, 0x3a, 0xce, 0x11, 0xb1, 0xb1, 0x90, 0xeb, 0x47, 0x50, 0x9a, 0x46, 0x49, 0x42,
-	0x5d, 0xf3, 0x0d, 0x4c, 0x57, 0xb0, 0xb2, 0xf0, 0xe5, 0x48, 0x0b, 0x05, 0xf4, 0x0e, 0x14, 0xbd,
-	0x52, 0x76, 0x48, 0x8c, 0xd0, 0x1f, 0xf1, 0xb5, 0x48, 0x41, 0xf4, 0x77, 0xfc, 0xee, 0x07, 0x8f,
-	0xa0, 0x30, 0xf3, 0x31, 0x0e, 0x2a, 0x40, 0xe6, 0xe9, 0x6e, 0x7b, 0x7f, 0xbb, 0xd9, 0xfa, 0xb8,
-	0xb5, 0xfd, 0xa8, 0xf8, 0x1a, 0x02, 0x48, 0xb4, 0x5b, 0xbb, 0x8f, 0x9f, 0x6c, 0x17, 0x15, 0x94,
-	0x86, 0xf8, 0xce, 0xd3, 0x27, 0x9d, 0x56, 0x51, 0xf5, 0x1e, 0x3b, 0xcf, 0xf6, 0xf6, 0x9b, 0x45,
-	0xed, 0xc1, 0x87, 0x90, 0x11, 0x75, 0xe1, 0x9e, 0xdb, 0x23, 0xae, 0x37, 0x60, 0x77, 0x0f, 0xef,
-	0x6c, 0x3e, 0x29, 0xbe, 0x86, 0x92, 0xa0, 0xed, 0x63, 0x6f, 0x64, 0x0a, 0x62, 0xfb, 0x7b, 0xed,
-	0x4e, 0x51, 0x45, 0x79, 0x80, 0xcd, 0xa7, 0x9d, 0xbd, 0xe6, 0xde, 0xce, 0x4e, 0xab, 0x53, 0xd4,
-	0xb6, 0x3e, 0x80, 0x82, 0xe5, 0xd4, 0x27, 0x16, 0x23, 0x94, 0x8a, 0xcf, 0xa9, 0x7e, 0xf4, 0x96,
-	0x6c, 0x59, 0xce, 0x86, 0x78, 0xda, 0xe8, 0x3b, 0x1b, 0x13, 0xb6, 0xc1, 0xa5, 0x1b, 0x22, 0x41,
-	0x1c, 0x26, 0x78, 0xeb, 0xfd, 0xaf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x4b, 0x03, 0xdd, 0xba, 0xce,
-	0x25, 0x00, 0x00,
+	// 2258 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x8f, 0x1b, 0x49,
+	0x15, 0xdf, 0xee, 0xf6, 0xe7, 0xf3, 0x67, 0x2a, 0x4e, 0xb6, 0xe3, 0x1d, 0x92, 0xd9, 0xde, 0x44,
+	0x71, 0xb2, 0x61, 0x86, 0x9d, 0x85, 0x65, 0xb5, 0x5a, 0xb4, 0x64, 0x9c, 0xd9, 0xc8, 0xda, 0x4c,
+	0x66, 0xa8, 0x71, 0x26, 0x80, 0x58, 0xb5, 0x7a, 0xdc, 0xb5, 0x4e, 0x33, 0x76, 0xb7, 0xb7, 0xab,
+	0xec, 0x60, 0x24, 0xd0, 0xfe, 0x07, 0x2b, 0x0e, 0x48, 0x68, 0x85, 0x84, 0x90, 0x90, 0x38, 0x71,
+	0x45, 0x02, 0x2e, 0xdc, 0x90, 0xb8, 0x20, 0x4e, 0xdc, 0xf9, 0x07, 0x90, 0xe0, 0x1f, 0x40, 0x5d,
+	0x55, 0xfd, 0xe1, 0x9e, 0x2f, 0xcf, 0x4c, 0x26, 0x72, 0x2e, 0x56, 0xd7, 0x7b, 0xaf, 0x5e, 0xbd,
+	0xfa, 0xbd, 0x5f, 0xbd, 0x7e, 0x2e, 0x1b, 0xca, 0x13, 0xd6, 0xb7, 0x18, 0x59, 0x19, 0xf9, 0x1e,
+	0xf3, 0x50, 0x4e, 0x8c, 0x9a, 0xf5, 0x3d, 0xc7, 0x1d, 0x78, 0x7d, 0xdb, 0x62, 0x96, 0xd0, 0x34,
+	0x4b, 0x9f, 0x8f, 0x89, 0x3f, 0x95, 0x83, 0x2a, 0xf3, 0x46, 0x5e, 0x52, 0x39, 0x61, 0xfe, 0xa8,
+	0x27, 0x06, 0xc6, 0xff, 0x0a, 0x90, 0xdf, 0x21, 0x94, 0x3a, 0x9e, 0x8b, 0x6e, 0x41, 0xd5, 0x71,
+	0x4d, 0xe6, 0x5b, 0x2e, 0xb5, 0x7a, 0xcc, 0xf1, 0x5c, 0x5d, 0x59, 0x56, 0x5a, 0x05, 0x5c, 0x71,
+	0xdc, 0x6e, 0x2c, 0x44, 0x6d, 0xa8, 0xd2, 0x67, 0x96, 0x6f, 0x9b, 0x54, 0xcc, 0xa3, 0xba, 0xba,
+	0xac, 0xb5, 0x4a, 0x6b, 0x4b, 0x2b, 0x32, 0x3a, 0xe9, 0x6f, 0x65, 0x27, 0xb0, 0x92, 0x03, 0x5c,
+	0xa1, 0x89, 0x11, 0x45, 0x6f, 0x40, 0x91, 0x3a, 0x6e, 0x7f, 0x40, 0x4c, 0x7b, 0x4f, 0xd7, 0xf8,
+	0x32, 0x05, 0x21, 0x78, 0xb0, 0x87, 0xae, 0x03, 0x58, 0x63, 0xe6, 0xf5, 0xbc, 0xe1, 0xd0, 0x61,
+	0x7a, 0x86, 0x6b, 0x13, 0x12, 0xf4, 0x16, 0x54, 0x98, 0xe5, 0xf7, 0x09, 0x33, 0x29, 0xf3, 0x1d,
+	0xb7, 0xaf, 0x67, 0x97, 0x95, 0x56, 0x11, 0x97, 0x85, 0x70, 0x87, 0xcb, 0xd0, 0x2a, 0xe4, 0xbd,
+	0x11, 0xe3, 0xf1, 0xe5, 0x96, 0x95, 0x56, 0x69, 0xed, 0xca, 0x8a, 0x40, 0x65, 0xe3, 0x27, 0xa4,
+	0x37, 0x66, 0x64, 0x4b, 0x28, 0x71, 0x68, 0x85, 0xd6, 0xa1, 0x9e, 0xd8, 0xbb, 0x39, 0xf4, 0x6c,
+	0xa2, 0xe7, 0x97, 0x95, 0x56, 0x75, 0xed, 0xf5, 0x70, 0x67, 0x09, 0x18, 0x36, 0x3d, 0x9b, 0xe0,
+	0x1a, 0x9b, 0x15, 0xa0, 0x55, 0x28, 0x3c, 0xb7, 0x7c, 0xd7, 0x71, 0xfb, 0x54, 0x2f, 0x70, 0x54,
+	0x2e, 0xcb, 0x55, 0xbf, 0x17, 0x7c, 0x3e, 0x15, 0x3a, 0x1c, 0x19, 0xa1, 0x8f, 0xa0, 0x3c, 0xf2,
+	0x49, 0x0c, 0x65, 0x71, 0x0e, 0x28, 0x4b, 0x23, 0x9f, 0x44, 0x40, 0xde, 0x87, 0xca, 0xc8, 0xa3,
+	0x2c, 0xf6, 0x00, 0x73, 0x78, 0x28, 0x07, 0x53, 0x22, 0x17, 0x37, 0xa1, 0x3a, 0xb0, 0x28, 0x33,
+	0x1d, 0x97, 0x12, 0x9f, 0x99, 0x8e, 0xad, 0x97, 0x96, 0x95, 0x56, 0x06, 0x97, 0x03, 0x69, 0x87,
+	0x0b, 0x3b, 0x36, 0x32, 0xe1, 0xea, 0x98, 0x12, 0xdf, 0xb4, 0xc9, 0x67, 0x8e, 0x4b, 0x6c, 0x73,
+	0x62, 0xf9, 0x8e, 0xb5, 0x37, 0x20, 0x54, 0x2f, 0xf3, 0x15, 0xef, 0xa4, 0x57, 0x7c, 0x42, 0x89,
+	0xff, 0x40, 0x18, 0xef, 0x86, 0xb6, 0x1b, 0x2e, 0xf3, 0xa7, 0xb8, 0x31, 0x3e, 0x44, 0x15, 0x64,
+	0x9d, 0x5a, 0x13, 0x32, 0xf2, 0x1c, 0x97, 0x51, 0xbd, 0xb2, 0xac, 0xb5, 0x8a, 0x38, 0x21, 0x41,
+	0x2d, 0xa8, 0x3b, 0xae, 0xe9, 0x13, 0x4a, 0xfc, 0x09, 0xb1, 0xcd, 0x9e, 0xe7, 0xba, 0x7a, 0x95,
+	0x73, 0xa3, 0xea, 0xb8, 0x58, 0x8a, 0xdb, 0x9e, 0xeb, 0xa2, 0x2d, 0xa8, 0xd3, 0x29, 0x65, 0x64,
+	0x98, 0x08, 0xb2, 0xc6, 0x83, 0xbc, 0x79, 0x00, 0x16, 0x6e, 0x97, 0x8a, 0xaf, 0x46, 0x67, 0xa5,
+	0xe8, 0xeb, 0x80, 0xc6, 0xae, 0x4f, 0x46, 0x03, 0x6b, 0x1a, 0x08, 0x4c, 0xca, 0x2c, 0x46, 0xf4,
+	0x3a, 0x5f, 0xfc, 0x52, 0x52, 0xb3, 0x13, 0x28, 0x9a, 0x3f, 0x83, 0x72, 0x12, 0x6e, 0x74, 0x0b,
+	0x72, 0x82, 0x9a, 0xfc, 0x40, 0x95, 0xd6, 0x2a, 0x92, 0x13, 0x5d, 0x2e, 0xc4, 0x52, 0x19, 0x9c,
+	0xbf, 0x24, 0x01, 0x1d, 0x5b, 0x57, 0x97, 0x95, 0x96, 0x86, 0x2b, 0x09, 0x69, 0xc7, 0x46, 0x37,
+	0xa0, 0x14, 0x81, 0xe0, 0xd8, 0xfc, 0xf0, 0x68, 0x18, 0x42, 0x51, 0xc7, 0x6e, 0xfe, 0x08, 0xae,
+	0x1d, 0x89, 0x3d, 0xaa, 0x83, 0xb6, 0x4f, 0xa6, 0x3c, 0x90, 0x22, 0x0e, 0x1e, 0xd1, 0x1d, 0xc8,
+	0x4e, 0xac, 0xc1, 0x98, 0xf0, 0xd5, 0x62, 0xc2, 0xae, 0x3b, 0x6e, 0x34, 0x17, 0x0b, 0x8b, 0x0f,
+	0xd4, 0xf7, 0x95, 0xe6, 0x3a, 0x34, 0x0e, 0x03, 0xed, 0x10, 0xc7, 0x8d, 0xa4, 0xe3, 0x62, 0xc2,
+	0x87, 0xf1, 0x0f, 0x15, 0xaa, 0xf2, 0x18, 0x62, 0xf2, 0xf9, 0x98, 0x50, 0x86, 0xee, 0x41, 0xb1,
+	0x67, 0x0d, 0x06, 0xc4, 0x0f, 0xf6, 0x24, 0x60, 0xaa, 0xad, 0x88, 0x4a, 0xd5, 0xe6, 0xf2, 0xce,
+	0x03, 0x5c, 0x10, 0x16, 0x1d, 0x1b, 0xdd, 0x81, 0xbc, 0x24, 0xbc, 0xae, 0x46, 0xb6, 0xc9, 0xc4,
+	0xe2, 0x50, 0x8f, 0x6e, 0x43, 0x96, 0x6f, 0x88, 0x03, 0x55, 0x5a, 0xbb, 0x14, 0x6e, 0xcf, 0x1b,
+	0xbb, 0x36, 0x3f, 0x94, 0x58, 0xe8, 0xd1, 0xb7, 0xa0, 0xc4, 0x82, 0xfd, 0x30, 0x93, 0x4d, 0x47,
+	0x84, 0x97, 0x9d, 0xea, 0x5a, 0x63, 0x25, 0xaa, 0x9e, 0x5d, 0xae, 0xec, 0x4e, 0x47, 0x04, 0x03,
+	0x8b, 0x9e, 0xd1, 0x3d, 0x40, 0xae, 0xc7, 0xcc, 0x54, 0xe5, 0xcc, 0x72, 0x6e, 0xd4, 0x5d, 0x8f,
+	0x75, 0x66, 0x8a, 0xe7, 0x2d, 0xa8, 0xee, 0x93, 0x29, 0x1d, 0x59, 0x3d, 0x62, 0xf2, 0x8a, 0xc8,
+	0x8b, 0x53, 0x11, 0x57, 0x42, 0x29, 0x27, 0x4e, 0xb2, 0x78, 0xe5, 0xe7, 0x29, 0x5e, 0xc6, 0x97,
+	0x0a, 0xd4, 0x22, 0x44, 0xe9, 0xc8, 0x73, 0x29, 0x41, 0xb7, 0x20, 0x4b, 0x7c, 0xdf, 0xf3, 0x53,
+	0x70, 0xe2, 0xed, 0xf6, 0x46, 0x20, 0xc6, 0x42, 0x7b, 0x1a, 0x2c, 0xef, 0x42, 0xce, 0x27, 0x74,
+	0x3c, 0x60, 0x12, 0x4c, 0x94, 0x2c, 0x6e, 0x98, 0x6b, 0xb0, 0xb4, 0x30, 0xfe, 0xad, 0x42, 0x43,
+	0x46, 0xc4, 0xf7, 0x44, 0x17, 0x27, 0xd3, 0x4d, 0x28, 0x84, 0x70, 0xf3, 0x34, 0x17, 0x71, 0x34,
+	0x46, 0x57, 0x21, 0xc7, 0xf3, 0x42, 0xf5, 0x2c, 0xaf, 0x40, 0x72, 0x94, 0x66, 0x47, 0xee, 0x5c,
+	0xec, 0xc8, 0x1f, 0xc1, 0x8e, 0x44, 0xda, 0x0b, 0x73, 0xa5, 0xfd, 0x97, 0x0a, 0x5c, 0x49, 0x81,
+	0xbc, 0x10, 0xc9, 0xff, 0xaf, 0x0a, 0xd7, 0x64, 0x5c, 0x9f, 0x48, 0x64, 0x3b, 0xaf, 0x0a, 0x03,
+	0xde, 0x84, 0x72, 0x74, 0x44, 0x1d, 0xc9, 0x83, 0x32, 0x2e, 0xed, 0xc7, 0xfb, 0x58, 0x50, 0x32,
+	0x7c, 0xa5, 0x40, 0xf3, 0x30, 0xd0, 0x17, 0x82, 0x11, 0x5f, 0x68, 0xf0, 0x7a, 0x1c, 0x1c, 0xb6,
+	0xdc, 0x3e, 0x79, 0x45, 0xf8, 0xf0, 0x0e, 0xc0, 0x3e, 0x99, 0x9a, 0x3e, 0x0f, 0x99, 0xb3, 0x21,
+	0xd8, 0x69, 0x94, 0xeb, 0x70, 0x37, 0xb8, 0xb8, 0x2f, 0x9f, 0x16, 0x95, 0x1f, 0xbf, 0x52, 0x40,
+	0x3f, 0x98, 0x82, 0x85, 0x60, 0xc7, 0x9f, 0x32, 0x11, 0x3b, 0x36, 0x5c, 0xe6, 0xb0, 0xe9, 0x2b,
+	0x53, 0x2d, 0xee, 0x01, 0x22, 0x3c, 0x62, 0xb3, 0xe7, 0x0d, 0xc6, 0x43, 0xd7, 0x74, 0xad, 0x21,
+	0x91, 0x5f, 0x48, 0xea, 0x42, 0xd3, 0xe6, 0x8a, 0xc7, 0xd6, 0x90, 0xa0, 0xef, 0xc3, 0x65, 0x69,
+	0x3d, 0x53, 0x62, 0x72, 0x9c, 0x54, 0xad, 0x30, 0xd2, 0x23, 0x90, 0x58, 0x09, 0x05, 0xf8, 0x92,
+	0x70, 0xf2, 0xc9, 0xd1, 0x25, 0x29, 0x7f, 0x2e, 0xca, 0x15, 0x4e, 0xa6, 0x5c, 0x71, 0x1e, 0xca,
+	0x35, 0xf7, 0xa0, 0x10, 0x06, 0x8d, 0x6e, 0x40, 0x86, 0x87, 0xa6, 0xf0, 0xd0, 0x4a, 0x61, 0x0f,
+	0x1c, 0x44, 0xc4, 0x15, 0xb3, 0xfd, 0x62, 0x59, 0xf6, 0x8b, 0x41, 0xbb, 0x9b, 0xc0, 0x8a, 0xe7,
+	0xaa, 0x8c, 0x21, 0xae, 0xc6, 0x49, 0x5a, 0x27, 0x10, 0x5b, 0x08, 0x5a, 0xff, 0x53, 0x85, 0xcb,
+	0x32, 0xb4, 0x75, 0x8b, 0xf5, 0x9e, 0x5d, 0x38, 0xa5, 0xdf, 0x86, 0x7c, 0x10, 0x8d, 0x43, 0xa8,
+	0xae, 0x2d, 0x6b, 0x87, 0x93, 0x3a, 0xb4, 0x38, 0x6b, 0xc3, 0x7b, 0x0b, 0xaa, 0x16, 0x3d, 0xa4,
+	0xd9, 0xad, 0x58, 0xf4, 0x65, 0x74, 0xba, 0x5f, 0x29, 0xd0, 0x98, 0xc5, 0xf4, 0xc2, 0x52, 0xfd,
+	0x0d, 0xc8, 0x8b, 0x44, 0x86, 0x68, 0x5e, 0x95, 0xb1, 0x89, 0x34, 0x3f, 0x75, 0xd8, 0x33, 0xe1,
+	0x3a, 0x34, 0x33, 0x5c, 0xa8, 0x71, 0xa4, 0xf9, 0xde, 0x38, 0xdc, 0x71, 0x95, 0x51, 0x4e, 0x51,
+	0x65, 0xd4, 0x23, 0xbb, 0x52, 0x2d, 0xd9, 0x95, 0x1a, 0x7f, 0x8c, 0xfb, 0x2c, 0x0e, 0xc6, 0x4b,
+	0xea, 0xb4, 0xdf, 0x49, 0xd3, 0x2c, 0xba, 0x21, 0x49, 0xed, 0xfe, 0x65, 0x91, 0xed, 0xb4, 0x97,
+	0x3d, 0xc6, 0xaf, 0xe3, 0x5e, 0x69, 0x06, 0xb8, 0x0b, 0xe3, 0xd2, 0xbd, 0x34, 0x97, 0x0e, 0xab,
+	0x1b, 0x11, 0x8f, 0x7e, 0x0e, 0x0d, 0x8e, 0x64, 0x5c, 0xe1, 0x5f, 0x20, 0x99, 0xd2, 0x0d, 0xae,
+	0x76, 0xa0, 0xc1, 0x35, 0xfe, 0xaa, 0xc2, 0xf5, 0x24, 0x3c, 0x2f, 0xb3, 0x89, 0x7f, 0x2f, 0x4d,
+	0xae, 0xa5, 0x19, 0x72, 0xa5, 0x20, 0x59, 0x58, 0x86, 0xfd, 0x56, 0x81, 0x1b, 0x47, 0x42, 0xb8,
+	0x20, 0x34, 0xfb, 0xbd, 0x0a, 0x8d, 0x1d, 0xe6, 0x13, 0x6b, 0x78, 0xae, 0xdb, 0x98, 0x88, 0x95,
+	0xea, 0xe9, 0xae, 0x58, 0xb4, 0xf9, 0x53, 0x94, 0x7a, 0x95, 0x64, 0x4e, 0x78, 0x95, 0x64, 0xe7,
+	0xba, 0xf1, 0x4d, 0xe0, 0x9a, 0x3b, 0x1e, 0x57, 0xa3, 0x0d, 0x57, 0x52, 0x40, 0xc9, 0x14, 0xc6,
+	0xed, 0x80, 0x72, 0x62, 0x3b, 0xf0, 0xa5, 0x0a, 0xcd, 0x19, 0x2f, 0xe7, 0x29, 0xd7, 0x73, 0x83,
+	0x9e, 0x2c, 0x05, 0xda, 0x91, 0xef, 0x95, 0xcc, 0x71, 0xb7, 0x1d, 0xd9, 0x39, 0x13, 0x75, 0xea,
+	0x43, 0xd2, 0x81, 0x37, 0x0e, 0x05, 0xe4, 0x0c, 0xe0, 0xfe, 0x46, 0x85, 0x1b, 0x33, 0xbe, 0xce,
+	0x5d, 0xb3, 0x5e, 0x08, 0xc2, 0xe9, 0x62, 0x9b, 0x39, 0xf1, 0x36, 0xe1, 0xc2, 0xc0, 0x7e, 0x0c,
+	0xcb, 0x47, 0x03, 0x74, 0x06, 0xc4, 0xff, 0xa0, 0xc2, 0xd7, 0xd2, 0x0e, 0xcf, 0xf3, 0xc5, 0xfe,
+	0x85, 0xe0, 0x3d, 0xfb, 0x6d, 0x3d, 0x73, 0x86, 0x6f, 0xeb, 0x17, 0x86, 0xff, 0x23, 0xb8, 0x7e,
+	0x14, 0x5c, 0x67, 0x40, 0xff, 0x07, 0x50, 0x5e, 0x27, 0x7d, 0xc7, 0x3d, 0x1b, 0xd6, 0x33, 0xbf,
+	0xbf, 0xa9, 0xb3, 0xbf, 0xbf, 0x19, 0x1f, 0x40, 0x45, 0xba, 0x96, 0x71, 0x25, 0x0a, 0xa5, 0x72,
+	0x42, 0xa1, 0xfc, 0x42, 0x81, 0x4a, 0x9b, 0xff, 0x4c, 0x77, 0xe1, 0x8d, 0xc2, 0x55, 0xc8, 0x59,
+	0xcc, 0x1b, 0x3a, 0x3d, 0xf9, 0x03, 0xa2, 0x1c, 0x19, 0x75, 0xa8, 0x86, 0x11, 0x88, 0xf8, 0x8d,
+	0x1f, 0x43, 0x0d, 0x7b, 0x83, 0xc1, 0x9e, 0xd5, 0xdb, 0xbf, 0xe8, 0xa8, 0x0c, 0x04, 0xf5, 0x78,
+	0x2d, 0xb9, 0xfe, 0xa7, 0x70, 0x0d, 0x13, 0xea, 0x0d, 0x26, 0x24, 0xd1, 0x52, 0x9c, 0x2d, 0x12,
+	0x04, 0x19, 0x9b, 0xc9, 0x9f, 0x86, 0x8a, 0x98, 0x3f, 0x1b, 0x7f, 0x51, 0xa0, 0xb1, 0x49, 0x28,
+	0xb5, 0xfa, 0x44, 0x10, 0xec, 0x6c, 0xae, 0x8f, 0xeb, 0x19, 0x1b, 0x90, 0x15, 0x6f, 0x5e, 0x71,
+	0xde, 0xc4, 0x00, 0xad, 0x42, 0x31, 0x3a, 0x6c, 0x7a, 0x46, 0x52, 0xf6, 0xe0, 0x59, 0x2b, 0x84,
+	0x67, 0x2d, 0x88, 0x3e, 0x71, 0x3f, 0xc2, 0x9f, 0x8d, 0x5f, 0x28, 0x70, 0x49, 0x46, 0x7f, 0xbf,
+	0xb7, 0xff, 0xe2, 0x43, 0x0f, 0xd7, 0xd4, 0xe2, 0x35, 0xd1, 0x75, 0xd0, 0xc2, 0x62, 0x5c, 0x5a,
+	0x2b, 0xcb, 0x53, 0xb6, 0x6b, 0x0d, 0xc6, 0x04, 0x07, 0x0a, 0x63, 0x13, 0xca, 0x9d, 0x44, 0xa7,
+	0x89, 0x96, 0x40, 0x8d, 0xc2, 0x98, 0x35, 0x57, 0x1d, 0x3b, 0x7d, 0x45, 0xa1, 0x1e, 0xb8, 0xa2,
+	0xf8, 0xb3, 0x02, 0x4b, 0xf1, 0x16, 0xcf, 0xfd, 0x62, 0x3a, 0xed, 0x6e, 0x3f, 0x84, 0x9a, 0x63,
+	0x9b, 0x07, 0x5e, 0x43, 0xa5, 0xb5, 0x46, 0xc8, 0xe2, 0xe4, 0x66, 0x71, 0xc5, 0x49, 0x8c, 0xa8,
+	0xb1, 0x04, 0xcd, 0xc3, 0xc8, 0x2b, 0xa9, 0xfd, 0x1f, 0x15, 0x2e, 0xed, 0x8c, 0x06, 0x0e, 0x93,
+	0x35, 0xea, 0x45, 0xef, 0x67, 0xee, 0x4b, 0xba, 0x37, 0xa1, 0x4c, 0x83, 0x38, 0xe4, 0x3d, 0x9c,
+	0x6c, 0x68, 0x4a, 0x5c, 0x26, 0x6e, 0xe0, 0x82, 0x3c, 0x85, 0x26, 0x63, 0x97, 0x71, 0x12, 0x6a,
+	0x18, 0xa4, 0xc5, 0xd8, 0x65, 0xe8, 0x9b, 0xf0, 0xba, 0x3b, 0x1e, 0x9a, 0xbe, 0xf7, 0x9c, 0x9a,
+	0x23, 0xe2, 0x9b, 0xdc, 0xb3, 0x39, 0xb2, 0x7c, 0xc6, 0x4b, 0xbc, 0x86, 0x2f, 0xbb, 0xe3, 0x21,
+	0xf6, 0x9e, 0xd3, 0x6d, 0xe2, 0xf3, 0xc5, 0xb7, 0x2d, 0x9f, 0xa1, 0xef, 0x42, 0xd1, 0x1a, 0xf4,
+	0x3d, 0xdf, 0x61, 0xcf, 0x86, 0xf2, 0xe2, 0xcd, 0x90, 0x61, 0x1e, 0x40, 0x66, 0xe5, 0x7e, 0x68,
+	0x89, 0xe3, 0x49, 0xe8, 0x6d, 0x40, 0x63, 0x4a, 0x4c, 0x11, 0x9c, 0x58, 0x74, 0xb2, 0x26, 0x6f,
+	0xe1, 0x6a, 0x63, 0x4a, 0x62, 0x37, 0xbb, 0x6b, 0xc6, 0xdf, 0x34, 0x40, 0x49, 0xbf, 0xb2, 0x46,
+	0x7f, 0x1b, 0x72, 0x7c, 0x3e, 0xd5, 0x15, 0x9e, 0xdb, 0x1b, 0x51, 0x85, 0x3a, 0x60, 0xbb, 0x12,
+	0x84, 0x8d, 0xa5, 0x79, 0xf3, 0x53, 0x28, 0x87, 0x27, 0x95, 0x6f, 0x27, 0x99, 0x0d, 0xe5, 0xd8,
+	0xb7, 0xab, 0x3a, 0xc7, 0xdb, 0xb5, 0xf9, 0x11, 0x14, 0x79, 0x57, 0x77, 0xa2, 0xef, 0xb8, 0x17,
+	0x55, 0x93, 0xbd, 0x68, 0xf3, 0x5f, 0x0a, 0x64, 0xf8, 0xe4, 0xb9, 0xbf, 0xfc, 0x6e, 0x42, 0x35,
+	0x8a, 0x52, 0x64, 0x4f, 0x14, 0xed, 0xdb, 0xc7, 0x40, 0x92, 0x84, 0x00, 0x97, 0xf7, 0x13, 0x23,
+	0xd4, 0x06, 0x10, 0x7f, 0x78, 0xe1, 0xae, 0x04, 0x0f, 0x6f, 0x1e, 0xe3, 0x2a, 0xda, 0x2e, 0x2e,
+	0xd2, 0x68, 0xe7, 0x08, 0x32, 0xd4, 0xf9, 0xa9, 0xa8, 0x92, 0x1a, 0xe6, 0xcf, 0xc6, 0xbb, 0x70,
+	0xe5, 0x21, 0x61, 0x3b, 0xfe, 0x24, 0x3c, 0x6e, 0xe1, 0xf1, 0x39, 0x06, 0x26, 0x03, 0xc3, 0xd5,
+	0xf4, 0x24, 0xc9, 0x80, 0xf7, 0xa1, 0x4c, 0xfd, 0x89, 0x39, 0x33, 0x33, 0xe8, 0x4a, 0xa2, 0xf4,
+	0x24, 0x27, 0x95, 0x68, 0x3c, 0x30, 0xfe, 0xae, 0x40, 0x75, 0xf7, 0x3c, 0xaf, 0x8e, 0x54, 0x0b,
+	0xa5, 0xce, 0xd9, 0x42, 0xdd, 0x86, 0xec, 0xa4, 0xcf, 0xe4, 0xad, 0x6e, 0x90, 0xd1, 0xc4, 0x3f,
+	0x99, 0x76, 0x1f, 0x32, 0xc7, 0xc6, 0x42, 0x1f, 0x34, 0x46, 0x9f, 0x39, 0x03, 0x46, 0xfc, 0xe8,
+	0x2d, 0x93, 0xb0, 0xfc, 0x98, 0x6b, 0xb0, 0xb4, 0x30, 0xbe, 0x03, 0xb5, 0x68, 0x2f, 0x71, 0x5f,
+	0x45, 0x26, 0xc4, 0x8d, 0xce, 0xc6, 0xcc, 0xf4, 0xdd, 0x8d, 0x40, 0x85, 0xa5, 0x85, 0xf1, 0x3b,
+	0x15, 0x2e, 0x3f, 0x19, 0xd9, 0x16, 0x5b, 0xf4, 0x77, 0xe9, 0x19, 0xdb, 0xd6, 0x25, 0x28, 0x32,
+	0x67, 0x48, 0x28, 0xb3, 0x86, 0x23, 0x59, 0xd5, 0x62, 0x41, 0x90, 0x11, 0x8e, 0x83, 0x9e, 0x9f,
+	0x39, 0x63, 0x1c, 0xa2, 0xae, 0xb7, 0x4f, 0x5c, 0x2c, 0xf4, 0xc6, 0x3e, 0x34, 0x66, 0x51, 0x92,
+	0x50, 0xb7, 0x42, 0x07, 0xb3, 0x1d, 0xac, 0x6c, 0x7c, 0x39, 0xd2, 0xc2, 0x00, 0xdd, 0x81, 0x7a,
+	0xd0, 0xca, 0x0e, 0x89, 0x19, 0xc7, 0x23, 0xfe, 0xf0, 0x52, 0x13, 0xf2, 0x6e, 0x28, 0xbe, 0xfb,
+	0x00, 0x6a, 0xa9, 0xbf, 0x5e, 0xa1, 0x1a, 0x94, 0x9e, 0x3c, 0xde, 0xd9, 0xde, 0x68, 0x77, 0x3e,
+	0xee, 0x6c, 0x3c, 0xa8, 0xbf, 0x86, 0x00, 0x72, 0x3b, 0x9d, 0xc7, 0x0f, 0x1f, 0x6d, 0xd4, 0x15,
+	0x54, 0x84, 0xec, 0xe6, 0x93, 0x47, 0xdd, 0x4e, 0x5d, 0x0d, 0x1e, 0xbb, 0x4f, 0xb7, 0xb6, 0xdb,
+	0x75, 0xed, 0xee, 0x87, 0x50, 0x12, 0x7d, 0xe1, 0x96, 0x6f, 0x13, 0x3f, 0x98, 0xf0, 0x78, 0x0b,
+	0x6f, 0xde, 0x7f, 0x54, 0x7f, 0x0d, 0xe5, 0x41, 0xdb, 0xc6, 0xc1, 0xcc, 0x02, 0x64, 0xb6, 0xb7,
+	0x76, 0xba, 0x75, 0x15, 0x55, 0x01, 0xee, 0x3f, 0xe9, 0x6e, 0xb5, 0xb7, 0x36, 0x37, 0x3b, 0xdd,
+	0xba, 0xb6, 0xfe, 0x1e, 0xd4, 0x1c, 0x6f, 0x65, 0xe2, 0x30, 0x42, 0xa9, 0xf8, 0xf3, 0xdc, 0x0f,
+	0xdf, 0x92, 0x23, 0xc7, 0x5b, 0x15, 0x4f, 0xab, 0x7d, 0x6f, 0x75, 0xc2, 0x56, 0xb9, 0x76, 0x55,
+	0x14, 0x88, 0xbd, 0x1c, 0x1f, 0xbd, 0xfb, 0xff, 0x01, 0x00, 0x45, 0x4a, 0x98, 0x3b, 0xbc, 0x27,
+	0x00, 0x00,
 }
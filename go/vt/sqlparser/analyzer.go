@@ -53,6 +53,10 @@ const (
 	StmtUnknown
 	StmtComment
 	StmtPriv
+	StmtSavepoint
+	StmtSRollback
+	StmtRelease
+	StmtKill
 )
 
 // Preview analyzes the beginning of the query using a simpler and faster
@@ -113,6 +117,14 @@ func Preview(sql string) StatementType {
 		return StmtOther
 	case "grant", "revoke":
 		return StmtPriv
+	case "savepoint":
+		return StmtSavepoint
+	case "rollback":
+		return StmtSRollback
+	case "release":
+		return StmtRelease
+	case "kill":
+		return StmtKill
 	}
 	return StmtUnknown
 }
@@ -149,6 +161,14 @@ func (s StatementType) String() string {
 		return "OTHER"
 	case StmtPriv:
 		return "PRIV"
+	case StmtSavepoint:
+		return "SAVEPOINT"
+	case StmtSRollback:
+		return "SAVEPOINT_ROLLBACK"
+	case StmtRelease:
+		return "RELEASE"
+	case StmtKill:
+		return "KILL"
 	default:
 		return "UNKNOWN"
 	}
@@ -163,6 +183,34 @@ func IsDML(sql string) bool {
 	return false
 }
 
+// lockFunctions are the functions that acquire or inspect named locks.
+// Named locks are owned by the MySQL connection.
+var lockFunctions = map[string]bool{
+	"get_lock":          true,
+	"release_lock":      true,
+	"release_all_locks": true,
+	"is_free_lock":      true,
+	"is_used_lock":      true,
+}
+
+// NeedsReservedConn returns true if the statement creates or uses
+// state that belongs to the MySQL connection, like temporary tables
+// or named locks. Such statements are unsafe with connection pooling.
+func NeedsReservedConn(stmt Statement) bool {
+	if ddl, ok := stmt.(*DDL); ok {
+		return ddl.Temporary
+	}
+	needs := false
+	_ = Walk(func(node SQLNode) (kontinue bool, err error) {
+		if f, ok := node.(*FuncExpr); ok && f.Qualifier.IsEmpty() && lockFunctions[f.Name.Lowered()] {
+			needs = true
+			return false, nil
+		}
+		return !needs, nil
+	}, stmt)
+	return needs
+}
+
 // SplitAndExpression breaks up the Expr into AND-separated conditions
 // and appends them to filters. Outer parenthesis are removed. Precedence
 // should be taken into account if expressions are recombined.
@@ -315,7 +363,9 @@ type SetKey struct {
 // ExtractSetValues returns a map of key-value pairs
 // if the query is a SET statement. Values can be bool, int64 or string.
 // Since set variable names are case insensitive, all keys are returned
-// as lower case.
+// as lower case. The keys of user-defined variables have the UserDefinedStr
+// scope, and their values are returned as expressions, which must be
+// evaluated by the caller.
 func ExtractSetValues(sql string) (keyValues map[SetKey]interface{}, scope string, err error) {
 	stmt, err := Parse(sql)
 	if err != nil {
@@ -341,6 +391,9 @@ func ExtractSetValues(sql string) (keyValues map[SetKey]interface{}, scope strin
 			key = strings.TrimPrefix(key, "@@vitess_metadata.")
 		case strings.HasPrefix(key, "@@"):
 			key = strings.TrimPrefix(key, "@@")
+		case strings.HasPrefix(key, "@"):
+			scope = UserDefinedStr
+			key = strings.TrimPrefix(key, "@")
 		}
 
 		if strings.HasPrefix(expr.Name.Lowered(), "@@") {
@@ -355,6 +408,10 @@ func ExtractSetValues(sql string) (keyValues map[SetKey]interface{}, scope strin
 			Key:   key,
 			Scope: scope,
 		}
+		if scope == UserDefinedStr {
+			result[setKey] = expr.Expr
+			continue
+		}
 
 		switch expr := expr.Expr.(type) {
 		case *SQLVal:
@@ -58,6 +58,10 @@ func TestPreview(t *testing.T) {
 		{"commit /*...*/", StmtCommit},
 		{"rollback", StmtRollback},
 		{"rollback /*...*/", StmtRollback},
+		{"rollback to a", StmtSRollback},
+		{"savepoint a", StmtSavepoint},
+		{"release savepoint a", StmtRelease},
+		{"kill query 1", StmtKill},
 		{"create", StmtDDL},
 		{"alter", StmtDDL},
 		{"rename", StmtDDL},
@@ -117,6 +121,34 @@ func TestIsDML(t *testing.T) {
 	}
 }
 
+func TestNeedsReservedConn(t *testing.T) {
+	testcases := []struct {
+		sql  string
+		want bool
+	}{
+		{"select get_lock('a', 10) from dual", true},
+		{"select 1 from t where RELEASE_LOCK('a') = 1", true},
+		{"select release_all_locks()", true},
+		{"select is_free_lock('a'), is_used_lock('a')", true},
+		{"select a.get_lock('a')", false},
+		{"select * from t", false},
+		{"create temporary table t(id int)", true},
+		{"drop temporary table t", true},
+		{"create table t(id int)", false},
+		{"drop table t", false},
+		{"insert into t values (1)", false},
+	}
+	for _, tcase := range testcases {
+		stmt, err := Parse(tcase.sql)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := NeedsReservedConn(stmt); got != tcase.want {
+			t.Errorf("NeedsReservedConn(%s): %v, want %v", tcase.sql, got, tcase.want)
+		}
+	}
+}
+
 func TestSplitAndExpression(t *testing.T) {
 	testcases := []struct {
 		sql string
@@ -579,6 +611,16 @@ func TestExtractSetValues(t *testing.T) {
 		sql:   "set session sql_safe_updates = 1",
 		out:   map[SetKey]interface{}{{Key: "sql_safe_updates", Scope: ImplicitStr}: int64(1)},
 		scope: SessionStr,
+	}, {
+		sql: "set @A = 'Abc', @b = 1 + @a",
+		out: map[SetKey]interface{}{
+			{Key: "a", Scope: UserDefinedStr}: newStrVal("Abc"),
+			{Key: "b", Scope: UserDefinedStr}: &BinaryExpr{
+				Operator: PlusStr,
+				Left:     newIntVal("1"),
+				Right:    &ColName{Name: NewColIdent("@a")},
+			},
+		},
 	}}
 	for _, tcase := range testcases {
 		out, _, err := ExtractSetValues(tcase.sql)
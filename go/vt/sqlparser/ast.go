@@ -50,11 +50,13 @@ type (
 		iInsertRows()
 		AddOrder(*Order)
 		SetLimit(*Limit)
+		SetWith(*With)
 		SQLNode
 	}
 
 	// Select represents a SELECT statement.
 	Select struct {
+		With        *With
 		Cache       string
 		Comments    Comments
 		Distinct    string
@@ -64,6 +66,7 @@ type (
 		Where       *Where
 		GroupBy     GroupBy
 		Having      *Where
+		Windows     NamedWindows
 		OrderBy     OrderBy
 		Limit       *Limit
 		Lock        string
@@ -71,6 +74,7 @@ type (
 
 	// Union represents a UNION statement.
 	Union struct {
+		With        *With
 		Type        string
 		Left, Right SelectStatement
 		OrderBy     OrderBy
@@ -157,6 +161,9 @@ type (
 		// Table is set if Action is other than RenameStr or DropStr.
 		Table TableName
 
+		// Temporary is set for CREATE TEMPORARY TABLE and DROP TEMPORARY TABLE.
+		Temporary bool
+
 		// The following fields are set if a DDL was fully analyzed.
 		IfExists      bool
 		TableSpec     *TableSpec
@@ -202,6 +209,36 @@ type (
 	// Rollback represents a Rollback statement.
 	Rollback struct{}
 
+	// Savepoint represents a SAVEPOINT statement.
+	Savepoint struct {
+		Name ColIdent
+	}
+
+	// SRollback represents a ROLLBACK TO SAVEPOINT statement.
+	SRollback struct {
+		Name ColIdent
+	}
+
+	// Release represents a RELEASE SAVEPOINT statement.
+	Release struct {
+		Name ColIdent
+	}
+
+	// Kill represents a KILL statement. Type is the kind
+	// of KILL: query or connection. ID is an integer literal.
+	Kill struct {
+		Type string
+		ID   Expr
+	}
+
+	// Explain represents an EXPLAIN statement. Type is the
+	// FORMAT of the output, which is empty if unspecified.
+	// The VITESS and VTEXPLAIN formats are handled by vtgate.
+	Explain struct {
+		Type      string
+		Statement Statement
+	}
+
 	// OtherRead represents a DESCRIBE, or EXPLAIN statement.
 	// It should be used only as an indicator. It does not contain
 	// the full AST for the statement.
@@ -228,6 +265,11 @@ func (*Use) iStatement()               {}
 func (*Begin) iStatement()             {}
 func (*Commit) iStatement()            {}
 func (*Rollback) iStatement()          {}
+func (*Savepoint) iStatement()         {}
+func (*SRollback) iStatement()         {}
+func (*Release) iStatement()           {}
+func (*Kill) iStatement()              {}
+func (*Explain) iStatement()           {}
 func (*OtherRead) iStatement()         {}
 func (*OtherAdmin) iStatement()        {}
 func (*Select) iSelectStatement()      {}
@@ -476,6 +518,20 @@ type (
 	Subquery struct {
 		Select SelectStatement
 	}
+
+	// With represents a WITH clause.
+	With struct {
+		Recursive bool
+		CTEs      []*CommonTableExpr
+	}
+
+	// CommonTableExpr represents a common table
+	// expression of a WITH clause.
+	CommonTableExpr struct {
+		Name     TableIdent
+		Columns  Columns
+		Subquery *Subquery
+	}
 )
 
 func (TableName) iSimpleTableExpr() {}
@@ -624,12 +680,14 @@ type (
 		Charset string
 	}
 
-	// FuncExpr represents a function call.
+	// FuncExpr represents a function call. Over is set
+	// if the function is called as a window function.
 	FuncExpr struct {
 		Qualifier TableIdent
 		Name      ColIdent
 		Distinct  bool
 		Exprs     SelectExprs
+		Over      *WindowSpec
 	}
 
 	// GroupConcatExpr represents a call to GROUP_CONCAT
@@ -759,6 +817,24 @@ type GroupBy []Expr
 // OrderBy represents an ORDER By clause.
 type OrderBy []*Order
 
+// WindowSpec represents the window of a window function, or the
+// definition of a named window. Name refers to another named window
+// whose definition is extended by this one.
+type WindowSpec struct {
+	Name        ColIdent
+	PartitionBy Exprs
+	OrderBy     OrderBy
+}
+
+// NamedWindows represents a WINDOW clause.
+type NamedWindows []*NamedWindow
+
+// NamedWindow represents a window definition of a WINDOW clause.
+type NamedWindow struct {
+	Name ColIdent
+	Spec *WindowSpec
+}
+
 // Order represents an ordering expression.
 type Order struct {
 	Expr      Expr
@@ -814,10 +890,10 @@ type TableIdent struct {
 
 // Format formats the node.
 func (node *Select) Format(buf *TrackedBuffer) {
-	buf.Myprintf("select %v%s%s%s%v from %v%v%v%v%v%v%s",
-		node.Comments, node.Cache, node.Distinct, node.Hints, node.SelectExprs,
+	buf.Myprintf("%vselect %v%s%s%s%v from %v%v%v%v%v%v%v%s",
+		node.With, node.Comments, node.Cache, node.Distinct, node.Hints, node.SelectExprs,
 		node.From, node.Where,
-		node.GroupBy, node.Having, node.OrderBy,
+		node.GroupBy, node.Having, node.Windows, node.OrderBy,
 		node.Limit, node.Lock)
 }
 
@@ -828,7 +904,7 @@ func (node *ParenSelect) Format(buf *TrackedBuffer) {
 
 // Format formats the node.
 func (node *Union) Format(buf *TrackedBuffer) {
-	buf.Myprintf("%v %s %v%v%v%s", node.Left, node.Type, node.Right,
+	buf.Myprintf("%v%v %s %v%v%v%s", node.With, node.Left, node.Type, node.Right,
 		node.OrderBy, node.Limit, node.Lock)
 }
 
@@ -889,19 +965,27 @@ func (node *DBDDL) Format(buf *TrackedBuffer) {
 func (node *DDL) Format(buf *TrackedBuffer) {
 	switch node.Action {
 	case CreateStr:
+		temporary := ""
+		if node.Temporary {
+			temporary = " temporary"
+		}
 		if node.OptLike != nil {
-			buf.Myprintf("%s table %v %v", node.Action, node.Table, node.OptLike)
+			buf.Myprintf("%s%s table %v %v", node.Action, temporary, node.Table, node.OptLike)
 		} else if node.TableSpec != nil {
-			buf.Myprintf("%s table %v %v", node.Action, node.Table, node.TableSpec)
+			buf.Myprintf("%s%s table %v %v", node.Action, temporary, node.Table, node.TableSpec)
 		} else {
-			buf.Myprintf("%s table %v", node.Action, node.Table)
+			buf.Myprintf("%s%s table %v", node.Action, temporary, node.Table)
 		}
 	case DropStr:
+		temporary := ""
+		if node.Temporary {
+			temporary = " temporary"
+		}
 		exists := ""
 		if node.IfExists {
 			exists = " if exists"
 		}
-		buf.Myprintf("%s table%s %v", node.Action, exists, node.FromTables)
+		buf.Myprintf("%s%s table%s %v", node.Action, temporary, exists, node.FromTables)
 	case RenameStr:
 		buf.Myprintf("%s table %v to %v", node.Action, node.FromTables[0], node.ToTables[0])
 		for i := 1; i < len(node.FromTables); i++ {
@@ -1181,6 +1265,10 @@ func (node *Show) Format(buf *TrackedBuffer) {
 		buf.Myprintf("%v", opt.Filter)
 		return
 	}
+	if node.Type == "processlist" && node.ShowTablesOpt != nil {
+		buf.Myprintf("show %sprocesslist", node.ShowTablesOpt.Full)
+		return
+	}
 	if node.Scope == "" {
 		buf.Myprintf("show %s", node.Type)
 	} else {
@@ -1236,6 +1324,47 @@ func (node *Rollback) Format(buf *TrackedBuffer) {
 	buf.WriteString("rollback")
 }
 
+// Format formats the node.
+func (node *Savepoint) Format(buf *TrackedBuffer) {
+	buf.Myprintf("savepoint %v", node.Name)
+}
+
+// Format formats the node.
+func (node *SRollback) Format(buf *TrackedBuffer) {
+	buf.Myprintf("rollback to %v", node.Name)
+}
+
+// Format formats the node.
+func (node *Release) Format(buf *TrackedBuffer) {
+	buf.Myprintf("release savepoint %v", node.Name)
+}
+
+// Kill.Type
+const (
+	KillQueryStr      = "query"
+	KillConnectionStr = "connection"
+)
+
+// Format formats the node.
+func (node *Kill) Format(buf *TrackedBuffer) {
+	buf.Myprintf("kill %s %v", node.Type, node.ID)
+}
+
+// Explain.Type
+const (
+	ExplainVitessStr    = "vitess"
+	ExplainVTExplainStr = "vtexplain"
+)
+
+// Format formats the node.
+func (node *Explain) Format(buf *TrackedBuffer) {
+	if node.Type == "" {
+		buf.Myprintf("explain %v", node.Statement)
+		return
+	}
+	buf.Myprintf("explain format = %s %v", node.Type, node.Statement)
+}
+
 // Format formats the node.
 func (node *OtherRead) Format(buf *TrackedBuffer) {
 	buf.WriteString("otherread")
@@ -1273,9 +1402,16 @@ func (node *StarExpr) Format(buf *TrackedBuffer) {
 // Format formats the node.
 func (node *AliasedExpr) Format(buf *TrackedBuffer) {
 	buf.Myprintf("%v", node.Expr)
-	if !node.As.IsEmpty() {
-		buf.Myprintf(" as %v", node.As)
+	if node.As.IsEmpty() {
+		return
+	}
+	if strings.HasPrefix(node.As.String(), "@") {
+		// The alias must be quoted to not be taken for a variable.
+		buf.WriteString(" as ")
+		writeEscapedString(buf, node.As.String())
+		return
 	}
+	buf.Myprintf(" as %v", node.As)
 }
 
 // Format formats the node.
@@ -1495,6 +1631,28 @@ func (node *Subquery) Format(buf *TrackedBuffer) {
 	buf.Myprintf("(%v)", node.Select)
 }
 
+// Format formats the node.
+func (node *With) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.WriteString("with ")
+	if node.Recursive {
+		buf.WriteString("recursive ")
+	}
+	prefix := ""
+	for _, cte := range node.CTEs {
+		buf.Myprintf("%s%v", prefix, cte)
+		prefix = ", "
+	}
+	buf.WriteString(" ")
+}
+
+// Format formats the node.
+func (node *CommonTableExpr) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v%v as %v", node.Name, node.Columns, node.Subquery)
+}
+
 // Format formats the node.
 func (node ListArg) Format(buf *TrackedBuffer) {
 	buf.WriteArg(string(node))
@@ -1553,6 +1711,13 @@ func (node *FuncExpr) Format(buf *TrackedBuffer) {
 		buf.WriteString(funcName)
 	}
 	buf.Myprintf("(%s%v)", distinct, node.Exprs)
+	if node.Over != nil {
+		if len(node.Over.PartitionBy) == 0 && len(node.Over.OrderBy) == 0 && !node.Over.Name.IsEmpty() {
+			buf.Myprintf(" over %v", node.Over.Name)
+			return
+		}
+		buf.Myprintf(" over %v", node.Over)
+	}
 }
 
 // Format formats the node
@@ -1657,6 +1822,42 @@ func (node OrderBy) Format(buf *TrackedBuffer) {
 	}
 }
 
+// Format formats the node.
+func (node *WindowSpec) Format(buf *TrackedBuffer) {
+	buf.WriteByte('(')
+	prefix := ""
+	if !node.Name.IsEmpty() {
+		buf.Myprintf("%v", node.Name)
+		prefix = " "
+	}
+	if len(node.PartitionBy) > 0 {
+		buf.Myprintf("%spartition by %v", prefix, node.PartitionBy)
+		prefix = " "
+	}
+	for i, order := range node.OrderBy {
+		if i == 0 {
+			buf.Myprintf("%sorder by %v", prefix, order)
+			continue
+		}
+		buf.Myprintf(", %v", order)
+	}
+	buf.WriteByte(')')
+}
+
+// Format formats the node.
+func (node NamedWindows) Format(buf *TrackedBuffer) {
+	prefix := " window "
+	for _, n := range node {
+		buf.Myprintf("%s%v", prefix, n)
+		prefix = ", "
+	}
+}
+
+// Format formats the node.
+func (node *NamedWindow) Format(buf *TrackedBuffer) {
+	buf.Myprintf("%v as %v", node.Name, node.Spec)
+}
+
 // Format formats the node.
 func (node *Order) Format(buf *TrackedBuffer) {
 	if node, ok := node.Expr.(*NullVal); ok {
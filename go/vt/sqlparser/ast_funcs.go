@@ -523,7 +523,13 @@ var Aggregates = map[string]bool{
 
 // IsAggregate returns true if the function is an aggregate.
 func (node *FuncExpr) IsAggregate() bool {
-	return Aggregates[node.Name.Lowered()]
+	return node.Over == nil && Aggregates[node.Name.Lowered()]
+}
+
+// IsWindowFunction returns true if the function is called
+// with an OVER clause.
+func (node *FuncExpr) IsWindowFunction() bool {
+	return node.Over != nil
 }
 
 // NewColIdent makes a new ColIdent.
@@ -696,6 +702,11 @@ func (node *Select) SetLimit(limit *Limit) {
 	node.Limit = limit
 }
 
+// SetWith sets the with clause
+func (node *Select) SetWith(with *With) {
+	node.With = with
+}
+
 // AddWhere adds the boolean expression to the
 // WHERE clause as an AND condition. If the expression
 // is an OR clause, it parenthesizes it. Currently,
@@ -750,6 +761,11 @@ func (node *ParenSelect) SetLimit(limit *Limit) {
 	panic("unreachable")
 }
 
+// SetWith sets the with clause
+func (node *ParenSelect) SetWith(with *With) {
+	panic("unreachable")
+}
+
 // AddOrder adds an order by element
 func (node *Union) AddOrder(order *Order) {
 	node.OrderBy = append(node.OrderBy, order)
@@ -759,3 +775,8 @@ func (node *Union) AddOrder(order *Order) {
 func (node *Union) SetLimit(limit *Limit) {
 	node.Limit = limit
 }
+
+// SetWith sets the with clause
+func (node *Union) SetWith(with *With) {
+	node.With = with
+}
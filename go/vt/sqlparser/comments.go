@@ -32,6 +32,10 @@ const (
 	DirectiveQueryTimeout = "QUERY_TIMEOUT_MS"
 	// DirectiveScatterErrorsAsWarnings enables partial success scatter select queries
 	DirectiveScatterErrorsAsWarnings = "SCATTER_ERRORS_AS_WARNINGS"
+	// DirectiveHashJoin forces the use of hash joins for cross-shard joins on equalities.
+	DirectiveHashJoin = "HASH_JOIN"
+	// DirectiveNoHashJoin prevents the use of hash joins.
+	DirectiveNoHashJoin = "NO_HASH_JOIN"
 )
 
 func isNonSpace(r rune) bool {
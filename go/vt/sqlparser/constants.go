@@ -42,6 +42,7 @@ const (
 	SessionStr        = "session"
 	GlobalStr         = "global"
 	VitessMetadataStr = "vitess_metadata"
+	UserDefinedStr    = "user_defined"
 	ImplicitStr       = ""
 
 	// DDL strings.
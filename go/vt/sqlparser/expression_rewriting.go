@@ -17,6 +17,9 @@ limitations under the License.
 package sqlparser
 
 import (
+	"sort"
+	"strings"
+
 	"vitess.io/vitess/go/vt/log"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/proto/vtrpc"
@@ -34,6 +37,9 @@ type BindVarNeeds struct {
 	NeedLastInsertID bool
 	NeedDatabase     bool
 	NeedFoundRows    bool
+	// NeedUserDefinedVariables contains the names
+	// of the user-defined variables the query uses.
+	NeedUserDefinedVariables []string
 }
 
 // RewriteAST rewrites the whole AST, replacing function calls and adding column aliases to queries
@@ -54,6 +60,12 @@ func RewriteAST(in Statement) (*RewriteASTResult, error) {
 	if _, ok := er.bindVars[FoundRowsName]; ok {
 		r.NeedFoundRows = true
 	}
+	for name := range er.bindVars {
+		if strings.HasPrefix(name, UserDefinedVariableName) {
+			r.NeedUserDefinedVariables = append(r.NeedUserDefinedVariables, strings.TrimPrefix(name, UserDefinedVariableName))
+		}
+	}
+	sort.Strings(r.NeedUserDefinedVariables)
 
 	return r, nil
 }
@@ -102,6 +114,9 @@ const (
 
 	//FoundRowsName is a reserved bind var name for found_rows()
 	FoundRowsName = "__vtfrows"
+
+	//UserDefinedVariableName is the prefix of the reserved bind var names for user-defined variables
+	UserDefinedVariableName = "__vtudv"
 )
 
 func (er *expressionRewriter) goingDown(cursor *Cursor) bool {
@@ -128,6 +143,27 @@ func (er *expressionRewriter) goingDown(cursor *Cursor) bool {
 			return false
 		}
 
+	case *ColName:
+		name, ok := UserDefinedVariable(node)
+		if !ok {
+			break
+		}
+		// Names of columns can't be replaced.
+		switch parent := cursor.Parent().(type) {
+		case *UpdateExpr:
+			if parent.Name == node {
+				return true
+			}
+		case *SubstrExpr:
+			if parent.Name == node {
+				return true
+			}
+		case *ValuesFuncExpr:
+			return true
+		}
+		cursor.Replace(bindVarExpression(UserDefinedVariableName + name))
+		er.needBindVarFor(UserDefinedVariableName + name)
+
 	case *FuncExpr:
 		switch {
 		case node.Name.EqualString("last_insert_id"):
@@ -173,3 +209,17 @@ func (er *expressionRewriter) didAnythingChange() bool {
 func bindVarExpression(name string) *SQLVal {
 	return NewValArg([]byte(":" + name))
 }
+
+// UserDefinedVariable returns the lower case name of the
+// user-defined variable that col refers to, like @a.
+// It returns false if col is not a user-defined variable.
+func UserDefinedVariable(col *ColName) (string, bool) {
+	if !col.Qualifier.IsEmpty() {
+		return "", false
+	}
+	name := col.Name.Lowered()
+	if !strings.HasPrefix(name, "@") || strings.HasPrefix(name, "@@") {
+		return "", false
+	}
+	return strings.TrimPrefix(name, "@"), true
+}
@@ -25,6 +25,7 @@ import (
 type myTestCase struct {
 	in, expected        string
 	liid, db, foundRows bool
+	udv                 []string
 }
 
 func TestRewrites(in *testing.T) {
@@ -94,6 +95,26 @@ func TestRewrites(in *testing.T) {
 			expected: "select :__vtfrows as 'found_rows()'",
 			db:       false, liid: false, foundRows: true,
 		},
+		{
+			in:       "select @A, @@session.autocommit",
+			expected: "select :__vtudva as `@A`, @@session.autocommit",
+			udv:      []string{"a"},
+		},
+		{
+			in:       "select id from user where col = @b and col2 in (@a, @b)",
+			expected: "select id from user where col = :__vtudvb and col2 in (:__vtudva, :__vtudvb)",
+			udv:      []string{"a", "b"},
+		},
+		{
+			in:       "update user set col = @a where id = 1",
+			expected: "update user set col = :__vtudva where id = 1",
+			udv:      []string{"a"},
+		},
+		{
+			in:       "insert into user(id, col) values (1, @a)",
+			expected: "insert into user(id, col) values (1, :__vtudva)",
+			udv:      []string{"a"},
+		},
 	}
 
 	for _, tc := range tests {
@@ -112,6 +133,7 @@ func TestRewrites(in *testing.T) {
 			require.Equal(t, tc.liid, result.NeedLastInsertID, "should need last insert id")
 			require.Equal(t, tc.db, result.NeedDatabase, "should need database name")
 			require.Equal(t, tc.foundRows, result.NeedFoundRows, "should need found rows")
+			require.Equal(t, tc.udv, result.NeedUserDefinedVariables, "should need user-defined variables")
 		})
 	}
 }
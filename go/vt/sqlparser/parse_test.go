@@ -104,6 +104,35 @@ var (
 		input: "select /* union all */ 1 from t union all select 1 from t",
 	}, {
 		input: "select /* union distinct */ 1 from t union distinct select 1 from t",
+	}, {
+		input: "with cte as (select a from t) select /* with */ * from cte",
+	}, {
+		input: "with cte(x, y) as (select a, b from t), cte2 as (select x from cte) select /* with columns */ * from cte2",
+	}, {
+		input: "with recursive cte(n) as (select 1 from dual union all select n + 1 from cte where n < 5) select /* with recursive */ n from cte",
+	}, {
+		input: "with cte as (select a from t) select /* with union */ a from cte union select a from cte",
+	}, {
+		input: "select /* with in derived table */ * from (with cte as (select a from t) select a from cte) as b",
+	}, {
+		input: "select /* with in subquery */ * from t where a in (with cte as (select a from t) select a from cte)",
+	}, {
+		input: "select /* row_number */ a, row_number() over (partition by b order by c asc) from t",
+	}, {
+		input:  "select /* rank */ rank() over (order by a desc), dense_rank() over (order by a) from t",
+		output: "select /* rank */ rank() over (order by a desc), dense_rank() over (order by a asc) from t",
+	}, {
+		input: "select /* lag and lead */ lag(a, 1) over (partition by b), lead(a, 2, 0) over (partition by b) from t",
+	}, {
+		input: "select /* aggregate window function */ sum(a) over (partition by b, c), count(*) over () from t",
+	}, {
+		input:  "select /* named window */ row_number() over w, rank() over (w order by a) from t window w as (partition by b)",
+		output: "select /* named window */ row_number() over w, rank() over (w order by a asc) from t window w as (partition by b)",
+	}, {
+		input: "select /* window clause */ sum(a) over w1 from t group by a having a > 1 window w1 as (), w2 as (w1 partition by b) order by a asc",
+	}, {
+		input:  "select /* window keywords as identifiers */ `rank`, `over` from `window`",
+		output: "select /* window keywords as identifiers */ `rank`, `over` from `window`",
 	}, {
 		input:  "(select /* union parenthesized select */ 1 from t order by a) union select 1 from t",
 		output: "(select /* union parenthesized select */ 1 from t order by a asc) union select 1 from t",
@@ -154,6 +183,8 @@ var (
 		input: "select /* column alias with as */ a as b from t",
 	}, {
 		input: "select /* keyword column alias */ a as `By` from t",
+	}, {
+		input: "select /* variable column alias */ @a as `@a` from t",
 	}, {
 		input:  "select /* column alias as string */ a as \"b\" from t",
 		output: "select /* column alias as string */ a as b from t",
@@ -1010,6 +1041,11 @@ var (
 	}, {
 		input:  "create table a (b1 bool not null primary key, b2 boolean not null)",
 		output: "create table a (\n\tb1 bool not null primary key,\n\tb2 boolean not null\n)",
+	}, {
+		input:  "create temporary table a (a int)",
+		output: "create temporary table a (\n\ta int\n)",
+	}, {
+		input: "create temporary table a like b",
 	}, {
 		input: "alter vschema create vindex hash_vdx using hash",
 	}, {
@@ -1115,6 +1151,8 @@ var (
 	}, {
 		input:  "drop table a, b",
 		output: "drop table a, b",
+	}, {
+		input: "drop temporary table if exists a, b",
 	}, {
 		input:  "drop table if exists a",
 		output: "drop table if exists a",
@@ -1249,7 +1287,7 @@ var (
 		output: "show processlist",
 	}, {
 		input:  "show full processlist",
-		output: "show processlist",
+		output: "show full processlist",
 	}, {
 		input:  "show profile cpu for query 1",
 		output: "show profile",
@@ -1366,6 +1404,32 @@ var (
 	}, {
 		input:  "explain foobar",
 		output: "otherread",
+	}, {
+		input:  "explain foobar id",
+		output: "otherread",
+	}, {
+		input:  "explain extended select * from t",
+		output: "otherread",
+	}, {
+		input:  "explain analyze select * from t",
+		output: "otherread",
+	}, {
+		input:  "explain for connection 10",
+		output: "otherread",
+	}, {
+		input: "explain select * from t",
+	}, {
+		input:  "explain format=vitess select * from t where id = 1",
+		output: "explain format = vitess select * from t where id = 1",
+	}, {
+		input:  "explain format = VTEXPLAIN update t set a = 1",
+		output: "explain format = vtexplain update t set a = 1",
+	}, {
+		input:  "explain format = json with x as (select 1 from dual) select * from x",
+		output: "explain format = json with x as (select 1 from dual) select * from x",
+	}, {
+		input:  "select format(a, 2) from format",
+		output: "select format(a, 2) from `format`",
 	}, {
 		input:  "truncate table foo",
 		output: "truncate table foo",
@@ -1501,6 +1565,34 @@ var (
 		input: "commit",
 	}, {
 		input: "rollback",
+	}, {
+		input: "savepoint a",
+	}, {
+		input:  "savepoint `@@@;a`",
+		output: "savepoint `@@@;a`",
+	}, {
+		input: "rollback to a",
+	}, {
+		input:  "rollback to savepoint a",
+		output: "rollback to a",
+	}, {
+		input:  "rollback to savepoint savepoint",
+		output: "rollback to `savepoint`",
+	}, {
+		input: "release savepoint a",
+	}, {
+		input:  "select release, savepoint from t",
+		output: "select `release`, `savepoint` from t",
+	}, {
+		input:  "kill 5",
+		output: "kill connection 5",
+	}, {
+		input: "kill connection 5",
+	}, {
+		input: "kill query 5",
+	}, {
+		input:  "select connection from t",
+		output: "select `connection` from t",
 	}, {
 		input: "create database test_db",
 	}, {
@@ -2493,6 +2585,18 @@ var (
 		output       string
 		excludeMulti bool // Don't use in the ParseNext multi-statement parsing tests.
 	}{{
+		input:  "kill query a",
+		output: "syntax error at position 13 near 'a'",
+	}, {
+		input:  "with cte as select a from t select * from cte",
+		output: "syntax error at position 19 near 'select'",
+	}, {
+		input:  "select row_number() from t",
+		output: "syntax error at position 25 near 'from'",
+	}, {
+		input:  "select a, rank over (order by a) from t",
+		output: "syntax error at position 20 near 'over'",
+	}, {
 		input:  "select $ from t",
 		output: "syntax error at position 9 near '$'",
 	}, {
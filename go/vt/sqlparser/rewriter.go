@@ -144,6 +144,18 @@ func (r *replaceColumnsItems) inc() {
 	*r++
 }
 
+func replaceCommonTableExprColumns(newNode, parent SQLNode) {
+	parent.(*CommonTableExpr).Columns = newNode.(Columns)
+}
+
+func replaceCommonTableExprName(newNode, parent SQLNode) {
+	parent.(*CommonTableExpr).Name = newNode.(TableIdent)
+}
+
+func replaceCommonTableExprSubquery(newNode, parent SQLNode) {
+	parent.(*CommonTableExpr).Subquery = newNode.(*Subquery)
+}
+
 func replaceComparisonExprEscape(newNode, parent SQLNode) {
 	parent.(*ComparisonExpr).Escape = newNode.(Expr)
 }
@@ -262,6 +274,10 @@ func replaceExistsExprSubquery(newNode, parent SQLNode) {
 	parent.(*ExistsExpr).Subquery = newNode.(*Subquery)
 }
 
+func replaceExplainStatement(newNode, parent SQLNode) {
+	parent.(*Explain).Statement = newNode.(Statement)
+}
+
 type replaceExprsItems int
 
 func (r *replaceExprsItems) replace(newNode, container SQLNode) {
@@ -300,6 +316,10 @@ func replaceFuncExprName(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Name = newNode.(ColIdent)
 }
 
+func replaceFuncExprOver(newNode, parent SQLNode) {
+	parent.(*FuncExpr).Over = newNode.(*WindowSpec)
+}
+
 func replaceFuncExprQualifier(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Qualifier = newNode.(TableIdent)
 }
@@ -398,6 +418,10 @@ func replaceJoinTableExprRightExpr(newNode, parent SQLNode) {
 	parent.(*JoinTableExpr).RightExpr = newNode.(TableExpr)
 }
 
+func replaceKillID(newNode, parent SQLNode) {
+	parent.(*Kill).ID = newNode.(Expr)
+}
+
 func replaceLimitOffset(newNode, parent SQLNode) {
 	parent.(*Limit).Offset = newNode.(Expr)
 }
@@ -414,6 +438,24 @@ func replaceMatchExprExpr(newNode, parent SQLNode) {
 	parent.(*MatchExpr).Expr = newNode.(Expr)
 }
 
+func replaceNamedWindowName(newNode, parent SQLNode) {
+	parent.(*NamedWindow).Name = newNode.(ColIdent)
+}
+
+func replaceNamedWindowSpec(newNode, parent SQLNode) {
+	parent.(*NamedWindow).Spec = newNode.(*WindowSpec)
+}
+
+type replaceNamedWindowsItems int
+
+func (r *replaceNamedWindowsItems) replace(newNode, container SQLNode) {
+	container.(NamedWindows)[int(*r)] = newNode.(*NamedWindow)
+}
+
+func (r *replaceNamedWindowsItems) inc() {
+	*r++
+}
+
 func replaceNextvalExpr(newNode, parent SQLNode) {
 	tmp := parent.(Nextval)
 	tmp.Expr = newNode.(Expr)
@@ -515,6 +557,18 @@ func replaceRangeCondTo(newNode, parent SQLNode) {
 	parent.(*RangeCond).To = newNode.(Expr)
 }
 
+func replaceReleaseName(newNode, parent SQLNode) {
+	parent.(*Release).Name = newNode.(ColIdent)
+}
+
+func replaceSRollbackName(newNode, parent SQLNode) {
+	parent.(*SRollback).Name = newNode.(ColIdent)
+}
+
+func replaceSavepointName(newNode, parent SQLNode) {
+	parent.(*Savepoint).Name = newNode.(ColIdent)
+}
+
 func replaceSelectComments(newNode, parent SQLNode) {
 	parent.(*Select).Comments = newNode.(Comments)
 }
@@ -547,6 +601,14 @@ func replaceSelectWhere(newNode, parent SQLNode) {
 	parent.(*Select).Where = newNode.(*Where)
 }
 
+func replaceSelectWindows(newNode, parent SQLNode) {
+	parent.(*Select).Windows = newNode.(NamedWindows)
+}
+
+func replaceSelectWith(newNode, parent SQLNode) {
+	parent.(*Select).With = newNode.(*With)
+}
+
 type replaceSelectExprsItems int
 
 func (r *replaceSelectExprsItems) replace(newNode, container SQLNode) {
@@ -719,6 +781,10 @@ func replaceUnionRight(newNode, parent SQLNode) {
 	parent.(*Union).Right = newNode.(SelectStatement)
 }
 
+func replaceUnionWith(newNode, parent SQLNode) {
+	parent.(*Union).With = newNode.(*With)
+}
+
 func replaceUpdateComments(newNode, parent SQLNode) {
 	parent.(*Update).Comments = newNode.(Comments)
 }
@@ -824,6 +890,28 @@ func replaceWhereExpr(newNode, parent SQLNode) {
 	parent.(*Where).Expr = newNode.(Expr)
 }
 
+func replaceWindowSpecName(newNode, parent SQLNode) {
+	parent.(*WindowSpec).Name = newNode.(ColIdent)
+}
+
+func replaceWindowSpecOrderBy(newNode, parent SQLNode) {
+	parent.(*WindowSpec).OrderBy = newNode.(OrderBy)
+}
+
+func replaceWindowSpecPartitionBy(newNode, parent SQLNode) {
+	parent.(*WindowSpec).PartitionBy = newNode.(Exprs)
+}
+
+type replaceWithCTEs int
+
+func (r *replaceWithCTEs) replace(newNode, container SQLNode) {
+	container.(*With).CTEs[int(*r)] = newNode.(*CommonTableExpr)
+}
+
+func (r *replaceWithCTEs) inc() {
+	*r++
+}
+
 // apply is where the visiting happens. Here is where we keep the big switch-case that will be used
 // to do the actual visiting of SQLNodes
 func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
@@ -917,6 +1005,11 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 
 	case *Commit:
 
+	case *CommonTableExpr:
+		a.apply(node, n.Columns, replaceCommonTableExprColumns)
+		a.apply(node, n.Name, replaceCommonTableExprName)
+		a.apply(node, n.Subquery, replaceCommonTableExprSubquery)
+
 	case *ComparisonExpr:
 		a.apply(node, n.Escape, replaceComparisonExprEscape)
 		a.apply(node, n.Left, replaceComparisonExprLeft)
@@ -972,6 +1065,9 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 	case *ExistsExpr:
 		a.apply(node, n.Subquery, replaceExistsExprSubquery)
 
+	case *Explain:
+		a.apply(node, n.Statement, replaceExplainStatement)
+
 	case Exprs:
 		replacer := replaceExprsItems(0)
 		replacerRef := &replacer
@@ -990,6 +1086,7 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 	case *FuncExpr:
 		a.apply(node, n.Exprs, replaceFuncExprExprs)
 		a.apply(node, n.Name, replaceFuncExprName)
+		a.apply(node, n.Over, replaceFuncExprOver)
 		a.apply(node, n.Qualifier, replaceFuncExprQualifier)
 
 	case GroupBy:
@@ -1042,6 +1139,9 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.LeftExpr, replaceJoinTableExprLeftExpr)
 		a.apply(node, n.RightExpr, replaceJoinTableExprRightExpr)
 
+	case *Kill:
+		a.apply(node, n.ID, replaceKillID)
+
 	case *Limit:
 		a.apply(node, n.Offset, replaceLimitOffset)
 		a.apply(node, n.Rowcount, replaceLimitRowcount)
@@ -1052,6 +1152,18 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.Columns, replaceMatchExprColumns)
 		a.apply(node, n.Expr, replaceMatchExprExpr)
 
+	case *NamedWindow:
+		a.apply(node, n.Name, replaceNamedWindowName)
+		a.apply(node, n.Spec, replaceNamedWindowSpec)
+
+	case NamedWindows:
+		replacer := replaceNamedWindowsItems(0)
+		replacerRef := &replacer
+		for _, item := range n {
+			a.apply(node, item, replacerRef.replace)
+			replacerRef.inc()
+		}
+
 	case Nextval:
 		a.apply(node, n.Expr, replaceNextvalExpr)
 
@@ -1127,10 +1239,19 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 
 	case ReferenceAction:
 
+	case *Release:
+		a.apply(node, n.Name, replaceReleaseName)
+
 	case *Rollback:
 
 	case *SQLVal:
 
+	case *SRollback:
+		a.apply(node, n.Name, replaceSRollbackName)
+
+	case *Savepoint:
+		a.apply(node, n.Name, replaceSavepointName)
+
 	case *Select:
 		a.apply(node, n.Comments, replaceSelectComments)
 		a.apply(node, n.From, replaceSelectFrom)
@@ -1140,6 +1261,8 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.OrderBy, replaceSelectOrderBy)
 		a.apply(node, n.SelectExprs, replaceSelectSelectExprs)
 		a.apply(node, n.Where, replaceSelectWhere)
+		a.apply(node, n.Windows, replaceSelectWindows)
+		a.apply(node, n.With, replaceSelectWith)
 
 	case SelectExprs:
 		replacer := replaceSelectExprsItems(0)
@@ -1243,6 +1366,7 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.Limit, replaceUnionLimit)
 		a.apply(node, n.OrderBy, replaceUnionOrderBy)
 		a.apply(node, n.Right, replaceUnionRight)
+		a.apply(node, n.With, replaceUnionWith)
 
 	case *Update:
 		a.apply(node, n.Comments, replaceUpdateComments)
@@ -1306,6 +1430,19 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 	case *Where:
 		a.apply(node, n.Expr, replaceWhereExpr)
 
+	case *WindowSpec:
+		a.apply(node, n.Name, replaceWindowSpecName)
+		a.apply(node, n.OrderBy, replaceWindowSpecOrderBy)
+		a.apply(node, n.PartitionBy, replaceWindowSpecPartitionBy)
+
+	case *With:
+		replacerCTEs := replaceWithCTEs(0)
+		replacerCTEsB := &replacerCTEs
+		for _, item := range n.CTEs {
+			a.apply(node, item, replacerCTEsB.replace)
+			replacerCTEsB.inc()
+		}
+
 	default:
 		panic("unknown ast type " + reflect.TypeOf(node).String())
 	}
@@ -7,6 +7,8 @@ import __yyfmt__ "fmt"
 
 //line sql.y:18
 
+import "strings"
+
 func setParseTree(yylex interface{}, stmt Statement) {
 	yylex.(*Tokenizer).ParseTree = stmt
 }
@@ -38,7 +40,7 @@ func skipToEnd(yylex interface{}) {
 	yylex.(*Tokenizer).SkipToEnd = true
 }
 
-//line sql.y:53
+//line sql.y:55
 type yySymType struct {
 	yys                  int
 	empty                struct{}
@@ -70,6 +72,12 @@ type yySymType struct {
 	values               Values
 	valTuple             ValTuple
 	subquery             *Subquery
+	with                 *With
+	cte                  *CommonTableExpr
+	ctes                 []*CommonTableExpr
+	windowSpec           *WindowSpec
+	namedWindows         NamedWindows
+	namedWindow          *NamedWindow
 	whens                []*When
 	when                 *When
 	orderBy              OrderBy
@@ -211,229 +219,235 @@ const ADD = 57446
 const FLUSH = 57447
 const SCHEMA = 57448
 const TABLE = 57449
-const INDEX = 57450
-const VIEW = 57451
-const TO = 57452
-const IGNORE = 57453
-const IF = 57454
-const UNIQUE = 57455
-const PRIMARY = 57456
-const COLUMN = 57457
-const SPATIAL = 57458
-const FULLTEXT = 57459
-const KEY_BLOCK_SIZE = 57460
-const CHECK = 57461
-const ACTION = 57462
-const CASCADE = 57463
-const CONSTRAINT = 57464
-const FOREIGN = 57465
-const NO = 57466
-const REFERENCES = 57467
-const RESTRICT = 57468
-const SHOW = 57469
-const DESCRIBE = 57470
-const EXPLAIN = 57471
-const DATE = 57472
-const ESCAPE = 57473
-const REPAIR = 57474
-const OPTIMIZE = 57475
-const TRUNCATE = 57476
-const MAXVALUE = 57477
-const PARTITION = 57478
-const REORGANIZE = 57479
-const LESS = 57480
-const THAN = 57481
-const PROCEDURE = 57482
-const TRIGGER = 57483
-const VINDEX = 57484
-const VINDEXES = 57485
-const STATUS = 57486
-const VARIABLES = 57487
-const WARNINGS = 57488
-const SEQUENCE = 57489
-const BEGIN = 57490
-const START = 57491
-const TRANSACTION = 57492
-const COMMIT = 57493
-const ROLLBACK = 57494
-const BIT = 57495
-const TINYINT = 57496
-const SMALLINT = 57497
-const MEDIUMINT = 57498
-const INT = 57499
-const INTEGER = 57500
-const BIGINT = 57501
-const INTNUM = 57502
-const REAL = 57503
-const DOUBLE = 57504
-const FLOAT_TYPE = 57505
-const DECIMAL = 57506
-const NUMERIC = 57507
-const TIME = 57508
-const TIMESTAMP = 57509
-const DATETIME = 57510
-const YEAR = 57511
-const CHAR = 57512
-const VARCHAR = 57513
-const BOOL = 57514
-const CHARACTER = 57515
-const VARBINARY = 57516
-const NCHAR = 57517
-const TEXT = 57518
-const TINYTEXT = 57519
-const MEDIUMTEXT = 57520
-const LONGTEXT = 57521
-const BLOB = 57522
-const TINYBLOB = 57523
-const MEDIUMBLOB = 57524
-const LONGBLOB = 57525
-const JSON = 57526
-const ENUM = 57527
-const GEOMETRY = 57528
-const POINT = 57529
-const LINESTRING = 57530
-const POLYGON = 57531
-const GEOMETRYCOLLECTION = 57532
-const MULTIPOINT = 57533
-const MULTILINESTRING = 57534
-const MULTIPOLYGON = 57535
-const NULLX = 57536
-const AUTO_INCREMENT = 57537
-const APPROXNUM = 57538
-const SIGNED = 57539
-const UNSIGNED = 57540
-const ZEROFILL = 57541
-const COLLATION = 57542
-const DATABASES = 57543
-const TABLES = 57544
-const VITESS_METADATA = 57545
-const VSCHEMA = 57546
-const FULL = 57547
-const PROCESSLIST = 57548
-const COLUMNS = 57549
-const FIELDS = 57550
-const ENGINES = 57551
-const PLUGINS = 57552
-const NAMES = 57553
-const CHARSET = 57554
-const GLOBAL = 57555
-const SESSION = 57556
-const ISOLATION = 57557
-const LEVEL = 57558
-const READ = 57559
-const WRITE = 57560
-const ONLY = 57561
-const REPEATABLE = 57562
-const COMMITTED = 57563
-const UNCOMMITTED = 57564
-const SERIALIZABLE = 57565
-const CURRENT_TIMESTAMP = 57566
-const DATABASE = 57567
-const CURRENT_DATE = 57568
-const CURRENT_TIME = 57569
-const LOCALTIME = 57570
-const LOCALTIMESTAMP = 57571
-const UTC_DATE = 57572
-const UTC_TIME = 57573
-const UTC_TIMESTAMP = 57574
-const REPLACE = 57575
-const CONVERT = 57576
-const CAST = 57577
-const SUBSTR = 57578
-const SUBSTRING = 57579
-const GROUP_CONCAT = 57580
-const SEPARATOR = 57581
-const TIMESTAMPADD = 57582
-const TIMESTAMPDIFF = 57583
-const MATCH = 57584
-const AGAINST = 57585
-const BOOLEAN = 57586
-const LANGUAGE = 57587
-const WITH = 57588
-const QUERY = 57589
-const EXPANSION = 57590
-const UNUSED = 57591
-const ARRAY = 57592
-const CUME_DIST = 57593
-const DESCRIPTION = 57594
-const DENSE_RANK = 57595
-const EMPTY = 57596
-const EXCEPT = 57597
-const FIRST_VALUE = 57598
-const GROUPING = 57599
-const GROUPS = 57600
-const JSON_TABLE = 57601
-const LAG = 57602
-const LAST_VALUE = 57603
-const LATERAL = 57604
-const LEAD = 57605
-const MEMBER = 57606
-const NTH_VALUE = 57607
-const NTILE = 57608
-const OF = 57609
-const OVER = 57610
-const PERCENT_RANK = 57611
-const RANK = 57612
-const RECURSIVE = 57613
-const ROW_NUMBER = 57614
+const TEMPORARY = 57450
+const INDEX = 57451
+const VIEW = 57452
+const TO = 57453
+const IGNORE = 57454
+const IF = 57455
+const UNIQUE = 57456
+const PRIMARY = 57457
+const COLUMN = 57458
+const SPATIAL = 57459
+const FULLTEXT = 57460
+const KEY_BLOCK_SIZE = 57461
+const CHECK = 57462
+const ACTION = 57463
+const CASCADE = 57464
+const CONSTRAINT = 57465
+const FOREIGN = 57466
+const NO = 57467
+const REFERENCES = 57468
+const RESTRICT = 57469
+const SHOW = 57470
+const DESCRIBE = 57471
+const EXPLAIN = 57472
+const FORMAT = 57473
+const DATE = 57474
+const ESCAPE = 57475
+const REPAIR = 57476
+const OPTIMIZE = 57477
+const TRUNCATE = 57478
+const MAXVALUE = 57479
+const PARTITION = 57480
+const REORGANIZE = 57481
+const LESS = 57482
+const THAN = 57483
+const PROCEDURE = 57484
+const TRIGGER = 57485
+const VINDEX = 57486
+const VINDEXES = 57487
+const STATUS = 57488
+const VARIABLES = 57489
+const WARNINGS = 57490
+const SEQUENCE = 57491
+const BEGIN = 57492
+const START = 57493
+const TRANSACTION = 57494
+const COMMIT = 57495
+const ROLLBACK = 57496
+const SAVEPOINT = 57497
+const RELEASE = 57498
+const KILL = 57499
+const CONNECTION = 57500
+const BIT = 57501
+const TINYINT = 57502
+const SMALLINT = 57503
+const MEDIUMINT = 57504
+const INT = 57505
+const INTEGER = 57506
+const BIGINT = 57507
+const INTNUM = 57508
+const REAL = 57509
+const DOUBLE = 57510
+const FLOAT_TYPE = 57511
+const DECIMAL = 57512
+const NUMERIC = 57513
+const TIME = 57514
+const TIMESTAMP = 57515
+const DATETIME = 57516
+const YEAR = 57517
+const CHAR = 57518
+const VARCHAR = 57519
+const BOOL = 57520
+const CHARACTER = 57521
+const VARBINARY = 57522
+const NCHAR = 57523
+const TEXT = 57524
+const TINYTEXT = 57525
+const MEDIUMTEXT = 57526
+const LONGTEXT = 57527
+const BLOB = 57528
+const TINYBLOB = 57529
+const MEDIUMBLOB = 57530
+const LONGBLOB = 57531
+const JSON = 57532
+const ENUM = 57533
+const GEOMETRY = 57534
+const POINT = 57535
+const LINESTRING = 57536
+const POLYGON = 57537
+const GEOMETRYCOLLECTION = 57538
+const MULTIPOINT = 57539
+const MULTILINESTRING = 57540
+const MULTIPOLYGON = 57541
+const NULLX = 57542
+const AUTO_INCREMENT = 57543
+const APPROXNUM = 57544
+const SIGNED = 57545
+const UNSIGNED = 57546
+const ZEROFILL = 57547
+const COLLATION = 57548
+const DATABASES = 57549
+const TABLES = 57550
+const VITESS_METADATA = 57551
+const VSCHEMA = 57552
+const FULL = 57553
+const PROCESSLIST = 57554
+const COLUMNS = 57555
+const FIELDS = 57556
+const ENGINES = 57557
+const PLUGINS = 57558
+const NAMES = 57559
+const CHARSET = 57560
+const GLOBAL = 57561
+const SESSION = 57562
+const ISOLATION = 57563
+const LEVEL = 57564
+const READ = 57565
+const WRITE = 57566
+const ONLY = 57567
+const REPEATABLE = 57568
+const COMMITTED = 57569
+const UNCOMMITTED = 57570
+const SERIALIZABLE = 57571
+const CURRENT_TIMESTAMP = 57572
+const DATABASE = 57573
+const CURRENT_DATE = 57574
+const CURRENT_TIME = 57575
+const LOCALTIME = 57576
+const LOCALTIMESTAMP = 57577
+const UTC_DATE = 57578
+const UTC_TIME = 57579
+const UTC_TIMESTAMP = 57580
+const REPLACE = 57581
+const CONVERT = 57582
+const CAST = 57583
+const SUBSTR = 57584
+const SUBSTRING = 57585
+const GROUP_CONCAT = 57586
+const SEPARATOR = 57587
+const TIMESTAMPADD = 57588
+const TIMESTAMPDIFF = 57589
+const MATCH = 57590
+const AGAINST = 57591
+const BOOLEAN = 57592
+const LANGUAGE = 57593
+const WITH = 57594
+const QUERY = 57595
+const EXPANSION = 57596
+const UNUSED = 57597
+const ARRAY = 57598
+const CUME_DIST = 57599
+const DESCRIPTION = 57600
+const EMPTY = 57601
+const EXCEPT = 57602
+const FIRST_VALUE = 57603
+const GROUPING = 57604
+const GROUPS = 57605
+const JSON_TABLE = 57606
+const LAST_VALUE = 57607
+const LATERAL = 57608
+const MEMBER = 57609
+const NTH_VALUE = 57610
+const NTILE = 57611
+const OF = 57612
+const PERCENT_RANK = 57613
+const RECURSIVE = 57614
 const SYSTEM = 57615
-const WINDOW = 57616
-const ACTIVE = 57617
-const ADMIN = 57618
-const BUCKETS = 57619
-const CLONE = 57620
-const COMPONENT = 57621
-const DEFINITION = 57622
-const ENFORCED = 57623
-const EXCLUDE = 57624
-const FOLLOWING = 57625
-const GEOMCOLLECTION = 57626
-const GET_MASTER_PUBLIC_KEY = 57627
-const HISTOGRAM = 57628
-const HISTORY = 57629
-const INACTIVE = 57630
-const INVISIBLE = 57631
-const LOCKED = 57632
-const MASTER_COMPRESSION_ALGORITHMS = 57633
-const MASTER_PUBLIC_KEY_PATH = 57634
-const MASTER_TLS_CIPHERSUITES = 57635
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57636
-const NESTED = 57637
-const NETWORK_NAMESPACE = 57638
-const NOWAIT = 57639
-const NULLS = 57640
-const OJ = 57641
-const OLD = 57642
-const OPTIONAL = 57643
-const ORDINALITY = 57644
-const ORGANIZATION = 57645
-const OTHERS = 57646
-const PATH = 57647
-const PERSIST = 57648
-const PERSIST_ONLY = 57649
-const PRECEDING = 57650
-const PRIVILEGE_CHECKS_USER = 57651
-const PROCESS = 57652
-const RANDOM = 57653
-const REFERENCE = 57654
-const REQUIRE_ROW_FORMAT = 57655
-const RESOURCE = 57656
-const RESPECT = 57657
-const RESTART = 57658
-const RETAIN = 57659
-const REUSE = 57660
-const ROLE = 57661
-const SECONDARY = 57662
-const SECONDARY_ENGINE = 57663
-const SECONDARY_LOAD = 57664
-const SECONDARY_UNLOAD = 57665
-const SKIP = 57666
-const SRID = 57667
-const THREAD_PRIORITY = 57668
-const TIES = 57669
-const UNBOUNDED = 57670
-const VCPU = 57671
-const VISIBLE = 57672
+const OVER = 57616
+const WINDOW = 57617
+const ROW_NUMBER = 57618
+const RANK = 57619
+const DENSE_RANK = 57620
+const LAG = 57621
+const LEAD = 57622
+const ACTIVE = 57623
+const ADMIN = 57624
+const BUCKETS = 57625
+const CLONE = 57626
+const COMPONENT = 57627
+const DEFINITION = 57628
+const ENFORCED = 57629
+const EXCLUDE = 57630
+const FOLLOWING = 57631
+const GEOMCOLLECTION = 57632
+const GET_MASTER_PUBLIC_KEY = 57633
+const HISTOGRAM = 57634
+const HISTORY = 57635
+const INACTIVE = 57636
+const INVISIBLE = 57637
+const LOCKED = 57638
+const MASTER_COMPRESSION_ALGORITHMS = 57639
+const MASTER_PUBLIC_KEY_PATH = 57640
+const MASTER_TLS_CIPHERSUITES = 57641
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57642
+const NESTED = 57643
+const NETWORK_NAMESPACE = 57644
+const NOWAIT = 57645
+const NULLS = 57646
+const OJ = 57647
+const OLD = 57648
+const OPTIONAL = 57649
+const ORDINALITY = 57650
+const ORGANIZATION = 57651
+const OTHERS = 57652
+const PATH = 57653
+const PERSIST = 57654
+const PERSIST_ONLY = 57655
+const PRECEDING = 57656
+const PRIVILEGE_CHECKS_USER = 57657
+const PROCESS = 57658
+const RANDOM = 57659
+const REFERENCE = 57660
+const REQUIRE_ROW_FORMAT = 57661
+const RESOURCE = 57662
+const RESPECT = 57663
+const RESTART = 57664
+const RETAIN = 57665
+const REUSE = 57666
+const ROLE = 57667
+const SECONDARY = 57668
+const SECONDARY_ENGINE = 57669
+const SECONDARY_LOAD = 57670
+const SECONDARY_UNLOAD = 57671
+const SKIP = 57672
+const SRID = 57673
+const THREAD_PRIORITY = 57674
+const TIES = 57675
+const UNBOUNDED = 57676
+const VCPU = 57677
+const VISIBLE = 57678
 
 var yyToknames = [...]string{
 	"$end",
@@ -560,6 +574,7 @@ var yyToknames = [...]string{
 	"FLUSH",
 	"SCHEMA",
 	"TABLE",
+	"TEMPORARY",
 	"INDEX",
 	"VIEW",
 	"TO",
@@ -582,6 +597,7 @@ var yyToknames = [...]string{
 	"SHOW",
 	"DESCRIBE",
 	"EXPLAIN",
+	"FORMAT",
 	"DATE",
 	"ESCAPE",
 	"REPAIR",
@@ -605,6 +621,10 @@ var yyToknames = [...]string{
 	"TRANSACTION",
 	"COMMIT",
 	"ROLLBACK",
+	"SAVEPOINT",
+	"RELEASE",
+	"KILL",
+	"CONNECTION",
 	"BIT",
 	"TINYINT",
 	"SMALLINT",
@@ -705,28 +725,28 @@ var yyToknames = [...]string{
 	"ARRAY",
 	"CUME_DIST",
 	"DESCRIPTION",
-	"DENSE_RANK",
 	"EMPTY",
 	"EXCEPT",
 	"FIRST_VALUE",
 	"GROUPING",
 	"GROUPS",
 	"JSON_TABLE",
-	"LAG",
 	"LAST_VALUE",
 	"LATERAL",
-	"LEAD",
 	"MEMBER",
 	"NTH_VALUE",
 	"NTILE",
 	"OF",
-	"OVER",
 	"PERCENT_RANK",
-	"RANK",
 	"RECURSIVE",
-	"ROW_NUMBER",
 	"SYSTEM",
+	"OVER",
 	"WINDOW",
+	"ROW_NUMBER",
+	"RANK",
+	"DENSE_RANK",
+	"LAG",
+	"LEAD",
 	"ACTIVE",
 	"ADMIN",
 	"BUCKETS",
@@ -797,1796 +817,2052 @@ var yyExca = [...]int{
 	1, -1,
 	-2, 0,
 	-1, 3,
-	5, 29,
+	5, 40,
 	-2, 4,
 	-1, 37,
-	161, 302,
-	162, 302,
-	-2, 290,
-	-1, 322,
-	113, 646,
-	-2, 642,
-	-1, 323,
-	113, 647,
-	-2, 643,
-	-1, 392,
-	83, 895,
-	-2, 63,
-	-1, 393,
-	83, 813,
-	-2, 64,
-	-1, 398,
-	83, 782,
-	-2, 608,
-	-1, 400,
-	83, 843,
-	-2, 610,
-	-1, 699,
-	1, 355,
-	5, 355,
-	12, 355,
-	13, 355,
-	14, 355,
-	15, 355,
-	17, 355,
-	19, 355,
-	30, 355,
-	31, 355,
-	43, 355,
-	44, 355,
-	45, 355,
-	46, 355,
-	47, 355,
-	49, 355,
-	50, 355,
-	53, 355,
-	54, 355,
-	56, 355,
-	57, 355,
-	348, 355,
-	-2, 373,
-	-1, 702,
-	54, 44,
-	56, 44,
-	-2, 48,
-	-1, 854,
-	113, 649,
-	-2, 645,
-	-1, 1084,
-	5, 30,
-	-2, 441,
-	-1, 1115,
-	5, 29,
-	-2, 582,
-	-1, 1359,
-	5, 30,
-	-2, 583,
-	-1, 1412,
-	5, 29,
-	-2, 585,
-	-1, 1492,
-	5, 30,
-	-2, 586,
+	126, 688,
+	-2, 92,
+	-1, 43,
+	163, 315,
+	164, 315,
+	-2, 303,
+	-1, 66,
+	5, 40,
+	-2, 5,
+	-1, 269,
+	126, 962,
+	-2, 93,
+	-1, 356,
+	113, 697,
+	-2, 693,
+	-1, 357,
+	113, 698,
+	-2, 694,
+	-1, 431,
+	83, 951,
+	-2, 74,
+	-1, 432,
+	83, 867,
+	-2, 75,
+	-1, 437,
+	83, 834,
+	-2, 659,
+	-1, 439,
+	83, 897,
+	-2, 661,
+	-1, 626,
+	5, 40,
+	-2, 340,
+	-1, 763,
+	1, 388,
+	5, 388,
+	12, 388,
+	13, 388,
+	14, 388,
+	15, 388,
+	17, 388,
+	19, 388,
+	30, 388,
+	31, 388,
+	43, 388,
+	44, 388,
+	45, 388,
+	46, 388,
+	47, 388,
+	49, 388,
+	50, 388,
+	53, 388,
+	54, 388,
+	56, 388,
+	57, 388,
+	292, 388,
+	354, 388,
+	-2, 406,
+	-1, 766,
+	54, 55,
+	56, 55,
+	-2, 59,
+	-1, 889,
+	5, 40,
+	-2, 341,
+	-1, 926,
+	113, 700,
+	-2, 696,
+	-1, 1168,
+	5, 41,
+	-2, 474,
+	-1, 1205,
+	5, 40,
+	-2, 633,
+	-1, 1460,
+	5, 41,
+	-2, 634,
+	-1, 1516,
+	5, 40,
+	-2, 636,
+	-1, 1597,
+	5, 41,
+	-2, 637,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 16995
+const yyLast = 19402
 
 var yyAct = [...]int{
 
-	323, 1526, 1516, 1321, 1480, 654, 1392, 1379, 1210, 1118,
-	327, 353, 1261, 340, 969, 1295, 942, 1425, 1262, 998,
-	965, 1136, 1119, 940, 552, 1258, 968, 1012, 1163, 978,
-	1268, 57, 81, 397, 301, 1274, 266, 1233, 801, 266,
-	817, 879, 890, 1189, 1180, 886, 1075, 982, 715, 992,
-	908, 292, 856, 585, 944, 591, 714, 521, 386, 701,
-	391, 922, 1008, 310, 929, 597, 606, 266, 81, 383,
-	325, 388, 266, 1142, 266, 696, 668, 704, 56, 1519,
-	1503, 61, 695, 669, 1514, 653, 3, 1031, 1490, 1511,
-	1322, 541, 1502, 563, 1489, 263, 293, 294, 295, 296,
-	1250, 1030, 299, 329, 1351, 526, 314, 63, 64, 65,
-	66, 67, 1454, 619, 618, 628, 629, 621, 622, 623,
-	624, 625, 626, 627, 620, 1289, 385, 630, 556, 1151,
-	1035, 523, 1150, 525, 716, 1152, 717, 300, 365, 1029,
-	371, 372, 369, 370, 368, 367, 366, 1290, 1291, 960,
-	961, 959, 579, 298, 373, 374, 261, 257, 258, 259,
-	574, 394, 297, 1171, 575, 572, 573, 1234, 991, 1212,
-	1382, 1399, 253, 999, 1342, 251, 1340, 255, 291, 790,
-	577, 567, 568, 789, 1214, 787, 1513, 1510, 1481, 1026,
-	1023, 1024, 1209, 1022, 558, 923, 560, 983, 1473, 1534,
-	542, 1215, 528, 255, 1426, 1236, 794, 1530, 778, 1137,
-	1139, 578, 1284, 985, 1283, 1282, 524, 1428, 788, 791,
-	531, 268, 1213, 1434, 1206, 1033, 1036, 557, 559, 538,
-	1208, 256, 1043, 642, 643, 1042, 1093, 1462, 1090, 1238,
-	1362, 1242, 1219, 1237, 1147, 1235, 1103, 1069, 985, 828,
-	1240, 710, 266, 610, 548, 1307, 966, 266, 620, 1239,
-	630, 630, 1028, 266, 24, 25, 52, 27, 28, 266,
-	254, 955, 1241, 1243, 81, 260, 81, 81, 1164, 81,
-	825, 81, 1455, 43, 1027, 1427, 1138, 81, 29, 48,
-	49, 252, 535, 605, 536, 822, 1471, 537, 522, 1443,
-	554, 1272, 718, 999, 818, 1488, 1308, 70, 276, 38,
-	984, 532, 555, 54, 1252, 1528, 540, 81, 1529, 780,
-	1527, 909, 547, 1032, 1476, 1435, 1433, 1207, 549, 1205,
-	1169, 520, 863, 286, 54, 1088, 593, 1087, 1034, 642,
-	643, 642, 643, 71, 859, 984, 861, 862, 860, 581,
-	582, 544, 545, 546, 604, 603, 619, 618, 628, 629,
-	621, 622, 623, 624, 625, 626, 627, 620, 1089, 603,
-	630, 605, 600, 1494, 31, 32, 34, 33, 36, 553,
-	50, 266, 266, 266, 269, 605, 819, 1388, 988, 595,
-	81, 272, 594, 909, 989, 1100, 81, 1535, 1387, 280,
-	275, 527, 37, 44, 45, 694, 1184, 46, 47, 35,
-	1076, 621, 622, 623, 624, 625, 626, 627, 620, 604,
-	603, 630, 1183, 39, 40, 985, 41, 42, 640, 584,
-	1172, 880, 278, 881, 1348, 1496, 605, 1536, 285, 1153,
-	693, 1154, 702, 671, 673, 675, 677, 679, 681, 682,
-	672, 674, 703, 678, 680, 522, 683, 846, 848, 849,
-	1472, 712, 708, 847, 1406, 270, 619, 618, 628, 629,
-	621, 622, 623, 624, 625, 626, 627, 620, 529, 530,
-	630, 1385, 1181, 394, 1052, 699, 623, 624, 625, 626,
-	627, 620, 282, 273, 630, 283, 284, 289, 831, 832,
-	806, 274, 277, 22, 271, 288, 287, 1469, 53, 619,
-	618, 628, 629, 621, 622, 623, 624, 625, 626, 627,
-	620, 266, 984, 630, 604, 603, 81, 981, 979, 1324,
-	980, 266, 266, 81, 81, 81, 977, 983, 1164, 266,
-	827, 605, 266, 1431, 1512, 266, 1159, 604, 603, 266,
-	250, 81, 882, 352, 1498, 584, 81, 81, 81, 266,
-	81, 81, 800, 305, 605, 799, 604, 603, 81, 81,
-	913, 1431, 1484, 1254, 1431, 584, 805, 781, 826, 779,
-	726, 776, 803, 605, 550, 79, 1066, 1067, 1068, 543,
-	782, 783, 534, 1354, 533, 604, 603, 81, 792, 1431,
-	1463, 385, 266, 584, 798, 1431, 1430, 1440, 81, 889,
-	1439, 795, 605, 1377, 1376, 380, 381, 1304, 811, 354,
-	51, 396, 1364, 584, 986, 857, 1361, 584, 833, 1314,
-	1313, 619, 618, 628, 629, 621, 622, 623, 624, 625,
-	626, 627, 620, 1271, 854, 630, 1310, 1311, 1259, 852,
-	1211, 1271, 81, 343, 342, 345, 346, 347, 348, 835,
-	892, 842, 344, 349, 1310, 1309, 1082, 584, 926, 584,
-	1222, 51, 899, 902, 850, 892, 584, 706, 910, 306,
-	725, 724, 58, 1357, 706, 81, 81, 24, 931, 934,
-	935, 936, 932, 266, 933, 937, 1143, 24, 1275, 1276,
-	24, 266, 266, 1442, 853, 266, 266, 1143, 926, 266,
-	266, 266, 81, 883, 884, 1082, 1411, 858, 1312, 925,
-	707, 1113, 709, 1082, 1155, 81, 1114, 707, 950, 705,
-	906, 958, 952, 918, 919, 1106, 54, 949, 1105, 705,
-	926, 1082, 894, 705, 803, 926, 54, 307, 711, 54,
-	1395, 1271, 924, 829, 793, 54, 1504, 1394, 993, 1000,
-	1001, 1002, 1369, 1013, 948, 951, 1300, 1158, 953, 1009,
-	957, 1004, 956, 1275, 1276, 1521, 1003, 1016, 1517, 266,
-	81, 1302, 81, 973, 994, 995, 996, 997, 266, 266,
-	266, 266, 266, 1278, 266, 266, 54, 1259, 266, 81,
-	1005, 1006, 1007, 1014, 699, 394, 1185, 823, 699, 797,
-	1130, 1128, 699, 1197, 841, 1131, 1129, 266, 970, 266,
-	266, 1281, 1280, 1132, 266, 935, 936, 396, 1054, 396,
-	396, 1127, 396, 1126, 396, 1010, 1011, 1508, 1017, 1501,
-	396, 1218, 1049, 1195, 311, 312, 1506, 1037, 1038, 1039,
-	1040, 1041, 598, 1044, 1045, 1064, 1063, 1046, 1176, 723,
-	598, 1168, 854, 586, 551, 599, 1478, 1057, 596, 1477,
-	608, 583, 857, 599, 1409, 587, 1048, 1166, 1160, 1355,
-	1390, 1448, 1019, 1053, 796, 1058, 939, 1059, 308, 309,
-	302, 1062, 303, 562, 58, 562, 562, 1447, 562, 1061,
-	562, 931, 934, 935, 936, 932, 562, 933, 937, 1397,
-	1196, 1143, 576, 1071, 1094, 1201, 1198, 1191, 1199, 1194,
-	1091, 1190, 853, 816, 1192, 1193, 51, 266, 266, 266,
-	266, 266, 1523, 1522, 1523, 601, 1120, 1459, 1200, 266,
-	1383, 639, 266, 396, 641, 824, 266, 60, 62, 720,
-	266, 55, 1, 1515, 1323, 1391, 1025, 1479, 1099, 1424,
-	1294, 976, 967, 69, 858, 519, 68, 1470, 975, 81,
-	974, 1432, 652, 1144, 656, 657, 658, 659, 660, 661,
-	662, 663, 664, 1156, 667, 670, 670, 670, 676, 670,
-	670, 676, 670, 684, 685, 686, 687, 688, 689, 690,
-	1141, 700, 1133, 1122, 1123, 1381, 1125, 1148, 1115, 1121,
-	987, 1165, 1124, 1170, 1173, 1174, 1347, 81, 81, 1175,
-	990, 1177, 1178, 1179, 1145, 1301, 1146, 894, 1161, 1162,
-	699, 699, 699, 699, 699, 1167, 1475, 731, 729, 730,
-	728, 733, 732, 727, 279, 699, 389, 81, 938, 1182,
-	719, 1015, 602, 699, 72, 1204, 1203, 1021, 821, 570,
-	571, 266, 970, 281, 638, 1060, 1149, 1202, 395, 1266,
-	81, 830, 590, 1188, 1446, 1396, 1098, 665, 907, 396,
-	328, 845, 341, 338, 339, 836, 396, 396, 396, 1217,
-	1112, 619, 618, 628, 629, 621, 622, 623, 624, 625,
-	626, 627, 620, 320, 396, 630, 612, 326, 318, 396,
-	396, 396, 698, 396, 396, 691, 1225, 81, 81, 1226,
-	1220, 396, 396, 1260, 1120, 1232, 1263, 1245, 930, 1251,
-	1244, 928, 927, 384, 1277, 1273, 697, 854, 1221, 1350,
-	1453, 81, 1057, 840, 26, 562, 59, 313, 19, 18,
-	837, 1346, 562, 562, 562, 17, 81, 20, 81, 81,
-	1279, 608, 16, 1224, 396, 834, 15, 14, 539, 30,
-	562, 1285, 1293, 21, 1286, 562, 562, 562, 13, 562,
-	562, 12, 1292, 11, 10, 9, 266, 562, 562, 1297,
-	1298, 1299, 1270, 8, 1305, 1306, 7, 1255, 6, 5,
-	4, 304, 1265, 23, 266, 885, 2, 0, 0, 0,
-	81, 0, 0, 81, 81, 81, 266, 0, 1288, 0,
-	81, 911, 0, 266, 891, 893, 619, 618, 628, 629,
-	621, 622, 623, 624, 625, 626, 627, 620, 915, 916,
-	630, 1316, 1329, 0, 0, 1315, 1331, 0, 0, 970,
-	0, 970, 0, 0, 1317, 0, 1319, 0, 0, 0,
-	0, 51, 0, 1318, 0, 396, 1338, 895, 896, 0,
-	0, 901, 904, 905, 0, 1328, 656, 0, 396, 0,
-	0, 0, 1120, 0, 1335, 1336, 1356, 1337, 0, 316,
-	1339, 0, 1341, 81, 1366, 1330, 917, 0, 0, 920,
-	921, 81, 1365, 0, 0, 0, 0, 1156, 0, 0,
-	0, 0, 0, 1224, 0, 0, 81, 0, 0, 0,
-	941, 1375, 0, 81, 700, 0, 699, 0, 700, 1384,
-	0, 1386, 0, 396, 0, 396, 628, 629, 621, 622,
-	623, 624, 625, 626, 627, 620, 1378, 0, 630, 0,
-	0, 0, 396, 0, 0, 0, 1398, 0, 0, 0,
-	0, 0, 81, 81, 0, 81, 0, 0, 0, 1263,
-	81, 0, 81, 81, 81, 266, 1410, 1418, 81, 1419,
-	1421, 1422, 396, 0, 0, 1405, 970, 0, 0, 0,
-	0, 1423, 0, 1429, 0, 81, 266, 1436, 0, 562,
-	1417, 562, 1444, 0, 0, 1437, 0, 1438, 0, 0,
-	0, 0, 0, 0, 0, 0, 1393, 0, 562, 0,
-	0, 0, 0, 1263, 1460, 0, 0, 0, 0, 0,
-	0, 0, 81, 0, 644, 645, 646, 647, 648, 649,
-	650, 651, 1468, 81, 81, 1467, 1412, 0, 0, 1482,
-	1065, 0, 0, 1078, 1486, 1445, 0, 1079, 1483, 0,
-	0, 0, 0, 81, 0, 1084, 1085, 1086, 0, 1491,
-	1120, 0, 1092, 1070, 266, 1095, 1096, 0, 0, 911,
-	0, 1102, 81, 0, 0, 1104, 0, 0, 1107, 1108,
-	1109, 1110, 1111, 1500, 0, 0, 0, 1080, 1081, 1461,
-	0, 0, 0, 1505, 1507, 81, 0, 0, 0, 0,
-	561, 1135, 1509, 0, 0, 0, 1097, 0, 1520, 0,
-	0, 0, 396, 0, 0, 1531, 0, 0, 0, 0,
-	0, 0, 0, 1495, 1353, 0, 1393, 970, 0, 0,
-	0, 0, 1116, 1117, 0, 0, 700, 700, 700, 700,
-	700, 0, 0, 0, 0, 589, 0, 0, 0, 0,
-	0, 941, 0, 1140, 0, 0, 0, 0, 0, 700,
-	1186, 396, 619, 618, 628, 629, 621, 622, 623, 624,
-	625, 626, 627, 620, 0, 0, 630, 0, 0, 0,
-	0, 264, 588, 592, 290, 0, 0, 1345, 0, 0,
-	396, 0, 0, 0, 0, 0, 0, 0, 0, 611,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 317,
-	0, 0, 387, 396, 0, 0, 0, 264, 0, 264,
-	0, 0, 0, 0, 0, 0, 0, 562, 0, 0,
-	0, 0, 0, 0, 655, 0, 0, 0, 0, 0,
-	0, 1230, 1231, 666, 0, 0, 0, 396, 0, 0,
-	0, 0, 0, 0, 0, 0, 562, 911, 0, 0,
-	1267, 1269, 619, 618, 628, 629, 621, 622, 623, 624,
-	625, 626, 627, 620, 0, 0, 630, 0, 0, 0,
-	0, 0, 0, 0, 1269, 0, 619, 618, 628, 629,
-	621, 622, 623, 624, 625, 626, 627, 620, 0, 396,
-	630, 396, 1296, 0, 0, 0, 855, 1227, 0, 864,
-	865, 866, 867, 868, 869, 870, 871, 872, 873, 874,
-	875, 876, 877, 878, 1264, 0, 51, 619, 618, 628,
-	629, 621, 622, 623, 624, 625, 626, 627, 620, 0,
-	0, 630, 618, 628, 629, 621, 622, 623, 624, 625,
-	626, 627, 620, 1320, 0, 630, 1325, 1326, 1327, 0,
-	0, 0, 0, 396, 914, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 564, 565, 0, 566,
-	0, 569, 0, 0, 0, 0, 1332, 580, 0, 0,
-	0, 0, 0, 0, 1334, 0, 0, 264, 0, 0,
-	0, 0, 264, 0, 0, 1343, 1344, 0, 264, 0,
-	0, 0, 0, 0, 264, 911, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1358, 1359, 1360, 0, 1363,
-	0, 0, 700, 0, 807, 0, 396, 0, 0, 0,
-	0, 0, 0, 0, 1380, 0, 1374, 1077, 0, 0,
-	0, 0, 0, 0, 0, 0, 820, 0, 0, 396,
-	1349, 0, 0, 0, 0, 0, 396, 619, 618, 628,
-	629, 621, 622, 623, 624, 625, 626, 627, 620, 0,
-	0, 630, 0, 843, 844, 0, 0, 0, 0, 0,
-	0, 0, 1371, 1372, 1373, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1414, 1415, 0, 1416, 0,
-	0, 0, 0, 1380, 0, 1380, 1380, 1380, 0, 0,
-	0, 1296, 0, 0, 0, 562, 264, 264, 264, 0,
-	0, 0, 1420, 0, 0, 0, 655, 0, 1380, 897,
-	898, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1072, 1073, 1074, 0, 0,
-	0, 1449, 1450, 1451, 1452, 0, 1456, 1264, 1457, 1458,
-	1413, 0, 0, 0, 0, 1474, 0, 0, 0, 0,
-	1464, 0, 1465, 1466, 0, 0, 396, 396, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 964, 0,
-	1441, 0, 0, 911, 0, 0, 1493, 0, 0, 0,
-	0, 0, 0, 0, 1487, 0, 0, 0, 0, 0,
-	0, 1264, 1492, 51, 0, 1499, 777, 0, 0, 0,
-	0, 0, 0, 784, 785, 786, 0, 0, 0, 0,
-	1497, 0, 0, 0, 0, 0, 0, 0, 1380, 0,
-	0, 804, 0, 0, 0, 0, 808, 809, 810, 0,
-	812, 813, 0, 0, 0, 0, 264, 0, 814, 815,
-	0, 0, 0, 0, 0, 0, 264, 264, 0, 0,
-	0, 0, 0, 0, 264, 1532, 1533, 264, 0, 0,
-	264, 0, 0, 0, 802, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 264, 0, 1055, 1056, 614, 592,
-	617, 0, 0, 0, 0, 0, 631, 632, 633, 634,
-	635, 636, 637, 1518, 615, 616, 613, 619, 618, 628,
-	629, 621, 622, 623, 624, 625, 626, 627, 620, 0,
-	0, 630, 0, 0, 0, 0, 0, 264, 0, 0,
-	0, 0, 0, 0, 0, 0, 802, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1228, 1229, 1083, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1246, 1247, 0, 1248, 1249, 1101,
-	0, 0, 0, 0, 0, 0, 0, 0, 317, 1256,
-	1257, 0, 0, 317, 317, 0, 0, 317, 317, 317,
-	0, 0, 0, 912, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 317, 317, 317, 317, 317, 0, 264, 0,
-	0, 0, 0, 0, 0, 0, 264, 946, 0, 0,
-	264, 264, 0, 0, 264, 954, 802, 0, 0, 0,
-	0, 0, 1303, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1018, 0, 1020, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1047,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1333, 0, 0, 264, 0, 0, 0, 0, 0,
-	0, 0, 0, 264, 264, 264, 264, 264, 0, 264,
-	264, 0, 0, 264, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 264, 0, 1050, 1051, 0, 0, 0, 264,
-	0, 0, 0, 0, 802, 0, 0, 0, 0, 0,
-	1253, 0, 0, 0, 0, 0, 317, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 748,
-	0, 0, 0, 1287, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 317, 317, 0, 0, 0, 0, 1400,
-	1401, 1402, 1403, 1404, 0, 0, 0, 1407, 1408, 0,
-	0, 0, 317, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 912, 264, 264, 264, 264, 264, 0, 0, 0,
-	0, 0, 0, 0, 1134, 0, 0, 264, 0, 0,
-	0, 946, 0, 0, 0, 264, 0, 0, 736, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1187, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1352, 0, 749, 0, 0, 0,
-	0, 0, 0, 0, 655, 0, 0, 1216, 0, 0,
-	0, 0, 1367, 0, 0, 1368, 0, 0, 1370, 762,
-	765, 766, 767, 768, 769, 770, 0, 771, 772, 773,
-	774, 775, 750, 751, 752, 753, 734, 735, 763, 0,
-	737, 0, 738, 739, 740, 741, 742, 743, 744, 745,
-	746, 747, 754, 755, 756, 757, 758, 759, 760, 761,
-	0, 0, 0, 0, 0, 0, 264, 0, 0, 0,
-	0, 0, 1524, 0, 0, 0, 317, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 317, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 802,
-	764, 0, 0, 0, 0, 0, 0, 0, 0, 912,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1485, 655, 0,
-	0, 264, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 264,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 264, 0, 0, 0, 0, 0, 0, 264, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1389, 912, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	946, 0, 0, 0, 0, 0, 506, 494, 0, 451,
-	509, 424, 441, 517, 442, 445, 482, 409, 464, 165,
-	439, 264, 428, 404, 435, 405, 426, 453, 111, 457,
-	423, 496, 467, 508, 137, 429, 515, 139, 473, 0,
-	211, 153, 0, 0, 455, 498, 462, 491, 450, 483,
-	414, 472, 510, 440, 480, 511, 0, 0, 0, 80,
-	0, 971, 972, 0, 0, 0, 0, 0, 101, 0,
-	477, 505, 437, 479, 481, 403, 474, 0, 407, 410,
-	516, 501, 432, 433, 1157, 912, 0, 0, 0, 0,
-	0, 454, 463, 488, 448, 0, 0, 0, 0, 264,
-	0, 0, 0, 430, 0, 471, 0, 0, 0, 411,
-	408, 0, 0, 452, 0, 0, 0, 413, 0, 431,
-	489, 0, 401, 119, 493, 500, 449, 267, 504, 447,
-	446, 507, 184, 0, 215, 122, 136, 97, 83, 93,
-	0, 121, 162, 191, 195, 497, 427, 436, 105, 434,
-	193, 172, 231, 470, 174, 192, 140, 221, 185, 230,
-	240, 241, 218, 238, 245, 208, 86, 217, 229, 102,
-	203, 88, 227, 214, 151, 131, 132, 87, 0, 189,
-	110, 117, 107, 164, 224, 225, 106, 248, 94, 237,
-	90, 95, 236, 158, 220, 228, 152, 145, 89, 226,
-	150, 144, 135, 114, 124, 182, 142, 183, 125, 155,
-	154, 156, 0, 406, 0, 212, 234, 249, 99, 422,
-	219, 243, 244, 0, 0, 100, 118, 113, 181, 157,
-	96, 127, 209, 134, 141, 188, 247, 171, 194, 103,
-	233, 210, 418, 421, 416, 417, 465, 466, 512, 513,
-	514, 490, 412, 0, 419, 420, 0, 495, 502, 503,
-	469, 82, 91, 138, 246, 186, 116, 235, 402, 415,
-	109, 425, 0, 0, 438, 443, 444, 456, 458, 459,
-	460, 461, 468, 475, 476, 478, 484, 485, 486, 487,
-	492, 499, 518, 84, 85, 92, 98, 104, 108, 112,
-	115, 120, 123, 126, 128, 129, 130, 133, 143, 146,
-	147, 148, 149, 159, 160, 161, 163, 166, 167, 168,
-	169, 170, 173, 175, 176, 177, 178, 179, 180, 187,
-	190, 196, 197, 198, 199, 200, 201, 202, 204, 205,
-	206, 207, 213, 216, 222, 223, 232, 239, 242, 506,
-	494, 0, 451, 509, 424, 441, 517, 442, 445, 482,
-	409, 464, 165, 439, 0, 428, 404, 435, 405, 426,
-	453, 111, 457, 423, 496, 467, 508, 137, 429, 515,
-	139, 473, 0, 211, 153, 0, 0, 455, 498, 462,
-	491, 450, 483, 414, 472, 510, 440, 480, 511, 0,
-	0, 0, 80, 0, 971, 972, 0, 0, 0, 0,
-	0, 101, 0, 477, 505, 437, 479, 481, 403, 474,
-	0, 407, 410, 516, 501, 432, 433, 0, 0, 0,
-	0, 0, 0, 0, 454, 463, 488, 448, 0, 0,
-	0, 0, 0, 0, 0, 0, 430, 0, 471, 0,
-	0, 0, 411, 408, 0, 0, 452, 0, 0, 0,
-	413, 0, 431, 489, 0, 401, 119, 493, 500, 449,
-	267, 504, 447, 446, 507, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 497, 427,
-	436, 105, 434, 193, 172, 231, 470, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 406, 0, 212, 234,
-	249, 99, 422, 219, 243, 244, 0, 0, 100, 118,
-	113, 181, 157, 96, 127, 209, 134, 141, 188, 247,
-	171, 194, 103, 233, 210, 418, 421, 416, 417, 465,
-	466, 512, 513, 514, 490, 412, 0, 419, 420, 0,
-	495, 502, 503, 469, 82, 91, 138, 246, 186, 116,
-	235, 402, 415, 109, 425, 0, 0, 438, 443, 444,
-	456, 458, 459, 460, 461, 468, 475, 476, 478, 484,
-	485, 486, 487, 492, 499, 518, 84, 85, 92, 98,
-	104, 108, 112, 115, 120, 123, 126, 128, 129, 130,
-	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
-	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
-	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
-	202, 204, 205, 206, 207, 213, 216, 222, 223, 232,
-	239, 242, 506, 494, 0, 451, 509, 424, 441, 517,
-	442, 445, 482, 409, 464, 165, 439, 0, 428, 404,
-	435, 405, 426, 453, 111, 457, 423, 496, 467, 508,
-	137, 429, 515, 139, 473, 0, 211, 153, 0, 0,
-	455, 498, 462, 491, 450, 483, 414, 472, 510, 440,
-	480, 511, 54, 0, 0, 80, 0, 0, 0, 0,
-	0, 0, 0, 0, 101, 0, 477, 505, 437, 479,
-	481, 403, 474, 0, 407, 410, 516, 501, 432, 433,
-	0, 0, 0, 0, 0, 0, 0, 454, 463, 488,
-	448, 0, 0, 0, 0, 0, 0, 0, 0, 430,
-	0, 471, 0, 0, 0, 411, 408, 0, 0, 452,
-	0, 0, 0, 413, 0, 431, 489, 0, 401, 119,
-	493, 500, 449, 267, 504, 447, 446, 507, 184, 0,
-	215, 122, 136, 97, 83, 93, 0, 121, 162, 191,
-	195, 497, 427, 436, 105, 434, 193, 172, 231, 470,
-	174, 192, 140, 221, 185, 230, 240, 241, 218, 238,
-	245, 208, 86, 217, 229, 102, 203, 88, 227, 214,
-	151, 131, 132, 87, 0, 189, 110, 117, 107, 164,
-	224, 225, 106, 248, 94, 237, 90, 95, 236, 158,
-	220, 228, 152, 145, 89, 226, 150, 144, 135, 114,
-	124, 182, 142, 183, 125, 155, 154, 156, 0, 406,
-	0, 212, 234, 249, 99, 422, 219, 243, 244, 0,
-	0, 100, 118, 113, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 418, 421,
-	416, 417, 465, 466, 512, 513, 514, 490, 412, 0,
-	419, 420, 0, 495, 502, 503, 469, 82, 91, 138,
-	246, 186, 116, 235, 402, 415, 109, 425, 0, 0,
-	438, 443, 444, 456, 458, 459, 460, 461, 468, 475,
-	476, 478, 484, 485, 486, 487, 492, 499, 518, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
-	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
-	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
-	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242, 506, 494, 0, 451, 509,
-	424, 441, 517, 442, 445, 482, 409, 464, 165, 439,
-	0, 428, 404, 435, 405, 426, 453, 111, 457, 423,
-	496, 467, 508, 137, 429, 515, 139, 473, 0, 211,
-	153, 0, 0, 455, 498, 462, 491, 450, 483, 414,
-	472, 510, 440, 480, 511, 0, 0, 0, 80, 0,
-	0, 0, 0, 0, 0, 0, 0, 101, 0, 477,
-	505, 437, 479, 481, 403, 474, 0, 407, 410, 516,
-	501, 432, 433, 0, 0, 0, 0, 0, 0, 0,
-	454, 463, 488, 448, 0, 0, 0, 0, 0, 0,
-	1223, 0, 430, 0, 471, 0, 0, 0, 411, 408,
-	0, 0, 452, 0, 0, 0, 413, 0, 431, 489,
-	0, 401, 119, 493, 500, 449, 267, 504, 447, 446,
-	507, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 497, 427, 436, 105, 434, 193,
-	172, 231, 470, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 406, 0, 212, 234, 249, 99, 422, 219,
-	243, 244, 0, 0, 100, 118, 113, 181, 157, 96,
-	127, 209, 134, 141, 188, 247, 171, 194, 103, 233,
-	210, 418, 421, 416, 417, 465, 466, 512, 513, 514,
-	490, 412, 0, 419, 420, 0, 495, 502, 503, 469,
-	82, 91, 138, 246, 186, 116, 235, 402, 415, 109,
-	425, 0, 0, 438, 443, 444, 456, 458, 459, 460,
-	461, 468, 475, 476, 478, 484, 485, 486, 487, 492,
-	499, 518, 84, 85, 92, 98, 104, 108, 112, 115,
-	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
-	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
-	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
-	196, 197, 198, 199, 200, 201, 202, 204, 205, 206,
-	207, 213, 216, 222, 223, 232, 239, 242, 506, 494,
-	0, 451, 509, 424, 441, 517, 442, 445, 482, 409,
-	464, 165, 439, 0, 428, 404, 435, 405, 426, 453,
-	111, 457, 423, 496, 467, 508, 137, 429, 515, 139,
-	473, 0, 211, 153, 0, 0, 455, 498, 462, 491,
-	450, 483, 414, 472, 510, 440, 480, 511, 0, 0,
-	0, 265, 0, 0, 0, 0, 0, 0, 0, 0,
-	101, 0, 477, 505, 437, 479, 481, 403, 474, 0,
-	407, 410, 516, 501, 432, 433, 0, 0, 0, 0,
-	0, 0, 0, 454, 463, 488, 448, 0, 0, 0,
-	0, 0, 0, 955, 0, 430, 0, 471, 0, 0,
-	0, 411, 408, 0, 0, 452, 0, 0, 0, 413,
-	0, 431, 489, 0, 401, 119, 493, 500, 449, 267,
-	504, 447, 446, 507, 184, 0, 215, 122, 136, 97,
-	83, 93, 0, 121, 162, 191, 195, 497, 427, 436,
-	105, 434, 193, 172, 231, 470, 174, 192, 140, 221,
-	185, 230, 240, 241, 218, 238, 245, 208, 86, 217,
-	229, 102, 203, 88, 227, 214, 151, 131, 132, 87,
-	0, 189, 110, 117, 107, 164, 224, 225, 106, 248,
-	94, 237, 90, 95, 236, 158, 220, 228, 152, 145,
-	89, 226, 150, 144, 135, 114, 124, 182, 142, 183,
-	125, 155, 154, 156, 0, 406, 0, 212, 234, 249,
-	99, 422, 219, 243, 244, 0, 0, 100, 118, 113,
-	181, 157, 96, 127, 209, 134, 141, 188, 247, 171,
-	194, 103, 233, 210, 418, 421, 416, 417, 465, 466,
-	512, 513, 514, 490, 412, 0, 419, 420, 0, 495,
-	502, 503, 469, 82, 91, 138, 246, 186, 116, 235,
-	402, 415, 109, 425, 0, 0, 438, 443, 444, 456,
-	458, 459, 460, 461, 468, 475, 476, 478, 484, 485,
-	486, 487, 492, 499, 518, 84, 85, 92, 98, 104,
-	108, 112, 115, 120, 123, 126, 128, 129, 130, 133,
-	143, 146, 147, 148, 149, 159, 160, 161, 163, 166,
-	167, 168, 169, 170, 173, 175, 176, 177, 178, 179,
-	180, 187, 190, 196, 197, 198, 199, 200, 201, 202,
-	204, 205, 206, 207, 213, 216, 222, 223, 232, 239,
-	242, 506, 494, 0, 451, 509, 424, 441, 517, 442,
-	445, 482, 409, 464, 165, 439, 0, 428, 404, 435,
-	405, 426, 453, 111, 457, 423, 496, 467, 508, 137,
-	429, 515, 139, 473, 0, 211, 153, 0, 0, 455,
-	498, 462, 491, 450, 483, 414, 472, 510, 440, 480,
-	511, 0, 0, 0, 322, 0, 0, 0, 0, 0,
-	0, 0, 0, 101, 0, 477, 505, 437, 479, 481,
-	403, 474, 0, 407, 410, 516, 501, 432, 433, 0,
-	0, 0, 0, 0, 0, 0, 454, 463, 488, 448,
-	0, 0, 0, 0, 0, 0, 851, 0, 430, 0,
-	471, 0, 0, 0, 411, 408, 0, 0, 452, 0,
-	0, 0, 413, 0, 431, 489, 0, 401, 119, 493,
-	500, 449, 267, 504, 447, 446, 507, 184, 0, 215,
-	122, 136, 97, 83, 93, 0, 121, 162, 191, 195,
-	497, 427, 436, 105, 434, 193, 172, 231, 470, 174,
-	192, 140, 221, 185, 230, 240, 241, 218, 238, 245,
-	208, 86, 217, 229, 102, 203, 88, 227, 214, 151,
-	131, 132, 87, 0, 189, 110, 117, 107, 164, 224,
-	225, 106, 248, 94, 237, 90, 95, 236, 158, 220,
-	228, 152, 145, 89, 226, 150, 144, 135, 114, 124,
-	182, 142, 183, 125, 155, 154, 156, 0, 406, 0,
-	212, 234, 249, 99, 422, 219, 243, 244, 0, 0,
-	100, 118, 113, 181, 157, 96, 127, 209, 134, 141,
-	188, 247, 171, 194, 103, 233, 210, 418, 421, 416,
-	417, 465, 466, 512, 513, 514, 490, 412, 0, 419,
-	420, 0, 495, 502, 503, 469, 82, 91, 138, 246,
-	186, 116, 235, 402, 415, 109, 425, 0, 0, 438,
-	443, 444, 456, 458, 459, 460, 461, 468, 475, 476,
-	478, 484, 485, 486, 487, 492, 499, 518, 84, 85,
-	92, 98, 104, 108, 112, 115, 120, 123, 126, 128,
-	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
-	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
-	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
-	200, 201, 202, 204, 205, 206, 207, 213, 216, 222,
-	223, 232, 239, 242, 506, 494, 0, 451, 509, 424,
-	441, 517, 442, 445, 482, 409, 464, 165, 439, 0,
-	428, 404, 435, 405, 426, 453, 111, 457, 423, 496,
-	467, 508, 137, 429, 515, 139, 473, 0, 211, 153,
-	0, 0, 455, 498, 462, 491, 450, 483, 414, 472,
-	510, 440, 480, 511, 0, 0, 0, 80, 0, 0,
-	0, 0, 0, 0, 0, 0, 101, 0, 477, 505,
-	437, 479, 481, 403, 474, 0, 407, 410, 516, 501,
-	432, 433, 0, 0, 0, 0, 0, 0, 0, 454,
-	463, 488, 448, 0, 0, 0, 0, 0, 0, 0,
-	0, 430, 0, 471, 0, 0, 0, 411, 408, 0,
-	0, 452, 0, 0, 0, 413, 0, 431, 489, 0,
-	401, 119, 493, 500, 449, 267, 504, 447, 446, 507,
-	184, 0, 215, 122, 136, 97, 83, 93, 0, 121,
-	162, 191, 195, 497, 427, 436, 105, 434, 193, 172,
-	231, 470, 174, 192, 140, 221, 185, 230, 240, 241,
-	218, 238, 245, 208, 86, 217, 229, 102, 203, 88,
-	227, 214, 151, 131, 132, 87, 0, 189, 110, 117,
-	107, 164, 224, 225, 106, 248, 94, 237, 90, 95,
-	236, 158, 220, 228, 152, 145, 89, 226, 150, 144,
-	135, 114, 124, 182, 142, 183, 125, 155, 154, 156,
-	0, 406, 0, 212, 234, 249, 99, 422, 219, 243,
-	244, 0, 0, 100, 118, 113, 181, 157, 96, 127,
-	209, 134, 141, 188, 247, 171, 194, 103, 233, 210,
-	418, 421, 416, 417, 465, 466, 512, 513, 514, 490,
-	412, 0, 419, 420, 0, 495, 502, 503, 469, 82,
-	91, 138, 246, 186, 116, 235, 402, 415, 109, 425,
-	0, 0, 438, 443, 444, 456, 458, 459, 460, 461,
-	468, 475, 476, 478, 484, 485, 486, 487, 492, 499,
-	518, 84, 85, 92, 98, 104, 108, 112, 115, 120,
-	123, 126, 128, 129, 130, 133, 143, 146, 147, 148,
-	149, 159, 160, 161, 163, 166, 167, 168, 169, 170,
-	173, 175, 176, 177, 178, 179, 180, 187, 190, 196,
-	197, 198, 199, 200, 201, 202, 204, 205, 206, 207,
-	213, 216, 222, 223, 232, 239, 242, 506, 494, 0,
-	451, 509, 424, 441, 517, 442, 445, 482, 409, 464,
-	165, 439, 0, 428, 404, 435, 405, 426, 453, 111,
-	457, 423, 496, 467, 508, 137, 429, 515, 139, 473,
-	0, 211, 153, 0, 0, 455, 498, 462, 491, 450,
-	483, 414, 472, 510, 440, 480, 511, 0, 0, 0,
-	322, 0, 0, 0, 0, 0, 0, 0, 0, 101,
-	0, 477, 505, 437, 479, 481, 403, 474, 0, 407,
-	410, 516, 501, 432, 433, 0, 0, 0, 0, 0,
-	0, 0, 454, 463, 488, 448, 0, 0, 0, 0,
-	0, 0, 0, 0, 430, 0, 471, 0, 0, 0,
-	411, 408, 0, 0, 452, 0, 0, 0, 413, 0,
-	431, 489, 0, 401, 119, 493, 500, 449, 267, 504,
-	447, 446, 507, 184, 0, 215, 122, 136, 97, 83,
-	93, 0, 121, 162, 191, 195, 497, 427, 436, 105,
-	434, 193, 172, 231, 470, 174, 192, 140, 221, 185,
-	230, 240, 241, 218, 238, 245, 208, 86, 217, 229,
-	102, 203, 88, 227, 214, 151, 131, 132, 87, 0,
-	189, 110, 117, 107, 164, 224, 225, 106, 248, 94,
-	237, 90, 95, 236, 158, 220, 228, 152, 145, 89,
-	226, 150, 144, 135, 114, 124, 182, 142, 183, 125,
-	155, 154, 156, 0, 406, 0, 212, 234, 249, 99,
-	422, 219, 243, 244, 0, 0, 100, 118, 113, 181,
-	157, 96, 127, 209, 134, 141, 188, 247, 171, 194,
-	103, 233, 210, 418, 421, 416, 417, 465, 466, 512,
-	513, 514, 490, 412, 0, 419, 420, 0, 495, 502,
-	503, 469, 82, 91, 138, 246, 186, 116, 235, 402,
-	415, 109, 425, 0, 0, 438, 443, 444, 456, 458,
-	459, 460, 461, 468, 475, 476, 478, 484, 485, 486,
-	487, 492, 499, 518, 84, 85, 92, 98, 104, 108,
-	112, 115, 120, 123, 126, 128, 129, 130, 133, 143,
-	146, 147, 148, 149, 159, 160, 161, 163, 166, 167,
-	168, 169, 170, 173, 175, 176, 177, 178, 179, 180,
-	187, 190, 196, 197, 198, 199, 200, 201, 202, 204,
-	205, 206, 207, 213, 216, 222, 223, 232, 239, 242,
-	506, 494, 0, 451, 509, 424, 441, 517, 442, 445,
-	482, 409, 464, 165, 439, 0, 428, 404, 435, 405,
-	426, 453, 111, 457, 423, 496, 467, 508, 137, 429,
-	515, 139, 473, 0, 211, 153, 0, 0, 455, 498,
-	462, 491, 450, 483, 414, 472, 510, 440, 480, 511,
-	0, 0, 0, 80, 0, 0, 0, 0, 0, 0,
-	0, 0, 101, 0, 477, 505, 437, 479, 481, 403,
-	474, 0, 407, 410, 516, 501, 432, 433, 0, 0,
-	0, 0, 0, 0, 0, 454, 463, 488, 448, 0,
-	0, 0, 0, 0, 0, 0, 0, 430, 0, 471,
-	0, 0, 0, 411, 408, 0, 0, 452, 0, 0,
-	0, 413, 0, 431, 489, 0, 401, 119, 493, 500,
-	449, 267, 504, 447, 446, 507, 184, 0, 215, 122,
-	136, 97, 83, 93, 0, 121, 162, 191, 195, 497,
-	427, 436, 105, 434, 193, 172, 231, 470, 174, 192,
-	140, 221, 185, 230, 240, 241, 218, 238, 245, 208,
-	86, 217, 229, 102, 203, 88, 227, 214, 151, 131,
-	132, 87, 0, 189, 110, 117, 107, 164, 224, 225,
-	106, 248, 94, 237, 90, 399, 236, 158, 220, 228,
-	152, 145, 89, 226, 150, 144, 135, 114, 124, 182,
-	142, 183, 125, 155, 154, 156, 0, 406, 0, 212,
-	234, 249, 99, 422, 219, 243, 244, 0, 0, 100,
-	118, 113, 181, 400, 398, 127, 209, 134, 141, 188,
-	247, 171, 194, 103, 233, 210, 418, 421, 416, 417,
-	465, 466, 512, 513, 514, 490, 412, 0, 419, 420,
-	0, 495, 502, 503, 469, 82, 91, 138, 246, 186,
-	116, 235, 402, 415, 109, 425, 0, 0, 438, 443,
-	444, 456, 458, 459, 460, 461, 468, 475, 476, 478,
-	484, 485, 486, 487, 492, 499, 518, 84, 85, 92,
-	98, 104, 108, 112, 115, 120, 123, 126, 128, 129,
-	130, 133, 143, 146, 147, 148, 149, 159, 160, 161,
-	163, 166, 167, 168, 169, 170, 173, 175, 176, 177,
-	178, 179, 180, 187, 190, 196, 197, 198, 199, 200,
-	201, 202, 204, 205, 206, 207, 213, 216, 222, 223,
-	232, 239, 242, 506, 494, 0, 451, 509, 424, 441,
-	517, 442, 445, 482, 409, 464, 165, 439, 0, 428,
-	404, 435, 405, 426, 453, 111, 457, 423, 496, 467,
-	508, 137, 429, 515, 139, 473, 0, 211, 153, 0,
-	0, 455, 498, 462, 491, 450, 483, 414, 472, 510,
-	440, 480, 511, 0, 0, 0, 265, 0, 0, 0,
-	0, 0, 0, 0, 0, 101, 0, 477, 505, 437,
-	479, 481, 403, 474, 0, 407, 410, 516, 501, 432,
-	433, 0, 0, 0, 0, 0, 0, 0, 454, 463,
-	488, 448, 0, 0, 0, 0, 0, 0, 0, 0,
-	430, 0, 471, 0, 0, 0, 411, 408, 0, 0,
-	452, 0, 0, 0, 413, 0, 431, 489, 0, 401,
-	119, 493, 500, 449, 267, 504, 447, 446, 507, 184,
-	0, 215, 122, 136, 97, 83, 93, 0, 121, 162,
-	191, 195, 497, 427, 436, 105, 434, 193, 172, 231,
-	470, 174, 192, 140, 221, 185, 230, 240, 241, 218,
-	238, 245, 208, 86, 217, 229, 102, 203, 88, 227,
-	214, 151, 131, 132, 87, 0, 189, 110, 117, 107,
-	164, 224, 225, 106, 248, 94, 237, 90, 95, 236,
-	158, 220, 228, 152, 145, 89, 226, 150, 144, 135,
-	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
-	406, 0, 212, 234, 249, 99, 422, 219, 243, 244,
-	0, 0, 100, 118, 113, 181, 157, 96, 127, 209,
-	134, 141, 188, 247, 171, 194, 103, 233, 210, 418,
-	421, 416, 417, 465, 466, 512, 513, 514, 490, 412,
-	0, 419, 420, 0, 495, 502, 503, 469, 82, 91,
-	138, 246, 186, 116, 235, 402, 415, 109, 425, 0,
-	0, 438, 443, 444, 456, 458, 459, 460, 461, 468,
-	475, 476, 478, 484, 485, 486, 487, 492, 499, 518,
-	84, 85, 92, 98, 104, 108, 112, 115, 120, 123,
-	126, 128, 129, 130, 133, 143, 146, 147, 148, 149,
-	159, 160, 161, 163, 166, 167, 168, 169, 170, 173,
-	175, 176, 177, 178, 179, 180, 187, 190, 196, 197,
-	198, 199, 200, 201, 202, 204, 205, 206, 207, 213,
-	216, 222, 223, 232, 239, 242, 506, 494, 0, 451,
-	509, 424, 441, 517, 442, 445, 482, 409, 464, 165,
-	439, 0, 428, 404, 435, 405, 426, 453, 111, 457,
-	423, 496, 467, 508, 137, 429, 515, 139, 473, 0,
-	211, 153, 0, 0, 455, 498, 462, 491, 450, 483,
-	414, 472, 510, 440, 480, 511, 0, 0, 0, 80,
-	0, 0, 0, 0, 0, 0, 0, 0, 101, 0,
-	477, 505, 437, 479, 481, 403, 474, 0, 407, 410,
-	516, 501, 432, 433, 0, 0, 0, 0, 0, 0,
-	0, 454, 463, 488, 448, 0, 0, 0, 0, 0,
-	0, 0, 0, 430, 0, 471, 0, 0, 0, 411,
-	408, 0, 0, 452, 0, 0, 0, 413, 0, 431,
-	489, 0, 401, 119, 493, 500, 449, 267, 504, 447,
-	446, 507, 184, 0, 215, 122, 136, 97, 83, 93,
-	0, 121, 162, 191, 195, 497, 427, 436, 105, 434,
-	193, 172, 231, 470, 174, 192, 140, 221, 185, 230,
-	240, 241, 218, 238, 245, 208, 86, 217, 713, 102,
-	203, 88, 227, 214, 151, 131, 132, 87, 0, 189,
-	110, 117, 107, 164, 224, 225, 106, 248, 94, 237,
-	90, 399, 236, 158, 220, 228, 152, 145, 89, 226,
-	150, 144, 135, 114, 124, 182, 142, 183, 125, 155,
-	154, 156, 0, 406, 0, 212, 234, 249, 99, 422,
-	219, 243, 244, 0, 0, 100, 118, 113, 181, 400,
-	398, 127, 209, 134, 141, 188, 247, 171, 194, 103,
-	233, 210, 418, 421, 416, 417, 465, 466, 512, 513,
-	514, 490, 412, 0, 419, 420, 0, 495, 502, 503,
-	469, 82, 91, 138, 246, 186, 116, 235, 402, 415,
-	109, 425, 0, 0, 438, 443, 444, 456, 458, 459,
-	460, 461, 468, 475, 476, 478, 484, 485, 486, 487,
-	492, 499, 518, 84, 85, 92, 98, 104, 108, 112,
-	115, 120, 123, 126, 128, 129, 130, 133, 143, 146,
-	147, 148, 149, 159, 160, 161, 163, 166, 167, 168,
-	169, 170, 173, 175, 176, 177, 178, 179, 180, 187,
-	190, 196, 197, 198, 199, 200, 201, 202, 204, 205,
-	206, 207, 213, 216, 222, 223, 232, 239, 242, 506,
-	494, 0, 451, 509, 424, 441, 517, 442, 445, 482,
-	409, 464, 165, 439, 0, 428, 404, 435, 405, 426,
-	453, 111, 457, 423, 496, 467, 508, 137, 429, 515,
-	139, 473, 0, 211, 153, 0, 0, 455, 498, 462,
-	491, 450, 483, 414, 472, 510, 440, 480, 511, 0,
-	0, 0, 80, 0, 0, 0, 0, 0, 0, 0,
-	0, 101, 0, 477, 505, 437, 479, 481, 403, 474,
-	0, 407, 410, 516, 501, 432, 433, 0, 0, 0,
-	0, 0, 0, 0, 454, 463, 488, 448, 0, 0,
-	0, 0, 0, 0, 0, 0, 430, 0, 471, 0,
-	0, 0, 411, 408, 0, 0, 452, 0, 0, 0,
-	413, 0, 431, 489, 0, 401, 119, 493, 500, 449,
-	267, 504, 447, 446, 507, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 497, 427,
-	436, 105, 434, 193, 172, 231, 470, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 390, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 399, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 406, 0, 212, 234,
-	249, 99, 422, 219, 243, 244, 0, 0, 100, 118,
-	113, 181, 400, 398, 393, 392, 134, 141, 188, 247,
-	171, 194, 103, 233, 210, 418, 421, 416, 417, 465,
-	466, 512, 513, 514, 490, 412, 0, 419, 420, 0,
-	495, 502, 503, 469, 82, 91, 138, 246, 186, 116,
-	235, 402, 415, 109, 425, 0, 0, 438, 443, 444,
-	456, 458, 459, 460, 461, 468, 475, 476, 478, 484,
-	485, 486, 487, 492, 499, 518, 84, 85, 92, 98,
-	104, 108, 112, 115, 120, 123, 126, 128, 129, 130,
-	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
-	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
-	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
-	202, 204, 205, 206, 207, 213, 216, 222, 223, 232,
-	239, 242, 165, 0, 0, 887, 0, 324, 0, 0,
-	0, 111, 0, 321, 0, 0, 0, 137, 888, 364,
-	139, 0, 0, 211, 153, 0, 0, 0, 0, 355,
-	356, 0, 0, 0, 0, 0, 0, 0, 0, 54,
-	0, 0, 322, 343, 342, 345, 346, 347, 348, 0,
-	0, 101, 344, 349, 350, 351, 0, 0, 0, 319,
-	336, 0, 363, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 333, 334, 315, 0, 0, 0, 378, 0,
-	335, 0, 0, 330, 331, 332, 337, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 119, 377, 0, 0,
-	267, 0, 0, 375, 0, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 0, 0,
-	0, 105, 0, 193, 172, 231, 0, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 0, 0, 212, 234,
-	249, 99, 0, 219, 243, 244, 0, 0, 100, 118,
-	113, 181, 157, 96, 127, 209, 134, 141, 188, 247,
-	171, 194, 103, 233, 210, 365, 376, 371, 372, 369,
-	370, 368, 367, 366, 379, 357, 358, 359, 360, 362,
-	0, 373, 374, 361, 82, 91, 138, 246, 186, 116,
-	235, 0, 0, 109, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 84, 85, 92, 98,
-	104, 108, 112, 115, 120, 123, 126, 128, 129, 130,
-	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
-	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
-	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
-	202, 204, 205, 206, 207, 213, 216, 222, 223, 232,
-	239, 242, 165, 0, 0, 0, 0, 324, 0, 0,
-	0, 111, 0, 321, 0, 0, 0, 137, 0, 364,
-	139, 0, 0, 211, 153, 0, 0, 0, 0, 355,
-	356, 0, 0, 0, 0, 0, 0, 962, 0, 54,
-	0, 0, 322, 343, 342, 345, 346, 347, 348, 0,
-	0, 101, 344, 349, 350, 351, 963, 0, 0, 319,
-	336, 0, 363, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 333, 334, 0, 0, 0, 0, 378, 0,
-	335, 0, 0, 330, 331, 332, 337, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 119, 377, 0, 0,
-	267, 0, 0, 375, 0, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 0, 0,
-	0, 105, 0, 193, 172, 231, 0, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 0, 0, 212, 234,
-	249, 99, 0, 219, 243, 244, 0, 0, 100, 118,
-	113, 181, 157, 96, 127, 209, 134, 141, 188, 247,
-	171, 194, 103, 233, 210, 365, 376, 371, 372, 369,
-	370, 368, 367, 366, 379, 357, 358, 359, 360, 362,
-	0, 373, 374, 361, 82, 91, 138, 246, 186, 116,
-	235, 0, 0, 109, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 84, 85, 92, 98,
-	104, 108, 112, 115, 120, 123, 126, 128, 129, 130,
-	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
-	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
-	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
-	202, 204, 205, 206, 207, 213, 216, 222, 223, 232,
-	239, 242, 165, 0, 0, 0, 0, 324, 0, 0,
-	0, 111, 0, 321, 0, 0, 0, 137, 0, 364,
-	139, 0, 0, 211, 153, 0, 0, 0, 0, 355,
-	356, 0, 0, 0, 0, 0, 0, 0, 0, 54,
-	0, 584, 322, 343, 342, 345, 346, 347, 348, 0,
-	0, 101, 344, 349, 350, 351, 0, 0, 0, 319,
-	336, 0, 363, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 333, 334, 0, 0, 0, 0, 378, 0,
-	335, 0, 0, 330, 331, 332, 337, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 119, 377, 0, 0,
-	267, 0, 0, 375, 0, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 0, 0,
-	0, 105, 0, 193, 172, 231, 0, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 0, 0, 212, 234,
-	249, 99, 0, 219, 243, 244, 0, 0, 100, 118,
-	113, 181, 157, 96, 127, 209, 134, 141, 188, 247,
-	171, 194, 103, 233, 210, 365, 376, 371, 372, 369,
-	370, 368, 367, 366, 379, 357, 358, 359, 360, 362,
-	0, 373, 374, 361, 82, 91, 138, 246, 186, 116,
-	235, 0, 0, 109, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 84, 85, 92, 98,
-	104, 108, 112, 115, 120, 123, 126, 128, 129, 130,
-	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
-	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
-	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
-	202, 204, 205, 206, 207, 213, 216, 222, 223, 232,
-	239, 242, 165, 0, 0, 0, 0, 324, 0, 0,
-	0, 111, 0, 321, 0, 0, 0, 137, 0, 364,
-	139, 0, 0, 211, 153, 0, 0, 0, 0, 355,
-	356, 0, 0, 0, 0, 0, 0, 0, 0, 54,
-	0, 0, 322, 343, 342, 345, 346, 347, 348, 0,
-	0, 101, 344, 349, 350, 351, 0, 0, 0, 319,
-	336, 0, 363, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 333, 334, 315, 0, 0, 0, 378, 0,
-	335, 0, 0, 330, 331, 332, 337, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 119, 377, 0, 0,
-	267, 0, 0, 375, 0, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 0, 0,
-	0, 105, 0, 193, 172, 231, 0, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 0, 0, 212, 234,
-	249, 99, 0, 219, 243, 244, 0, 0, 100, 118,
-	113, 181, 157, 96, 127, 209, 134, 141, 188, 247,
-	171, 194, 103, 233, 210, 365, 376, 371, 372, 369,
-	370, 368, 367, 366, 379, 357, 358, 359, 360, 362,
-	0, 373, 374, 361, 82, 91, 138, 246, 186, 116,
-	235, 0, 0, 109, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 84, 85, 92, 98,
-	104, 108, 112, 115, 120, 123, 126, 128, 129, 130,
-	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
-	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
-	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
-	202, 204, 205, 206, 207, 213, 216, 222, 223, 232,
-	239, 242, 165, 0, 0, 0, 0, 324, 0, 0,
-	0, 111, 0, 321, 0, 0, 0, 137, 0, 364,
-	139, 0, 0, 211, 153, 0, 0, 0, 0, 355,
-	356, 0, 0, 0, 0, 0, 0, 0, 0, 54,
-	0, 0, 322, 343, 903, 345, 346, 347, 348, 0,
-	0, 101, 344, 349, 350, 351, 0, 0, 0, 319,
-	336, 0, 363, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 333, 334, 315, 0, 0, 0, 378, 0,
-	335, 0, 0, 330, 331, 332, 337, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 119, 377, 0, 0,
-	267, 0, 0, 375, 0, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 0, 0,
-	0, 105, 0, 193, 172, 231, 0, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 0, 0, 212, 234,
-	249, 99, 0, 219, 243, 244, 0, 0, 100, 118,
-	113, 181, 157, 96, 127, 209, 134, 141, 188, 247,
-	171, 194, 103, 233, 210, 365, 376, 371, 372, 369,
-	370, 368, 367, 366, 379, 357, 358, 359, 360, 362,
-	0, 373, 374, 361, 82, 91, 138, 246, 186, 116,
-	235, 0, 0, 109, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 84, 85, 92, 98,
-	104, 108, 112, 115, 120, 123, 126, 128, 129, 130,
-	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
-	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
-	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
-	202, 204, 205, 206, 207, 213, 216, 222, 223, 232,
-	239, 242, 165, 0, 0, 0, 0, 324, 0, 0,
-	0, 111, 0, 321, 0, 0, 0, 137, 0, 364,
-	139, 0, 0, 211, 153, 0, 0, 0, 0, 355,
-	356, 0, 0, 0, 0, 0, 0, 0, 0, 54,
-	0, 0, 322, 343, 900, 345, 346, 347, 348, 0,
-	0, 101, 344, 349, 350, 351, 0, 0, 0, 319,
-	336, 0, 363, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 333, 334, 315, 0, 0, 0, 378, 0,
-	335, 0, 0, 330, 331, 332, 337, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 119, 377, 0, 0,
-	267, 0, 0, 375, 0, 184, 0, 215, 122, 136,
-	97, 83, 93, 0, 121, 162, 191, 195, 0, 0,
-	0, 105, 0, 193, 172, 231, 0, 174, 192, 140,
-	221, 185, 230, 240, 241, 218, 238, 245, 208, 86,
-	217, 229, 102, 203, 88, 227, 214, 151, 131, 132,
-	87, 0, 189, 110, 117, 107, 164, 224, 225, 106,
-	248, 94, 237, 90, 95, 236, 158, 220, 228, 152,
-	145, 89, 226, 150, 144, 135, 114, 124, 182, 142,
-	183, 125, 155, 154, 156, 0, 0, 0, 212, 234,
-	249, 99, 0, 219, 243, 244, 0, 0, 100, 118,
-	113, 181, 157, 96, 127, 209, 134, 141, 188, 247,
-	171, 194, 103, 233, 210, 365, 376, 371, 372, 369,
-	370, 368, 367, 366, 379, 357, 358, 359, 360, 362,
-	0, 373, 374, 361, 82, 91, 138, 246, 186, 116,
-	235, 0, 0, 109, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 84, 85, 92, 98,
-	104, 108, 112, 115, 120, 123, 126, 128, 129, 130,
-	133, 143, 146, 147, 148, 149, 159, 160, 161, 163,
-	166, 167, 168, 169, 170, 173, 175, 176, 177, 178,
-	179, 180, 187, 190, 196, 197, 198, 199, 200, 201,
-	202, 204, 205, 206, 207, 213, 216, 222, 223, 232,
-	239, 242, 24, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 165, 0, 0, 0, 0, 324,
-	0, 0, 0, 111, 0, 321, 0, 0, 0, 137,
-	0, 364, 139, 0, 0, 211, 153, 0, 0, 0,
-	0, 355, 356, 0, 0, 0, 0, 0, 0, 0,
-	0, 54, 0, 0, 322, 343, 342, 345, 346, 347,
-	348, 0, 0, 101, 344, 349, 350, 351, 0, 0,
-	0, 319, 336, 0, 363, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 333, 334, 0, 0, 0, 0,
-	378, 0, 335, 0, 0, 330, 331, 332, 337, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 119, 377,
-	0, 0, 267, 0, 0, 375, 0, 184, 0, 215,
-	122, 136, 97, 83, 93, 0, 121, 162, 191, 195,
-	0, 0, 0, 105, 0, 193, 172, 231, 0, 174,
-	192, 140, 221, 185, 230, 240, 241, 218, 238, 245,
-	208, 86, 217, 229, 102, 203, 88, 227, 214, 151,
-	131, 132, 87, 0, 189, 110, 117, 107, 164, 224,
-	225, 106, 248, 94, 237, 90, 95, 236, 158, 220,
-	228, 152, 145, 89, 226, 150, 144, 135, 114, 124,
-	182, 142, 183, 125, 155, 154, 156, 0, 0, 0,
-	212, 234, 249, 99, 0, 219, 243, 244, 0, 0,
-	100, 118, 113, 181, 157, 96, 127, 209, 134, 141,
-	188, 247, 171, 194, 103, 233, 210, 365, 376, 371,
-	372, 369, 370, 368, 367, 366, 379, 357, 358, 359,
-	360, 362, 0, 373, 374, 361, 82, 91, 138, 246,
-	186, 116, 235, 0, 0, 109, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 84, 85,
-	92, 98, 104, 108, 112, 115, 120, 123, 126, 128,
-	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
-	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
-	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
-	200, 201, 202, 204, 205, 206, 207, 213, 216, 222,
-	223, 232, 239, 242, 165, 0, 0, 0, 0, 324,
-	0, 0, 0, 111, 0, 321, 0, 0, 0, 137,
-	0, 364, 139, 0, 0, 211, 153, 0, 0, 0,
-	0, 355, 356, 0, 0, 0, 0, 0, 0, 0,
-	0, 54, 0, 0, 322, 343, 342, 345, 346, 347,
-	348, 0, 0, 101, 344, 349, 350, 351, 0, 0,
-	0, 319, 336, 0, 363, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 333, 334, 0, 0, 0, 0,
-	378, 0, 335, 0, 0, 330, 331, 332, 337, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 119, 377,
-	0, 0, 267, 0, 0, 375, 0, 184, 0, 215,
-	122, 136, 97, 83, 93, 0, 121, 162, 191, 195,
-	0, 0, 0, 105, 0, 193, 172, 231, 0, 174,
-	192, 140, 221, 185, 230, 240, 241, 218, 238, 245,
-	208, 86, 217, 229, 102, 203, 88, 227, 214, 151,
-	131, 132, 87, 0, 189, 110, 117, 107, 164, 224,
-	225, 106, 248, 94, 237, 90, 95, 236, 158, 220,
-	228, 152, 145, 89, 226, 150, 144, 135, 114, 124,
-	182, 142, 183, 125, 155, 154, 156, 0, 0, 0,
-	212, 234, 249, 99, 0, 219, 243, 244, 0, 0,
-	100, 118, 113, 181, 157, 96, 127, 209, 134, 141,
-	188, 247, 171, 194, 103, 233, 210, 365, 376, 371,
-	372, 369, 370, 368, 367, 366, 379, 357, 358, 359,
-	360, 362, 0, 373, 374, 361, 82, 91, 138, 246,
-	186, 116, 235, 0, 0, 109, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 84, 85,
-	92, 98, 104, 108, 112, 115, 120, 123, 126, 128,
-	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
-	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
-	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
-	200, 201, 202, 204, 205, 206, 207, 213, 216, 222,
-	223, 232, 239, 242, 165, 0, 0, 0, 0, 0,
-	0, 0, 0, 111, 0, 0, 0, 0, 0, 137,
-	0, 364, 139, 0, 0, 211, 153, 0, 0, 0,
-	0, 355, 356, 0, 0, 0, 0, 0, 0, 0,
-	0, 54, 0, 0, 322, 343, 342, 345, 346, 347,
-	348, 0, 0, 101, 344, 349, 350, 351, 0, 0,
-	0, 0, 336, 0, 363, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 333, 334, 0, 0, 0, 0,
-	378, 0, 335, 0, 0, 330, 331, 332, 337, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 119, 377,
-	0, 0, 267, 0, 0, 375, 0, 184, 0, 215,
-	122, 136, 97, 83, 93, 0, 121, 162, 191, 195,
-	0, 0, 0, 105, 0, 193, 172, 231, 1525, 174,
-	192, 140, 221, 185, 230, 240, 241, 218, 238, 245,
-	208, 86, 217, 229, 102, 203, 88, 227, 214, 151,
-	131, 132, 87, 0, 189, 110, 117, 107, 164, 224,
-	225, 106, 248, 94, 237, 90, 95, 236, 158, 220,
-	228, 152, 145, 89, 226, 150, 144, 135, 114, 124,
-	182, 142, 183, 125, 155, 154, 156, 0, 0, 0,
-	212, 234, 249, 99, 0, 219, 243, 244, 0, 0,
-	100, 118, 113, 181, 157, 96, 127, 209, 134, 141,
-	188, 247, 171, 194, 103, 233, 210, 365, 376, 371,
-	372, 369, 370, 368, 367, 366, 379, 357, 358, 359,
-	360, 362, 0, 373, 374, 361, 82, 91, 138, 246,
-	186, 116, 235, 0, 0, 109, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 84, 85,
-	92, 98, 104, 108, 112, 115, 120, 123, 126, 128,
-	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
-	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
-	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
-	200, 201, 202, 204, 205, 206, 207, 213, 216, 222,
-	223, 232, 239, 242, 165, 0, 0, 0, 0, 0,
-	0, 0, 0, 111, 0, 0, 0, 0, 0, 137,
-	0, 364, 139, 0, 0, 211, 153, 0, 0, 0,
-	0, 355, 356, 0, 0, 0, 0, 0, 0, 0,
-	0, 54, 0, 584, 322, 343, 342, 345, 346, 347,
-	348, 0, 0, 101, 344, 349, 350, 351, 0, 0,
-	0, 0, 336, 0, 363, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 333, 334, 0, 0, 0, 0,
-	378, 0, 335, 0, 0, 330, 331, 332, 337, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 119, 377,
-	0, 0, 267, 0, 0, 375, 0, 184, 0, 215,
-	122, 136, 97, 83, 93, 0, 121, 162, 191, 195,
-	0, 0, 0, 105, 0, 193, 172, 231, 0, 174,
-	192, 140, 221, 185, 230, 240, 241, 218, 238, 245,
-	208, 86, 217, 229, 102, 203, 88, 227, 214, 151,
-	131, 132, 87, 0, 189, 110, 117, 107, 164, 224,
-	225, 106, 248, 94, 237, 90, 95, 236, 158, 220,
-	228, 152, 145, 89, 226, 150, 144, 135, 114, 124,
-	182, 142, 183, 125, 155, 154, 156, 0, 0, 0,
-	212, 234, 249, 99, 0, 219, 243, 244, 0, 0,
-	100, 118, 113, 181, 157, 96, 127, 209, 134, 141,
-	188, 247, 171, 194, 103, 233, 210, 365, 376, 371,
-	372, 369, 370, 368, 367, 366, 379, 357, 358, 359,
-	360, 362, 0, 373, 374, 361, 82, 91, 138, 246,
-	186, 116, 235, 0, 0, 109, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 84, 85,
-	92, 98, 104, 108, 112, 115, 120, 123, 126, 128,
-	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
-	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
-	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
-	200, 201, 202, 204, 205, 206, 207, 213, 216, 222,
-	223, 232, 239, 242, 165, 0, 0, 0, 0, 0,
-	0, 0, 0, 111, 0, 0, 0, 0, 0, 137,
-	0, 364, 139, 0, 0, 211, 153, 0, 0, 0,
-	0, 355, 356, 0, 0, 0, 0, 0, 0, 0,
-	0, 54, 0, 0, 322, 343, 342, 345, 346, 347,
-	348, 0, 0, 101, 344, 349, 350, 351, 0, 0,
-	0, 0, 336, 0, 363, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 333, 334, 0, 0, 0, 0,
-	378, 0, 335, 0, 0, 330, 331, 332, 337, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 119, 377,
-	0, 0, 267, 0, 0, 375, 0, 184, 0, 215,
-	122, 136, 97, 83, 93, 0, 121, 162, 191, 195,
-	0, 0, 0, 105, 0, 193, 172, 231, 0, 174,
-	192, 140, 221, 185, 230, 240, 241, 218, 238, 245,
-	208, 86, 217, 229, 102, 203, 88, 227, 214, 151,
-	131, 132, 87, 0, 189, 110, 117, 107, 164, 224,
-	225, 106, 248, 94, 237, 90, 95, 236, 158, 220,
-	228, 152, 145, 89, 226, 150, 144, 135, 114, 124,
-	182, 142, 183, 125, 155, 154, 156, 0, 0, 0,
-	212, 234, 249, 99, 0, 219, 243, 244, 0, 0,
-	100, 118, 113, 181, 157, 96, 127, 209, 134, 141,
-	188, 247, 171, 194, 103, 233, 210, 365, 376, 371,
-	372, 369, 370, 368, 367, 366, 379, 357, 358, 359,
-	360, 362, 0, 373, 374, 361, 82, 91, 138, 246,
-	186, 116, 235, 0, 0, 109, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 84, 85,
-	92, 98, 104, 108, 112, 115, 120, 123, 126, 128,
-	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
-	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
-	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
-	200, 201, 202, 204, 205, 206, 207, 213, 216, 222,
-	223, 232, 239, 242, 165, 0, 0, 0, 0, 0,
-	0, 0, 0, 111, 0, 0, 0, 0, 0, 137,
-	0, 0, 139, 0, 0, 211, 153, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 80, 0, 0, 0, 0, 0,
-	0, 0, 0, 101, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	619, 618, 628, 629, 621, 622, 623, 624, 625, 626,
-	627, 620, 0, 0, 630, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 119, 0,
-	0, 0, 267, 0, 0, 0, 0, 184, 0, 215,
-	122, 136, 97, 83, 93, 0, 121, 162, 191, 195,
-	0, 0, 0, 105, 0, 193, 172, 231, 0, 174,
-	192, 140, 221, 185, 230, 240, 241, 218, 238, 245,
-	208, 86, 217, 229, 102, 203, 88, 227, 214, 151,
-	131, 132, 87, 0, 189, 110, 117, 107, 164, 224,
-	225, 106, 248, 94, 237, 90, 95, 236, 158, 220,
-	228, 152, 145, 89, 226, 150, 144, 135, 114, 124,
-	182, 142, 183, 125, 155, 154, 156, 0, 0, 0,
-	212, 234, 249, 99, 0, 219, 243, 244, 0, 0,
-	100, 118, 113, 181, 157, 96, 127, 209, 134, 141,
-	188, 247, 171, 194, 103, 233, 210, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 82, 91, 138, 246,
-	186, 116, 235, 0, 0, 109, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 84, 85,
-	92, 98, 104, 108, 112, 115, 120, 123, 126, 128,
-	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
-	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
-	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
-	200, 201, 202, 204, 205, 206, 207, 213, 216, 222,
-	223, 232, 239, 242, 165, 0, 0, 0, 607, 0,
-	0, 0, 0, 111, 0, 0, 0, 0, 0, 137,
-	0, 0, 139, 0, 0, 211, 153, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 80, 0, 609, 0, 0, 0,
-	0, 0, 0, 101, 0, 0, 0, 0, 0, 604,
-	603, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 605, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 119, 0,
-	0, 0, 267, 0, 0, 0, 0, 184, 0, 215,
-	122, 136, 97, 83, 93, 0, 121, 162, 191, 195,
-	0, 0, 0, 105, 0, 193, 172, 231, 0, 174,
-	192, 140, 221, 185, 230, 240, 241, 218, 238, 245,
-	208, 86, 217, 229, 102, 203, 88, 227, 214, 151,
-	131, 132, 87, 0, 189, 110, 117, 107, 164, 224,
-	225, 106, 248, 94, 237, 90, 95, 236, 158, 220,
-	228, 152, 145, 89, 226, 150, 144, 135, 114, 124,
-	182, 142, 183, 125, 155, 154, 156, 0, 0, 0,
-	212, 234, 249, 99, 0, 219, 243, 244, 0, 0,
-	100, 118, 113, 181, 157, 96, 127, 209, 134, 141,
-	188, 247, 171, 194, 103, 233, 210, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 82, 91, 138, 246,
-	186, 116, 235, 0, 0, 109, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 84, 85,
-	92, 98, 104, 108, 112, 115, 120, 123, 126, 128,
-	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
-	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
-	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
-	200, 201, 202, 204, 205, 206, 207, 213, 216, 222,
-	223, 232, 239, 242, 165, 0, 0, 0, 0, 0,
-	0, 0, 0, 111, 0, 0, 0, 0, 0, 137,
-	0, 0, 139, 0, 0, 211, 153, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 80, 0, 0, 0, 0, 0,
-	0, 0, 0, 101, 0, 0, 0, 0, 0, 74,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 119, 76,
-	77, 0, 73, 0, 0, 0, 78, 184, 0, 215,
-	122, 136, 97, 83, 93, 0, 121, 162, 191, 195,
-	0, 0, 0, 105, 0, 193, 172, 231, 0, 174,
-	192, 140, 221, 185, 230, 240, 241, 218, 238, 245,
-	208, 86, 217, 229, 102, 203, 88, 227, 214, 151,
-	131, 132, 87, 0, 189, 110, 117, 107, 164, 224,
-	225, 106, 248, 94, 237, 90, 95, 236, 158, 220,
-	228, 152, 145, 89, 226, 150, 144, 135, 114, 124,
-	182, 142, 183, 125, 155, 154, 156, 0, 0, 0,
-	212, 234, 249, 99, 0, 219, 243, 244, 0, 0,
-	100, 118, 113, 181, 157, 96, 127, 209, 134, 141,
-	188, 247, 171, 194, 103, 233, 210, 0, 75, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 82, 91, 138, 246,
-	186, 116, 235, 0, 0, 109, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 84, 85,
-	92, 98, 104, 108, 112, 115, 120, 123, 126, 128,
-	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
-	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
-	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
-	200, 201, 202, 204, 205, 206, 207, 213, 216, 222,
-	223, 232, 239, 242, 165, 0, 0, 0, 945, 0,
-	0, 0, 0, 111, 0, 0, 0, 0, 0, 137,
-	0, 0, 139, 0, 0, 211, 153, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 265, 0, 947, 0, 0, 0,
-	0, 0, 0, 101, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 119, 0,
-	0, 0, 267, 0, 0, 0, 0, 184, 0, 215,
-	122, 136, 97, 83, 93, 0, 121, 162, 191, 195,
-	0, 0, 0, 105, 0, 193, 172, 231, 0, 174,
-	192, 140, 221, 185, 230, 240, 241, 218, 238, 245,
-	208, 86, 217, 229, 102, 203, 88, 227, 214, 151,
-	131, 132, 87, 0, 189, 110, 117, 107, 164, 224,
-	225, 106, 248, 94, 237, 90, 95, 236, 158, 220,
-	228, 152, 145, 89, 226, 150, 144, 135, 114, 124,
-	182, 142, 183, 125, 155, 154, 156, 0, 0, 0,
-	212, 234, 249, 99, 0, 219, 243, 244, 0, 0,
-	100, 118, 113, 181, 157, 96, 127, 209, 134, 141,
-	188, 247, 171, 194, 103, 233, 210, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 82, 91, 138, 246,
-	186, 116, 235, 0, 0, 109, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 84, 85,
-	92, 98, 104, 108, 112, 115, 120, 123, 126, 128,
-	129, 130, 133, 143, 146, 147, 148, 149, 159, 160,
-	161, 163, 166, 167, 168, 169, 170, 173, 175, 176,
-	177, 178, 179, 180, 187, 190, 196, 197, 198, 199,
-	200, 201, 202, 204, 205, 206, 207, 213, 216, 222,
-	223, 232, 239, 242, 24, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 165, 0, 0, 0,
-	0, 0, 0, 0, 0, 111, 0, 0, 0, 0,
-	0, 137, 0, 0, 139, 0, 0, 211, 153, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 54, 0, 0, 80, 0, 0, 0,
-	0, 0, 0, 0, 0, 101, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	119, 0, 0, 0, 267, 0, 0, 0, 0, 184,
-	0, 215, 122, 136, 97, 83, 93, 0, 121, 162,
-	191, 195, 0, 0, 0, 105, 0, 193, 172, 231,
-	0, 174, 192, 140, 221, 185, 230, 240, 241, 218,
-	238, 245, 208, 86, 217, 229, 102, 203, 88, 227,
-	214, 151, 131, 132, 87, 0, 189, 110, 117, 107,
-	164, 224, 225, 106, 248, 94, 237, 90, 95, 236,
-	158, 220, 228, 152, 145, 89, 226, 150, 144, 135,
-	114, 124, 182, 142, 183, 125, 155, 154, 156, 0,
-	0, 0, 212, 234, 249, 99, 0, 219, 243, 244,
-	0, 0, 100, 118, 113, 181, 157, 96, 127, 209,
-	134, 141, 188, 247, 171, 194, 103, 233, 210, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 82, 91,
-	138, 246, 186, 116, 235, 0, 0, 109, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	84, 85, 92, 98, 104, 108, 112, 115, 120, 123,
-	126, 128, 129, 130, 133, 143, 146, 147, 148, 149,
-	159, 160, 161, 163, 166, 167, 168, 169, 170, 173,
-	175, 176, 177, 178, 179, 180, 187, 190, 196, 197,
-	198, 199, 200, 201, 202, 204, 205, 206, 207, 213,
-	216, 222, 223, 232, 239, 242, 24, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 165, 0,
-	0, 0, 0, 0, 0, 0, 0, 111, 0, 0,
-	0, 0, 0, 137, 0, 0, 139, 0, 0, 211,
-	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 54, 0, 0, 265, 0,
-	0, 0, 0, 0, 0, 0, 0, 101, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 119, 0, 0, 0, 267, 0, 0, 0,
-	0, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 0, 0, 0, 105, 0, 193,
-	172, 231, 0, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 0, 0, 212, 234, 249, 99, 0, 219,
-	243, 244, 0, 0, 100, 118, 113, 181, 157, 96,
-	127, 209, 134, 141, 188, 247, 171, 194, 103, 233,
-	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 91, 138, 246, 186, 116, 235, 0, 0, 109,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 84, 85, 92, 98, 104, 108, 112, 115,
-	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
-	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
-	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
-	196, 197, 198, 199, 200, 201, 202, 204, 205, 206,
-	207, 213, 216, 222, 223, 232, 239, 242, 165, 0,
-	0, 0, 945, 0, 0, 0, 0, 111, 0, 0,
-	0, 0, 0, 137, 0, 0, 139, 0, 0, 211,
-	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 265, 0,
-	947, 0, 0, 0, 0, 0, 0, 101, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 119, 0, 0, 0, 267, 0, 0, 0,
-	0, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 0, 0, 0, 105, 0, 193,
-	172, 231, 0, 943, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 0, 0, 212, 234, 249, 99, 0, 219,
-	243, 244, 0, 0, 100, 118, 113, 181, 157, 96,
-	127, 209, 134, 141, 188, 247, 171, 194, 103, 233,
-	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 91, 138, 246, 186, 116, 235, 0, 0, 109,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 84, 85, 92, 98, 104, 108, 112, 115,
-	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
-	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
-	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
-	196, 197, 198, 199, 200, 201, 202, 204, 205, 206,
-	207, 213, 216, 222, 223, 232, 239, 242, 165, 0,
-	0, 0, 0, 0, 0, 0, 0, 111, 0, 0,
-	0, 0, 0, 137, 0, 0, 139, 0, 0, 211,
-	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 80, 0,
-	0, 838, 0, 0, 839, 0, 0, 101, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 119, 0, 0, 0, 267, 0, 0, 0,
-	0, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 0, 0, 0, 105, 0, 193,
-	172, 231, 0, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 0, 0, 212, 234, 249, 99, 0, 219,
-	243, 244, 0, 0, 100, 118, 113, 181, 157, 96,
-	127, 209, 134, 141, 188, 247, 171, 194, 103, 233,
-	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 91, 138, 246, 186, 116, 235, 0, 0, 109,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 84, 85, 92, 98, 104, 108, 112, 115,
-	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
-	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
-	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
-	196, 197, 198, 199, 200, 201, 202, 204, 205, 206,
-	207, 213, 216, 222, 223, 232, 239, 242, 165, 0,
-	0, 0, 0, 0, 0, 0, 0, 111, 0, 722,
-	0, 0, 0, 137, 0, 0, 139, 0, 0, 211,
-	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 80, 0,
-	721, 0, 0, 0, 0, 0, 0, 101, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 119, 0, 0, 0, 267, 0, 0, 0,
-	0, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 0, 0, 0, 105, 0, 193,
-	172, 231, 0, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 0, 0, 212, 234, 249, 99, 0, 219,
-	243, 244, 0, 0, 100, 118, 113, 181, 157, 96,
-	127, 209, 134, 141, 188, 247, 171, 194, 103, 233,
-	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 91, 138, 246, 186, 116, 235, 0, 0, 109,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 84, 85, 92, 98, 104, 108, 112, 115,
-	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
-	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
-	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
-	196, 197, 198, 199, 200, 201, 202, 204, 205, 206,
-	207, 213, 216, 222, 223, 232, 239, 242, 165, 0,
-	0, 0, 0, 0, 0, 0, 0, 111, 0, 0,
-	0, 0, 0, 137, 0, 0, 139, 0, 0, 211,
-	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 584, 80, 0,
-	0, 0, 0, 0, 0, 0, 0, 101, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 119, 0, 0, 0, 267, 0, 0, 0,
-	0, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 0, 0, 0, 105, 0, 193,
-	172, 231, 0, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 0, 0, 212, 234, 249, 99, 0, 219,
-	243, 244, 0, 0, 100, 118, 113, 181, 157, 96,
-	127, 209, 134, 141, 188, 247, 171, 194, 103, 233,
-	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 91, 138, 246, 186, 116, 235, 0, 0, 109,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 84, 85, 92, 98, 104, 108, 112, 115,
-	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
-	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
-	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
-	196, 197, 198, 199, 200, 201, 202, 204, 205, 206,
-	207, 213, 216, 222, 223, 232, 239, 242, 165, 0,
-	0, 0, 0, 0, 0, 0, 0, 111, 0, 0,
-	0, 0, 0, 137, 0, 0, 139, 0, 0, 211,
-	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 54, 0, 0, 265, 0,
-	0, 0, 0, 0, 0, 0, 0, 101, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 119, 0, 0, 0, 267, 0, 0, 0,
-	0, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 0, 0, 0, 105, 0, 193,
-	172, 231, 0, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 0, 0, 212, 234, 249, 99, 0, 219,
-	243, 244, 0, 0, 100, 118, 113, 181, 157, 96,
-	127, 209, 134, 141, 188, 247, 171, 194, 103, 233,
-	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 91, 138, 246, 186, 116, 235, 0, 0, 109,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 84, 85, 92, 98, 104, 108, 112, 115,
-	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
-	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
-	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
-	196, 197, 198, 199, 200, 201, 202, 204, 205, 206,
-	207, 213, 216, 222, 223, 232, 239, 242, 165, 0,
-	0, 0, 0, 0, 0, 0, 0, 111, 0, 0,
-	0, 0, 0, 137, 0, 0, 139, 0, 0, 211,
-	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 265, 0,
-	947, 0, 0, 0, 0, 0, 0, 101, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 119, 0, 0, 0, 267, 0, 0, 0,
-	0, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 0, 0, 0, 105, 0, 193,
-	172, 231, 0, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 0, 0, 212, 234, 249, 99, 0, 219,
-	243, 244, 0, 0, 100, 118, 113, 181, 157, 96,
-	127, 209, 134, 141, 188, 247, 171, 194, 103, 233,
-	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 91, 138, 246, 186, 116, 235, 0, 0, 109,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 84, 85, 92, 98, 104, 108, 112, 115,
-	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
-	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
-	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
-	196, 197, 198, 199, 200, 201, 202, 204, 205, 206,
-	207, 213, 216, 222, 223, 232, 239, 242, 165, 0,
-	0, 0, 0, 0, 0, 0, 0, 111, 0, 0,
-	0, 0, 0, 137, 0, 0, 139, 0, 0, 211,
-	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 80, 0,
-	609, 0, 0, 0, 0, 0, 0, 101, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 119, 0, 0, 0, 267, 0, 0, 0,
-	0, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 0, 0, 0, 105, 0, 193,
-	172, 231, 0, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 0, 0, 212, 234, 249, 99, 0, 219,
-	243, 244, 0, 0, 100, 118, 113, 181, 157, 96,
-	127, 209, 134, 141, 188, 247, 171, 194, 103, 233,
-	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 91, 138, 246, 186, 116, 235, 0, 0, 109,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 84, 85, 92, 98, 104, 108, 112, 115,
-	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
-	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
-	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
-	196, 197, 198, 199, 200, 201, 202, 204, 205, 206,
-	207, 213, 216, 222, 223, 232, 239, 242, 165, 0,
-	0, 0, 0, 0, 0, 0, 692, 111, 0, 0,
-	0, 0, 0, 137, 0, 0, 139, 0, 0, 211,
-	153, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 265, 0,
-	0, 0, 0, 0, 0, 0, 0, 101, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 119, 0, 0, 0, 267, 0, 0, 0,
-	0, 184, 0, 215, 122, 136, 97, 83, 93, 0,
-	121, 162, 191, 195, 0, 0, 0, 105, 0, 193,
-	172, 231, 0, 174, 192, 140, 221, 185, 230, 240,
-	241, 218, 238, 245, 208, 86, 217, 229, 102, 203,
-	88, 227, 214, 151, 131, 132, 87, 0, 189, 110,
-	117, 107, 164, 224, 225, 106, 248, 94, 237, 90,
-	95, 236, 158, 220, 228, 152, 145, 89, 226, 150,
-	144, 135, 114, 124, 182, 142, 183, 125, 155, 154,
-	156, 0, 0, 0, 212, 234, 249, 99, 0, 219,
-	243, 244, 0, 0, 100, 118, 113, 181, 157, 96,
-	127, 209, 134, 141, 188, 247, 171, 194, 103, 233,
-	210, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	82, 91, 138, 246, 186, 116, 235, 0, 0, 109,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 84, 85, 92, 98, 104, 108, 112, 115,
-	120, 123, 126, 128, 129, 130, 133, 143, 146, 147,
-	148, 149, 159, 160, 161, 163, 166, 167, 168, 169,
-	170, 173, 175, 176, 177, 178, 179, 180, 187, 190,
-	196, 197, 198, 199, 200, 201, 202, 204, 205, 206,
-	207, 213, 216, 222, 223, 232, 239, 242, 382, 0,
-	0, 0, 0, 0, 0, 165, 0, 0, 0, 0,
-	0, 0, 0, 0, 111, 0, 0, 0, 0, 0,
-	137, 0, 0, 139, 0, 0, 211, 153, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 0, 0, 0, 0,
-	0, 0, 0, 0, 101, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
-	0, 0, 0, 267, 0, 0, 0, 0, 184, 0,
-	215, 122, 136, 97, 83, 93, 0, 121, 162, 191,
-	195, 0, 0, 0, 105, 0, 193, 172, 231, 0,
-	174, 192, 140, 221, 185, 230, 240, 241, 218, 238,
-	245, 208, 86, 217, 229, 102, 203, 88, 227, 214,
-	151, 131, 132, 87, 0, 189, 110, 117, 107, 164,
-	224, 225, 106, 248, 94, 237, 90, 95, 236, 158,
-	220, 228, 152, 145, 89, 226, 150, 144, 135, 114,
-	124, 182, 142, 183, 125, 155, 154, 156, 0, 0,
-	0, 212, 234, 249, 99, 0, 219, 243, 244, 0,
-	0, 100, 118, 113, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 91, 138,
-	246, 186, 116, 235, 0, 0, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
-	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
-	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
-	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242, 165, 0, 0, 0, 0,
-	0, 0, 0, 0, 111, 0, 0, 0, 0, 0,
-	137, 0, 0, 139, 0, 0, 211, 153, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 0, 0, 0, 0,
-	0, 0, 0, 0, 101, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
-	0, 262, 0, 267, 0, 0, 0, 0, 184, 0,
-	215, 122, 136, 97, 83, 93, 0, 121, 162, 191,
-	195, 0, 0, 0, 105, 0, 193, 172, 231, 0,
-	174, 192, 140, 221, 185, 230, 240, 241, 218, 238,
-	245, 208, 86, 217, 229, 102, 203, 88, 227, 214,
-	151, 131, 132, 87, 0, 189, 110, 117, 107, 164,
-	224, 225, 106, 248, 94, 237, 90, 95, 236, 158,
-	220, 228, 152, 145, 89, 226, 150, 144, 135, 114,
-	124, 182, 142, 183, 125, 155, 154, 156, 0, 0,
-	0, 212, 234, 249, 99, 0, 219, 243, 244, 0,
-	0, 100, 118, 113, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 91, 138,
-	246, 186, 116, 235, 0, 0, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
-	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
-	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
-	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242, 165, 0, 0, 0, 0,
-	0, 0, 0, 0, 111, 0, 0, 0, 0, 0,
-	137, 0, 0, 139, 0, 0, 211, 153, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 80, 0, 0, 0, 0,
-	0, 0, 0, 0, 101, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
-	0, 0, 0, 267, 0, 0, 0, 0, 184, 0,
-	215, 122, 136, 97, 83, 93, 0, 121, 162, 191,
-	195, 0, 0, 0, 105, 0, 193, 172, 231, 0,
-	174, 192, 140, 221, 185, 230, 240, 241, 218, 238,
-	245, 208, 86, 217, 229, 102, 203, 88, 227, 214,
-	151, 131, 132, 87, 0, 189, 110, 117, 107, 164,
-	224, 225, 106, 248, 94, 237, 90, 95, 236, 158,
-	220, 228, 152, 145, 89, 226, 150, 144, 135, 114,
-	124, 182, 142, 183, 125, 155, 154, 156, 0, 0,
-	0, 212, 234, 249, 99, 0, 219, 243, 244, 0,
-	0, 100, 118, 113, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 91, 138,
-	246, 186, 116, 235, 0, 0, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
-	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
-	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
-	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242, 165, 0, 0, 0, 0,
-	0, 0, 0, 0, 111, 0, 0, 0, 0, 0,
-	137, 0, 0, 139, 0, 0, 211, 153, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 265, 0, 0, 0, 0,
-	0, 0, 0, 0, 101, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
-	0, 0, 0, 267, 0, 0, 0, 0, 184, 0,
-	215, 122, 136, 97, 83, 93, 0, 121, 162, 191,
-	195, 0, 0, 0, 105, 0, 193, 172, 231, 0,
-	174, 192, 140, 221, 185, 230, 240, 241, 218, 238,
-	245, 208, 86, 217, 229, 102, 203, 88, 227, 214,
-	151, 131, 132, 87, 0, 189, 110, 117, 107, 164,
-	224, 225, 106, 248, 94, 237, 90, 95, 236, 158,
-	220, 228, 152, 145, 89, 226, 150, 144, 135, 114,
-	124, 182, 142, 183, 125, 155, 154, 156, 0, 0,
-	0, 212, 234, 249, 99, 0, 219, 243, 244, 0,
-	0, 100, 118, 113, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 91, 138,
-	246, 186, 116, 235, 0, 0, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
-	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
-	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
-	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242, 165, 0, 0, 0, 0,
-	0, 0, 0, 0, 111, 0, 0, 0, 0, 0,
-	137, 0, 0, 139, 0, 0, 211, 153, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 322, 0, 0, 0, 0,
-	0, 0, 0, 0, 101, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 119,
-	0, 0, 0, 267, 0, 0, 0, 0, 184, 0,
-	215, 122, 136, 97, 83, 93, 0, 121, 162, 191,
-	195, 0, 0, 0, 105, 0, 193, 172, 231, 0,
-	174, 192, 140, 221, 185, 230, 240, 241, 218, 238,
-	245, 208, 86, 217, 229, 102, 203, 88, 227, 214,
-	151, 131, 132, 87, 0, 189, 110, 117, 107, 164,
-	224, 225, 106, 248, 94, 237, 90, 95, 236, 158,
-	220, 228, 152, 145, 89, 226, 150, 144, 135, 114,
-	124, 182, 142, 183, 125, 155, 154, 156, 0, 0,
-	0, 212, 234, 249, 99, 0, 219, 243, 244, 0,
-	0, 100, 118, 113, 181, 157, 96, 127, 209, 134,
-	141, 188, 247, 171, 194, 103, 233, 210, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 82, 91, 138,
-	246, 186, 116, 235, 0, 0, 109, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 84,
-	85, 92, 98, 104, 108, 112, 115, 120, 123, 126,
-	128, 129, 130, 133, 143, 146, 147, 148, 149, 159,
-	160, 161, 163, 166, 167, 168, 169, 170, 173, 175,
-	176, 177, 178, 179, 180, 187, 190, 196, 197, 198,
-	199, 200, 201, 202, 204, 205, 206, 207, 213, 216,
-	222, 223, 232, 239, 242,
+	357, 1639, 1431, 1628, 1606, 1417, 1583, 1478, 1300, 350,
+	1208, 361, 713, 1491, 1527, 1358, 387, 1226, 67, 1391,
+	374, 1047, 1359, 1043, 1020, 1432, 1209, 592, 1355, 1090,
+	1253, 78, 331, 603, 1056, 1364, 865, 951, 271, 323,
+	1046, 1370, 78, 658, 1330, 78, 1018, 962, 1158, 881,
+	271, 1279, 1022, 78, 1232, 958, 1007, 779, 639, 1270,
+	928, 436, 1076, 645, 1060, 759, 711, 3, 422, 430,
+	778, 66, 760, 1086, 561, 78, 425, 651, 665, 359,
+	340, 583, 1000, 986, 567, 427, 768, 732, 65, 75,
+	71, 712, 4, 1589, 324, 325, 326, 733, 8, 329,
+	7, 1171, 1110, 1632, 6, 1610, 29, 29, 61, 33,
+	34, 1626, 316, 1595, 1622, 1418, 1109, 1609, 1594, 1347,
+	1452, 566, 252, 253, 254, 255, 256, 330, 1558, 678,
+	677, 687, 688, 680, 681, 682, 683, 684, 685, 686,
+	679, 272, 1385, 689, 404, 1114, 410, 411, 408, 409,
+	407, 406, 405, 1037, 1108, 63, 63, 1386, 1387, 1241,
+	412, 413, 1240, 619, 596, 1242, 1170, 283, 275, 284,
+	280, 281, 273, 328, 277, 1038, 1039, 780, 327, 781,
+	1261, 614, 1069, 1302, 296, 615, 612, 613, 1481, 891,
+	1503, 1077, 1443, 1441, 311, 314, 854, 607, 608, 853,
+	617, 1304, 851, 1619, 1105, 1102, 1103, 317, 1101, 306,
+	1624, 1584, 1500, 1299, 1001, 1643, 1576, 1061, 1647, 1063,
+	601, 1528, 584, 568, 1227, 1229, 618, 277, 1063, 842,
+	598, 1305, 858, 600, 1530, 312, 852, 1536, 855, 1063,
+	1303, 1380, 1112, 1115, 1379, 1378, 564, 581, 571, 562,
+	570, 288, 278, 700, 701, 1314, 78, 271, 1254, 1122,
+	289, 78, 1121, 78, 1033, 597, 599, 292, 1183, 1309,
+	1237, 578, 276, 1193, 78, 300, 1152, 295, 913, 78,
+	1044, 897, 774, 1107, 669, 1296, 78, 588, 689, 78,
+	433, 1298, 282, 274, 271, 1403, 271, 271, 882, 271,
+	348, 271, 1228, 1529, 1559, 1106, 679, 271, 894, 689,
+	298, 1180, 886, 271, 594, 271, 305, 1062, 664, 259,
+	271, 1574, 1059, 1057, 1641, 1058, 1062, 1642, 1077, 1640,
+	1545, 1055, 1061, 562, 575, 1593, 576, 1062, 892, 577,
+	1537, 1535, 642, 646, 1111, 78, 1404, 290, 271, 1368,
+	569, 271, 1287, 647, 595, 260, 62, 662, 1070, 1113,
+	632, 633, 1331, 670, 585, 586, 560, 635, 636, 30,
+	30, 700, 701, 664, 302, 293, 782, 303, 304, 309,
+	883, 634, 1285, 294, 297, 626, 291, 308, 307, 1297,
+	935, 1295, 1349, 593, 987, 419, 420, 844, 714, 663,
+	662, 73, 1333, 648, 933, 934, 932, 1178, 1648, 1177,
+	627, 987, 730, 1190, 700, 701, 664, 630, 1259, 629,
+	78, 78, 78, 628, 663, 662, 663, 662, 649, 271,
+	1066, 1351, 1579, 354, 655, 271, 1067, 1149, 1150, 1151,
+	1335, 664, 1339, 664, 1334, 27, 1332, 654, 1649, 1598,
+	1286, 1337, 900, 901, 1487, 1291, 1288, 1281, 1289, 1284,
+	1336, 1280, 433, 1486, 1282, 1283, 1274, 758, 63, 918,
+	920, 921, 1273, 1338, 1340, 919, 345, 1262, 931, 1290,
+	687, 688, 680, 681, 682, 683, 684, 685, 686, 679,
+	767, 638, 689, 735, 737, 739, 741, 743, 745, 746,
+	624, 663, 662, 736, 738, 1600, 742, 744, 1575, 747,
+	772, 952, 1179, 953, 776, 335, 604, 605, 664, 606,
+	1243, 609, 1244, 1510, 1484, 1271, 1132, 620, 678, 677,
+	687, 688, 680, 681, 682, 683, 684, 685, 686, 679,
+	631, 870, 689, 1533, 1623, 638, 678, 677, 687, 688,
+	680, 681, 682, 683, 684, 685, 686, 679, 1602, 638,
+	689, 78, 1572, 663, 662, 1420, 271, 1533, 1587, 896,
+	1533, 638, 78, 271, 271, 271, 1533, 1566, 1542, 78,
+	664, 1533, 1532, 1541, 78, 1476, 1475, 1463, 638, 78,
+	1455, 271, 912, 638, 1410, 1409, 271, 271, 271, 78,
+	271, 271, 1159, 1254, 871, 1406, 1407, 895, 271, 271,
+	680, 681, 682, 683, 684, 685, 686, 679, 1249, 869,
+	689, 1406, 1405, 271, 663, 662, 884, 954, 678, 677,
+	687, 688, 680, 681, 682, 683, 684, 685, 686, 679,
+	864, 664, 689, 863, 388, 60, 1165, 638, 1400, 60,
+	867, 271, 1004, 638, 770, 846, 965, 638, 1233, 845,
+	843, 78, 840, 859, 789, 788, 29, 271, 770, 590,
+	582, 574, 915, 916, 682, 683, 684, 685, 686, 679,
+	902, 573, 689, 377, 376, 379, 380, 381, 382, 929,
+	1203, 1233, 378, 383, 889, 1204, 1064, 771, 1356, 773,
+	68, 1367, 1004, 925, 1615, 60, 924, 29, 1312, 1367,
+	271, 771, 965, 769, 336, 63, 1009, 1012, 1013, 1014,
+	1010, 347, 1011, 1015, 1458, 714, 1371, 1372, 1003, 29,
+	904, 926, 1544, 975, 976, 1367, 1515, 977, 980, 1004,
+	1027, 1165, 769, 988, 1408, 922, 769, 1245, 1036, 271,
+	271, 1196, 1195, 1165, 1004, 1165, 63, 78, 775, 898,
+	857, 344, 63, 346, 337, 78, 78, 1611, 1493, 78,
+	78, 1071, 1468, 78, 78, 78, 271, 1091, 63, 964,
+	955, 956, 967, 1396, 1371, 1372, 841, 1248, 1087, 271,
+	1082, 1081, 1042, 848, 849, 850, 63, 1301, 702, 703,
+	704, 705, 706, 707, 708, 709, 984, 996, 997, 433,
+	1494, 868, 1634, 63, 1094, 1028, 872, 873, 874, 1030,
+	876, 877, 1048, 1629, 1398, 1374, 1356, 1275, 878, 879,
+	887, 860, 386, 1220, 1218, 1377, 867, 910, 1221, 1219,
+	1449, 1376, 1217, 78, 271, 1034, 271, 78, 1035, 1026,
+	1031, 1454, 78, 78, 78, 78, 78, 1216, 78, 78,
+	78, 78, 271, 266, 1051, 1617, 1078, 1079, 1080, 1092,
+	268, 1009, 1012, 1013, 1014, 1010, 1608, 1011, 1015, 341,
+	342, 78, 313, 78, 78, 1308, 1134, 1613, 78, 678,
+	677, 687, 688, 680, 681, 682, 683, 684, 685, 686,
+	679, 1144, 1143, 689, 279, 1135, 1136, 1266, 646, 1129,
+	1088, 1089, 787, 271, 271, 678, 677, 687, 688, 680,
+	681, 682, 683, 684, 685, 686, 679, 640, 1125, 689,
+	1222, 925, 1013, 1014, 1137, 652, 591, 652, 602, 641,
+	602, 602, 1258, 602, 1456, 602, 1581, 1580, 653, 929,
+	653, 602, 1513, 650, 1256, 1250, 1489, 1097, 862, 926,
+	961, 1139, 1138, 60, 602, 1616, 1146, 1017, 338, 339,
+	1142, 332, 1501, 1550, 333, 1167, 68, 1549, 1141, 1496,
+	1233, 60, 616, 1636, 1635, 70, 1184, 1181, 880, 660,
+	1636, 657, 1563, 1154, 1482, 893, 72, 1191, 64, 1,
+	698, 1627, 1419, 657, 1490, 78, 78, 78, 78, 78,
+	1104, 1582, 1526, 1390, 1054, 1210, 1045, 78, 258, 559,
+	78, 257, 1573, 1053, 78, 1052, 1534, 1480, 78, 1065,
+	1260, 710, 1068, 715, 716, 717, 718, 719, 720, 721,
+	722, 723, 724, 725, 726, 727, 728, 271, 731, 734,
+	734, 734, 740, 734, 734, 740, 734, 748, 749, 750,
+	751, 752, 753, 754, 1096, 764, 1098, 1205, 1246, 1189,
+	1211, 1397, 1223, 1214, 1234, 1257, 1231, 1578, 1212, 1213,
+	1048, 1215, 1127, 1235, 795, 1236, 967, 793, 794, 435,
+	792, 1255, 797, 991, 1238, 271, 271, 796, 791, 299,
+	1265, 428, 1267, 1268, 1269, 927, 1016, 783, 936, 937,
+	938, 939, 940, 941, 942, 943, 944, 945, 946, 947,
+	948, 949, 950, 1251, 1252, 1093, 435, 271, 435, 435,
+	661, 435, 261, 435, 1294, 1263, 1264, 1172, 1173, 435,
+	1278, 78, 1272, 1293, 1100, 621, 885, 623, 610, 611,
+	301, 697, 435, 271, 1292, 1140, 1239, 1072, 1073, 1074,
+	1075, 434, 899, 644, 1548, 1495, 1188, 1319, 992, 729,
+	985, 362, 271, 1083, 1084, 1085, 917, 375, 1307, 372,
+	659, 373, 905, 667, 1202, 671, 1316, 678, 677, 687,
+	688, 680, 681, 682, 683, 684, 685, 686, 679, 360,
+	1350, 689, 352, 762, 1318, 755, 1348, 271, 271, 1317,
+	602, 1008, 1006, 1325, 1357, 1210, 1341, 602, 602, 602,
+	1005, 1360, 423, 1373, 1342, 1369, 761, 1352, 1311, 1451,
+	1137, 271, 1557, 909, 32, 602, 69, 343, 315, 23,
+	602, 602, 602, 1383, 602, 602, 271, 22, 271, 271,
+	1363, 1382, 602, 602, 21, 926, 1375, 20, 19, 18,
+	890, 435, 318, 1366, 625, 24, 1381, 784, 25, 1389,
+	17, 16, 60, 1362, 15, 579, 78, 36, 26, 1048,
+	363, 1048, 1394, 1395, 1393, 14, 1388, 1401, 1402, 1384,
+	13, 12, 11, 10, 78, 9, 5, 1605, 1588, 334,
+	271, 28, 60, 271, 271, 271, 78, 2, 0, 0,
+	271, 0, 0, 78, 0, 271, 1277, 0, 0, 1412,
+	0, 0, 0, 0, 0, 0, 0, 271, 0, 1322,
+	0, 0, 1413, 0, 1415, 1425, 0, 0, 0, 0,
+	0, 1328, 1329, 1316, 0, 0, 0, 1306, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 60, 0, 1427,
+	715, 1453, 0, 0, 0, 1439, 1426, 1155, 1156, 1157,
+	0, 714, 0, 0, 0, 0, 0, 0, 1466, 1210,
+	0, 1467, 0, 0, 1469, 1464, 1457, 0, 0, 271,
+	0, 0, 0, 0, 1465, 0, 0, 271, 435, 0,
+	0, 0, 0, 0, 0, 435, 435, 435, 0, 1019,
+	1246, 0, 271, 764, 0, 1474, 0, 764, 0, 271,
+	0, 0, 1048, 435, 637, 0, 0, 0, 435, 435,
+	435, 0, 435, 435, 0, 0, 0, 0, 0, 0,
+	435, 435, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1498, 1492, 0, 0, 888, 0, 0, 0, 1499,
+	0, 0, 0, 0, 271, 0, 0, 0, 1483, 271,
+	1485, 271, 271, 271, 78, 1360, 1520, 271, 1521, 1523,
+	1524, 1514, 0, 906, 1509, 0, 0, 0, 602, 0,
+	602, 0, 0, 0, 271, 78, 1531, 1519, 1525, 667,
+	1502, 1546, 435, 1538, 0, 0, 602, 1539, 0, 1540,
+	0, 714, 0, 0, 1552, 0, 0, 0, 1551, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1516, 0,
+	0, 1564, 1360, 0, 271, 0, 0, 0, 0, 0,
+	0, 1571, 957, 1570, 0, 271, 271, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1585, 1590,
+	714, 0, 0, 1591, 989, 0, 0, 1586, 0, 0,
+	1153, 0, 0, 0, 1596, 1210, 0, 78, 1492, 1048,
+	0, 993, 994, 0, 1565, 271, 0, 0, 0, 0,
+	271, 0, 0, 1320, 1321, 0, 1604, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 435, 1612,
+	1614, 0, 271, 0, 1343, 1344, 271, 1345, 1346, 1618,
+	1620, 435, 271, 0, 1625, 0, 0, 656, 0, 1353,
+	1354, 1633, 1488, 0, 0, 0, 0, 0, 1644, 699,
+	0, 0, 1448, 0, 0, 1206, 1207, 0, 0, 764,
+	764, 764, 764, 764, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1019, 0, 1230, 0, 0, 0,
+	0, 0, 764, 0, 0, 0, 435, 968, 435, 0,
+	0, 972, 973, 974, 0, 0, 979, 982, 983, 0,
+	1436, 1437, 1399, 1438, 435, 765, 1440, 0, 1442, 0,
+	0, 763, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 995, 0, 0, 998, 999, 0, 678, 677, 687,
+	688, 680, 681, 682, 683, 684, 685, 686, 679, 0,
+	435, 689, 0, 0, 0, 0, 0, 286, 0, 0,
+	602, 0, 903, 1447, 0, 1147, 1148, 0, 319, 0,
+	0, 1477, 911, 1430, 0, 0, 673, 0, 676, 0,
+	0, 0, 0, 0, 690, 691, 692, 693, 694, 695,
+	696, 602, 674, 675, 672, 678, 677, 687, 688, 680,
+	681, 682, 683, 684, 685, 686, 679, 0, 0, 689,
+	0, 657, 678, 677, 687, 688, 680, 681, 682, 683,
+	684, 685, 686, 679, 0, 963, 689, 966, 0, 0,
+	0, 969, 970, 971, 0, 1446, 1326, 0, 678, 677,
+	687, 688, 680, 681, 682, 683, 684, 685, 686, 679,
+	0, 0, 689, 1160, 0, 0, 989, 677, 687, 688,
+	680, 681, 682, 683, 684, 685, 686, 679, 0, 1361,
+	689, 60, 0, 678, 677, 687, 688, 680, 681, 682,
+	683, 684, 685, 686, 679, 0, 0, 689, 0, 0,
+	1145, 0, 0, 0, 0, 0, 0, 0, 0, 435,
+	1504, 1505, 1506, 1507, 1508, 0, 0, 0, 1511, 1512,
+	678, 677, 687, 688, 680, 681, 682, 683, 684, 685,
+	686, 679, 0, 0, 689, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 643, 0,
+	1163, 1164, 0, 0, 0, 0, 0, 1276, 435, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1187, 0, 0, 0, 76,
+	0, 424, 0, 930, 0, 0, 563, 764, 565, 435,
+	287, 0, 0, 310, 0, 0, 0, 0, 0, 572,
+	0, 287, 0, 0, 580, 0, 0, 0, 0, 0,
+	0, 587, 0, 0, 589, 435, 0, 0, 0, 0,
+	0, 0, 1450, 76, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1327, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1470, 1471, 1472, 435, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 989, 0, 0, 659,
+	1365, 0, 0, 0, 0, 763, 0, 0, 1161, 763,
+	0, 0, 1162, 763, 0, 0, 602, 0, 1166, 0,
+	0, 1168, 1169, 1365, 1637, 0, 1174, 1175, 1176, 0,
+	0, 0, 0, 1182, 0, 0, 1185, 1186, 435, 0,
+	435, 1392, 1192, 0, 0, 0, 1194, 0, 0, 1197,
+	1198, 1199, 1200, 1201, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1361, 0, 0, 1517, 0, 0, 0,
+	0, 0, 1225, 0, 0, 757, 0, 766, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1416, 0, 1543, 1421, 1422, 1423, 0, 0,
+	0, 0, 435, 0, 0, 0, 0, 1428, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1433,
+	1361, 0, 60, 0, 0, 0, 0, 0, 0, 0,
+	0, 351, 0, 0, 426, 0, 0, 0, 0, 287,
+	0, 287, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 287, 0, 0, 0, 0, 287, 0, 0,
+	989, 0, 0, 0, 287, 0, 0, 287, 0, 0,
+	0, 0, 0, 930, 0, 0, 0, 0, 0, 0,
+	0, 435, 0, 0, 0, 0, 0, 0, 0, 1479,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 435, 0, 0, 0, 0, 0,
+	0, 435, 0, 0, 0, 0, 790, 1323, 1324, 0,
+	0, 1621, 0, 76, 0, 0, 0, 847, 0, 1630,
+	0, 0, 0, 0, 856, 0, 0, 0, 0, 861,
+	0, 0, 0, 0, 0, 763, 763, 763, 763, 763,
+	0, 0, 0, 0, 875, 0, 1518, 0, 0, 0,
+	763, 1479, 0, 1479, 1479, 1479, 0, 0, 763, 1392,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1479, 29, 31, 61,
+	33, 34, 0, 0, 0, 0, 0, 0, 287, 287,
+	287, 0, 0, 0, 0, 0, 53, 0, 0, 0,
+	0, 35, 57, 58, 0, 0, 914, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1577, 0, 0, 0,
+	0, 0, 44, 0, 0, 0, 63, 435, 435, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 989, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1429, 0, 0, 0, 0, 0, 1603, 0, 0,
+	0, 0, 1607, 0, 1435, 0, 0, 1313, 0, 0,
+	0, 0, 0, 0, 0, 1444, 1445, 37, 38, 40,
+	39, 42, 0, 59, 1479, 0, 0, 0, 1607, 0,
+	0, 0, 1002, 0, 1433, 1459, 1460, 1461, 1462, 0,
+	0, 0, 0, 0, 0, 1029, 43, 54, 52, 0,
+	0, 0, 55, 56, 41, 1473, 0, 0, 0, 287,
+	0, 0, 0, 0, 0, 0, 0, 0, 45, 46,
+	287, 47, 48, 49, 50, 51, 0, 287, 0, 0,
+	0, 0, 287, 0, 0, 0, 0, 866, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 287, 0, 0,
+	0, 0, 0, 0, 0, 1497, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1095, 0,
+	0, 0, 1099, 0, 0, 0, 0, 1116, 1117, 1118,
+	1119, 1120, 0, 1123, 1124, 424, 1126, 0, 0, 0,
+	0, 0, 0, 0, 1522, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1128, 62, 0, 287,
+	0, 0, 0, 1133, 0, 0, 0, 0, 866, 0,
+	30, 0, 0, 763, 0, 0, 0, 0, 1553, 1554,
+	1555, 1556, 0, 1560, 0, 1561, 1562, 0, 0, 0,
+	0, 0, 0, 0, 0, 1567, 0, 1568, 1569, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 351,
+	0, 0, 0, 0, 0, 351, 0, 0, 0, 351,
+	351, 351, 0, 0, 351, 351, 351, 0, 0, 0,
+	990, 1592, 0, 0, 0, 0, 0, 0, 0, 1597,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 351,
+	351, 351, 351, 351, 0, 287, 1601, 0, 0, 0,
+	0, 0, 0, 287, 1024, 0, 0, 287, 287, 0,
+	0, 287, 1032, 866, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1631,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1645, 1646, 0, 0, 812, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 287, 0, 0, 0, 287, 0, 0, 0, 0,
+	287, 287, 287, 287, 287, 0, 287, 287, 287, 287,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 287,
+	0, 1130, 1131, 0, 0, 0, 287, 0, 0, 0,
+	0, 0, 0, 0, 800, 0, 866, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 351, 0,
+	0, 0, 0, 0, 0, 0, 1310, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 813, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 351, 351,
+	0, 826, 829, 830, 831, 832, 833, 834, 0, 835,
+	836, 837, 838, 839, 814, 815, 816, 817, 798, 799,
+	827, 0, 801, 351, 802, 803, 804, 805, 806, 807,
+	808, 809, 810, 811, 818, 819, 820, 821, 822, 823,
+	824, 825, 990, 287, 287, 287, 287, 287, 0, 0,
+	0, 0, 0, 0, 0, 1224, 0, 0, 287, 0,
+	0, 0, 1024, 0, 0, 0, 287, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1411, 828, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1414,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1424, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 287,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 351, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 351, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 866, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 990, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1547, 0, 0, 0, 287, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 287, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 287, 0, 0, 0, 0, 0,
+	0, 287, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1599, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 990, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1024, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 545, 533, 287, 490, 548, 463, 480, 556, 481,
+	484, 521, 448, 503, 164, 478, 0, 467, 443, 474,
+	444, 465, 492, 109, 496, 462, 535, 506, 547, 136,
+	468, 554, 138, 512, 0, 212, 152, 0, 0, 494,
+	537, 501, 530, 489, 522, 453, 511, 549, 479, 519,
+	550, 0, 0, 0, 270, 0, 1049, 1050, 0, 0,
+	0, 0, 0, 98, 0, 516, 544, 476, 518, 520,
+	442, 513, 990, 446, 449, 555, 540, 471, 472, 1247,
+	0, 0, 0, 0, 0, 287, 493, 502, 527, 487,
+	0, 0, 0, 0, 0, 0, 0, 0, 469, 0,
+	510, 0, 0, 0, 450, 447, 0, 0, 491, 0,
+	0, 0, 452, 0, 470, 528, 0, 440, 117, 532,
+	539, 221, 488, 242, 543, 486, 485, 546, 183, 0,
+	216, 121, 135, 94, 80, 90, 0, 119, 161, 190,
+	194, 536, 466, 475, 120, 103, 473, 192, 171, 233,
+	509, 173, 191, 139, 223, 184, 232, 243, 244, 219,
+	240, 248, 209, 83, 218, 231, 99, 203, 204, 200,
+	0, 102, 85, 229, 215, 150, 130, 131, 84, 0,
+	188, 108, 115, 105, 163, 226, 227, 104, 250, 91,
+	239, 87, 92, 238, 157, 222, 230, 151, 144, 86,
+	228, 149, 143, 134, 112, 123, 181, 141, 182, 124,
+	154, 153, 155, 0, 445, 0, 213, 236, 251, 96,
+	461, 220, 246, 247, 0, 0, 97, 116, 111, 180,
+	156, 93, 126, 210, 133, 140, 187, 249, 170, 193,
+	100, 235, 211, 457, 460, 455, 456, 504, 505, 551,
+	552, 553, 529, 451, 0, 458, 459, 0, 534, 541,
+	542, 508, 79, 88, 137, 558, 185, 114, 237, 441,
+	454, 107, 0, 0, 477, 482, 483, 495, 498, 499,
+	507, 514, 515, 517, 524, 526, 538, 523, 557, 531,
+	525, 464, 497, 500, 81, 82, 89, 95, 101, 106,
+	110, 113, 118, 122, 125, 127, 128, 129, 132, 142,
+	145, 146, 147, 148, 158, 159, 160, 162, 165, 166,
+	167, 168, 169, 172, 174, 175, 176, 177, 178, 179,
+	186, 189, 195, 196, 197, 198, 199, 201, 202, 205,
+	206, 207, 208, 214, 217, 224, 225, 234, 241, 245,
+	545, 533, 0, 490, 548, 463, 480, 556, 481, 484,
+	521, 448, 503, 164, 478, 0, 467, 443, 474, 444,
+	465, 492, 109, 496, 462, 535, 506, 547, 136, 468,
+	554, 138, 512, 0, 212, 152, 0, 0, 494, 537,
+	501, 530, 489, 522, 453, 511, 549, 479, 519, 550,
+	0, 0, 0, 270, 0, 1049, 1050, 0, 0, 0,
+	0, 0, 98, 0, 516, 544, 476, 518, 520, 442,
+	513, 0, 446, 449, 555, 540, 471, 472, 0, 0,
+	0, 0, 0, 0, 0, 493, 502, 527, 487, 0,
+	0, 0, 0, 0, 0, 0, 0, 469, 0, 510,
+	0, 0, 0, 450, 447, 0, 0, 491, 0, 0,
+	0, 452, 0, 470, 528, 0, 440, 117, 532, 539,
+	221, 488, 242, 543, 486, 485, 546, 183, 0, 216,
+	121, 135, 94, 80, 90, 0, 119, 161, 190, 194,
+	536, 466, 475, 120, 103, 473, 192, 171, 233, 509,
+	173, 191, 139, 223, 184, 232, 243, 244, 219, 240,
+	248, 209, 83, 218, 231, 99, 203, 204, 200, 0,
+	102, 85, 229, 215, 150, 130, 131, 84, 0, 188,
+	108, 115, 105, 163, 226, 227, 104, 250, 91, 239,
+	87, 92, 238, 157, 222, 230, 151, 144, 86, 228,
+	149, 143, 134, 112, 123, 181, 141, 182, 124, 154,
+	153, 155, 0, 445, 0, 213, 236, 251, 96, 461,
+	220, 246, 247, 0, 0, 97, 116, 111, 180, 156,
+	93, 126, 210, 133, 140, 187, 249, 170, 193, 100,
+	235, 211, 457, 460, 455, 456, 504, 505, 551, 552,
+	553, 529, 451, 0, 458, 459, 0, 534, 541, 542,
+	508, 79, 88, 137, 558, 185, 114, 237, 441, 454,
+	107, 0, 0, 477, 482, 483, 495, 498, 499, 507,
+	514, 515, 517, 524, 526, 538, 523, 557, 531, 525,
+	464, 497, 500, 81, 82, 89, 95, 101, 106, 110,
+	113, 118, 122, 125, 127, 128, 129, 132, 142, 145,
+	146, 147, 148, 158, 159, 160, 162, 165, 166, 167,
+	168, 169, 172, 174, 175, 176, 177, 178, 179, 186,
+	189, 195, 196, 197, 198, 199, 201, 202, 205, 206,
+	207, 208, 214, 217, 224, 225, 234, 241, 245, 545,
+	533, 0, 490, 548, 463, 480, 556, 481, 484, 521,
+	448, 503, 164, 478, 0, 467, 443, 474, 444, 465,
+	492, 109, 496, 462, 535, 506, 547, 136, 468, 554,
+	138, 512, 0, 212, 152, 0, 0, 494, 537, 501,
+	530, 489, 522, 453, 511, 549, 479, 519, 550, 63,
+	0, 0, 270, 0, 0, 0, 0, 0, 0, 0,
+	0, 98, 0, 516, 544, 476, 518, 520, 442, 513,
+	0, 446, 449, 555, 540, 471, 472, 0, 0, 0,
+	0, 0, 0, 0, 493, 502, 527, 487, 0, 0,
+	0, 0, 0, 0, 0, 0, 469, 0, 510, 0,
+	0, 0, 450, 447, 0, 0, 491, 0, 0, 0,
+	452, 0, 470, 528, 0, 440, 117, 532, 539, 221,
+	488, 242, 543, 486, 485, 546, 183, 0, 216, 121,
+	135, 94, 80, 90, 0, 119, 161, 190, 194, 536,
+	466, 475, 120, 103, 473, 192, 171, 233, 509, 173,
+	191, 139, 223, 184, 232, 243, 244, 219, 240, 248,
+	209, 83, 218, 231, 99, 203, 204, 200, 0, 102,
+	85, 229, 215, 150, 130, 131, 84, 0, 188, 108,
+	115, 105, 163, 226, 227, 104, 250, 91, 239, 87,
+	92, 238, 157, 222, 230, 151, 144, 86, 228, 149,
+	143, 134, 112, 123, 181, 141, 182, 124, 154, 153,
+	155, 0, 445, 0, 213, 236, 251, 96, 461, 220,
+	246, 247, 0, 0, 97, 116, 111, 180, 156, 93,
+	126, 210, 133, 140, 187, 249, 170, 193, 100, 235,
+	211, 457, 460, 455, 456, 504, 505, 551, 552, 553,
+	529, 451, 0, 458, 459, 0, 534, 541, 542, 508,
+	79, 88, 137, 558, 185, 114, 237, 441, 454, 107,
+	0, 0, 477, 482, 483, 495, 498, 499, 507, 514,
+	515, 517, 524, 526, 538, 523, 557, 531, 525, 464,
+	497, 500, 81, 82, 89, 95, 101, 106, 110, 113,
+	118, 122, 125, 127, 128, 129, 132, 142, 145, 146,
+	147, 148, 158, 159, 160, 162, 165, 166, 167, 168,
+	169, 172, 174, 175, 176, 177, 178, 179, 186, 189,
+	195, 196, 197, 198, 199, 201, 202, 205, 206, 207,
+	208, 214, 217, 224, 225, 234, 241, 245, 545, 533,
+	0, 490, 548, 463, 480, 556, 481, 484, 521, 448,
+	503, 164, 478, 0, 467, 443, 474, 444, 465, 492,
+	109, 496, 462, 535, 506, 547, 136, 468, 554, 138,
+	512, 0, 212, 152, 0, 0, 494, 537, 501, 530,
+	489, 522, 453, 511, 549, 479, 519, 550, 0, 0,
+	0, 270, 0, 0, 0, 0, 0, 0, 0, 0,
+	98, 0, 516, 544, 476, 518, 520, 442, 513, 0,
+	446, 449, 555, 540, 471, 472, 0, 0, 0, 0,
+	0, 0, 0, 493, 502, 527, 487, 0, 0, 0,
+	0, 0, 0, 1315, 0, 469, 0, 510, 0, 0,
+	0, 450, 447, 0, 0, 491, 0, 0, 0, 452,
+	0, 470, 528, 0, 440, 117, 532, 539, 221, 488,
+	242, 543, 486, 485, 546, 183, 0, 216, 121, 135,
+	94, 80, 90, 0, 119, 161, 190, 194, 536, 466,
+	475, 120, 103, 473, 192, 171, 233, 509, 173, 191,
+	139, 223, 184, 232, 243, 244, 219, 240, 248, 209,
+	83, 218, 231, 99, 203, 204, 200, 0, 102, 85,
+	229, 215, 150, 130, 131, 84, 0, 188, 108, 115,
+	105, 163, 226, 227, 104, 250, 91, 239, 87, 92,
+	238, 157, 222, 230, 151, 144, 86, 228, 149, 143,
+	134, 112, 123, 181, 141, 182, 124, 154, 153, 155,
+	0, 445, 0, 213, 236, 251, 96, 461, 220, 246,
+	247, 0, 0, 97, 116, 111, 180, 156, 93, 126,
+	210, 133, 140, 187, 249, 170, 193, 100, 235, 211,
+	457, 460, 455, 456, 504, 505, 551, 552, 553, 529,
+	451, 0, 458, 459, 0, 534, 541, 542, 508, 79,
+	88, 137, 558, 185, 114, 237, 441, 454, 107, 0,
+	0, 477, 482, 483, 495, 498, 499, 507, 514, 515,
+	517, 524, 526, 538, 523, 557, 531, 525, 464, 497,
+	500, 81, 82, 89, 95, 101, 106, 110, 113, 118,
+	122, 125, 127, 128, 129, 132, 142, 145, 146, 147,
+	148, 158, 159, 160, 162, 165, 166, 167, 168, 169,
+	172, 174, 175, 176, 177, 178, 179, 186, 189, 195,
+	196, 197, 198, 199, 201, 202, 205, 206, 207, 208,
+	214, 217, 224, 225, 234, 241, 245, 545, 533, 0,
+	490, 548, 463, 480, 556, 481, 484, 521, 448, 503,
+	164, 478, 0, 467, 443, 474, 444, 465, 492, 109,
+	496, 462, 535, 506, 547, 136, 468, 554, 138, 512,
+	0, 212, 152, 0, 0, 494, 537, 501, 530, 489,
+	522, 453, 511, 549, 479, 519, 550, 0, 0, 0,
+	77, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	0, 516, 544, 476, 518, 520, 442, 513, 0, 446,
+	449, 555, 540, 471, 472, 0, 0, 0, 0, 0,
+	0, 0, 493, 502, 527, 487, 0, 0, 0, 0,
+	0, 0, 1033, 0, 469, 0, 510, 0, 0, 0,
+	450, 447, 0, 0, 491, 0, 0, 0, 452, 0,
+	470, 528, 0, 440, 117, 532, 539, 221, 488, 242,
+	543, 486, 485, 546, 183, 0, 216, 121, 135, 94,
+	80, 90, 0, 119, 161, 190, 194, 536, 466, 475,
+	120, 103, 473, 192, 171, 233, 509, 173, 191, 139,
+	223, 184, 232, 243, 244, 219, 240, 248, 209, 83,
+	218, 231, 99, 203, 204, 200, 0, 102, 85, 229,
+	215, 150, 130, 131, 84, 0, 188, 108, 115, 105,
+	163, 226, 227, 104, 250, 91, 239, 87, 92, 238,
+	157, 222, 230, 151, 144, 86, 228, 149, 143, 134,
+	112, 123, 181, 141, 182, 124, 154, 153, 155, 0,
+	445, 0, 213, 236, 251, 96, 461, 220, 246, 247,
+	0, 0, 97, 116, 111, 180, 156, 93, 126, 210,
+	133, 140, 187, 249, 170, 193, 100, 235, 211, 457,
+	460, 455, 456, 504, 505, 551, 552, 553, 529, 451,
+	0, 458, 459, 0, 534, 541, 542, 508, 79, 88,
+	137, 558, 185, 114, 237, 441, 454, 107, 0, 0,
+	477, 482, 483, 495, 498, 499, 507, 514, 515, 517,
+	524, 526, 538, 523, 557, 531, 525, 464, 497, 500,
+	81, 82, 89, 95, 101, 106, 110, 113, 118, 122,
+	125, 127, 128, 129, 132, 142, 145, 146, 147, 148,
+	158, 159, 160, 162, 165, 166, 167, 168, 169, 172,
+	174, 175, 176, 177, 178, 179, 186, 189, 195, 196,
+	197, 198, 199, 201, 202, 205, 206, 207, 208, 214,
+	217, 224, 225, 234, 241, 245, 545, 533, 0, 490,
+	548, 463, 480, 556, 481, 484, 521, 448, 503, 164,
+	478, 0, 467, 443, 474, 444, 465, 492, 109, 496,
+	462, 535, 506, 547, 136, 468, 554, 138, 512, 0,
+	212, 152, 0, 0, 494, 537, 501, 530, 489, 522,
+	453, 511, 549, 479, 519, 550, 0, 0, 0, 356,
+	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
+	516, 544, 476, 518, 520, 442, 513, 0, 446, 449,
+	555, 540, 471, 472, 0, 0, 0, 0, 0, 0,
+	0, 493, 502, 527, 487, 0, 0, 0, 0, 0,
+	0, 923, 0, 469, 0, 510, 0, 0, 0, 450,
+	447, 0, 0, 491, 0, 0, 0, 452, 0, 470,
+	528, 0, 440, 117, 532, 539, 221, 488, 242, 543,
+	486, 485, 546, 183, 0, 216, 121, 135, 94, 80,
+	90, 0, 119, 161, 190, 194, 536, 466, 475, 120,
+	103, 473, 192, 171, 233, 509, 173, 191, 139, 223,
+	184, 232, 243, 244, 219, 240, 248, 209, 83, 218,
+	231, 99, 203, 204, 200, 0, 102, 85, 229, 215,
+	150, 130, 131, 84, 0, 188, 108, 115, 105, 163,
+	226, 227, 104, 250, 91, 239, 87, 92, 238, 157,
+	222, 230, 151, 144, 86, 228, 149, 143, 134, 112,
+	123, 181, 141, 182, 124, 154, 153, 155, 0, 445,
+	0, 213, 236, 251, 96, 461, 220, 246, 247, 0,
+	0, 97, 116, 111, 180, 156, 93, 126, 210, 133,
+	140, 187, 249, 170, 193, 100, 235, 211, 457, 460,
+	455, 456, 504, 505, 551, 552, 553, 529, 451, 0,
+	458, 459, 0, 534, 541, 542, 508, 79, 88, 137,
+	558, 185, 114, 237, 441, 454, 107, 0, 0, 477,
+	482, 483, 495, 498, 499, 507, 514, 515, 517, 524,
+	526, 538, 523, 557, 531, 525, 464, 497, 500, 81,
+	82, 89, 95, 101, 106, 110, 113, 118, 122, 125,
+	127, 128, 129, 132, 142, 145, 146, 147, 148, 158,
+	159, 160, 162, 165, 166, 167, 168, 169, 172, 174,
+	175, 176, 177, 178, 179, 186, 189, 195, 196, 197,
+	198, 199, 201, 202, 205, 206, 207, 208, 214, 217,
+	224, 225, 234, 241, 245, 545, 533, 0, 490, 548,
+	463, 480, 556, 481, 484, 521, 448, 503, 164, 478,
+	0, 467, 443, 474, 444, 465, 492, 109, 496, 462,
+	535, 506, 547, 136, 468, 554, 138, 512, 0, 212,
+	152, 0, 0, 494, 537, 501, 530, 489, 522, 453,
+	511, 549, 479, 519, 550, 0, 0, 0, 270, 0,
+	0, 0, 0, 0, 0, 0, 0, 98, 0, 516,
+	544, 476, 518, 520, 442, 513, 0, 446, 449, 555,
+	540, 471, 472, 0, 0, 0, 0, 0, 0, 0,
+	493, 502, 527, 487, 0, 0, 0, 0, 0, 0,
+	0, 0, 469, 0, 510, 0, 0, 0, 450, 447,
+	0, 0, 491, 0, 0, 0, 452, 0, 470, 528,
+	0, 440, 117, 532, 539, 221, 488, 242, 543, 486,
+	485, 546, 183, 0, 216, 121, 135, 94, 80, 90,
+	0, 119, 161, 190, 194, 536, 466, 475, 120, 103,
+	473, 192, 171, 233, 509, 173, 191, 139, 223, 184,
+	232, 243, 244, 219, 240, 248, 209, 83, 218, 231,
+	99, 203, 204, 200, 0, 102, 85, 229, 215, 150,
+	130, 131, 84, 0, 188, 108, 115, 105, 163, 226,
+	227, 104, 250, 91, 239, 87, 92, 238, 157, 222,
+	230, 151, 144, 86, 228, 149, 143, 134, 112, 123,
+	181, 141, 182, 124, 154, 153, 155, 0, 445, 0,
+	213, 236, 251, 96, 461, 220, 246, 247, 0, 0,
+	97, 116, 111, 180, 156, 93, 126, 210, 133, 140,
+	187, 249, 170, 193, 100, 235, 211, 457, 460, 455,
+	456, 504, 505, 551, 552, 553, 529, 451, 0, 458,
+	459, 0, 534, 541, 542, 508, 79, 88, 137, 558,
+	185, 114, 237, 441, 454, 107, 0, 0, 477, 482,
+	483, 495, 498, 499, 507, 514, 515, 517, 524, 526,
+	538, 523, 557, 531, 525, 464, 497, 500, 81, 82,
+	89, 95, 101, 106, 110, 113, 118, 122, 125, 127,
+	128, 129, 132, 142, 145, 146, 147, 148, 158, 159,
+	160, 162, 165, 166, 167, 168, 169, 172, 174, 175,
+	176, 177, 178, 179, 186, 189, 195, 196, 197, 198,
+	199, 201, 202, 205, 206, 207, 208, 214, 217, 224,
+	225, 234, 241, 245, 545, 533, 0, 490, 548, 463,
+	480, 556, 481, 484, 521, 448, 503, 164, 478, 0,
+	467, 443, 474, 444, 465, 492, 109, 496, 462, 535,
+	506, 547, 136, 468, 554, 138, 512, 0, 212, 152,
+	0, 0, 494, 537, 501, 530, 489, 522, 453, 511,
+	549, 479, 519, 550, 0, 0, 0, 356, 0, 0,
+	0, 0, 0, 0, 0, 0, 98, 0, 516, 544,
+	476, 518, 520, 442, 513, 0, 446, 449, 555, 540,
+	471, 472, 0, 0, 0, 0, 0, 0, 0, 493,
+	502, 527, 487, 0, 0, 0, 0, 0, 0, 0,
+	0, 469, 0, 510, 0, 0, 0, 450, 447, 0,
+	0, 491, 0, 0, 0, 452, 0, 470, 528, 0,
+	440, 117, 532, 539, 221, 488, 242, 543, 486, 485,
+	546, 183, 0, 216, 121, 135, 94, 80, 90, 0,
+	119, 161, 190, 194, 536, 466, 475, 120, 103, 473,
+	192, 171, 233, 509, 173, 191, 139, 223, 184, 232,
+	243, 244, 219, 240, 248, 209, 83, 218, 231, 99,
+	203, 204, 200, 0, 102, 85, 229, 215, 150, 130,
+	131, 84, 0, 188, 108, 115, 105, 163, 226, 227,
+	104, 250, 91, 239, 87, 92, 238, 157, 222, 230,
+	151, 144, 86, 228, 149, 143, 134, 112, 123, 181,
+	141, 182, 124, 154, 153, 155, 0, 445, 0, 213,
+	236, 251, 96, 461, 220, 246, 247, 0, 0, 97,
+	116, 111, 180, 156, 93, 126, 210, 133, 140, 187,
+	249, 170, 193, 100, 235, 211, 457, 460, 455, 456,
+	504, 505, 551, 552, 553, 529, 451, 0, 458, 459,
+	0, 534, 541, 542, 508, 79, 88, 137, 558, 185,
+	114, 237, 441, 454, 107, 0, 0, 477, 482, 483,
+	495, 498, 499, 507, 514, 515, 517, 524, 526, 538,
+	523, 557, 531, 525, 464, 497, 500, 81, 82, 89,
+	95, 101, 106, 110, 113, 118, 122, 125, 127, 128,
+	129, 132, 142, 145, 146, 147, 148, 158, 159, 160,
+	162, 165, 166, 167, 168, 169, 172, 174, 175, 176,
+	177, 178, 179, 186, 189, 195, 196, 197, 198, 199,
+	201, 202, 205, 206, 207, 208, 214, 217, 224, 225,
+	234, 241, 245, 545, 533, 0, 490, 548, 463, 480,
+	556, 481, 484, 521, 448, 503, 164, 478, 0, 467,
+	443, 474, 444, 465, 492, 109, 496, 462, 535, 506,
+	547, 136, 468, 554, 138, 512, 0, 212, 152, 0,
+	0, 494, 537, 501, 530, 489, 522, 453, 511, 549,
+	479, 519, 550, 0, 0, 0, 270, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 0, 516, 544, 476,
+	518, 520, 442, 513, 0, 446, 449, 555, 540, 471,
+	472, 0, 0, 0, 0, 0, 0, 0, 493, 502,
+	527, 487, 0, 0, 0, 0, 0, 0, 0, 0,
+	469, 0, 510, 0, 0, 0, 450, 447, 0, 0,
+	491, 0, 0, 0, 452, 0, 470, 528, 0, 440,
+	117, 532, 539, 221, 488, 242, 543, 486, 485, 546,
+	183, 0, 216, 121, 135, 94, 80, 90, 0, 119,
+	161, 190, 194, 536, 466, 475, 120, 103, 473, 192,
+	171, 233, 509, 173, 191, 139, 223, 184, 232, 243,
+	244, 219, 240, 248, 209, 83, 218, 231, 99, 203,
+	204, 200, 0, 102, 85, 229, 215, 150, 130, 131,
+	84, 0, 188, 108, 115, 105, 163, 226, 227, 104,
+	250, 91, 239, 87, 438, 238, 157, 222, 230, 151,
+	144, 86, 228, 149, 143, 134, 112, 123, 181, 141,
+	182, 124, 154, 153, 155, 0, 445, 0, 213, 236,
+	251, 96, 461, 220, 246, 247, 0, 0, 97, 116,
+	111, 180, 439, 437, 126, 210, 133, 140, 187, 249,
+	170, 193, 100, 235, 211, 457, 460, 455, 456, 504,
+	505, 551, 552, 553, 529, 451, 0, 458, 459, 0,
+	534, 541, 542, 508, 79, 88, 137, 558, 185, 114,
+	237, 441, 454, 107, 0, 0, 477, 482, 483, 495,
+	498, 499, 507, 514, 515, 517, 524, 526, 538, 523,
+	557, 531, 525, 464, 497, 500, 81, 82, 89, 95,
+	101, 106, 110, 113, 118, 122, 125, 127, 128, 129,
+	132, 142, 145, 146, 147, 148, 158, 159, 160, 162,
+	165, 166, 167, 168, 169, 172, 174, 175, 176, 177,
+	178, 179, 186, 189, 195, 196, 197, 198, 199, 201,
+	202, 205, 206, 207, 208, 214, 217, 224, 225, 234,
+	241, 245, 545, 533, 0, 490, 548, 463, 480, 556,
+	481, 484, 521, 448, 503, 164, 478, 0, 467, 443,
+	474, 444, 465, 492, 109, 496, 462, 535, 506, 547,
+	136, 468, 554, 138, 512, 0, 212, 152, 0, 0,
+	494, 537, 501, 530, 489, 522, 453, 511, 549, 479,
+	519, 550, 0, 0, 0, 77, 0, 0, 0, 0,
+	0, 0, 0, 0, 98, 0, 516, 544, 476, 518,
+	520, 442, 513, 0, 446, 449, 555, 540, 471, 472,
+	0, 0, 0, 0, 0, 0, 0, 493, 502, 527,
+	487, 0, 0, 0, 0, 0, 0, 0, 0, 469,
+	0, 510, 0, 0, 0, 450, 447, 0, 0, 491,
+	0, 0, 0, 452, 0, 470, 528, 0, 440, 117,
+	532, 539, 221, 488, 242, 543, 486, 485, 546, 183,
+	0, 216, 121, 135, 94, 80, 90, 0, 119, 161,
+	190, 194, 536, 466, 475, 120, 103, 473, 192, 171,
+	233, 509, 173, 191, 139, 223, 184, 232, 243, 244,
+	219, 240, 248, 209, 83, 218, 231, 99, 203, 204,
+	200, 0, 102, 85, 229, 215, 150, 130, 131, 84,
+	0, 188, 108, 115, 105, 163, 226, 227, 104, 250,
+	91, 239, 87, 92, 238, 157, 222, 230, 151, 144,
+	86, 228, 149, 143, 134, 112, 123, 181, 141, 182,
+	124, 154, 153, 155, 0, 445, 0, 213, 236, 251,
+	96, 461, 220, 246, 247, 0, 0, 97, 116, 111,
+	180, 156, 93, 126, 210, 133, 140, 187, 249, 170,
+	193, 100, 235, 211, 457, 460, 455, 456, 504, 505,
+	551, 552, 553, 529, 451, 0, 458, 459, 0, 534,
+	541, 542, 508, 79, 88, 137, 558, 185, 114, 237,
+	441, 454, 107, 0, 0, 477, 482, 483, 495, 498,
+	499, 507, 514, 515, 517, 524, 526, 538, 523, 557,
+	531, 525, 464, 497, 500, 81, 82, 89, 95, 101,
+	106, 110, 113, 118, 122, 125, 127, 128, 129, 132,
+	142, 145, 146, 147, 148, 158, 159, 160, 162, 165,
+	166, 167, 168, 169, 172, 174, 175, 176, 177, 178,
+	179, 186, 189, 195, 196, 197, 198, 199, 201, 202,
+	205, 206, 207, 208, 214, 217, 224, 225, 234, 241,
+	245, 545, 533, 0, 490, 548, 463, 480, 556, 481,
+	484, 521, 448, 503, 164, 478, 0, 467, 443, 474,
+	444, 465, 492, 109, 496, 462, 535, 506, 547, 136,
+	468, 554, 138, 512, 0, 212, 152, 0, 0, 494,
+	537, 501, 530, 489, 522, 453, 511, 549, 479, 519,
+	550, 0, 0, 0, 270, 0, 0, 0, 0, 0,
+	0, 0, 0, 98, 0, 516, 544, 476, 518, 520,
+	442, 513, 0, 446, 449, 555, 540, 471, 472, 0,
+	0, 0, 0, 0, 0, 0, 493, 502, 527, 487,
+	0, 0, 0, 0, 0, 0, 0, 0, 469, 0,
+	510, 0, 0, 0, 450, 447, 0, 0, 491, 0,
+	0, 0, 452, 0, 470, 528, 0, 440, 117, 532,
+	539, 221, 488, 242, 543, 486, 485, 546, 183, 0,
+	216, 121, 135, 94, 80, 90, 0, 119, 161, 190,
+	194, 536, 466, 475, 120, 103, 473, 192, 171, 233,
+	509, 173, 191, 139, 223, 184, 232, 243, 244, 219,
+	240, 248, 209, 83, 218, 777, 99, 203, 204, 200,
+	0, 102, 85, 229, 215, 150, 130, 131, 84, 0,
+	188, 108, 115, 105, 163, 226, 227, 104, 250, 91,
+	239, 87, 438, 238, 157, 222, 230, 151, 144, 86,
+	228, 149, 143, 134, 112, 123, 181, 141, 182, 124,
+	154, 153, 155, 0, 445, 0, 213, 236, 251, 96,
+	461, 220, 246, 247, 0, 0, 97, 116, 111, 180,
+	439, 437, 126, 210, 133, 140, 187, 249, 170, 193,
+	100, 235, 211, 457, 460, 455, 456, 504, 505, 551,
+	552, 553, 529, 451, 0, 458, 459, 0, 534, 541,
+	542, 508, 79, 88, 137, 558, 185, 114, 237, 441,
+	454, 107, 0, 0, 477, 482, 483, 495, 498, 499,
+	507, 514, 515, 517, 524, 526, 538, 523, 557, 531,
+	525, 464, 497, 500, 81, 82, 89, 95, 101, 106,
+	110, 113, 118, 122, 125, 127, 128, 129, 132, 142,
+	145, 146, 147, 148, 158, 159, 160, 162, 165, 166,
+	167, 168, 169, 172, 174, 175, 176, 177, 178, 179,
+	186, 189, 195, 196, 197, 198, 199, 201, 202, 205,
+	206, 207, 208, 214, 217, 224, 225, 234, 241, 245,
+	545, 533, 0, 490, 548, 463, 480, 556, 481, 484,
+	521, 448, 503, 164, 478, 0, 467, 443, 474, 444,
+	465, 492, 109, 496, 462, 535, 506, 547, 136, 468,
+	554, 138, 512, 0, 212, 152, 0, 0, 494, 537,
+	501, 530, 489, 522, 453, 511, 549, 479, 519, 550,
+	0, 0, 0, 270, 0, 0, 0, 0, 0, 0,
+	0, 0, 98, 0, 516, 544, 476, 518, 520, 442,
+	513, 0, 446, 449, 555, 540, 471, 472, 0, 0,
+	0, 0, 0, 0, 0, 493, 502, 527, 487, 0,
+	0, 0, 0, 0, 0, 0, 0, 469, 0, 510,
+	0, 0, 0, 450, 447, 0, 0, 491, 0, 0,
+	0, 452, 0, 470, 528, 0, 440, 117, 532, 539,
+	221, 488, 242, 543, 486, 485, 546, 183, 0, 216,
+	121, 135, 94, 80, 90, 0, 119, 161, 190, 194,
+	536, 466, 475, 120, 103, 473, 192, 171, 233, 509,
+	173, 191, 139, 223, 184, 232, 243, 244, 219, 240,
+	248, 209, 83, 218, 429, 99, 203, 204, 200, 0,
+	102, 85, 229, 215, 150, 130, 131, 84, 0, 188,
+	108, 115, 105, 163, 226, 227, 104, 250, 91, 239,
+	87, 438, 238, 157, 222, 230, 151, 144, 86, 228,
+	149, 143, 134, 112, 123, 181, 141, 182, 124, 154,
+	153, 155, 0, 445, 0, 213, 236, 251, 96, 461,
+	220, 246, 247, 0, 0, 97, 116, 111, 180, 439,
+	437, 432, 431, 133, 140, 187, 249, 170, 193, 100,
+	235, 211, 457, 460, 455, 456, 504, 505, 551, 552,
+	553, 529, 451, 0, 458, 459, 0, 534, 541, 542,
+	508, 79, 88, 137, 558, 185, 114, 237, 441, 454,
+	107, 0, 0, 477, 482, 483, 495, 498, 499, 507,
+	514, 515, 517, 524, 526, 538, 523, 557, 531, 525,
+	464, 497, 500, 81, 82, 89, 95, 101, 106, 110,
+	113, 118, 122, 125, 127, 128, 129, 132, 142, 145,
+	146, 147, 148, 158, 159, 160, 162, 165, 166, 167,
+	168, 169, 172, 174, 175, 176, 177, 178, 179, 186,
+	189, 195, 196, 197, 198, 199, 201, 202, 205, 206,
+	207, 208, 214, 217, 224, 225, 234, 241, 245, 164,
+	0, 0, 959, 0, 358, 0, 0, 0, 109, 0,
+	355, 0, 0, 0, 136, 960, 403, 138, 0, 0,
+	212, 152, 0, 0, 0, 0, 389, 395, 0, 0,
+	0, 0, 0, 0, 0, 0, 63, 0, 0, 356,
+	377, 376, 379, 380, 381, 382, 0, 0, 98, 378,
+	383, 384, 385, 0, 0, 0, 353, 370, 0, 402,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 367,
+	368, 349, 0, 0, 0, 417, 0, 369, 0, 0,
+	364, 365, 366, 371, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 117, 416, 0, 221, 0, 242, 0,
+	0, 414, 0, 183, 0, 216, 121, 135, 94, 80,
+	90, 0, 119, 161, 190, 194, 0, 0, 0, 120,
+	103, 0, 192, 171, 233, 0, 173, 191, 139, 223,
+	184, 232, 243, 244, 219, 240, 248, 209, 83, 218,
+	231, 99, 203, 204, 200, 0, 102, 85, 229, 215,
+	150, 130, 131, 84, 0, 188, 108, 115, 105, 163,
+	226, 227, 104, 250, 91, 239, 87, 92, 238, 157,
+	222, 230, 151, 144, 86, 228, 149, 143, 134, 112,
+	123, 181, 141, 182, 124, 154, 153, 155, 0, 0,
+	0, 213, 236, 251, 96, 0, 220, 246, 247, 0,
+	0, 97, 116, 111, 180, 156, 93, 126, 210, 133,
+	140, 187, 249, 170, 193, 100, 235, 211, 404, 415,
+	410, 411, 408, 409, 407, 406, 405, 418, 396, 397,
+	398, 399, 401, 0, 412, 413, 400, 79, 88, 137,
+	0, 185, 114, 237, 0, 0, 107, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 390, 391, 392, 393, 394, 81,
+	82, 89, 95, 101, 106, 110, 113, 118, 122, 125,
+	127, 128, 129, 132, 142, 145, 146, 147, 148, 158,
+	159, 160, 162, 165, 166, 167, 168, 169, 172, 174,
+	175, 176, 177, 178, 179, 186, 189, 195, 196, 197,
+	198, 199, 201, 202, 205, 206, 207, 208, 214, 217,
+	224, 225, 234, 241, 245, 164, 0, 0, 0, 0,
+	358, 0, 0, 0, 109, 0, 355, 0, 0, 0,
+	136, 0, 403, 138, 0, 0, 212, 152, 0, 0,
+	0, 0, 389, 395, 0, 0, 0, 0, 0, 0,
+	1040, 0, 63, 0, 0, 356, 377, 376, 379, 380,
+	381, 382, 0, 0, 98, 378, 383, 384, 385, 1041,
+	0, 0, 353, 370, 0, 402, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 367, 368, 0, 0, 0,
+	0, 417, 0, 369, 0, 0, 364, 365, 366, 371,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 117,
+	416, 0, 221, 0, 242, 0, 0, 414, 0, 183,
+	0, 216, 121, 135, 94, 80, 90, 0, 119, 161,
+	190, 194, 0, 0, 0, 120, 103, 0, 192, 171,
+	233, 0, 173, 191, 139, 223, 184, 232, 243, 244,
+	219, 240, 248, 209, 83, 218, 231, 99, 203, 204,
+	200, 0, 102, 85, 229, 215, 150, 130, 131, 84,
+	0, 188, 108, 115, 105, 163, 226, 227, 104, 250,
+	91, 239, 87, 92, 238, 157, 222, 230, 151, 144,
+	86, 228, 149, 143, 134, 112, 123, 181, 141, 182,
+	124, 154, 153, 155, 0, 0, 0, 213, 236, 251,
+	96, 0, 220, 246, 247, 0, 0, 97, 116, 111,
+	180, 156, 93, 126, 210, 133, 140, 187, 249, 170,
+	193, 100, 235, 211, 404, 415, 410, 411, 408, 409,
+	407, 406, 405, 418, 396, 397, 398, 399, 401, 0,
+	412, 413, 400, 79, 88, 137, 0, 185, 114, 237,
+	0, 0, 107, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	390, 391, 392, 393, 394, 81, 82, 89, 95, 101,
+	106, 110, 113, 118, 122, 125, 127, 128, 129, 132,
+	142, 145, 146, 147, 148, 158, 159, 160, 162, 165,
+	166, 167, 168, 169, 172, 174, 175, 176, 177, 178,
+	179, 186, 189, 195, 196, 197, 198, 199, 201, 202,
+	205, 206, 207, 208, 214, 217, 224, 225, 234, 241,
+	245, 29, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 164, 0, 0, 0, 0, 358, 0,
+	0, 0, 109, 0, 355, 0, 0, 0, 136, 0,
+	403, 138, 0, 0, 212, 152, 0, 0, 0, 0,
+	389, 395, 0, 0, 0, 0, 0, 0, 0, 0,
+	63, 0, 0, 356, 377, 376, 379, 380, 381, 382,
+	0, 0, 98, 378, 383, 384, 385, 0, 0, 0,
+	353, 370, 0, 402, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 367, 368, 0, 0, 0, 0, 417,
+	0, 369, 0, 0, 364, 365, 366, 371, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 117, 416, 0,
+	221, 0, 242, 0, 0, 414, 0, 183, 0, 216,
+	121, 135, 94, 80, 90, 0, 119, 161, 190, 194,
+	0, 0, 0, 120, 103, 0, 192, 171, 233, 0,
+	173, 191, 139, 223, 184, 232, 243, 244, 219, 240,
+	248, 209, 83, 218, 231, 99, 203, 204, 200, 0,
+	102, 85, 229, 215, 150, 130, 131, 84, 0, 188,
+	108, 115, 105, 163, 226, 227, 104, 250, 91, 239,
+	87, 92, 238, 157, 222, 230, 151, 144, 86, 228,
+	149, 143, 134, 112, 123, 181, 141, 182, 124, 154,
+	153, 155, 0, 0, 0, 213, 236, 251, 96, 0,
+	220, 246, 247, 0, 0, 97, 116, 111, 180, 156,
+	93, 126, 210, 133, 140, 187, 249, 170, 193, 100,
+	235, 211, 404, 415, 410, 411, 408, 409, 407, 406,
+	405, 418, 396, 397, 398, 399, 401, 0, 412, 413,
+	400, 79, 88, 137, 30, 185, 114, 237, 0, 0,
+	107, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 390, 391,
+	392, 393, 394, 81, 82, 89, 95, 101, 106, 110,
+	113, 118, 122, 125, 127, 128, 129, 132, 142, 145,
+	146, 147, 148, 158, 159, 160, 162, 165, 166, 167,
+	168, 169, 172, 174, 175, 176, 177, 178, 179, 186,
+	189, 195, 196, 197, 198, 199, 201, 202, 205, 206,
+	207, 208, 214, 217, 224, 225, 234, 241, 245, 164,
+	0, 0, 0, 0, 358, 0, 0, 0, 109, 0,
+	355, 0, 0, 0, 136, 0, 403, 138, 0, 0,
+	212, 152, 0, 0, 0, 0, 389, 395, 0, 0,
+	0, 0, 0, 0, 0, 0, 63, 0, 638, 356,
+	377, 376, 379, 380, 381, 382, 0, 0, 98, 378,
+	383, 384, 385, 0, 0, 0, 353, 370, 0, 402,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 367,
+	368, 0, 0, 0, 0, 417, 0, 369, 0, 0,
+	364, 365, 366, 371, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 117, 416, 0, 221, 0, 242, 0,
+	0, 414, 0, 183, 0, 216, 121, 135, 94, 80,
+	90, 0, 119, 161, 190, 194, 0, 0, 0, 120,
+	103, 0, 192, 171, 233, 0, 173, 191, 139, 223,
+	184, 232, 243, 244, 219, 240, 248, 209, 83, 218,
+	231, 99, 203, 204, 200, 0, 102, 85, 229, 215,
+	150, 130, 131, 84, 0, 188, 108, 115, 105, 163,
+	226, 227, 104, 250, 91, 239, 87, 92, 238, 157,
+	222, 230, 151, 144, 86, 228, 149, 143, 134, 112,
+	123, 181, 141, 182, 124, 154, 153, 155, 0, 0,
+	0, 213, 236, 251, 96, 0, 220, 246, 247, 0,
+	0, 97, 116, 111, 180, 156, 93, 126, 210, 133,
+	140, 187, 249, 170, 193, 100, 235, 211, 404, 415,
+	410, 411, 408, 409, 407, 406, 405, 418, 396, 397,
+	398, 399, 401, 0, 412, 413, 400, 79, 88, 137,
+	0, 185, 114, 237, 0, 0, 107, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 390, 391, 392, 393, 394, 81,
+	82, 89, 95, 101, 106, 110, 113, 118, 122, 125,
+	127, 128, 129, 132, 142, 145, 146, 147, 148, 158,
+	159, 160, 162, 165, 166, 167, 168, 169, 172, 174,
+	175, 176, 177, 178, 179, 186, 189, 195, 196, 197,
+	198, 199, 201, 202, 205, 206, 207, 208, 214, 217,
+	224, 225, 234, 241, 245, 164, 0, 0, 0, 0,
+	358, 0, 0, 0, 109, 0, 355, 0, 0, 0,
+	136, 0, 403, 138, 0, 0, 212, 152, 0, 0,
+	0, 0, 389, 395, 0, 0, 0, 0, 0, 0,
+	0, 0, 63, 0, 0, 356, 377, 376, 379, 380,
+	381, 382, 0, 0, 98, 378, 383, 384, 385, 0,
+	0, 0, 353, 370, 0, 402, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 367, 368, 349, 0, 0,
+	0, 417, 0, 369, 0, 0, 364, 365, 366, 371,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 117,
+	416, 0, 221, 0, 242, 0, 0, 414, 0, 183,
+	0, 216, 121, 135, 94, 80, 90, 0, 119, 161,
+	190, 194, 0, 0, 0, 120, 103, 0, 192, 171,
+	233, 0, 173, 191, 139, 223, 184, 232, 243, 244,
+	219, 240, 248, 209, 83, 218, 231, 99, 203, 204,
+	200, 0, 102, 85, 229, 215, 150, 130, 131, 84,
+	0, 188, 108, 115, 105, 163, 226, 227, 104, 250,
+	91, 239, 87, 92, 238, 157, 222, 230, 151, 144,
+	86, 228, 149, 143, 134, 112, 123, 181, 141, 182,
+	124, 154, 153, 155, 0, 0, 0, 213, 236, 251,
+	96, 0, 220, 246, 247, 0, 0, 97, 116, 111,
+	180, 156, 93, 126, 210, 133, 140, 187, 249, 170,
+	193, 100, 235, 211, 404, 415, 410, 411, 408, 409,
+	407, 406, 405, 418, 396, 397, 398, 399, 401, 0,
+	412, 413, 400, 79, 88, 137, 0, 185, 114, 237,
+	0, 0, 107, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	390, 391, 392, 393, 394, 81, 82, 89, 95, 101,
+	106, 110, 113, 118, 122, 125, 127, 128, 129, 132,
+	142, 145, 146, 147, 148, 158, 159, 160, 162, 165,
+	166, 167, 168, 169, 172, 174, 175, 176, 177, 178,
+	179, 186, 189, 195, 196, 197, 198, 199, 201, 202,
+	205, 206, 207, 208, 214, 217, 224, 225, 234, 241,
+	245, 164, 0, 0, 0, 0, 358, 0, 0, 0,
+	109, 0, 355, 0, 0, 0, 136, 0, 403, 138,
+	0, 0, 212, 152, 0, 0, 0, 0, 389, 395,
+	0, 0, 0, 0, 0, 0, 0, 0, 63, 0,
+	0, 356, 377, 981, 379, 380, 381, 382, 0, 0,
+	98, 378, 383, 384, 385, 0, 0, 0, 353, 370,
+	0, 402, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 367, 368, 349, 0, 0, 0, 417, 0, 369,
+	0, 0, 364, 365, 366, 371, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 117, 416, 0, 221, 0,
+	242, 0, 0, 414, 0, 183, 0, 216, 121, 135,
+	94, 80, 90, 0, 119, 161, 190, 194, 0, 0,
+	0, 120, 103, 0, 192, 171, 233, 0, 173, 191,
+	139, 223, 184, 232, 243, 244, 219, 240, 248, 209,
+	83, 218, 231, 99, 203, 204, 200, 0, 102, 85,
+	229, 215, 150, 130, 131, 84, 0, 188, 108, 115,
+	105, 163, 226, 227, 104, 250, 91, 239, 87, 92,
+	238, 157, 222, 230, 151, 144, 86, 228, 149, 143,
+	134, 112, 123, 181, 141, 182, 124, 154, 153, 155,
+	0, 0, 0, 213, 236, 251, 96, 0, 220, 246,
+	247, 0, 0, 97, 116, 111, 180, 156, 93, 126,
+	210, 133, 140, 187, 249, 170, 193, 100, 235, 211,
+	404, 415, 410, 411, 408, 409, 407, 406, 405, 418,
+	396, 397, 398, 399, 401, 0, 412, 413, 400, 79,
+	88, 137, 0, 185, 114, 237, 0, 0, 107, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 390, 391, 392, 393,
+	394, 81, 82, 89, 95, 101, 106, 110, 113, 118,
+	122, 125, 127, 128, 129, 132, 142, 145, 146, 147,
+	148, 158, 159, 160, 162, 165, 166, 167, 168, 169,
+	172, 174, 175, 176, 177, 178, 179, 186, 189, 195,
+	196, 197, 198, 199, 201, 202, 205, 206, 207, 208,
+	214, 217, 224, 225, 234, 241, 245, 164, 0, 0,
+	0, 0, 358, 0, 0, 0, 109, 0, 355, 0,
+	0, 0, 136, 0, 403, 138, 0, 0, 212, 152,
+	0, 0, 0, 0, 389, 395, 0, 0, 0, 0,
+	0, 0, 0, 0, 63, 0, 0, 356, 377, 978,
+	379, 380, 381, 382, 0, 0, 98, 378, 383, 384,
+	385, 0, 0, 0, 353, 370, 0, 402, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 367, 368, 349,
+	0, 0, 0, 417, 0, 369, 0, 0, 364, 365,
+	366, 371, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 117, 416, 0, 221, 0, 242, 0, 0, 414,
+	0, 183, 0, 216, 121, 135, 94, 80, 90, 0,
+	119, 161, 190, 194, 0, 0, 0, 120, 103, 0,
+	192, 171, 233, 0, 173, 191, 139, 223, 184, 232,
+	243, 244, 219, 240, 248, 209, 83, 218, 231, 99,
+	203, 204, 200, 0, 102, 85, 229, 215, 150, 130,
+	131, 84, 0, 188, 108, 115, 105, 163, 226, 227,
+	104, 250, 91, 239, 87, 92, 238, 157, 222, 230,
+	151, 144, 86, 228, 149, 143, 134, 112, 123, 181,
+	141, 182, 124, 154, 153, 155, 0, 0, 0, 213,
+	236, 251, 96, 0, 220, 246, 247, 0, 0, 97,
+	116, 111, 180, 156, 93, 126, 210, 133, 140, 187,
+	249, 170, 193, 100, 235, 211, 404, 415, 410, 411,
+	408, 409, 407, 406, 405, 418, 396, 397, 398, 399,
+	401, 0, 412, 413, 400, 79, 88, 137, 0, 185,
+	114, 237, 0, 0, 107, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 390, 391, 392, 393, 394, 81, 82, 89,
+	95, 101, 106, 110, 113, 118, 122, 125, 127, 128,
+	129, 132, 142, 145, 146, 147, 148, 158, 159, 160,
+	162, 165, 166, 167, 168, 169, 172, 174, 175, 176,
+	177, 178, 179, 186, 189, 195, 196, 197, 198, 199,
+	201, 202, 205, 206, 207, 208, 214, 217, 224, 225,
+	234, 241, 245, 164, 0, 0, 0, 0, 358, 0,
+	0, 0, 109, 0, 355, 0, 0, 0, 136, 0,
+	403, 138, 0, 0, 212, 152, 0, 0, 0, 0,
+	389, 395, 0, 0, 0, 0, 0, 0, 0, 0,
+	63, 0, 0, 356, 377, 376, 379, 380, 381, 382,
+	0, 0, 98, 378, 383, 384, 385, 0, 0, 0,
+	353, 370, 0, 402, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 367, 368, 0, 0, 0, 0, 417,
+	0, 369, 0, 0, 364, 365, 366, 371, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 117, 416, 0,
+	221, 0, 242, 0, 0, 414, 0, 183, 0, 216,
+	121, 135, 94, 80, 90, 0, 119, 161, 190, 194,
+	0, 0, 0, 120, 103, 0, 192, 171, 233, 0,
+	173, 191, 139, 223, 184, 232, 243, 244, 219, 240,
+	248, 209, 83, 218, 231, 99, 203, 204, 200, 0,
+	102, 85, 229, 215, 150, 130, 131, 84, 0, 188,
+	108, 115, 105, 163, 226, 227, 104, 250, 91, 239,
+	87, 92, 238, 157, 222, 230, 151, 144, 86, 228,
+	149, 143, 134, 112, 123, 181, 141, 182, 124, 154,
+	153, 155, 0, 0, 0, 213, 236, 251, 96, 0,
+	220, 246, 247, 0, 0, 97, 116, 111, 180, 156,
+	93, 126, 210, 133, 140, 187, 249, 170, 193, 100,
+	235, 211, 404, 415, 410, 411, 408, 409, 407, 406,
+	405, 418, 396, 397, 398, 399, 401, 0, 412, 413,
+	400, 79, 88, 137, 0, 185, 114, 237, 0, 0,
+	107, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 390, 391,
+	392, 393, 394, 81, 82, 89, 95, 101, 106, 110,
+	113, 118, 122, 125, 127, 128, 129, 132, 142, 145,
+	146, 147, 148, 158, 159, 160, 162, 165, 166, 167,
+	168, 169, 172, 174, 175, 176, 177, 178, 179, 186,
+	189, 195, 196, 197, 198, 199, 201, 202, 205, 206,
+	207, 208, 214, 217, 224, 225, 234, 241, 245, 164,
+	0, 0, 0, 0, 0, 0, 0, 0, 109, 0,
+	0, 0, 0, 0, 136, 0, 403, 138, 0, 0,
+	212, 152, 0, 0, 0, 0, 389, 395, 0, 0,
+	0, 0, 0, 0, 0, 0, 63, 0, 0, 356,
+	377, 376, 379, 380, 381, 382, 0, 0, 98, 378,
+	383, 384, 385, 0, 0, 0, 0, 370, 0, 402,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 367,
+	368, 0, 0, 0, 0, 417, 0, 369, 0, 0,
+	364, 365, 366, 371, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 117, 416, 0, 221, 0, 242, 0,
+	0, 414, 0, 183, 0, 216, 121, 135, 94, 80,
+	90, 0, 119, 161, 190, 194, 0, 0, 0, 120,
+	103, 0, 192, 171, 233, 1638, 173, 191, 139, 223,
+	184, 232, 243, 244, 219, 240, 248, 209, 83, 218,
+	231, 99, 203, 204, 200, 0, 102, 85, 229, 215,
+	150, 130, 131, 84, 0, 188, 108, 115, 105, 163,
+	226, 227, 104, 250, 91, 239, 87, 92, 238, 157,
+	222, 230, 151, 144, 86, 228, 149, 143, 134, 112,
+	123, 181, 141, 182, 124, 154, 153, 155, 0, 0,
+	0, 213, 236, 251, 96, 0, 220, 246, 247, 0,
+	0, 97, 116, 111, 180, 156, 93, 126, 210, 133,
+	140, 187, 249, 170, 193, 100, 235, 211, 404, 415,
+	410, 411, 408, 409, 407, 406, 405, 418, 396, 397,
+	398, 399, 401, 0, 412, 413, 400, 79, 88, 137,
+	0, 185, 114, 237, 0, 0, 107, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 390, 391, 392, 393, 394, 81,
+	82, 89, 95, 101, 106, 110, 113, 118, 122, 125,
+	127, 128, 129, 132, 142, 145, 146, 147, 148, 158,
+	159, 160, 162, 165, 166, 167, 168, 169, 172, 174,
+	175, 176, 177, 178, 179, 186, 189, 195, 196, 197,
+	198, 199, 201, 202, 205, 206, 207, 208, 214, 217,
+	224, 225, 234, 241, 245, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 109, 0, 0, 0, 0, 0,
+	136, 0, 403, 138, 0, 0, 212, 152, 0, 0,
+	0, 0, 389, 395, 0, 0, 0, 0, 0, 0,
+	0, 0, 63, 0, 638, 356, 377, 376, 379, 380,
+	381, 382, 0, 0, 98, 378, 383, 384, 385, 0,
+	0, 0, 0, 370, 0, 402, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 367, 368, 0, 0, 0,
+	0, 417, 0, 369, 0, 0, 364, 365, 366, 371,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 117,
+	416, 0, 221, 0, 242, 0, 0, 414, 0, 183,
+	0, 216, 121, 135, 94, 80, 90, 0, 119, 161,
+	190, 194, 0, 0, 0, 120, 103, 0, 192, 171,
+	233, 0, 173, 191, 139, 223, 184, 232, 243, 244,
+	219, 240, 248, 209, 83, 218, 231, 99, 203, 204,
+	200, 0, 102, 85, 229, 215, 150, 130, 131, 84,
+	0, 188, 108, 115, 105, 163, 226, 227, 104, 250,
+	91, 239, 87, 92, 238, 157, 222, 230, 151, 144,
+	86, 228, 149, 143, 134, 112, 123, 181, 141, 182,
+	124, 154, 153, 155, 0, 0, 0, 213, 236, 251,
+	96, 0, 220, 246, 247, 0, 0, 97, 116, 111,
+	180, 156, 93, 126, 210, 133, 140, 187, 249, 170,
+	193, 100, 235, 211, 404, 415, 410, 411, 408, 409,
+	407, 406, 405, 418, 396, 397, 398, 399, 401, 0,
+	412, 413, 400, 79, 88, 137, 0, 185, 114, 237,
+	0, 0, 107, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	390, 391, 392, 393, 394, 81, 82, 89, 95, 101,
+	106, 110, 113, 118, 122, 125, 127, 128, 129, 132,
+	142, 145, 146, 147, 148, 158, 159, 160, 162, 165,
+	166, 167, 168, 169, 172, 174, 175, 176, 177, 178,
+	179, 186, 189, 195, 196, 197, 198, 199, 201, 202,
+	205, 206, 207, 208, 214, 217, 224, 225, 234, 241,
+	245, 164, 0, 0, 0, 0, 0, 0, 0, 0,
+	109, 0, 0, 0, 0, 0, 136, 0, 403, 138,
+	0, 0, 212, 152, 0, 0, 0, 0, 389, 395,
+	0, 0, 0, 0, 0, 0, 0, 0, 63, 0,
+	0, 356, 377, 376, 379, 380, 381, 382, 0, 0,
+	98, 378, 383, 384, 385, 0, 0, 0, 0, 370,
+	0, 402, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 367, 368, 0, 0, 0, 0, 417, 0, 369,
+	0, 0, 364, 365, 366, 371, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 117, 416, 0, 221, 0,
+	242, 0, 0, 414, 0, 183, 0, 216, 121, 135,
+	94, 80, 90, 0, 119, 161, 190, 194, 0, 0,
+	0, 120, 103, 0, 192, 171, 233, 0, 173, 191,
+	139, 223, 184, 232, 243, 244, 219, 240, 248, 209,
+	83, 218, 231, 99, 203, 204, 200, 0, 102, 85,
+	229, 215, 150, 130, 131, 84, 0, 188, 108, 115,
+	105, 163, 226, 227, 104, 250, 91, 239, 87, 92,
+	238, 157, 222, 230, 151, 144, 86, 228, 149, 143,
+	134, 112, 123, 181, 141, 182, 124, 154, 153, 155,
+	0, 0, 0, 213, 236, 251, 96, 0, 220, 246,
+	247, 0, 0, 97, 116, 111, 180, 156, 93, 126,
+	210, 133, 140, 187, 249, 170, 193, 100, 235, 211,
+	404, 415, 410, 411, 408, 409, 407, 406, 405, 418,
+	396, 397, 398, 399, 401, 0, 412, 413, 400, 79,
+	88, 137, 0, 185, 114, 237, 0, 0, 107, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 390, 391, 392, 393,
+	394, 81, 82, 89, 95, 101, 106, 110, 113, 118,
+	122, 125, 127, 128, 129, 132, 142, 145, 146, 147,
+	148, 158, 159, 160, 162, 165, 166, 167, 168, 169,
+	172, 174, 175, 176, 177, 178, 179, 186, 189, 195,
+	196, 197, 198, 199, 201, 202, 205, 206, 207, 208,
+	214, 217, 224, 225, 234, 241, 245, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 109, 0, 0, 0,
+	0, 0, 136, 0, 0, 138, 0, 0, 212, 152,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 270, 0, 0,
+	0, 0, 0, 0, 0, 0, 98, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 678, 677, 687, 688, 680, 681, 682,
+	683, 684, 685, 686, 679, 0, 0, 689, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 117, 0, 0, 221, 0, 242, 0, 0, 0,
+	0, 183, 0, 216, 121, 135, 94, 80, 90, 0,
+	119, 161, 190, 194, 0, 0, 0, 120, 103, 0,
+	192, 171, 233, 0, 173, 191, 139, 223, 184, 232,
+	243, 244, 219, 240, 248, 209, 83, 218, 231, 99,
+	203, 204, 200, 0, 102, 85, 229, 215, 150, 130,
+	131, 84, 0, 188, 108, 115, 105, 163, 226, 227,
+	104, 250, 91, 239, 87, 92, 238, 157, 222, 230,
+	151, 144, 86, 228, 149, 143, 134, 112, 123, 181,
+	141, 182, 124, 154, 153, 155, 0, 0, 0, 213,
+	236, 251, 96, 0, 220, 246, 247, 0, 0, 97,
+	116, 111, 180, 156, 93, 126, 210, 133, 140, 187,
+	249, 170, 193, 100, 235, 211, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 79, 88, 137, 0, 185,
+	114, 237, 0, 0, 107, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 81, 82, 89,
+	95, 101, 106, 110, 113, 118, 122, 125, 127, 128,
+	129, 132, 142, 145, 146, 147, 148, 158, 159, 160,
+	162, 165, 166, 167, 168, 169, 172, 174, 175, 176,
+	177, 178, 179, 186, 189, 195, 196, 197, 198, 199,
+	201, 202, 205, 206, 207, 208, 214, 217, 224, 225,
+	234, 241, 245, 164, 0, 0, 0, 666, 0, 0,
+	0, 0, 109, 0, 0, 0, 0, 0, 136, 0,
+	0, 138, 0, 0, 212, 152, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 270, 0, 668, 0, 0, 0, 0,
+	0, 0, 98, 0, 0, 0, 0, 0, 663, 662,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 664, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 117, 0, 0,
+	221, 0, 242, 0, 0, 0, 0, 183, 0, 216,
+	121, 135, 94, 80, 90, 0, 119, 161, 190, 194,
+	0, 0, 0, 120, 103, 0, 192, 171, 233, 0,
+	173, 191, 139, 223, 184, 232, 243, 244, 219, 240,
+	248, 209, 83, 218, 231, 99, 203, 204, 200, 0,
+	102, 85, 229, 215, 150, 130, 131, 84, 0, 188,
+	108, 115, 105, 163, 226, 227, 104, 250, 91, 239,
+	87, 92, 238, 157, 222, 230, 151, 144, 86, 228,
+	149, 143, 134, 112, 123, 181, 141, 182, 124, 154,
+	153, 155, 0, 0, 0, 213, 236, 251, 96, 0,
+	220, 246, 247, 0, 0, 97, 116, 111, 180, 156,
+	93, 126, 210, 133, 140, 187, 249, 170, 193, 100,
+	235, 211, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 79, 88, 137, 0, 185, 114, 237, 0, 0,
+	107, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 81, 82, 89, 95, 101, 106, 110,
+	113, 118, 122, 125, 127, 128, 129, 132, 142, 145,
+	146, 147, 148, 158, 159, 160, 162, 165, 166, 167,
+	168, 169, 172, 174, 175, 176, 177, 178, 179, 186,
+	189, 195, 196, 197, 198, 199, 201, 202, 205, 206,
+	207, 208, 214, 217, 224, 225, 234, 241, 245, 164,
+	0, 0, 0, 0, 0, 0, 0, 0, 109, 0,
+	0, 0, 0, 0, 136, 0, 0, 138, 0, 0,
+	212, 152, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 270,
+	0, 0, 0, 0, 0, 0, 0, 0, 98, 0,
+	0, 0, 0, 0, 263, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 117, 265, 0, 269, 0, 262, 0,
+	0, 0, 267, 183, 0, 216, 121, 135, 94, 80,
+	90, 0, 119, 161, 190, 194, 0, 0, 0, 120,
+	103, 0, 192, 171, 233, 0, 173, 191, 139, 223,
+	184, 232, 243, 244, 219, 240, 248, 209, 83, 218,
+	231, 99, 203, 204, 200, 0, 102, 85, 229, 215,
+	150, 130, 131, 84, 0, 188, 108, 115, 105, 163,
+	226, 227, 104, 250, 91, 239, 87, 92, 238, 157,
+	222, 230, 151, 144, 86, 228, 149, 143, 134, 112,
+	123, 181, 141, 182, 124, 154, 153, 155, 0, 0,
+	0, 213, 236, 251, 96, 0, 220, 246, 247, 0,
+	0, 97, 116, 111, 180, 156, 93, 126, 210, 133,
+	140, 187, 249, 170, 193, 100, 235, 211, 0, 264,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 79, 88, 137,
+	0, 185, 114, 237, 0, 0, 107, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 81,
+	82, 89, 95, 101, 106, 110, 113, 118, 122, 125,
+	127, 128, 129, 132, 142, 145, 146, 147, 148, 158,
+	159, 160, 162, 165, 166, 167, 168, 169, 172, 174,
+	175, 176, 177, 178, 179, 186, 189, 195, 196, 197,
+	198, 199, 201, 202, 205, 206, 207, 208, 214, 217,
+	224, 225, 234, 241, 245, 29, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 109, 0, 0, 0,
+	0, 0, 136, 0, 0, 138, 0, 0, 212, 152,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 63, 0, 0, 77, 0, 0,
+	0, 0, 0, 0, 0, 0, 98, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 117, 0, 0, 221, 0, 242, 0, 0, 0,
+	0, 183, 0, 216, 121, 135, 94, 80, 90, 0,
+	119, 161, 190, 194, 0, 0, 0, 120, 103, 0,
+	192, 171, 233, 0, 173, 191, 139, 223, 184, 232,
+	243, 244, 219, 240, 248, 209, 83, 218, 231, 99,
+	203, 204, 200, 0, 102, 85, 229, 215, 150, 130,
+	131, 84, 0, 188, 108, 115, 105, 163, 226, 227,
+	104, 250, 91, 239, 87, 92, 238, 157, 222, 230,
+	151, 144, 86, 228, 149, 143, 134, 112, 123, 181,
+	141, 182, 124, 154, 153, 155, 0, 0, 0, 213,
+	236, 251, 96, 0, 220, 246, 247, 0, 0, 97,
+	116, 111, 180, 156, 93, 126, 210, 133, 140, 187,
+	249, 170, 193, 100, 235, 211, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 79, 88, 137, 30, 185,
+	114, 237, 0, 0, 107, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 81, 82, 89,
+	95, 101, 106, 110, 113, 118, 122, 125, 127, 128,
+	129, 132, 142, 145, 146, 147, 148, 158, 159, 160,
+	162, 165, 166, 167, 168, 169, 172, 174, 175, 176,
+	177, 178, 179, 186, 189, 195, 196, 197, 198, 199,
+	201, 202, 205, 206, 207, 208, 214, 217, 224, 225,
+	234, 241, 245, 164, 0, 0, 0, 1023, 0, 0,
+	0, 0, 109, 0, 0, 0, 0, 0, 136, 0,
+	0, 138, 0, 0, 212, 152, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 77, 0, 1025, 0, 0, 0, 0,
+	0, 0, 98, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 117, 0, 0,
+	221, 0, 242, 0, 0, 0, 0, 183, 0, 216,
+	121, 135, 94, 80, 90, 0, 119, 161, 190, 194,
+	0, 0, 0, 120, 103, 0, 192, 171, 233, 0,
+	173, 191, 139, 223, 184, 232, 243, 244, 219, 240,
+	248, 209, 83, 218, 231, 99, 203, 204, 200, 0,
+	102, 85, 229, 215, 150, 130, 131, 84, 0, 188,
+	108, 115, 105, 163, 226, 227, 104, 250, 91, 239,
+	87, 92, 238, 157, 222, 230, 151, 144, 86, 228,
+	149, 143, 134, 112, 123, 181, 141, 182, 124, 154,
+	153, 155, 0, 0, 0, 213, 236, 251, 96, 0,
+	220, 246, 247, 0, 0, 97, 116, 111, 180, 156,
+	93, 126, 210, 133, 140, 187, 249, 170, 193, 100,
+	235, 211, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 79, 88, 137, 0, 185, 114, 237, 0, 0,
+	107, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 81, 82, 89, 95, 101, 106, 110,
+	113, 118, 122, 125, 127, 128, 129, 132, 142, 145,
+	146, 147, 148, 158, 159, 160, 162, 165, 166, 167,
+	168, 169, 172, 174, 175, 176, 177, 178, 179, 186,
+	189, 195, 196, 197, 198, 199, 201, 202, 205, 206,
+	207, 208, 214, 217, 224, 225, 234, 241, 245, 29,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 164, 0, 0, 0, 0, 0, 0, 0, 0,
+	109, 0, 0, 0, 0, 0, 136, 0, 0, 138,
+	0, 0, 212, 152, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 63, 0,
+	0, 270, 0, 0, 0, 0, 0, 0, 0, 0,
+	98, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 117, 0, 0, 221, 0,
+	242, 0, 0, 0, 0, 183, 0, 216, 121, 135,
+	94, 80, 90, 0, 119, 161, 190, 194, 0, 0,
+	0, 120, 103, 0, 192, 171, 233, 0, 173, 191,
+	139, 223, 184, 232, 243, 244, 219, 240, 248, 209,
+	83, 218, 231, 99, 203, 204, 200, 0, 102, 85,
+	229, 215, 150, 130, 131, 84, 0, 188, 108, 115,
+	105, 163, 226, 227, 104, 250, 91, 239, 87, 92,
+	238, 157, 222, 230, 151, 144, 86, 228, 149, 143,
+	134, 112, 123, 181, 141, 182, 124, 154, 153, 155,
+	0, 0, 0, 213, 236, 251, 96, 0, 220, 246,
+	247, 0, 0, 97, 116, 111, 180, 156, 93, 126,
+	210, 133, 140, 187, 249, 170, 193, 100, 235, 211,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 79,
+	88, 137, 0, 185, 114, 237, 0, 0, 107, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 81, 82, 89, 95, 101, 106, 110, 113, 118,
+	122, 125, 127, 128, 129, 132, 142, 145, 146, 147,
+	148, 158, 159, 160, 162, 165, 166, 167, 168, 169,
+	172, 174, 175, 176, 177, 178, 179, 186, 189, 195,
+	196, 197, 198, 199, 201, 202, 205, 206, 207, 208,
+	214, 217, 224, 225, 234, 241, 245, 164, 0, 0,
+	0, 1023, 0, 0, 0, 0, 109, 0, 0, 0,
+	0, 0, 136, 0, 0, 138, 0, 0, 212, 152,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 77, 0, 1025,
+	0, 0, 0, 0, 0, 0, 98, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 117, 0, 0, 221, 0, 242, 0, 0, 0,
+	0, 183, 0, 216, 121, 135, 94, 80, 90, 0,
+	119, 161, 190, 194, 0, 0, 0, 120, 103, 0,
+	192, 171, 233, 0, 1021, 191, 139, 223, 184, 232,
+	243, 244, 219, 240, 248, 209, 83, 218, 231, 99,
+	203, 204, 200, 0, 102, 85, 229, 215, 150, 130,
+	131, 84, 0, 188, 108, 115, 105, 163, 226, 227,
+	104, 250, 91, 239, 87, 92, 238, 157, 222, 230,
+	151, 144, 86, 228, 149, 143, 134, 112, 123, 181,
+	141, 182, 124, 154, 153, 155, 0, 0, 0, 213,
+	236, 251, 96, 0, 220, 246, 247, 0, 0, 97,
+	116, 111, 180, 156, 93, 126, 210, 133, 140, 187,
+	249, 170, 193, 100, 235, 211, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 79, 88, 137, 0, 185,
+	114, 237, 0, 0, 107, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 81, 82, 89,
+	95, 101, 106, 110, 113, 118, 122, 125, 127, 128,
+	129, 132, 142, 145, 146, 147, 148, 158, 159, 160,
+	162, 165, 166, 167, 168, 169, 172, 174, 175, 176,
+	177, 178, 179, 186, 189, 195, 196, 197, 198, 199,
+	201, 202, 205, 206, 207, 208, 214, 217, 224, 225,
+	234, 241, 245, 164, 0, 0, 0, 0, 0, 0,
+	0, 0, 109, 0, 0, 0, 0, 0, 136, 0,
+	0, 138, 0, 0, 212, 152, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 270, 0, 0, 907, 0, 0, 908,
+	0, 0, 98, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 117, 0, 0,
+	221, 0, 242, 0, 0, 0, 0, 183, 0, 216,
+	121, 135, 94, 80, 90, 0, 119, 161, 190, 194,
+	0, 0, 0, 120, 103, 0, 192, 171, 233, 0,
+	173, 191, 139, 223, 184, 232, 243, 244, 219, 240,
+	248, 209, 83, 218, 231, 99, 203, 204, 200, 0,
+	102, 85, 229, 215, 150, 130, 131, 84, 0, 188,
+	108, 115, 105, 163, 226, 227, 104, 250, 91, 239,
+	87, 92, 238, 157, 222, 230, 151, 144, 86, 228,
+	149, 143, 134, 112, 123, 181, 141, 182, 124, 154,
+	153, 155, 0, 0, 0, 213, 236, 251, 96, 0,
+	220, 246, 247, 0, 0, 97, 116, 111, 180, 156,
+	93, 126, 210, 133, 140, 187, 249, 170, 193, 100,
+	235, 211, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 79, 88, 137, 0, 185, 114, 237, 0, 0,
+	107, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 81, 82, 89, 95, 101, 106, 110,
+	113, 118, 122, 125, 127, 128, 129, 132, 142, 145,
+	146, 147, 148, 158, 159, 160, 162, 165, 166, 167,
+	168, 169, 172, 174, 175, 176, 177, 178, 179, 186,
+	189, 195, 196, 197, 198, 199, 201, 202, 205, 206,
+	207, 208, 214, 217, 224, 225, 234, 241, 245, 164,
+	0, 0, 0, 0, 0, 0, 0, 0, 109, 0,
+	786, 0, 0, 0, 136, 0, 0, 138, 0, 0,
+	212, 152, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 270,
+	0, 785, 0, 0, 0, 0, 0, 0, 98, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 117, 0, 0, 221, 0, 242, 0,
+	0, 0, 0, 183, 0, 216, 121, 135, 94, 80,
+	90, 0, 119, 161, 190, 194, 0, 0, 0, 120,
+	103, 0, 192, 171, 233, 0, 173, 191, 139, 223,
+	184, 232, 243, 244, 219, 240, 248, 209, 83, 218,
+	231, 99, 203, 204, 200, 0, 102, 85, 229, 215,
+	150, 130, 131, 84, 0, 188, 108, 115, 105, 163,
+	226, 227, 104, 250, 91, 239, 87, 92, 238, 157,
+	222, 230, 151, 144, 86, 228, 149, 143, 134, 112,
+	123, 181, 141, 182, 124, 154, 153, 155, 0, 0,
+	0, 213, 236, 251, 96, 0, 220, 246, 247, 0,
+	0, 97, 116, 111, 180, 156, 93, 126, 210, 133,
+	140, 187, 249, 170, 193, 100, 235, 211, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 79, 88, 137,
+	0, 185, 114, 237, 0, 0, 107, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 81,
+	82, 89, 95, 101, 106, 110, 113, 118, 122, 125,
+	127, 128, 129, 132, 142, 145, 146, 147, 148, 158,
+	159, 160, 162, 165, 166, 167, 168, 169, 172, 174,
+	175, 176, 177, 178, 179, 186, 189, 195, 196, 197,
+	198, 199, 201, 202, 205, 206, 207, 208, 214, 217,
+	224, 225, 234, 241, 245, 164, 321, 0, 0, 0,
+	0, 0, 0, 0, 109, 0, 0, 0, 0, 0,
+	136, 0, 0, 138, 0, 0, 212, 152, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 77, 0, 0, 0, 0,
+	0, 0, 0, 0, 98, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 320, 0, 117,
+	0, 0, 221, 0, 242, 0, 0, 0, 0, 183,
+	0, 216, 121, 135, 94, 80, 90, 0, 119, 161,
+	190, 194, 0, 0, 0, 322, 103, 0, 192, 171,
+	233, 0, 173, 191, 139, 223, 184, 232, 243, 244,
+	219, 240, 248, 209, 83, 218, 231, 99, 203, 204,
+	200, 0, 102, 85, 229, 215, 150, 130, 131, 84,
+	0, 188, 108, 115, 105, 163, 226, 227, 104, 250,
+	91, 239, 87, 92, 238, 157, 222, 230, 151, 144,
+	86, 228, 149, 143, 134, 112, 123, 181, 141, 182,
+	124, 154, 153, 155, 0, 0, 0, 213, 236, 251,
+	96, 0, 220, 246, 247, 0, 0, 97, 116, 111,
+	180, 156, 93, 126, 210, 133, 140, 187, 249, 170,
+	193, 100, 235, 211, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 79, 88, 137, 0, 185, 114, 237,
+	0, 0, 107, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 81, 82, 89, 95, 101,
+	106, 110, 113, 118, 122, 125, 127, 128, 129, 132,
+	142, 145, 146, 147, 148, 158, 159, 160, 162, 165,
+	166, 167, 168, 169, 172, 174, 175, 176, 177, 178,
+	179, 186, 189, 195, 196, 197, 198, 199, 201, 202,
+	205, 206, 207, 208, 214, 217, 224, 225, 234, 241,
+	245, 164, 0, 0, 0, 0, 0, 0, 0, 0,
+	109, 0, 0, 0, 0, 0, 136, 0, 0, 138,
+	0, 0, 212, 152, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	638, 270, 0, 0, 0, 0, 0, 0, 0, 0,
+	98, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 117, 0, 0, 221, 0,
+	242, 0, 0, 0, 0, 183, 0, 216, 121, 135,
+	94, 80, 90, 0, 119, 161, 190, 194, 0, 0,
+	0, 120, 103, 0, 192, 171, 233, 0, 173, 191,
+	139, 223, 184, 232, 243, 244, 219, 240, 248, 209,
+	83, 218, 231, 99, 203, 204, 200, 0, 102, 85,
+	229, 215, 150, 130, 131, 84, 0, 188, 108, 115,
+	105, 163, 226, 227, 104, 250, 91, 239, 87, 92,
+	238, 157, 222, 230, 151, 144, 86, 228, 149, 143,
+	134, 112, 123, 181, 141, 182, 124, 154, 153, 155,
+	0, 0, 0, 213, 236, 251, 96, 0, 220, 246,
+	247, 0, 0, 97, 116, 111, 180, 156, 93, 126,
+	210, 133, 140, 187, 249, 170, 193, 100, 235, 211,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 79,
+	88, 137, 0, 185, 114, 237, 0, 0, 107, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 81, 82, 89, 95, 101, 106, 110, 113, 118,
+	122, 125, 127, 128, 129, 132, 142, 145, 146, 147,
+	148, 158, 159, 160, 162, 165, 166, 167, 168, 169,
+	172, 174, 175, 176, 177, 178, 179, 186, 189, 195,
+	196, 197, 198, 199, 201, 202, 205, 206, 207, 208,
+	214, 217, 224, 225, 234, 241, 245, 164, 0, 0,
+	0, 0, 0, 0, 0, 0, 109, 0, 0, 0,
+	0, 0, 136, 0, 0, 138, 0, 0, 212, 152,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 63, 0, 0, 77, 0, 0,
+	0, 0, 0, 0, 0, 0, 98, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 117, 0, 0, 221, 0, 242, 0, 0, 0,
+	0, 183, 0, 216, 121, 135, 94, 80, 90, 0,
+	119, 161, 190, 194, 0, 0, 0, 120, 103, 0,
+	192, 171, 233, 0, 173, 191, 139, 223, 184, 232,
+	243, 244, 219, 240, 248, 209, 83, 218, 231, 99,
+	203, 204, 200, 0, 102, 85, 229, 215, 150, 130,
+	131, 84, 0, 188, 108, 115, 105, 163, 226, 227,
+	104, 250, 91, 239, 87, 92, 238, 157, 222, 230,
+	151, 144, 86, 228, 149, 143, 134, 112, 123, 181,
+	141, 182, 124, 154, 153, 155, 0, 0, 0, 213,
+	236, 251, 96, 0, 220, 246, 247, 0, 0, 97,
+	116, 111, 180, 156, 93, 126, 210, 133, 140, 187,
+	249, 170, 193, 100, 235, 211, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 79, 88, 137, 0, 185,
+	114, 237, 0, 0, 107, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 81, 82, 89,
+	95, 101, 106, 110, 113, 118, 122, 125, 127, 128,
+	129, 132, 142, 145, 146, 147, 148, 158, 159, 160,
+	162, 165, 166, 167, 168, 169, 172, 174, 175, 176,
+	177, 178, 179, 186, 189, 195, 196, 197, 198, 199,
+	201, 202, 205, 206, 207, 208, 214, 217, 224, 225,
+	234, 241, 245, 164, 0, 0, 0, 0, 0, 0,
+	0, 0, 109, 0, 0, 0, 0, 0, 136, 0,
+	0, 138, 0, 0, 212, 152, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	63, 0, 0, 270, 0, 0, 0, 0, 0, 0,
+	0, 0, 98, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 117, 0, 0,
+	221, 0, 242, 0, 0, 0, 0, 183, 0, 216,
+	121, 135, 94, 80, 90, 0, 119, 161, 190, 194,
+	0, 0, 0, 120, 103, 0, 192, 171, 233, 0,
+	173, 191, 139, 223, 184, 232, 243, 244, 219, 240,
+	248, 209, 83, 218, 231, 99, 203, 204, 200, 0,
+	102, 85, 229, 215, 150, 130, 131, 84, 0, 188,
+	108, 115, 105, 163, 226, 227, 104, 250, 91, 239,
+	87, 92, 238, 157, 222, 230, 151, 144, 86, 228,
+	149, 143, 134, 112, 123, 181, 141, 182, 124, 154,
+	153, 155, 0, 0, 0, 213, 236, 251, 96, 0,
+	220, 246, 247, 0, 0, 97, 116, 111, 180, 156,
+	93, 126, 210, 133, 140, 187, 249, 170, 193, 100,
+	235, 211, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 79, 88, 137, 0, 185, 114, 237, 0, 0,
+	107, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 81, 82, 89, 95, 101, 106, 110,
+	113, 118, 122, 125, 127, 128, 129, 132, 142, 145,
+	146, 147, 148, 158, 159, 160, 162, 165, 166, 167,
+	168, 169, 172, 174, 175, 176, 177, 178, 179, 186,
+	189, 195, 196, 197, 198, 199, 201, 202, 205, 206,
+	207, 208, 214, 217, 224, 225, 234, 241, 245, 164,
+	0, 0, 0, 0, 0, 0, 0, 0, 109, 0,
+	0, 0, 0, 0, 136, 0, 0, 138, 0, 0,
+	212, 152, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 77,
+	0, 1025, 0, 0, 0, 0, 0, 0, 98, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 117, 0, 0, 221, 0, 242, 0,
+	0, 0, 0, 183, 0, 216, 121, 135, 94, 80,
+	90, 0, 119, 161, 190, 194, 0, 0, 0, 120,
+	103, 0, 192, 171, 233, 0, 173, 191, 139, 223,
+	184, 232, 243, 244, 219, 240, 248, 209, 83, 218,
+	231, 99, 203, 204, 200, 0, 102, 85, 229, 215,
+	150, 130, 131, 84, 0, 188, 108, 115, 105, 163,
+	226, 227, 104, 250, 91, 239, 87, 92, 238, 157,
+	222, 230, 151, 144, 86, 228, 149, 143, 134, 112,
+	123, 181, 141, 182, 124, 154, 153, 155, 0, 0,
+	0, 213, 236, 251, 96, 0, 220, 246, 247, 0,
+	0, 97, 116, 111, 180, 156, 93, 126, 210, 133,
+	140, 187, 249, 170, 193, 100, 235, 211, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 79, 88, 137,
+	0, 185, 114, 237, 0, 0, 107, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 81,
+	82, 89, 95, 101, 106, 110, 113, 118, 122, 125,
+	127, 128, 129, 132, 142, 145, 146, 147, 148, 158,
+	159, 160, 162, 165, 166, 167, 168, 169, 172, 174,
+	175, 176, 177, 178, 179, 186, 189, 195, 196, 197,
+	198, 199, 201, 202, 205, 206, 207, 208, 214, 217,
+	224, 225, 234, 241, 245, 164, 0, 0, 0, 0,
+	0, 0, 0, 0, 109, 0, 0, 0, 0, 0,
+	136, 0, 0, 138, 0, 0, 212, 152, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 270, 0, 668, 0, 0,
+	0, 0, 0, 0, 98, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 117,
+	0, 0, 221, 0, 242, 0, 0, 0, 0, 183,
+	0, 216, 121, 135, 94, 80, 90, 0, 119, 161,
+	190, 194, 0, 0, 0, 120, 103, 0, 192, 171,
+	233, 0, 173, 191, 139, 223, 184, 232, 243, 244,
+	219, 240, 248, 209, 83, 218, 231, 99, 203, 204,
+	200, 0, 102, 85, 229, 215, 150, 130, 131, 84,
+	0, 188, 108, 115, 105, 163, 226, 227, 104, 250,
+	91, 239, 87, 92, 238, 157, 222, 230, 151, 144,
+	86, 228, 149, 143, 134, 112, 123, 181, 141, 182,
+	124, 154, 153, 155, 0, 0, 0, 213, 236, 251,
+	96, 0, 220, 246, 247, 0, 0, 97, 116, 111,
+	180, 156, 93, 126, 210, 133, 140, 187, 249, 170,
+	193, 100, 235, 211, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 79, 88, 137, 0, 185, 114, 237,
+	0, 0, 107, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 81, 82, 89, 95, 101,
+	106, 110, 113, 118, 122, 125, 127, 128, 129, 132,
+	142, 145, 146, 147, 148, 158, 159, 160, 162, 165,
+	166, 167, 168, 169, 172, 174, 175, 176, 177, 178,
+	179, 186, 189, 195, 196, 197, 198, 199, 201, 202,
+	205, 206, 207, 208, 214, 217, 224, 225, 234, 241,
+	245, 164, 0, 0, 0, 0, 0, 0, 0, 756,
+	109, 0, 0, 0, 0, 0, 136, 0, 0, 138,
+	0, 0, 212, 152, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 77, 0, 0, 0, 0, 0, 0, 0, 0,
+	98, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 117, 0, 0, 221, 0,
+	242, 0, 0, 0, 0, 183, 0, 216, 121, 135,
+	94, 80, 90, 0, 119, 161, 190, 194, 0, 0,
+	0, 120, 103, 0, 192, 171, 233, 0, 173, 191,
+	139, 223, 184, 232, 243, 244, 219, 240, 248, 209,
+	83, 218, 231, 99, 203, 204, 200, 0, 102, 85,
+	229, 215, 150, 130, 131, 84, 0, 188, 108, 115,
+	105, 163, 226, 227, 104, 250, 91, 239, 87, 92,
+	238, 157, 222, 230, 151, 144, 86, 228, 149, 143,
+	134, 112, 123, 181, 141, 182, 124, 154, 153, 155,
+	0, 0, 0, 213, 236, 251, 96, 0, 220, 246,
+	247, 0, 0, 97, 116, 111, 180, 156, 93, 126,
+	210, 133, 140, 187, 249, 170, 193, 100, 235, 211,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 79,
+	88, 137, 0, 185, 114, 237, 0, 0, 107, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 81, 82, 89, 95, 101, 106, 110, 113, 118,
+	122, 125, 127, 128, 129, 132, 142, 145, 146, 147,
+	148, 158, 159, 160, 162, 165, 166, 167, 168, 169,
+	172, 174, 175, 176, 177, 178, 179, 186, 189, 195,
+	196, 197, 198, 199, 201, 202, 205, 206, 207, 208,
+	214, 217, 224, 225, 234, 241, 245, 421, 0, 0,
+	0, 0, 0, 0, 164, 0, 0, 0, 0, 0,
+	0, 0, 0, 109, 0, 0, 0, 0, 0, 136,
+	0, 0, 138, 0, 0, 212, 152, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 77, 0, 0, 0, 0, 0,
+	0, 0, 0, 98, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 117, 0,
+	0, 221, 0, 242, 0, 0, 0, 0, 183, 0,
+	216, 121, 135, 94, 80, 90, 0, 119, 161, 190,
+	194, 0, 0, 0, 120, 103, 0, 192, 171, 233,
+	0, 173, 191, 139, 223, 184, 232, 243, 244, 219,
+	240, 248, 209, 83, 218, 231, 99, 203, 204, 200,
+	0, 102, 85, 229, 215, 150, 130, 131, 84, 0,
+	188, 108, 115, 105, 163, 226, 227, 104, 250, 91,
+	239, 87, 92, 238, 157, 222, 230, 151, 144, 86,
+	228, 149, 143, 134, 112, 123, 181, 141, 182, 124,
+	154, 153, 155, 0, 0, 0, 213, 236, 251, 96,
+	0, 220, 246, 247, 0, 0, 97, 116, 111, 180,
+	156, 93, 126, 210, 133, 140, 187, 249, 170, 193,
+	100, 235, 211, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 79, 88, 137, 0, 185, 114, 237, 0,
+	0, 107, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 81, 82, 89, 95, 101, 106,
+	110, 113, 118, 122, 125, 127, 128, 129, 132, 142,
+	145, 146, 147, 148, 158, 159, 160, 162, 165, 166,
+	167, 168, 169, 172, 174, 175, 176, 177, 178, 179,
+	186, 189, 195, 196, 197, 198, 199, 201, 202, 205,
+	206, 207, 208, 214, 217, 224, 225, 234, 241, 245,
+	164, 0, 0, 0, 0, 0, 0, 0, 0, 109,
+	0, 0, 0, 0, 0, 136, 0, 0, 138, 0,
+	0, 212, 152, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	77, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 117, 0, 285, 221, 0, 242,
+	0, 0, 0, 0, 183, 0, 216, 121, 135, 94,
+	80, 90, 0, 119, 161, 190, 194, 0, 0, 0,
+	120, 103, 0, 192, 171, 233, 0, 173, 191, 139,
+	223, 184, 232, 243, 244, 219, 240, 248, 209, 83,
+	218, 231, 99, 203, 204, 200, 0, 102, 85, 229,
+	215, 150, 130, 131, 84, 0, 188, 108, 115, 105,
+	163, 226, 227, 104, 250, 91, 239, 87, 92, 238,
+	157, 222, 230, 151, 144, 86, 228, 149, 143, 134,
+	112, 123, 181, 141, 182, 124, 154, 153, 155, 0,
+	0, 0, 213, 236, 251, 96, 0, 220, 246, 247,
+	0, 0, 97, 116, 111, 180, 156, 93, 126, 210,
+	133, 140, 187, 249, 170, 193, 100, 235, 211, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 79, 88,
+	137, 0, 185, 114, 237, 0, 0, 107, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	81, 82, 89, 95, 101, 106, 110, 113, 118, 122,
+	125, 127, 128, 129, 132, 142, 145, 146, 147, 148,
+	158, 159, 160, 162, 165, 166, 167, 168, 169, 172,
+	174, 175, 176, 177, 178, 179, 186, 189, 195, 196,
+	197, 198, 199, 201, 202, 205, 206, 207, 208, 214,
+	217, 224, 225, 234, 241, 245, 164, 0, 0, 0,
+	0, 0, 0, 0, 0, 109, 0, 0, 0, 0,
+	0, 136, 0, 0, 138, 0, 0, 212, 152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 77, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	117, 0, 0, 221, 0, 242, 0, 0, 0, 0,
+	183, 0, 216, 121, 135, 94, 80, 90, 0, 119,
+	161, 190, 194, 0, 0, 0, 120, 103, 0, 192,
+	171, 233, 0, 173, 191, 139, 223, 184, 232, 243,
+	244, 219, 240, 248, 209, 83, 218, 231, 99, 203,
+	204, 200, 0, 102, 85, 229, 215, 150, 130, 131,
+	84, 0, 188, 108, 115, 105, 163, 226, 227, 104,
+	250, 91, 239, 87, 92, 238, 157, 222, 230, 151,
+	144, 86, 228, 149, 143, 134, 112, 123, 181, 141,
+	182, 124, 154, 153, 155, 0, 0, 0, 213, 236,
+	251, 96, 0, 220, 246, 247, 0, 0, 97, 116,
+	111, 180, 156, 93, 126, 210, 133, 140, 187, 249,
+	170, 193, 100, 235, 211, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 79, 88, 137, 0, 185, 114,
+	237, 0, 0, 107, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 74, 0, 0,
+	0, 0, 0, 0, 0, 0, 81, 82, 89, 95,
+	101, 106, 110, 113, 118, 122, 125, 127, 128, 129,
+	132, 142, 145, 146, 147, 148, 158, 159, 160, 162,
+	165, 166, 167, 168, 169, 172, 174, 175, 176, 177,
+	178, 179, 186, 189, 195, 196, 197, 198, 199, 201,
+	202, 205, 206, 207, 208, 214, 217, 224, 225, 234,
+	241, 245, 164, 0, 0, 0, 0, 0, 0, 0,
+	0, 109, 0, 0, 0, 0, 0, 136, 0, 0,
+	138, 0, 0, 212, 152, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 270, 0, 0, 0, 0, 0, 0, 0,
+	0, 98, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 117, 0, 0, 221,
+	0, 242, 0, 0, 0, 0, 183, 0, 216, 121,
+	135, 94, 80, 90, 0, 119, 161, 190, 194, 0,
+	0, 0, 120, 103, 0, 192, 171, 233, 0, 1434,
+	191, 139, 223, 184, 232, 243, 244, 219, 240, 248,
+	209, 83, 218, 231, 99, 203, 204, 200, 0, 102,
+	85, 229, 215, 150, 130, 131, 84, 0, 188, 108,
+	115, 105, 163, 226, 227, 104, 250, 91, 239, 87,
+	92, 238, 157, 222, 230, 151, 144, 86, 228, 149,
+	143, 134, 112, 123, 181, 141, 182, 124, 154, 153,
+	155, 0, 0, 0, 213, 236, 251, 96, 0, 220,
+	246, 247, 0, 0, 97, 116, 111, 180, 156, 93,
+	126, 210, 133, 140, 187, 249, 170, 193, 100, 235,
+	211, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	79, 88, 137, 0, 185, 114, 237, 0, 0, 107,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 81, 82, 89, 95, 101, 106, 110, 113,
+	118, 122, 125, 127, 128, 129, 132, 142, 145, 146,
+	147, 148, 158, 159, 160, 162, 165, 166, 167, 168,
+	169, 172, 174, 175, 176, 177, 178, 179, 186, 189,
+	195, 196, 197, 198, 199, 201, 202, 205, 206, 207,
+	208, 214, 217, 224, 225, 234, 241, 245, 164, 0,
+	0, 0, 0, 0, 0, 0, 0, 109, 0, 0,
+	0, 0, 0, 136, 0, 0, 138, 0, 0, 212,
+	152, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 270, 0,
+	0, 0, 0, 0, 0, 0, 0, 98, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 117, 0, 0, 221, 0, 242, 0, 0,
+	0, 0, 183, 0, 216, 121, 135, 94, 80, 90,
+	0, 119, 161, 190, 194, 0, 0, 0, 120, 103,
+	0, 192, 171, 233, 0, 173, 191, 139, 223, 184,
+	232, 243, 244, 219, 240, 248, 209, 83, 218, 231,
+	99, 203, 204, 200, 0, 102, 85, 229, 215, 150,
+	130, 131, 84, 0, 188, 108, 115, 105, 163, 226,
+	227, 104, 250, 91, 239, 87, 92, 238, 157, 222,
+	230, 151, 144, 86, 228, 149, 143, 134, 112, 123,
+	181, 141, 182, 124, 154, 153, 155, 0, 0, 0,
+	213, 236, 251, 96, 0, 220, 246, 247, 0, 0,
+	97, 116, 111, 180, 156, 93, 126, 210, 133, 140,
+	187, 249, 170, 193, 100, 235, 211, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 79, 88, 137, 0,
+	185, 114, 237, 0, 0, 107, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 81, 82,
+	89, 95, 101, 106, 110, 113, 118, 122, 125, 127,
+	128, 129, 132, 142, 145, 146, 147, 148, 158, 159,
+	160, 162, 165, 166, 167, 168, 169, 172, 174, 175,
+	176, 177, 178, 179, 186, 189, 195, 196, 197, 198,
+	199, 201, 202, 205, 206, 207, 208, 214, 217, 224,
+	225, 234, 241, 245, 164, 0, 0, 0, 0, 0,
+	0, 0, 0, 109, 0, 0, 0, 0, 0, 136,
+	0, 0, 138, 0, 0, 212, 152, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 77, 0, 0, 0, 0, 0,
+	0, 0, 0, 98, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 117, 0,
+	0, 221, 0, 242, 0, 0, 0, 0, 183, 0,
+	216, 121, 135, 94, 80, 90, 0, 119, 161, 190,
+	194, 0, 0, 0, 120, 103, 0, 192, 171, 233,
+	0, 173, 191, 139, 223, 184, 232, 243, 244, 219,
+	240, 248, 209, 83, 218, 231, 99, 203, 204, 200,
+	0, 102, 85, 229, 215, 150, 130, 131, 84, 0,
+	188, 108, 115, 105, 163, 226, 227, 104, 250, 91,
+	239, 87, 92, 238, 157, 222, 230, 151, 144, 86,
+	228, 149, 143, 134, 112, 123, 181, 141, 182, 124,
+	154, 153, 155, 0, 0, 0, 213, 236, 251, 96,
+	0, 220, 246, 247, 0, 0, 97, 116, 111, 180,
+	156, 93, 126, 210, 133, 140, 187, 249, 170, 193,
+	100, 235, 211, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 79, 88, 137, 0, 185, 114, 237, 0,
+	0, 107, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 81, 82, 89, 95, 101, 106,
+	110, 113, 118, 122, 125, 127, 128, 129, 132, 142,
+	145, 146, 147, 148, 158, 159, 160, 162, 165, 166,
+	167, 168, 169, 172, 174, 175, 176, 177, 178, 179,
+	186, 189, 195, 196, 197, 198, 199, 201, 202, 205,
+	206, 207, 208, 214, 217, 224, 225, 234, 241, 245,
+	164, 0, 0, 0, 0, 0, 0, 0, 0, 109,
+	0, 0, 0, 0, 0, 136, 0, 0, 138, 0,
+	0, 212, 152, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	356, 0, 0, 0, 0, 0, 0, 0, 0, 98,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 117, 0, 0, 221, 0, 242,
+	0, 0, 0, 0, 183, 0, 216, 121, 135, 94,
+	80, 90, 0, 119, 161, 190, 194, 0, 0, 0,
+	120, 103, 0, 192, 171, 233, 0, 173, 191, 139,
+	223, 184, 232, 243, 244, 219, 240, 248, 209, 83,
+	218, 231, 99, 203, 204, 200, 0, 102, 85, 229,
+	215, 150, 130, 131, 84, 0, 188, 108, 115, 105,
+	163, 226, 227, 104, 250, 91, 239, 87, 92, 238,
+	157, 222, 230, 151, 144, 86, 228, 149, 143, 134,
+	112, 123, 181, 141, 182, 124, 154, 153, 155, 0,
+	0, 0, 213, 236, 251, 96, 0, 220, 246, 247,
+	0, 0, 97, 116, 111, 180, 156, 93, 126, 210,
+	133, 140, 187, 249, 170, 193, 100, 235, 211, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 79, 88,
+	137, 0, 185, 114, 237, 0, 0, 107, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	81, 82, 89, 95, 101, 106, 110, 113, 118, 122,
+	125, 127, 128, 129, 132, 142, 145, 146, 147, 148,
+	158, 159, 160, 162, 165, 166, 167, 168, 169, 172,
+	174, 175, 176, 177, 178, 179, 186, 189, 195, 196,
+	197, 198, 199, 201, 202, 205, 206, 207, 208, 214,
+	217, 224, 225, 234, 241, 245, 164, 0, 0, 0,
+	0, 0, 0, 0, 0, 109, 0, 0, 0, 0,
+	0, 136, 0, 0, 138, 0, 0, 212, 152, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 270, 0, 0, 0,
+	0, 0, 0, 0, 0, 98, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	117, 0, 0, 221, 0, 242, 0, 0, 0, 0,
+	183, 0, 216, 121, 135, 94, 80, 90, 0, 119,
+	161, 190, 194, 0, 0, 0, 120, 103, 0, 192,
+	171, 233, 0, 173, 191, 139, 223, 184, 232, 243,
+	244, 219, 240, 248, 209, 83, 218, 231, 99, 203,
+	622, 200, 0, 102, 85, 229, 215, 150, 130, 131,
+	84, 0, 188, 108, 115, 105, 163, 226, 227, 104,
+	250, 91, 239, 87, 92, 238, 157, 222, 230, 151,
+	144, 86, 228, 149, 143, 134, 112, 123, 181, 141,
+	182, 124, 154, 153, 155, 0, 0, 0, 213, 236,
+	251, 96, 0, 220, 246, 247, 0, 0, 97, 116,
+	111, 180, 156, 93, 126, 210, 133, 140, 187, 249,
+	170, 193, 100, 235, 211, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 79, 88, 137, 0, 185, 114,
+	237, 0, 0, 107, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 81, 82, 89, 95,
+	101, 106, 110, 113, 118, 122, 125, 127, 128, 129,
+	132, 142, 145, 146, 147, 148, 158, 159, 160, 162,
+	165, 166, 167, 168, 169, 172, 174, 175, 176, 177,
+	178, 179, 186, 189, 195, 196, 197, 198, 199, 201,
+	202, 205, 206, 207, 208, 214, 217, 224, 225, 234,
+	241, 245,
 }
 var yyPact = [...]int{
 
-	258, -1000, -270, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 879, 942, -1000, -1000, -1000, -1000, -1000, -1000,
-	252, 11336, 49, 107, 33, 15657, 97, 275, 16317, -1000,
-	11, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -57, -66,
-	-1000, 694, -1000, -1000, -1000, -1000, -1000, 873, 876, 741,
-	868, 803, -1000, 8024, 75, 75, 15327, 6704, -1000, -1000,
-	240, 16317, 91, 16317, -145, 73, 73, 73, -1000, -1000,
+	2321, -1000, -266, -1000, 723, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 961, 980, -1000,
+	17368, -1000, -1000, -1000, -1000, -1000, 264, 11961, 45, 128,
+	44, 17032, 127, 151, 18376, -1000, 25, -1000, 107, 18040,
+	23, -63, 14337, -1000, -1000, -1000, -1000, -47, -52, -1000,
+	723, -1000, -1000, -1000, -1000, -1000, -1000, 954, 958, 758,
+	948, 838, -1000, 705, 18376, -1000, 741, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2603,21 +2879,27 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	96, 16317, 536, 534, 176, -1000, 16317, 71, 531, 71,
-	71, 71, 16317, -1000, 141, -1000, -1000, -1000, 16317, 526,
-	834, 288, 70, 3617, -1000, 3617, 3617, -1000, 3617, 20,
-	3617, -59, 900, 18, -8, -1000, 3617, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	546, 844, 9356, 9356, 879, -1000, 694, -1000, -1000, -1000,
-	831, -1000, -1000, 306, 924, -1000, 11006, 140, -1000, 9356,
-	2043, 700, -1000, -1000, 700, -1000, -1000, 119, -1000, -1000,
-	10346, 10346, 10346, 10346, 10346, 10346, 10346, 10346, -1000, -1000,
+	-1000, -1000, 8937, 98, 98, 16696, 7245, -1000, -1000, 275,
+	18376, 120, 18376, -135, 93, 93, 126, -1000, -1000, -1000,
+	-1000, -1000, 124, 18376, 623, 613, 218, -1000, 18376, 123,
+	612, 92, 92, 92, -1000, 18376, -1000, 174, 18376, 611,
+	906, 302, 106, 4104, -1000, 4104, 4104, -1000, 4104, 34,
+	4104, -44, 970, 36, 1, -1000, 4104, -1000, -1000, -1000,
+	-1000, -1000, 19048, -1000, 18040, 439, -1000, -1000, 100, 4104,
+	-1000, -1000, 298, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	488, 908, 9945, 9945, 961, -1000, 723, -1000, -1000, -1000,
+	916, -1000, -1000, 381, 18376, 705, 707, 18040, 978, -1000,
+	11625, 171, -1000, 9945, 1681, 707, -1000, -1000, 707, -1000,
+	-1000, 139, -1000, -1000, 10953, 10953, 10953, 10953, 10953, 10953,
+	10953, 10953, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 707, -1000, 8265, 707,
+	707, 707, 707, 707, 707, 707, 707, 707, 707, 707,
+	707, 707, 9945, 707, 707, 707, 707, 707, 707, 707,
+	707, 707, 707, 707, 707, 707, 707, 707, 707, 16353,
+	15009, 18376, 657, 643, -1000, -1000, 169, 702, 6896, -61,
+	-1000, -1000, -1000, 293, 14001, -1000, -1000, -1000, 882, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 700, -1000, 9026, 700, 700, 700, 700, 700,
-	700, 700, 700, 9356, 700, 700, 700, 700, 700, 700,
-	700, 700, 700, 700, 700, 700, 700, 700, 700, 700,
-	14990, 14000, 16317, 673, 666, -1000, -1000, 138, 692, 6361,
-	-98, -1000, -1000, -1000, 219, 13340, -1000, -1000, -1000, 829,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -2628,283 +2910,300 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 608,
+	18376, -1000, 2705, -1000, 604, 4104, 102, 602, 322, 601,
+	93, 18376, 4104, 4104, 4104, 41, 75, 72, 18376, 704,
+	104, 92, 778, 18376, 935, 585, 582, -1000, 6547, -1000,
+	4104, 302, -1000, 481, 9945, 4104, 4104, 4104, 18376, 4104,
+	4104, -1000, -1000, -1000, -1000, -1000, -1000, 4104, 4104, -1000,
+	977, 287, -1000, -1000, -1000, -1000, 9945, 221, -1000, 777,
+	-1000, -1000, 18040, -1000, -1000, -1000, -1000, 723, -1000, -1000,
+	-1000, -1000, -1000, -1000, 131, -1000, -1000, -1000, -1000, -1000,
+	986, 215, 551, 168, 703, -1000, 428, 954, 488, 838,
+	13665, 793, -1000, -1000, -1000, -1000, -1000, 101, 536, 165,
+	18376, -1000, 9945, 9945, 400, -1000, 16017, -1000, -1000, 5151,
+	228, 10953, 413, 313, 10953, 10953, 10953, 10953, 10953, 10953,
+	10953, 10953, 10953, 10953, 10953, 10953, 10953, 10953, 10953, 453,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 569, -1000,
+	624, 624, 180, 180, 180, 180, 180, 180, 180, 11289,
+	7581, 488, 723, 600, 326, 8265, 8937, 488, 488, 488,
+	8937, 8937, 8937, 9945, 9945, 9609, 9273, 8937, 914, 315,
+	326, 18712, -1000, -1000, 10617, -1000, -1000, -1000, -1000, -1000,
+	488, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 18040, 18040,
+	8937, 8937, 8937, 8937, 8937, 59, 18376, -1000, 698, 828,
+	-1000, -1000, -1000, 945, 12309, 13329, 59, 686, 15009, 18376,
+	-1000, -1000, 15009, 18376, 4802, 6198, 702, -61, 692, -1000,
+	-86, -66, 7917, 172, -1000, -1000, -1000, -1000, 3755, 191,
+	639, 361, -39, -1000, -1000, -1000, 716, -1000, 716, 716,
+	716, 716, -5, -5, -5, -5, -1000, -1000, -1000, -1000,
+	-1000, 736, 735, -1000, 716, 716, 716, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 624,
-	16317, -1000, 2399, -1000, 523, 3617, 82, 521, 244, 519,
-	16317, 16317, 3617, 3617, 3617, 26, 59, 55, 16317, 698,
-	79, 16317, 861, 756, 16317, 507, 504, -1000, 6018, -1000,
-	3617, 288, -1000, 440, 9356, 3617, 3617, 3617, 16317, 3617,
-	3617, -1000, -1000, -1000, -1000, -1000, -1000, 3617, 3617, -1000,
-	912, 293, -1000, -1000, -1000, -1000, 9356, 204, -1000, 754,
-	-1000, -1000, -1000, -1000, -1000, -1000, 936, 187, 522, 136,
-	697, -1000, 474, 873, 546, 803, 13010, 770, -1000, -1000,
-	-1000, 16317, -1000, 9356, 9356, 388, -1000, 14660, -1000, -1000,
-	4646, 203, 10346, 279, 255, 10346, 10346, 10346, 10346, 10346,
-	10346, 10346, 10346, 10346, 10346, 10346, 10346, 10346, 10346, 10346,
-	373, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 494,
-	-1000, 694, 594, 594, 152, 152, 152, 152, 152, 152,
-	152, 10676, 7034, 546, 619, 451, 9026, 8024, 8024, 9356,
-	9356, 8684, 8354, 8024, 839, 242, 451, 16647, -1000, -1000,
-	10016, -1000, -1000, -1000, -1000, -1000, 546, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 15987, 15987, 8024, 8024, 8024, 8024,
-	8024, 42, 16317, -1000, 689, 858, -1000, -1000, -1000, 864,
-	12350, 12680, 42, 683, 14000, 16317, -1000, -1000, 14000, 16317,
-	4303, 5675, 692, -98, 675, -1000, -82, -86, 7364, 148,
-	-1000, -1000, -1000, -1000, 3274, 397, 567, 319, -47, -1000,
-	-1000, -1000, 703, -1000, 703, 703, 703, 703, -17, -17,
-	-17, -17, -1000, -1000, -1000, -1000, -1000, 721, 716, -1000,
-	703, 703, 703, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 733, 733, 733, 722, 722,
+	760, -1000, 18376, 4104, 934, 4104, 18376, 87, -1000, -1000,
+	-1000, 18376, 18376, 18376, 18376, 18376, 141, 18376, 18376, 18376,
+	18376, 4104, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 326, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	18376, 302, 18376, 18376, 326, -1000, 466, 18376, -1000, -1000,
+	-1000, -1000, -1000, -1000, 847, 9945, 9945, 5849, 9945, -1000,
+	-1000, -1000, 908, -1000, 914, 959, -1000, 867, 866, 8937,
+	-1000, 944, 18040, 18040, -1000, 228, 283, -1000, -1000, 368,
+	-1000, -1000, -1000, -1000, 163, 707, -1000, 1698, -1000, -1000,
+	-1000, -1000, 413, 10953, 10953, 10953, 452, 1698, 1759, 384,
+	1742, 180, 574, 574, 201, 201, 201, 201, 201, 512,
+	512, -1000, -1000, -1000, 488, -1000, -1000, -1000, 488, 8937,
+	8937, 699, -1000, -1000, 488, 9945, -1000, 488, 590, -190,
+	-190, -190, 590, 590, 590, 353, 490, 300, 976, 590,
+	257, 975, 590, 590, 8937, 332, -1000, 9945, 488, -1000,
+	160, -1000, 434, 696, 695, 590, 488, 488, 590, 590,
+	660, 707, -1000, 18712, 15009, 15009, 15009, 15009, 15009, -1000,
+	814, 799, -1000, 791, 790, 887, 18376, -1000, 596, 12309,
+	173, 707, -1000, 15681, -1000, -1000, 968, 15009, 646, -1000,
+	646, -1000, 157, -1000, -1000, 692, -61, -81, -1000, -1000,
+	-1000, -1000, 326, -1000, 462, 691, 3406, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 732, 560, -1000, 927, 211, 200,
+	545, 926, -1000, -1000, -1000, 913, -1000, 349, -42, -1000,
+	-1000, 416, -5, -5, -1000, -1000, 172, 877, 172, 172,
+	172, 465, 465, -1000, -1000, -1000, -1000, 411, -1000, -1000,
+	-1000, 405, -1000, 774, 18040, 4104, -1000, -1000, -1000, -1000,
+	-1000, 324, 324, 263, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 58, 743, -1000, -1000, -1000,
+	-1000, 22, 40, 103, -1000, 690, 4104, -1000, 287, -1000,
+	-1000, -1000, -1000, -1000, 845, 326, 326, 156, -1000, -1000,
+	18376, -1000, -1000, -1000, -1000, 697, 707, 142, -1000, -1000,
+	-1000, -1000, 4453, 8937, -1000, 452, 1698, 1093, -1000, 10953,
+	10953, -1000, -190, 590, 590, 8937, -1000, 326, -1000, -1000,
+	-1000, 15345, -1000, -1000, -190, -190, -1000, 253, 453, 253,
+	10953, 10953, -1000, 10953, 10953, -1000, -147, 685, 310, -1000,
+	9945, 351, -1000, 5849, -1000, 10953, 10953, -1000, -1000, -1000,
+	-1000, -1000, 773, 18712, 707, -1000, 12993, 18040, 679, -1000,
+	266, 828, 731, 772, 673, -1000, -1000, -1000, -1000, 798,
+	-1000, 792, -1000, -1000, -1000, -1000, -1000, 119, 118, 115,
+	18040, -1000, 961, 9945, 646, -1000, -1000, 164, -1000, -1000,
+	-98, -87, -1000, -1000, -1000, 3755, -1000, 3755, 18040, 76,
+	-1000, 545, 545, -1000, -1000, -1000, 728, 771, 10953, -1000,
+	-1000, -1000, 591, 172, 172, -1000, 237, -1000, -1000, -1000,
+	565, -1000, 549, 688, 538, 18376, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 714, 714, 714, 708, 708, 723, -1000, 16317, 3617,
-	859, 3617, -1000, 72, -1000, -1000, -1000, 16317, 16317, 16317,
-	16317, 16317, 114, 16317, 16317, 687, -1000, 16317, 3617, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 451, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 16317, 288, 16317, 16317,
-	451, -1000, 424, 16317, -1000, 789, 9356, 9356, 5332, 9356,
-	-1000, -1000, -1000, 844, -1000, 839, 880, -1000, 821, 820,
-	8024, -1000, -1000, 203, 295, -1000, -1000, 517, -1000, -1000,
-	-1000, -1000, 134, 700, -1000, 1602, -1000, -1000, -1000, -1000,
-	279, 10346, 10346, 10346, 262, 1602, 1783, 1240, 1657, 152,
-	386, 386, 153, 153, 153, 153, 153, 313, 313, -1000,
-	-1000, -1000, 546, -1000, -1000, -1000, 546, 8024, 8024, 685,
-	-1000, -1000, 9356, -1000, 546, 610, 610, 281, 346, 227,
-	909, 610, 225, 903, 610, 610, 8024, 314, -1000, 9356,
-	546, -1000, 133, -1000, 372, 682, 679, 610, 546, 546,
-	610, 610, 691, 700, -1000, 16647, 14000, 14000, 14000, 14000,
-	14000, -1000, 790, 788, -1000, 768, 767, 780, 16317, -1000,
-	612, 12350, 158, 700, -1000, 14330, -1000, -1000, 899, 14000,
-	684, -1000, 684, -1000, 131, -1000, -1000, 675, -98, -105,
-	-1000, -1000, -1000, -1000, 451, -1000, 381, 668, 2931, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 712, 488, -1000, 850,
-	185, 220, 480, 849, -1000, -1000, -1000, 832, -1000, 261,
-	-53, -1000, -1000, 369, -17, -17, -1000, -1000, 148, 828,
-	148, 148, 148, 422, 422, -1000, -1000, -1000, -1000, 361,
-	-1000, -1000, -1000, 345, -1000, 753, 15987, 3617, -1000, -1000,
-	-1000, -1000, 785, 785, 202, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 39, 596, -1000, -1000,
-	-1000, -1000, 10, 25, 74, -1000, 3617, -1000, 293, -1000,
-	-1000, -1000, -1000, -1000, 801, 451, 451, 129, -1000, -1000,
-	16317, -1000, -1000, -1000, -1000, 659, -1000, -1000, -1000, 3960,
-	8024, -1000, 262, 1602, 1643, -1000, 10346, 10346, -1000, -1000,
-	610, 610, 8024, 451, -1000, -1000, -1000, 58, 373, 58,
-	10346, 10346, -1000, 10346, 10346, -1000, -160, 667, 232, -1000,
-	9356, 493, -1000, 5332, -1000, 10346, 10346, -1000, -1000, -1000,
-	-1000, -1000, 744, 16647, 700, -1000, 12008, 15987, 695, -1000,
-	218, 858, 720, 740, 645, -1000, -1000, -1000, -1000, 779,
-	-1000, 778, -1000, -1000, -1000, -1000, -1000, 90, 89, 87,
-	15987, -1000, 879, 9356, 684, -1000, -1000, 171, -1000, -1000,
-	-109, -91, -1000, -1000, -1000, 3274, -1000, 3274, 15987, 57,
-	-1000, 480, 480, -1000, -1000, -1000, 711, 728, 10346, -1000,
-	-1000, -1000, 560, 148, 148, -1000, 197, -1000, -1000, -1000,
-	608, -1000, 590, 662, 573, 16317, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 16317, -1000, -1000, -1000, -1000, -1000, 15987,
-	-173, 471, 15987, 15987, 15987, 16317, -1000, 288, -1000, 4989,
-	-1000, 899, 14000, -1000, -1000, 546, -1000, 10346, 1602, 1602,
-	-1000, -1000, -1000, 546, 703, 703, -1000, 703, 708, -1000,
-	703, 1, 703, -1, 546, 546, 1578, 1132, 997, 415,
-	700, -152, -1000, 451, 9356, -1000, 1478, 537, -1000, 852,
-	595, 627, -1000, -1000, 7694, 546, 570, 127, 566, -1000,
-	879, 16647, 9356, -1000, -1000, 9356, 707, -1000, 9356, -1000,
-	-1000, -1000, 700, 700, 700, 566, 873, 451, -1000, -1000,
-	-1000, -1000, 2931, -1000, 557, -1000, 703, -1000, -1000, -1000,
-	15987, -42, 931, 1602, -1000, -1000, -1000, -1000, -1000, -17,
-	421, -17, 337, -1000, 326, 3617, -1000, -1000, -1000, -1000,
-	854, -1000, 4989, -1000, -1000, 702, 696, -1000, -1000, -1000,
-	896, 652, -1000, 1602, -1000, -1000, 113, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 10346, 10346, 10346, 10346, 10346,
-	873, 404, 451, 10346, 10346, 846, -1000, 700, -1000, -1000,
-	681, 15987, 15987, -1000, 15987, 873, -1000, 451, 451, 15987,
-	451, 13670, 15987, 15987, 11666, -1000, 150, 15987, -1000, 549,
-	-1000, 195, -1000, -103, 148, -1000, 148, 553, 550, -1000,
-	700, 647, -1000, 216, 15987, 16317, 883, 865, -1000, -1000,
-	372, 372, 372, 372, 19, 546, -1000, 372, 372, 928,
-	-1000, 700, -1000, 694, 124, -1000, -1000, -1000, 543, 518,
-	-1000, 518, 518, 158, 150, -1000, 449, 213, 400, -1000,
-	56, 15987, 257, 841, -1000, 838, -1000, -1000, -1000, -1000,
-	-1000, 35, 4989, 3274, 515, -1000, -1000, 9356, 9356, -1000,
-	-1000, -1000, -1000, 546, 44, -176, -1000, -1000, -1000, 16647,
-	627, 546, 15987, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	312, -1000, -1000, 16317, -1000, -1000, 375, -1000, -1000, 498,
-	-1000, 15987, -1000, -1000, 596, 451, 604, -1000, 799, -170,
-	-185, 587, -1000, -1000, -1000, 701, -1000, -1000, 35, 811,
-	-173, -1000, 797, -1000, 15987, -1000, 32, -1000, -174, 487,
-	30, -180, 725, 700, -186, 722, -1000, 923, 9686, -1000,
-	-1000, 925, 177, 177, 372, 546, -1000, -1000, -1000, 62,
-	368, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 18376, -1000, -1000, -1000, -1000, -1000, 18040,
+	-154, 507, 18040, 18040, 18040, 18376, -1000, 302, -1000, 5500,
+	-1000, 968, 15009, -1000, 18040, -1000, -1000, 488, -1000, 10953,
+	1698, 1698, -1000, -1000, -1000, -1000, 17704, -1000, -1000, -1000,
+	488, 716, 716, -1000, 716, 722, -1000, 716, 12, 716,
+	11, 488, 488, 1796, 1724, 1623, 821, 707, -142, -1000,
+	326, 9945, -1000, 795, 534, -1000, 917, 645, 668, -1000,
+	-1000, 8601, 488, 536, 531, -1000, 961, 18712, 9945, -1000,
+	-1000, 9945, 717, -1000, 9945, -1000, -1000, -1000, 707, 707,
+	707, 531, 954, 326, -1000, -1000, -1000, -1000, 3406, -1000,
+	529, -1000, 716, -1000, -1000, -1000, 18040, -30, 985, 1698,
+	-1000, -1000, -1000, -1000, -1000, -5, 464, -5, 402, -1000,
+	393, 4104, -1000, -1000, -1000, -1000, 930, -1000, 5500, -1000,
+	-1000, 713, 756, -1000, -1000, -1000, 966, 683, -1000, -1000,
+	1698, 488, 961, 57, 956, -1000, -1000, 132, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 10953, 10953, 10953, 10953,
+	10953, 954, 463, 326, 10953, 10953, 924, -1000, 707, -1000,
+	-1000, 701, -1000, 18040, 954, -1000, 326, 326, 18040, 326,
+	14673, 18040, 18040, 12645, -1000, 167, 18040, -1000, 525, -1000,
+	209, -1000, -103, 172, -1000, 172, 526, 521, -1000, 707,
+	676, -1000, 247, 18040, 18376, 963, 957, -1000, -1000, 961,
+	956, 9945, -1000, -1000, 434, 434, 434, 434, 35, 488,
+	-1000, 434, 434, 983, -1000, 707, -1000, 723, -1000, -1000,
+	520, 514, -1000, 514, 514, 173, 167, -1000, 504, 238,
+	448, -1000, 73, 18040, 365, 919, -1000, 918, -1000, -1000,
+	-1000, -1000, -1000, 56, 5500, 3755, 511, -1000, -199, 9945,
+	9945, -1000, 656, -1000, -1000, -1000, -1000, 488, 68, -157,
+	-1000, -1000, -1000, 18712, 668, 488, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 388, -1000, -1000, 18376, -1000, -1000, 445,
+	-1000, -1000, 502, -1000, 18040, -1000, -1000, 743, -1000, 18040,
+	326, 656, -1000, 836, -151, -166, 653, -1000, -1000, 712,
+	-1000, -1000, 56, 852, -154, 648, -1000, 943, -1000, 825,
+	-1000, 18040, -1000, 46, -1000, 18040, 707, -155, 487, 52,
+	-1000, 17704, -159, 770, 707, 488, -168, 759, -1000, 974,
+	10281, -1000, -1000, -1000, 981, 185, 185, 434, 488, -1000,
+	-1000, -1000, 80, 379, -1000, -1000, -1000, -1000, -1000, -1000,
 }
 var yyPgo = [...]int{
 
-	0, 1206, 85, 503, 1203, 1201, 1200, 1199, 1198, 1196,
-	1193, 1185, 1184, 1183, 1181, 1178, 1173, 1169, 1168, 1167,
-	1166, 1162, 1157, 1155, 1149, 1148, 81, 1147, 1146, 1144,
-	65, 1143, 63, 1140, 1139, 46, 609, 45, 42, 1289,
-	1138, 23, 82, 75, 1136, 35, 1135, 1134, 69, 1133,
-	1132, 64, 1131, 1128, 59, 1115, 58, 1112, 21, 73,
-	1108, 1107, 1106, 1090, 70, 1103, 1085, 1084, 13, 1083,
-	1082, 83, 1081, 52, 5, 12, 11, 18, 1080, 103,
-	10, 1078, 50, 1077, 1076, 1075, 1074, 31, 1072, 55,
-	1071, 34, 53, 1069, 7, 61, 30, 25, 9, 71,
-	56, 1068, 22, 60, 48, 1066, 1065, 550, 1064, 1063,
-	40, 1060, 1059, 24, 1058, 91, 401, 1057, 1056, 1055,
-	1054, 33, 0, 553, 93, 66, 1052, 1051, 1050, 1555,
-	38, 54, 16, 1048, 51, 1510, 41, 1046, 1044, 37,
-	1043, 1042, 1041, 1040, 1039, 1038, 1037, 49, 1036, 1035,
-	1025, 19, 20, 1020, 1013, 62, 27, 1010, 1005, 971,
-	44, 57, 970, 968, 47, 28, 967, 966, 965, 963,
-	962, 26, 14, 961, 15, 960, 17, 959, 29, 957,
-	4, 956, 6, 955, 3, 954, 8, 43, 1, 953,
-	2, 952, 951, 619, 570, 77, 948, 76,
+	0, 1307, 66, 445, 1301, 1299, 91, 401, 89, 166,
+	2, 1298, 1297, 4, 25, 1296, 104, 100, 98, 1295,
+	1293, 1292, 1291, 1290, 1285, 1278, 1277, 1275, 1274, 1271,
+	1270, 1268, 1265, 1264, 1262, 1260, 1259, 1258, 1257, 1254,
+	1247, 1239, 1238, 90, 1237, 1236, 1234, 77, 1233, 80,
+	1232, 1229, 48, 960, 55, 47, 9, 1228, 46, 65,
+	72, 1226, 41, 1225, 1223, 68, 1222, 1220, 56, 1212,
+	1211, 1695, 1205, 76, 1203, 17, 54, 1202, 1199, 1185,
+	1184, 79, 433, 1182, 1181, 20, 1179, 1177, 97, 1176,
+	60, 12, 15, 16, 22, 1171, 1280, 11, 1170, 83,
+	1169, 1166, 1165, 1164, 18, 1163, 63, 1162, 32, 58,
+	43, 7, 82, 35, 28, 10, 85, 70, 1161, 26,
+	69, 57, 1156, 1155, 141, 1151, 1150, 49, 1149, 1148,
+	27, 1146, 81, 84, 1144, 1143, 1134, 1132, 61, 0,
+	832, 33, 78, 1130, 1125, 1107, 1918, 36, 52, 24,
+	1106, 39, 220, 37, 1101, 1099, 44, 1098, 1097, 1092,
+	1090, 1088, 1087, 1084, 358, 1077, 1075, 1071, 62, 23,
+	1032, 1030, 863, 73, 29, 1029, 1027, 1026, 59, 74,
+	1025, 1023, 64, 30, 1022, 1021, 1019, 1018, 1016, 40,
+	21, 1014, 19, 1013, 14, 1012, 34, 1011, 6, 1010,
+	13, 1004, 5, 1002, 8, 51, 1, 1001, 3, 999,
+	998, 644, 1093, 86, 996, 87,
 }
 var yyR1 = [...]int{
 
-	0, 191, 192, 192, 1, 1, 1, 1, 1, 1,
+	0, 209, 210, 210, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 2, 6, 3, 4,
-	4, 5, 5, 7, 7, 29, 29, 8, 9, 9,
-	9, 9, 195, 195, 48, 48, 49, 49, 95, 95,
-	10, 10, 10, 10, 100, 100, 104, 104, 104, 105,
-	105, 105, 105, 137, 137, 11, 11, 11, 11, 11,
-	11, 11, 186, 186, 185, 184, 184, 183, 183, 182,
-	17, 167, 169, 169, 168, 168, 168, 168, 161, 140,
-	140, 140, 140, 143, 143, 141, 141, 141, 141, 141,
-	141, 141, 141, 141, 142, 142, 142, 142, 142, 144,
-	144, 144, 144, 144, 145, 145, 145, 145, 145, 145,
-	145, 145, 145, 145, 145, 145, 145, 145, 145, 146,
-	146, 146, 146, 146, 146, 146, 146, 160, 160, 147,
-	147, 155, 155, 156, 156, 156, 153, 153, 154, 154,
-	157, 157, 157, 149, 149, 150, 150, 158, 158, 151,
-	151, 151, 152, 152, 152, 159, 159, 159, 159, 159,
-	148, 148, 162, 162, 177, 177, 176, 176, 176, 166,
-	166, 173, 173, 173, 173, 173, 164, 164, 165, 165,
-	175, 175, 174, 163, 163, 178, 178, 178, 178, 189,
-	190, 188, 188, 188, 188, 188, 170, 170, 170, 171,
-	171, 171, 172, 172, 172, 12, 12, 12, 12, 12,
-	12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-	12, 12, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 181, 179, 179, 180, 180, 13,
-	18, 18, 14, 14, 14, 14, 14, 15, 15, 19,
-	20, 20, 20, 20, 20, 20, 20, 20, 20, 20,
-	20, 20, 20, 20, 20, 20, 20, 20, 20, 20,
-	20, 20, 20, 20, 20, 20, 20, 20, 111, 111,
-	109, 109, 112, 112, 110, 110, 110, 113, 113, 113,
-	114, 114, 138, 138, 138, 21, 21, 23, 23, 24,
-	25, 22, 22, 22, 22, 22, 22, 22, 16, 196,
-	26, 27, 27, 28, 28, 28, 32, 32, 32, 30,
-	30, 30, 31, 31, 37, 37, 36, 36, 38, 38,
-	38, 38, 126, 126, 126, 125, 125, 40, 40, 41,
-	41, 42, 42, 43, 43, 43, 43, 57, 57, 94,
-	94, 96, 96, 44, 44, 44, 44, 45, 45, 46,
-	46, 47, 47, 133, 133, 132, 132, 132, 131, 131,
-	50, 50, 50, 52, 51, 51, 51, 51, 53, 53,
-	55, 55, 54, 54, 56, 58, 58, 58, 58, 58,
-	59, 59, 39, 39, 39, 39, 39, 39, 39, 108,
-	108, 61, 61, 60, 60, 60, 60, 60, 60, 60,
-	60, 60, 60, 72, 72, 72, 72, 72, 72, 62,
-	62, 62, 62, 62, 62, 62, 35, 35, 73, 73,
-	73, 79, 74, 74, 65, 65, 65, 65, 65, 65,
-	65, 65, 65, 65, 65, 65, 65, 65, 65, 65,
-	65, 65, 65, 65, 65, 65, 65, 65, 65, 65,
-	65, 65, 65, 65, 65, 65, 69, 69, 69, 69,
-	67, 67, 67, 67, 67, 67, 67, 67, 67, 67,
-	67, 67, 67, 68, 68, 68, 68, 68, 68, 68,
-	68, 68, 68, 68, 68, 68, 68, 68, 68, 197,
-	197, 71, 70, 70, 70, 70, 70, 70, 70, 33,
-	33, 33, 33, 33, 136, 136, 139, 139, 139, 139,
-	139, 139, 139, 139, 139, 139, 139, 139, 139, 83,
-	83, 34, 34, 81, 81, 82, 84, 84, 80, 80,
-	80, 64, 64, 64, 64, 64, 64, 64, 64, 66,
-	66, 66, 85, 85, 86, 86, 87, 87, 88, 88,
-	89, 90, 90, 90, 91, 91, 91, 91, 92, 92,
-	92, 63, 63, 63, 63, 63, 63, 93, 93, 93,
-	93, 97, 97, 75, 75, 77, 77, 76, 78, 98,
-	98, 102, 99, 99, 103, 103, 103, 103, 101, 101,
-	101, 128, 128, 128, 106, 106, 115, 115, 116, 116,
-	107, 107, 117, 117, 117, 117, 117, 117, 117, 117,
-	117, 117, 118, 118, 118, 119, 119, 120, 120, 120,
-	127, 127, 123, 123, 124, 124, 129, 129, 130, 130,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 121, 121,
-	121, 121, 121, 121, 121, 121, 121, 121, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 122, 122, 122,
-	122, 122, 122, 122, 122, 122, 122, 193, 194, 134,
-	135, 135, 135,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
+	2, 2, 6, 6, 7, 7, 8, 8, 15, 3,
+	4, 4, 5, 5, 16, 16, 46, 46, 17, 18,
+	18, 18, 18, 213, 213, 65, 65, 66, 66, 112,
+	112, 19, 19, 19, 19, 117, 117, 121, 121, 121,
+	122, 122, 122, 122, 154, 154, 20, 20, 20, 20,
+	20, 20, 20, 204, 204, 203, 202, 202, 201, 201,
+	200, 26, 172, 172, 185, 187, 187, 186, 186, 186,
+	186, 179, 157, 157, 157, 157, 160, 160, 158, 158,
+	158, 158, 158, 158, 158, 158, 158, 159, 159, 159,
+	159, 159, 161, 161, 161, 161, 161, 162, 162, 162,
+	162, 162, 162, 162, 162, 162, 162, 162, 162, 162,
+	162, 162, 163, 163, 163, 163, 163, 163, 163, 163,
+	178, 178, 164, 164, 173, 173, 174, 174, 174, 170,
+	170, 171, 171, 175, 175, 175, 166, 166, 167, 167,
+	176, 176, 168, 168, 168, 169, 169, 169, 177, 177,
+	177, 177, 177, 165, 165, 180, 180, 195, 195, 194,
+	194, 194, 184, 184, 191, 191, 191, 191, 191, 182,
+	182, 183, 183, 193, 193, 192, 181, 181, 196, 196,
+	196, 196, 207, 208, 206, 206, 206, 206, 206, 188,
+	188, 188, 189, 189, 189, 190, 190, 190, 21, 21,
+	21, 21, 21, 21, 21, 21, 21, 21, 21, 21,
+	21, 21, 21, 21, 21, 205, 205, 205, 205, 205,
+	205, 205, 205, 205, 205, 205, 205, 199, 197, 197,
+	198, 198, 22, 27, 27, 23, 23, 23, 23, 23,
+	24, 24, 28, 29, 29, 29, 29, 29, 29, 29,
+	29, 29, 29, 29, 29, 29, 29, 29, 29, 29,
+	29, 29, 29, 29, 29, 29, 29, 29, 29, 29,
+	29, 128, 128, 126, 126, 129, 129, 127, 127, 127,
+	130, 130, 130, 131, 131, 155, 155, 155, 30, 30,
+	36, 36, 37, 38, 38, 38, 39, 40, 41, 42,
+	42, 42, 32, 32, 32, 32, 34, 34, 35, 35,
+	33, 33, 33, 33, 33, 31, 31, 31, 31, 31,
+	31, 25, 214, 43, 44, 44, 45, 45, 45, 49,
+	49, 49, 47, 47, 47, 48, 48, 54, 54, 53,
+	53, 55, 55, 55, 55, 143, 143, 143, 142, 142,
+	57, 57, 58, 58, 59, 59, 60, 60, 60, 60,
+	74, 74, 111, 111, 113, 113, 61, 61, 61, 61,
+	62, 62, 63, 63, 64, 64, 150, 150, 149, 149,
+	149, 148, 148, 67, 67, 67, 69, 68, 68, 68,
+	68, 70, 70, 72, 72, 71, 71, 73, 75, 75,
+	75, 75, 75, 76, 76, 56, 56, 56, 56, 56,
+	56, 56, 125, 125, 78, 78, 77, 77, 77, 77,
+	77, 77, 77, 77, 77, 77, 89, 89, 89, 89,
+	89, 89, 79, 79, 79, 79, 79, 79, 79, 52,
+	52, 90, 90, 90, 96, 96, 91, 91, 82, 82,
+	82, 82, 82, 82, 82, 82, 82, 82, 82, 82,
+	82, 82, 82, 82, 82, 82, 82, 82, 82, 82,
+	82, 82, 82, 82, 82, 82, 82, 82, 82, 82,
+	86, 86, 86, 86, 86, 84, 84, 84, 84, 84,
+	84, 84, 84, 84, 84, 84, 84, 84, 84, 84,
+	84, 84, 84, 85, 85, 85, 85, 85, 85, 85,
+	85, 85, 85, 85, 85, 85, 85, 85, 85, 215,
+	215, 88, 87, 87, 87, 87, 87, 87, 87, 50,
+	50, 50, 50, 50, 153, 153, 156, 156, 156, 156,
+	156, 156, 156, 156, 156, 156, 156, 156, 156, 100,
+	100, 51, 51, 98, 98, 99, 101, 101, 97, 97,
+	97, 81, 81, 81, 81, 81, 81, 81, 81, 83,
+	83, 83, 102, 102, 103, 103, 11, 11, 12, 12,
+	13, 9, 9, 10, 10, 14, 14, 104, 104, 105,
+	105, 106, 107, 107, 107, 108, 108, 108, 108, 109,
+	109, 109, 80, 80, 80, 80, 80, 80, 110, 110,
+	110, 110, 114, 114, 92, 92, 94, 94, 93, 95,
+	115, 115, 119, 116, 116, 120, 120, 120, 120, 118,
+	118, 118, 145, 145, 145, 123, 123, 132, 132, 133,
+	133, 124, 124, 134, 134, 134, 134, 134, 134, 134,
+	134, 134, 134, 135, 135, 135, 136, 136, 137, 137,
+	137, 144, 144, 140, 140, 141, 141, 146, 146, 147,
+	147, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	138, 138, 138, 138, 138, 138, 138, 138, 138, 138,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 139, 139, 139, 139, 139, 139, 139,
+	139, 139, 139, 211, 212, 151, 152, 152, 152,
 }
 var yyR2 = [...]int{
 
-	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
+	0, 2, 0, 1, 1, 2, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 0, 4, 6, 7, 5, 10, 1,
-	3, 1, 3, 7, 8, 1, 1, 9, 8, 7,
-	6, 6, 1, 1, 1, 3, 1, 3, 0, 4,
-	3, 4, 5, 4, 1, 3, 3, 2, 2, 2,
-	2, 2, 1, 1, 1, 2, 2, 8, 4, 6,
-	5, 5, 0, 2, 1, 0, 2, 1, 3, 3,
-	4, 4, 2, 4, 1, 3, 3, 3, 8, 3,
-	1, 1, 1, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 2, 2, 2, 1,
-	2, 2, 2, 1, 4, 4, 2, 2, 3, 3,
-	3, 3, 1, 1, 1, 1, 1, 6, 6, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 3, 0,
-	3, 0, 5, 0, 3, 5, 0, 1, 0, 1,
-	0, 1, 2, 0, 2, 0, 3, 0, 1, 0,
-	3, 3, 0, 2, 2, 0, 2, 1, 2, 1,
-	0, 2, 5, 4, 1, 2, 2, 3, 2, 0,
-	1, 2, 3, 3, 2, 2, 1, 1, 0, 1,
-	1, 3, 2, 3, 1, 10, 11, 11, 12, 3,
-	3, 1, 1, 2, 2, 2, 0, 1, 3, 1,
-	2, 3, 1, 1, 1, 6, 7, 7, 7, 7,
-	4, 5, 4, 4, 7, 5, 5, 5, 12, 7,
-	5, 9, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 7, 1, 3, 8, 8, 3,
-	3, 5, 4, 6, 5, 4, 4, 3, 2, 3,
-	4, 4, 3, 4, 4, 4, 4, 4, 4, 3,
-	2, 3, 3, 2, 3, 4, 3, 7, 5, 4,
-	2, 4, 4, 3, 3, 5, 2, 3, 1, 1,
-	0, 1, 1, 1, 0, 2, 2, 0, 2, 2,
-	0, 2, 0, 1, 1, 2, 1, 1, 2, 1,
-	1, 2, 2, 2, 2, 2, 3, 3, 2, 0,
-	2, 0, 2, 1, 2, 2, 0, 1, 1, 0,
-	1, 1, 0, 1, 0, 1, 1, 3, 1, 2,
-	3, 5, 0, 1, 2, 1, 1, 0, 2, 1,
-	3, 1, 1, 1, 3, 1, 3, 3, 7, 1,
-	3, 1, 3, 4, 4, 4, 3, 2, 4, 0,
-	1, 0, 2, 0, 1, 0, 1, 2, 1, 1,
-	1, 2, 2, 1, 2, 3, 2, 3, 2, 2,
-	2, 1, 1, 3, 3, 0, 5, 4, 5, 5,
-	0, 2, 1, 3, 3, 2, 3, 1, 2, 0,
-	3, 1, 1, 3, 3, 4, 4, 5, 3, 4,
-	5, 6, 2, 1, 2, 1, 2, 1, 2, 1,
-	1, 1, 1, 1, 1, 1, 0, 2, 1, 1,
-	1, 3, 1, 3, 1, 1, 1, 1, 1, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 2, 2, 2, 2, 2, 2,
-	2, 3, 1, 1, 1, 1, 4, 5, 5, 6,
-	4, 4, 6, 6, 6, 8, 8, 8, 8, 9,
+	1, 1, 1, 1, 1, 1, 1, 1, 0, 4,
+	6, 7, 2, 3, 1, 3, 3, 6, 5, 11,
+	1, 3, 1, 3, 7, 8, 1, 1, 9, 8,
+	7, 6, 6, 1, 1, 1, 3, 1, 3, 0,
+	4, 3, 4, 5, 4, 1, 3, 3, 2, 2,
+	2, 2, 2, 1, 1, 1, 2, 2, 8, 4,
+	6, 5, 5, 0, 2, 1, 0, 2, 1, 3,
+	3, 5, 0, 1, 4, 2, 4, 1, 3, 3,
+	3, 8, 3, 1, 1, 1, 2, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 2, 2,
+	2, 2, 1, 2, 2, 2, 1, 4, 4, 2,
+	2, 3, 3, 3, 3, 1, 1, 1, 1, 1,
+	6, 6, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 3, 0, 3, 0, 5, 0, 3, 5, 0,
+	1, 0, 1, 0, 1, 2, 0, 2, 0, 3,
+	0, 1, 0, 3, 3, 0, 2, 2, 0, 2,
+	1, 2, 1, 0, 2, 5, 4, 1, 2, 2,
+	3, 2, 0, 1, 2, 3, 3, 2, 2, 1,
+	1, 0, 1, 1, 3, 2, 3, 1, 10, 11,
+	11, 12, 3, 3, 1, 1, 2, 2, 2, 0,
+	1, 3, 1, 2, 3, 1, 1, 1, 6, 7,
+	7, 7, 7, 4, 5, 4, 4, 7, 5, 5,
+	5, 12, 7, 5, 9, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 7, 1, 3,
+	8, 8, 3, 3, 5, 5, 6, 5, 4, 4,
+	3, 2, 3, 4, 4, 3, 4, 4, 4, 4,
+	4, 4, 3, 2, 3, 3, 2, 3, 4, 3,
+	7, 5, 4, 2, 4, 4, 3, 3, 5, 2,
+	3, 1, 1, 0, 1, 1, 1, 0, 2, 2,
+	0, 2, 2, 0, 2, 0, 1, 1, 2, 1,
+	1, 2, 1, 1, 3, 4, 2, 3, 3, 0,
+	1, 1, 3, 3, 3, 3, 0, 3, 1, 1,
+	1, 2, 1, 1, 1, 2, 2, 2, 2, 3,
+	3, 2, 0, 2, 0, 2, 1, 2, 2, 0,
+	1, 1, 0, 1, 1, 0, 1, 0, 1, 1,
+	3, 1, 2, 3, 5, 0, 1, 2, 1, 1,
+	0, 2, 1, 3, 1, 1, 1, 3, 1, 3,
+	3, 7, 1, 3, 1, 3, 4, 4, 4, 3,
+	2, 4, 0, 1, 0, 2, 0, 1, 0, 1,
+	2, 1, 1, 1, 2, 2, 1, 2, 3, 2,
+	3, 2, 2, 2, 1, 1, 3, 3, 0, 5,
+	4, 5, 5, 0, 2, 1, 3, 3, 2, 3,
+	1, 2, 0, 3, 1, 1, 3, 3, 4, 4,
+	5, 3, 4, 5, 6, 2, 1, 2, 1, 2,
+	1, 2, 1, 1, 1, 1, 1, 1, 1, 0,
+	2, 1, 1, 1, 3, 4, 1, 3, 1, 1,
+	1, 1, 1, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 2, 2,
+	2, 2, 2, 2, 2, 3, 1, 1, 1, 1,
+	4, 5, 5, 5, 6, 4, 4, 4, 4, 5,
+	5, 4, 6, 6, 6, 8, 8, 8, 8, 9,
 	8, 5, 4, 2, 2, 2, 2, 2, 2, 2,
 	2, 2, 2, 2, 2, 2, 2, 8, 8, 0,
 	2, 3, 4, 4, 4, 4, 4, 4, 4, 0,
@@ -2912,15 +3211,16 @@ var yyR2 = [...]int{
 	2, 2, 1, 2, 1, 2, 2, 1, 2, 0,
 	1, 0, 2, 1, 2, 4, 0, 2, 1, 3,
 	5, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	2, 2, 0, 3, 0, 2, 0, 3, 1, 3,
-	2, 0, 1, 1, 0, 2, 4, 4, 0, 2,
-	4, 2, 1, 3, 5, 4, 6, 1, 3, 3,
-	5, 0, 5, 1, 3, 1, 2, 3, 1, 1,
-	3, 3, 1, 3, 3, 3, 3, 3, 1, 2,
-	1, 1, 1, 1, 1, 1, 0, 2, 0, 3,
-	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 0, 1, 1, 1, 1, 0, 1, 1,
-	0, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	2, 2, 0, 3, 0, 2, 0, 2, 1, 3,
+	5, 4, 2, 2, 3, 0, 3, 0, 3, 1,
+	3, 2, 0, 1, 1, 0, 2, 4, 4, 0,
+	2, 4, 2, 1, 3, 5, 4, 6, 1, 3,
+	3, 5, 0, 5, 1, 3, 1, 2, 3, 1,
+	1, 3, 3, 1, 3, 3, 3, 3, 3, 1,
+	2, 1, 1, 1, 1, 1, 1, 0, 2, 0,
+	3, 0, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 0, 1, 1, 1, 1, 0, 1,
+	1, 0, 2, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -2949,322 +3249,344 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 0,
-	0, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 0, 0, 1, 1,
 }
 var yyChk = [...]int{
 
-	-1000, -191, -1, -2, -6, -7, -8, -9, -10, -11,
-	-12, -13, -14, -15, -19, -20, -21, -23, -24, -25,
-	-22, -16, -3, -4, 6, 7, -29, 9, 10, 30,
-	-17, 116, 117, 119, 118, 151, 120, 144, 51, 165,
-	166, 168, 169, 25, 145, 146, 149, 150, 31, 32,
-	122, -193, 8, 250, 55, -192, 348, -87, 15, -28,
-	5, -26, -196, -26, -26, -26, -26, -26, -167, -169,
-	55, 91, -120, 126, 73, 242, 123, 124, 130, -123,
-	58, -122, 260, 137, 292, 293, 165, 176, 170, 197,
-	189, 261, 294, 138, 187, 190, 229, 136, 295, 217,
-	224, 67, 168, 238, 296, 147, 185, 181, 297, 269,
-	179, 27, 298, 226, 202, 299, 265, 180, 225, 122,
-	300, 140, 134, 301, 203, 207, 302, 230, 303, 304,
-	305, 174, 175, 306, 232, 201, 135, 33, 262, 36,
-	155, 233, 205, 307, 200, 196, 308, 309, 310, 311,
-	199, 173, 195, 40, 209, 208, 210, 228, 192, 312,
-	313, 314, 141, 315, 182, 18, 316, 317, 318, 319,
-	320, 236, 150, 321, 153, 322, 323, 324, 325, 326,
-	327, 227, 204, 206, 131, 157, 264, 328, 234, 178,
-	329, 142, 154, 149, 237, 143, 330, 331, 332, 333,
-	334, 335, 336, 169, 337, 338, 339, 340, 164, 231,
-	240, 39, 214, 341, 172, 133, 342, 166, 161, 219,
-	193, 156, 343, 344, 183, 184, 198, 171, 194, 167,
-	158, 151, 345, 239, 215, 266, 191, 188, 162, 346,
-	159, 160, 347, 220, 221, 163, 263, 235, 186, 216,
-	-107, 126, 242, 123, 221, 128, 124, 124, 125, 126,
-	242, 123, 124, -54, -129, 58, -122, 126, 124, 109,
-	190, 229, 116, 218, 226, 125, 33, 227, 157, -138,
-	124, -109, 217, 220, 221, 163, 58, 231, 230, 222,
-	-129, 167, -134, -134, -134, -134, -134, 219, 219, -134,
-	-2, -91, 17, 16, -5, -3, -193, 6, 20, 21,
-	-32, 41, 42, -27, -38, 100, -39, -129, -60, 75,
-	-65, 29, 58, -122, 23, -64, -61, -80, -78, -79,
-	109, 110, 111, 98, 99, 106, 76, 112, -69, -67,
-	-68, -70, 60, 59, 68, 61, 62, 63, 64, 69,
-	70, 71, -123, -76, -193, 45, 46, 251, 252, 253,
-	254, 259, 255, 78, 35, 241, 249, 248, 247, 245,
-	246, 243, 244, 257, 258, 129, 242, 123, 104, 250,
-	-107, -107, 11, -48, -49, -54, -56, -129, -99, -137,
-	167, -103, 231, 230, -124, -101, -123, -121, 229, 190,
-	228, 121, 267, 74, 22, 24, 212, 77, 109, 16,
-	78, 108, 251, 116, 49, 268, 243, 244, 241, 253,
-	254, 242, 218, 29, 10, 270, 25, 145, 21, 34,
-	102, 118, 81, 82, 148, 23, 146, 71, 273, 19,
-	52, 11, 13, 274, 275, 14, 129, 128, 93, 125,
-	47, 8, 112, 26, 90, 43, 276, 28, 277, 278,
-	279, 280, 45, 91, 17, 245, 246, 31, 281, 259,
-	152, 104, 50, 37, 75, 282, 283, 69, 284, 72,
-	53, 73, 15, 48, 285, 286, 287, 288, 92, 119,
-	250, 46, 289, 123, 6, 256, 30, 144, 44, 290,
-	124, 80, 257, 258, 127, 70, 5, 130, 32, 9,
-	51, 54, 247, 248, 249, 35, 79, 12, 291, -168,
-	91, -161, 58, -54, 125, -54, 250, -116, 129, -116,
-	-116, 124, -54, 58, 58, 116, 118, 121, 53, -18,
-	-54, -115, 129, 58, -115, -115, -115, -54, 113, -54,
-	58, 30, -113, 91, 12, 242, 58, 157, 124, 158,
-	126, -135, -193, -124, -135, -135, -135, 161, 162, -135,
-	-112, -111, 224, 225, 219, 223, 12, 162, 219, 160,
-	-135, -134, -134, -194, 57, -92, 19, 31, -39, -129,
-	-88, -89, -39, -87, -2, -26, 37, -30, 21, 34,
-	66, 11, -126, 74, 73, 90, -125, 22, -123, 60,
-	113, -39, -62, 93, 75, 91, 92, 77, 95, 94,
-	105, 98, 99, 100, 101, 102, 103, 104, 96, 97,
-	108, 83, 84, 85, 86, 87, 88, 89, -108, -193,
-	-79, -193, 114, 115, -65, -65, -65, -65, -65, -65,
-	-65, -65, -193, -2, -74, -39, -193, -193, -193, -193,
-	-193, -193, -193, -193, -193, -83, -39, -193, -197, -71,
-	-193, -197, -71, -197, -71, -197, -193, -197, -71, -197,
-	-71, -197, -197, -71, -193, -193, -193, -193, -193, -193,
-	-193, -55, 26, -54, -41, -42, -43, -44, -57, -79,
-	-193, -54, -54, -48, -195, 56, 11, 54, -195, 56,
-	113, 56, -99, 167, -100, -104, 232, 234, 83, -128,
-	-123, 60, 29, 30, 57, 56, -54, -140, -143, -145,
-	-144, -146, -141, -142, 187, 188, 109, 191, 193, 194,
-	195, 196, 197, 198, 199, 200, 201, 202, 30, 147,
-	183, 184, 185, 186, 203, 204, 205, 206, 207, 208,
-	209, 210, 170, 189, 261, 171, 172, 173, 174, 175,
-	176, 178, 179, 180, 181, 182, 58, -135, 126, 58,
-	75, 58, -54, -54, -135, -135, -135, 159, 159, 124,
-	124, 164, -54, 56, 127, -48, 23, 53, -54, 58,
-	58, -130, -129, -121, -135, -113, 60, -39, -135, -135,
-	-135, -54, -135, -135, -135, -135, 11, -110, 11, 93,
-	-39, -114, 91, 53, 9, 93, 56, 18, 113, 56,
-	-90, 24, 25, -91, -194, -32, -66, -123, 61, 64,
-	-31, 44, -54, -39, -39, -72, 69, 75, 70, 71,
-	-125, 100, -130, -124, -121, -65, -73, -76, -79, 65,
-	93, 91, 92, 77, -65, -65, -65, -65, -65, -65,
-	-65, -65, -65, -65, -65, -65, -65, -65, -65, -136,
-	58, 60, 58, -64, -64, -123, -37, 21, 34, -36,
-	-38, -194, 56, -194, -2, -36, -36, -39, -39, -80,
-	60, -36, -80, 60, -36, -36, -30, -81, -82, 79,
-	-80, -123, -129, -194, -65, -123, -123, -36, -37, -37,
-	-36, -36, -95, 153, -54, 30, 56, -50, -52, -51,
-	-53, 43, 47, 49, 44, 45, 46, 50, -133, 22,
-	-41, -193, -132, 153, -131, 22, -129, 60, -95, 54,
-	-41, -54, -41, -56, -129, 100, -103, -100, 56, 233,
-	235, 236, 53, 72, -39, -152, 108, -170, -171, -172,
-	-124, 60, 61, -161, -162, -163, -173, 139, -178, 131,
-	133, 130, -164, 140, 125, 28, 57, -157, 69, 75,
-	-153, 215, -147, 55, -147, -147, -147, -147, -151, 190,
-	-151, -151, -151, 55, 55, -147, -147, -147, -155, 55,
-	-155, -155, -156, 55, -156, -127, 54, -54, -135, 23,
-	-135, -117, 121, 118, 119, -181, 117, 212, 190, 67,
-	29, 15, 251, 153, 266, 58, 154, -54, -54, -54,
-	-54, -54, 121, 118, -54, -54, -54, -135, -54, -113,
-	-129, -129, 60, -54, 39, -39, -39, -130, -89, -92,
-	-106, 19, 11, 35, 35, -36, 69, 70, 71, 113,
-	-193, -73, -65, -65, -65, -35, 148, 74, -194, -194,
-	-36, -36, 56, -39, -194, -194, -194, 56, 54, 22,
-	11, 11, -194, 11, 11, -194, -194, -36, -84, -82,
-	81, -39, -194, 113, -194, 56, 56, -194, -194, -194,
-	-194, -194, -63, 30, 35, -2, -193, -193, -98, -102,
-	-80, -42, -43, -43, -42, -43, 43, 43, 43, 48,
-	43, 48, 43, -51, -129, -194, -58, 51, 128, 52,
-	-193, -131, -59, 12, -41, -59, -59, 113, -104, -105,
-	237, 234, 240, 58, 60, 56, -172, 83, 55, 58,
-	28, -164, -164, -165, 58, -165, 28, -149, 29, 69,
-	-154, 216, 61, -151, -151, -152, 30, -152, -152, -152,
-	-160, 60, -160, 61, 61, 53, -123, -135, -134, -187,
-	136, 132, 139, 140, 134, 58, 125, 28, 131, 133,
-	153, 130, -187, -118, -119, 127, 22, 125, 28, 153,
-	-186, 54, 159, 212, 159, 127, -135, -110, 40, 113,
-	-54, -40, 11, 100, -124, -37, -35, 74, -65, -65,
-	-194, -194, -38, -139, 109, 187, 147, 185, 181, 201,
-	192, 214, 183, 215, -136, -139, -65, -65, -65, -65,
-	260, -87, 82, -39, 80, -124, -65, -65, -97, 53,
-	-98, -75, -77, -76, -193, -2, -93, -123, -96, -123,
-	-59, 56, 83, -46, -45, 53, 54, -47, 53, -45,
-	43, 43, 125, 125, 125, -96, -87, -39, -59, 234,
-	238, 239, -171, -172, -175, -174, -123, -178, -165, -165,
-	55, -150, 53, -65, 57, -152, -152, 58, 109, 57,
-	56, 57, 56, 57, 56, -54, -134, -134, -54, -134,
-	-123, -184, 263, -185, 58, -123, -123, -123, -54, -113,
-	-59, -41, -194, -65, -194, -147, -147, -147, -156, -147,
-	175, -147, 175, -194, -194, 19, 19, 19, 19, -193,
-	-34, 256, -39, 56, 56, 27, -97, 56, -194, -194,
-	-194, 56, 113, -194, 56, -87, -102, -39, -39, 55,
-	-39, -193, -193, -193, -194, -91, 57, 56, -147, -94,
-	-123, -158, 212, 9, -151, 60, -151, 61, 61, -135,
-	26, -183, -182, -124, 55, 54, -85, 13, -151, 58,
-	-65, -65, -65, -65, -65, -91, 60, -65, -65, 28,
-	-77, 35, -2, -193, -123, -123, -123, -91, -94, -94,
-	-194, -94, -94, -132, -177, -176, 54, 135, 67, -174,
-	57, 56, -159, 131, 28, 130, -68, -152, -152, 57,
-	57, -193, 56, 83, -94, -54, -86, 14, 16, -194,
-	-194, -194, -194, -33, 93, 263, -194, -194, -194, 9,
-	-75, -2, 113, 57, -194, -194, -194, -58, -176, 58,
-	-166, 83, 60, 142, -123, -148, 67, 28, 28, -179,
-	-180, 153, -182, -172, 57, -39, -74, -194, 261, 50,
-	264, -98, -194, -123, 61, -54, 60, -194, 56, -123,
-	-186, 40, 262, 265, 55, -180, 35, -184, 40, -94,
-	155, 263, 57, 156, 264, -189, -190, 53, -193, 265,
-	-190, 53, 10, 9, -65, 152, -188, 143, 138, 141,
-	30, -188, -194, -194, 137, 29, 69,
+	-1000, -209, -1, -2, -6, -15, -16, -17, -18, -19,
+	-20, -21, -22, -23, -24, -28, -29, -30, -36, -37,
+	-38, -39, -40, -41, -32, -31, -25, -3, -4, 6,
+	269, 7, -46, 9, 10, 30, -26, 116, 117, 119,
+	118, 153, 120, 145, 51, 167, 168, 170, 171, 172,
+	173, 174, 147, 25, 146, 151, 152, 31, 32, 122,
+	-211, 8, 256, 55, -210, 354, -2, -104, 15, -45,
+	5, -43, -214, -7, 289, -8, -146, 58, -139, 266,
+	138, 298, 299, 167, 182, 176, 203, 195, 267, 300,
+	139, 193, 196, 235, 137, 301, 223, 230, 67, 170,
+	244, 302, 175, 149, 191, 187, 303, 275, 185, 27,
+	304, 232, 208, 305, 271, 186, 231, 122, 306, 141,
+	148, 135, 307, 209, 213, 308, 236, 309, 310, 311,
+	180, 181, 312, 238, 207, 136, 33, 268, 36, 157,
+	239, 211, 313, 206, 202, 314, 315, 316, 317, 205,
+	179, 201, 40, 215, 214, 216, 234, 198, 318, 319,
+	320, 142, 321, 188, 18, 322, 323, 324, 325, 326,
+	242, 152, 327, 155, 328, 329, 330, 331, 332, 333,
+	233, 210, 212, 132, 159, 270, 334, 240, 184, 335,
+	143, 156, 151, 243, 144, 336, 337, 338, 339, 340,
+	173, 341, 342, 171, 172, 343, 344, 345, 346, 166,
+	237, 246, 39, 220, 347, 178, 134, 348, 168, 163,
+	225, 125, 199, 158, 349, 350, 189, 190, 204, 177,
+	200, 169, 160, 153, 351, 245, 221, 272, 197, 194,
+	164, 352, 127, 161, 162, 353, 226, 227, 165, 241,
+	192, 222, -43, -43, -43, -43, -43, -185, -187, 55,
+	91, -137, 127, 73, 248, 123, -172, 131, -140, 125,
+	58, -139, -124, 127, 248, 123, 227, 129, 124, -172,
+	126, 127, 248, 123, 125, 124, -71, -146, 124, 109,
+	196, 235, 116, 224, 232, 126, 33, 233, 159, -155,
+	124, -126, 223, 226, 227, 165, 58, 237, 236, 228,
+	-146, 169, 128, -140, 172, -42, 175, 270, -34, -71,
+	120, 19, 148, -151, -151, -151, -151, 225, 225, -151,
+	-2, -108, 17, 16, -5, -3, -211, 6, 20, 21,
+	-49, 41, 42, -44, 56, -7, 22, -211, -55, 100,
+	-56, -146, -77, 75, -82, 29, 58, -139, 23, -81,
+	-78, -97, -95, -96, 109, 110, 111, 98, 99, 106,
+	76, 112, -86, -84, -85, -87, 60, 59, 68, 61,
+	62, 63, 64, 69, 70, 71, -140, -93, -211, 45,
+	293, 294, 295, 296, 297, 46, 257, 258, 259, 260,
+	265, 261, 78, 35, 247, 255, 254, 253, 251, 252,
+	249, 250, 263, 264, 130, 248, 123, 104, 256, -124,
+	-124, 11, -65, -66, -71, -73, -146, -116, -154, 169,
+	-120, 237, 236, -141, -118, -140, -138, 235, 196, 234,
+	121, 273, 74, 22, 24, 218, 77, 109, 16, 78,
+	108, 257, 116, 49, 274, 249, 250, 247, 259, 260,
+	248, 224, 29, 10, 295, 25, 146, 21, 34, 102,
+	118, 81, 82, 150, 23, 147, 71, 278, 19, 52,
+	11, 13, 279, 280, 14, 130, 129, 93, 126, 47,
+	8, 112, 26, 90, 43, 281, 28, 296, 282, 283,
+	297, 45, 91, 17, 251, 252, 31, 284, 265, 154,
+	104, 50, 37, 75, 285, 286, 69, 287, 72, 53,
+	73, 15, 48, 291, 288, 294, 289, 92, 119, 256,
+	46, 293, 123, 6, 262, 30, 145, 44, 290, 124,
+	80, 263, 264, 128, 70, 5, 131, 32, 9, 51,
+	54, 253, 254, 255, 35, 79, 12, 292, 269, -186,
+	91, -179, 58, -71, 126, -71, 256, -133, 130, -133,
+	124, 124, -71, 58, 58, 116, 118, 121, 53, -27,
+	-71, 124, 58, -132, 130, -132, -132, -71, 113, -71,
+	58, 30, -130, 91, 12, 248, 58, 159, 124, 160,
+	127, -152, -211, -141, -152, -152, -152, 163, 164, -152,
+	-129, -128, 230, 231, 225, 229, 12, 164, 225, 162,
+	-152, -140, 172, -140, 61, -33, -2, -6, -16, -17,
+	-18, -152, -151, -151, 83, -151, -151, -212, 57, -109,
+	19, 31, -56, -146, -105, -106, -56, -104, -2, -43,
+	37, -47, 21, 34, 66, -8, -96, -211, -110, -140,
+	11, -143, 74, 73, 90, -142, 22, -140, 60, 113,
+	-56, -79, 93, 75, 91, 92, 77, 95, 94, 105,
+	98, 99, 100, 101, 102, 103, 104, 96, 97, 108,
+	83, 84, 85, 86, 87, 88, 89, -125, -211, -96,
+	114, 115, -82, -82, -82, -82, -82, -82, -82, -82,
+	-211, -2, -6, -91, -56, -211, -211, -211, -211, -211,
+	-211, -211, -211, -211, -211, -211, -211, -211, -211, -100,
+	-56, -211, -215, -88, -211, -215, -88, -215, -88, -215,
+	-211, -215, -88, -215, -88, -215, -215, -88, -211, -211,
+	-211, -211, -211, -211, -211, -72, 26, -71, -58, -59,
+	-60, -61, -74, -96, -211, -71, -71, -65, -213, 56,
+	11, 54, -213, 56, 113, 56, -116, 169, -117, -121,
+	238, 240, 83, -145, -140, 60, 29, 30, 57, 56,
+	-71, -157, -160, -162, -161, -163, -158, -159, 193, 194,
+	109, 197, 199, 200, 201, 202, 203, 204, 205, 206,
+	207, 208, 30, 149, 189, 190, 191, 192, 209, 210,
+	211, 212, 213, 214, 215, 216, 176, 195, 267, 177,
+	178, 179, 180, 181, 182, 184, 185, 186, 187, 188,
+	58, -152, 127, 58, 75, 58, -133, -71, -152, -152,
+	-152, 161, 161, 124, 124, 166, -71, 56, 128, -132,
+	53, -71, 23, 58, 58, -147, -146, -138, -152, -130,
+	60, -56, -152, -152, -152, -71, -152, -152, -152, -152,
+	11, -127, 11, 93, -56, -131, 91, 53, -140, -2,
+	-35, 58, 207, 9, 93, 56, 18, 113, 56, -107,
+	24, 25, -108, -212, -49, -83, -140, 61, 64, -48,
+	44, -212, 56, 113, -71, -56, -56, -89, 69, 75,
+	70, 71, -142, 100, -147, -141, -138, -82, -90, -93,
+	-96, 65, 93, 91, 92, 77, -82, -82, -82, -82,
+	-82, -82, -82, -82, -82, -82, -82, -82, -82, -82,
+	-82, -153, 58, 60, 58, -81, -81, -140, -54, 21,
+	34, -53, -55, -212, -2, 56, -212, -2, -53, -212,
+	-212, -212, -53, -53, -53, -56, -56, -97, 60, -53,
+	-97, 60, -53, -53, -47, -98, -99, 79, -97, -140,
+	-146, -212, -82, -140, -140, -53, -54, -54, -53, -53,
+	-112, 155, -71, 30, 56, -67, -69, -68, -70, 43,
+	47, 49, 44, 45, 46, 50, -150, 22, -58, -211,
+	-149, 155, -148, 22, -146, 60, -112, 54, -58, -71,
+	-58, -73, -146, 100, -120, -117, 56, 239, 241, 242,
+	53, 72, -56, -169, 108, -188, -189, -190, -141, 60,
+	61, -179, -180, -181, -191, 140, -196, 132, 134, 131,
+	-182, 141, 126, 28, 57, -175, 69, 75, -170, 221,
+	-164, 55, -164, -164, -164, -164, -168, 196, -168, -168,
+	-168, 55, 55, -164, -164, -164, -173, 55, -173, -173,
+	-174, 55, -174, -144, 54, -71, -152, 23, -152, -71,
+	-134, 121, 118, 119, -199, 117, 218, 196, 67, 29,
+	15, 257, 155, 272, 58, 156, -71, -71, -71, -71,
+	-71, 121, 118, -71, -71, -65, -71, -152, -71, -130,
+	-146, -146, 60, -71, 39, -56, -56, -147, -106, -109,
+	-123, 19, 11, 35, 35, -53, 22, -140, -140, 69,
+	70, 71, 113, -211, -90, -82, -82, -82, -52, 150,
+	74, -212, -212, -53, -53, 56, -212, -56, -212, -212,
+	-9, 291, -9, -9, -212, -212, -212, 56, 54, 22,
+	11, 11, -212, 11, 11, -212, -212, -53, -101, -99,
+	81, -56, -212, 113, -212, 56, 56, -212, -212, -212,
+	-212, -212, -80, 30, 35, -2, -211, -211, -115, -119,
+	-97, -59, -60, -60, -59, -60, 43, 43, 43, 48,
+	43, 48, 43, -68, -146, -212, -75, 51, 129, 52,
+	-211, -148, -76, 12, -58, -76, -76, 113, -121, -122,
+	243, 240, 246, 58, 60, 56, -190, 83, 55, 58,
+	28, -182, -182, -183, 58, -183, 28, -166, 29, 69,
+	-171, 222, 61, -168, -168, -169, 30, -169, -169, -169,
+	-178, 60, -178, 61, 61, 53, -140, -152, -151, -205,
+	137, 133, 140, 141, 135, 58, 126, 28, 132, 134,
+	155, 131, -205, -135, -136, 128, 22, 126, 28, 155,
+	-204, 54, 161, 218, 161, 128, -152, -127, 40, 113,
+	-71, -57, 11, -96, 113, 100, -141, -54, -52, 74,
+	-82, -82, -9, -212, -212, -55, -211, -140, -9, -9,
+	-156, 109, 193, 149, 191, 187, 207, 198, 220, 189,
+	221, -153, -156, -82, -82, -82, -82, 266, -104, 82,
+	-56, 80, -141, -82, -82, -114, 53, -115, -92, -94,
+	-93, -211, -2, -110, -113, -140, -76, 56, 83, -63,
+	-62, 53, 54, -64, 53, -62, 43, 43, 126, 126,
+	126, -113, -104, -56, -76, 240, 244, 245, -189, -190,
+	-193, -192, -140, -196, -183, -183, 55, -167, 53, -82,
+	57, -169, -169, 58, 109, 57, 56, 57, 56, 57,
+	56, -71, -151, -151, -71, -151, -140, -202, 269, -203,
+	58, -140, -140, -140, -71, -130, -76, -58, -140, -212,
+	-82, -10, -14, -140, 155, -212, -164, -164, -164, -174,
+	-164, 181, -164, 181, -212, -212, 19, 19, 19, 19,
+	-211, -51, 262, -56, 56, 56, 27, -114, 56, -212,
+	-212, -212, -212, 56, -104, -119, -56, -56, 55, -56,
+	-211, -211, -211, -212, -108, 57, 56, -164, -111, -140,
+	-176, 218, 9, -168, 60, -168, 61, 61, -152, 26,
+	-201, -200, -141, 55, 54, -102, 13, -212, -104, -14,
+	155, 16, -168, 58, -82, -82, -82, -82, -82, -108,
+	60, -82, -82, 28, -94, 35, -2, -211, -140, -108,
+	-111, -111, -212, -111, -111, -149, -195, -194, 54, 136,
+	67, -192, 57, 56, -177, 132, 28, 131, -85, -169,
+	-169, 57, 57, -211, 56, 83, -111, -71, -103, 14,
+	16, -104, -91, -212, -212, -212, -212, -50, 93, 269,
+	-212, -212, -212, 9, -92, -2, 57, -212, -212, -212,
+	-75, -194, 58, -184, 83, 60, 143, -140, -165, 67,
+	28, 28, -197, -198, 155, -200, -190, 57, -11, 292,
+	-56, -91, -212, 267, 50, 270, -115, -212, 61, -71,
+	60, -212, 56, -140, -204, -12, -13, -140, 40, 268,
+	271, 55, -198, 35, -202, 56, 22, 40, -111, 157,
+	-13, -211, 269, 57, 158, -10, 270, -207, -208, 53,
+	-211, -212, 271, -208, 53, 10, 9, -82, 154, -206,
+	144, 139, 142, 30, -206, -212, -212, 138, 29, 69,
 }
 var yyDef = [...]int{
 
-	23, -2, 2, -2, 5, 6, 7, 8, 9, 10,
+	28, -2, 2, -2, 0, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
-	21, 22, 566, 0, 319, 319, 319, 319, 319, 319,
-	0, 637, 620, 0, 0, 0, 0, -2, 306, 307,
-	0, 309, 310, 939, 939, 939, 939, 939, 0, 0,
-	939, 0, 35, 36, 937, 1, 3, 574, 0, 0,
-	323, 326, 321, 0, 620, 620, 0, 0, 65, 66,
-	0, 0, 0, 926, 0, 618, 618, 618, 638, 639,
-	642, 643, 768, 769, 770, 771, 772, 773, 774, 775,
-	776, 777, 778, 779, 780, 781, 782, 783, 784, 785,
-	786, 787, 788, 789, 790, 791, 792, 793, 794, 795,
-	796, 797, 798, 799, 800, 801, 802, 803, 804, 805,
-	806, 807, 808, 809, 810, 811, 812, 813, 814, 815,
-	816, 817, 818, 819, 820, 821, 822, 823, 824, 825,
-	826, 827, 828, 829, 830, 831, 832, 833, 834, 835,
-	836, 837, 838, 839, 840, 841, 842, 843, 844, 845,
-	846, 847, 848, 849, 850, 851, 852, 853, 854, 855,
-	856, 857, 858, 859, 860, 861, 862, 863, 864, 865,
-	866, 867, 868, 869, 870, 871, 872, 873, 874, 875,
-	876, 877, 878, 879, 880, 881, 882, 883, 884, 885,
-	886, 887, 888, 889, 890, 891, 892, 893, 894, 895,
-	896, 897, 898, 899, 900, 901, 902, 903, 904, 905,
-	906, 907, 908, 909, 910, 911, 912, 913, 914, 915,
-	916, 917, 918, 919, 920, 921, 922, 923, 924, 925,
-	927, 928, 929, 930, 931, 932, 933, 934, 935, 936,
-	0, 0, 0, 0, 0, 621, 0, 616, 0, 616,
-	616, 616, 0, 258, 392, 646, 647, 926, 0, 0,
-	0, 297, 0, 940, 270, 940, 940, 273, 940, 0,
-	940, 0, 280, 0, 0, 286, 940, 303, 304, 291,
-	305, 308, 311, 312, 313, 314, 315, 939, 939, 318,
-	29, 578, 0, 0, 566, 31, 0, 319, 324, 325,
-	329, 327, 328, 320, 0, 338, 342, 0, 402, 0,
-	407, 409, -2, -2, 0, 444, 445, 446, 447, 448,
-	0, 0, 0, 0, 0, 0, 0, 0, 472, 473,
-	474, 475, 551, 552, 553, 554, 555, 556, 557, 558,
-	411, 412, 548, 598, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 539, 0, 509, 509, 509, 509, 509,
-	509, 509, 509, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 44, 46, 392, 50, 0,
-	915, 602, -2, -2, 0, 0, 644, 645, -2, 781,
-	-2, 650, 651, 652, 653, 654, 655, 656, 657, 658,
-	659, 660, 661, 662, 663, 664, 665, 666, 667, 668,
-	669, 670, 671, 672, 673, 674, 675, 676, 677, 678,
-	679, 680, 681, 682, 683, 684, 685, 686, 687, 688,
-	689, 690, 691, 692, 693, 694, 695, 696, 697, 698,
-	699, 700, 701, 702, 703, 704, 705, 706, 707, 708,
-	709, 710, 711, 712, 713, 714, 715, 716, 717, 718,
-	719, 720, 721, 722, 723, 724, 725, 726, 727, 728,
-	729, 730, 731, 732, 733, 734, 735, 736, 737, 738,
-	739, 740, 741, 742, 743, 744, 745, 746, 747, 748,
-	749, 750, 751, 752, 753, 754, 755, 756, 757, 758,
-	759, 760, 761, 762, 763, 764, 765, 766, 767, 0,
-	0, 84, 0, 82, 0, 940, 0, 0, 0, 0,
-	0, 0, 940, 940, 940, 0, 0, 0, 0, 249,
-	0, 0, 0, 0, 0, 0, 0, 257, 0, 259,
-	940, 297, 262, 0, 0, 940, 940, 940, 0, 940,
-	940, 269, 941, 942, 271, 272, 274, 940, 940, 276,
-	0, 294, 292, 293, 288, 289, 0, 300, 283, 284,
-	287, 316, 317, 30, 938, 24, 0, 0, 575, 0,
-	567, 568, 571, 574, 29, 326, 0, 332, 330, 331,
-	322, 0, 339, 0, 0, 0, 343, 0, 345, 346,
-	0, 405, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 429, 430, 431, 432, 433, 434, 435, 408, 0,
-	422, 0, 0, 0, 464, 465, 466, 467, 468, 469,
-	470, 0, 334, 29, 0, 442, 0, 0, 0, 0,
-	0, 0, 0, 0, 329, 0, 540, 0, 493, 501,
-	0, 494, 502, 495, 503, 496, 0, 497, 504, 498,
-	505, 499, 500, 506, 0, 0, 0, 334, 334, 0,
-	0, 48, 0, 391, 0, 349, 351, 352, 353, -2,
-	0, 375, -2, 0, 0, 0, 42, 43, 0, 0,
-	0, 0, 51, 915, 53, 54, 0, 0, 0, 162,
-	611, 612, 613, 609, 206, 0, 0, 150, 146, 90,
-	91, 92, 139, 94, 139, 139, 139, 139, 159, 159,
-	159, 159, 122, 123, 124, 125, 126, 0, 0, 109,
-	139, 139, 139, 113, 129, 130, 131, 132, 133, 134,
-	135, 136, 95, 96, 97, 98, 99, 100, 101, 102,
-	103, 141, 141, 141, 143, 143, 640, 68, 0, 940,
-	0, 940, 80, 0, 220, 222, 223, 0, 0, 0,
-	0, 0, 0, 0, 0, 252, 617, 0, 940, 255,
-	256, 393, 648, 649, 260, 261, 298, 299, 263, 264,
-	265, 266, 267, 268, 275, 279, 0, 297, 0, 0,
-	281, 282, 0, 0, 579, 0, 0, 0, 0, 0,
-	570, 572, 573, 578, 32, 329, 0, 559, 0, 0,
-	0, 333, 27, 403, 404, 406, 423, 0, 425, 427,
-	344, 340, 0, 549, -2, 413, 414, 438, 439, 440,
-	0, 0, 0, 0, 436, 418, 0, 449, 450, 451,
-	452, 453, 454, 455, 456, 457, 458, 459, 460, 463,
-	524, 525, 0, 461, 462, 471, 0, 0, 0, 335,
-	336, 441, 0, 597, 29, 0, 0, 0, 0, 446,
-	551, 0, 446, 551, 0, 0, 0, 546, 543, 0,
-	0, 548, 0, 510, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 390, 0, 0, 0, 0, 0,
-	0, 380, 0, 0, 383, 0, 0, 0, 0, 374,
-	0, 0, 395, 860, 376, 0, 378, 379, 400, 0,
-	400, 45, 400, 47, 0, 394, 603, 52, 0, 0,
-	57, 58, 604, 605, 606, 607, 0, 81, 207, 209,
-	212, 213, 214, 85, 86, 87, 0, 0, 194, 0,
-	0, 188, 188, 0, 186, 187, 83, 153, 151, 0,
-	148, 147, 93, 0, 159, 159, 116, 117, 162, 0,
-	162, 162, 162, 0, 0, 110, 111, 112, 104, 0,
-	105, 106, 107, 0, 108, 0, 0, 940, 70, 619,
-	71, 939, 0, 0, 632, 221, 622, 623, 624, 625,
-	626, 627, 628, 629, 630, 631, 0, 72, 225, 227,
-	226, 230, 0, 0, 0, 250, 940, 254, 294, 278,
-	295, 296, 301, 285, 0, 576, 577, 0, 569, 25,
-	0, 614, 615, 560, 561, 347, 424, 426, 428, 0,
-	334, 415, 436, 419, 0, 416, 0, 0, 410, 476,
-	0, 0, 0, 443, -2, 480, 481, 0, 0, 0,
-	0, 0, 517, 0, 0, 518, 0, 566, 0, 544,
-	0, 0, 492, 0, 511, 0, 0, 512, 513, 514,
-	515, 516, 591, 0, 0, -2, 0, 0, 400, 599,
-	0, 350, 369, 371, 0, 366, 381, 382, 384, 0,
-	386, 0, 388, 389, 354, 356, 357, 0, 0, 0,
-	0, 377, 566, 0, 400, 40, 41, 0, 55, 56,
-	0, 0, 62, 163, 164, 0, 210, 0, 0, 0,
-	181, 188, 188, 184, 189, 185, 0, 155, 0, 152,
-	89, 149, 0, 162, 162, 118, 0, 119, 120, 121,
-	0, 137, 0, 0, 0, 0, 641, 69, 215, 939,
-	232, 233, 234, 235, 236, 237, 238, 239, 240, 241,
-	242, 243, 939, 0, 939, 633, 634, 635, 636, 0,
-	75, 0, 0, 0, 0, 0, 253, 297, 580, 0,
-	26, 400, 0, 341, 550, 0, 417, 0, 437, 420,
-	477, 478, 337, 0, 139, 139, 529, 139, 143, 532,
-	139, 534, 139, 537, 0, 0, 0, 0, 0, 0,
-	0, 541, 491, 547, 0, 549, 0, 0, 33, 0,
-	591, 581, 593, 595, 0, 29, 0, 587, 0, 361,
-	566, 0, 0, 363, 370, 0, 0, 364, 0, 365,
-	385, 387, 0, 0, 0, 0, 574, 401, 39, 59,
-	60, 61, 208, 211, 0, 190, 139, 193, 182, 183,
-	0, 157, 0, 154, 140, 114, 115, 160, 161, 159,
-	0, 159, 0, 144, 0, 940, 216, 217, 218, 219,
-	0, 224, 0, 73, 74, 0, 0, 229, 251, 277,
-	562, 348, 479, 421, 482, 526, 159, 530, 531, 533,
-	535, 536, 538, 484, 483, 0, 0, 0, 0, 0,
-	574, 0, 545, 0, 0, 0, 34, 0, 596, -2,
-	0, 0, 0, 49, 0, 574, 600, 601, 367, 0,
-	372, 0, 0, 0, 375, 38, 173, 0, 192, 0,
-	359, 165, 158, 0, 162, 138, 162, 0, 0, 67,
-	0, 76, 77, 0, 0, 0, 564, 0, 527, 528,
-	0, 0, 0, 0, 519, 0, 542, 0, 0, 0,
-	594, 0, -2, 0, 589, 588, 362, 37, 0, 0,
-	397, 0, 0, 395, 172, 174, 0, 179, 0, 191,
-	0, 0, 170, 0, 167, 169, 156, 127, 128, 142,
-	145, 0, 0, 0, 0, 231, 28, 0, 0, 485,
-	487, 486, 488, 0, 0, 0, 490, 507, 508, 0,
-	584, 29, 0, 368, 396, 398, 399, 358, 175, 176,
-	0, 180, 178, 0, 360, 88, 0, 166, 168, 0,
-	245, 0, 78, 79, 72, 565, 563, 489, 0, 0,
-	0, 592, -2, 590, 177, 0, 171, 244, 0, 0,
-	75, 520, 0, 523, 0, 246, 0, 228, 521, 0,
-	0, 0, 195, 0, 0, 196, 197, 0, 0, 522,
-	198, 0, 0, 0, 0, 0, 199, 201, 202, 0,
-	0, 200, 247, 248, 203, 204, 205,
+	21, 22, 23, 24, 25, 26, 27, 617, 0, 352,
+	0, 352, 352, 352, 352, 352, 0, -2, 671, 0,
+	92, 0, 0, -2, 319, 320, 0, 322, 323, 0,
+	0, 329, 336, 995, 995, 995, 995, 0, 0, 995,
+	0, 46, 47, 993, 1, 3, -2, 625, 0, 0,
+	356, 359, 354, 32, 0, 34, 0, 697, 698, 820,
+	821, 822, 823, 824, 825, 826, 827, 828, 829, 830,
+	831, 832, 833, 834, 835, 836, 837, 838, 839, 840,
+	841, 842, 843, 844, 845, 846, 847, 848, 849, 850,
+	851, 852, 853, 854, 855, 856, 857, 858, 859, 860,
+	861, 862, 863, 864, 865, 866, 867, 868, 869, 870,
+	871, 872, 873, 874, 875, 876, 877, 878, 879, 880,
+	881, 882, 883, 884, 885, 886, 887, 888, 889, 890,
+	891, 892, 893, 894, 895, 896, 897, 898, 899, 900,
+	901, 902, 903, 904, 905, 906, 907, 908, 909, 910,
+	911, 912, 913, 914, 915, 916, 917, 918, 919, 920,
+	921, 922, 923, 924, 925, 926, 927, 928, 929, 930,
+	931, 932, 933, 934, 935, 936, 937, 938, 939, 940,
+	941, 942, 943, 944, 945, 946, 947, 948, 949, 950,
+	951, 952, 953, 954, 955, 956, 957, 958, 959, 960,
+	961, 962, 963, 964, 965, 966, 967, 968, 969, 970,
+	971, 972, 973, 974, 975, 976, 977, 978, 979, 980,
+	981, 982, 983, 984, 985, 986, 987, 988, 989, 990,
+	991, 992, 0, 671, 671, 0, 0, 76, 77, 0,
+	0, 0, 983, 0, 669, 669, 0, 689, 690, -2,
+	693, 694, 0, 0, 0, 0, 0, 672, 0, 0,
+	0, 667, 667, 667, 93, 0, 271, 425, 0, 0,
+	0, 310, 0, 996, 283, 996, 996, 286, 996, 0,
+	996, 0, 293, 0, 0, 299, 996, 316, 317, 304,
+	318, 321, 0, 326, 0, 0, 330, 331, 0, 996,
+	995, 995, 861, 345, 346, 347, 348, 995, 995, 351,
+	40, 629, 0, 0, 617, 42, 0, 352, 357, 358,
+	362, 360, 361, 353, 0, 33, 0, 0, 0, 371,
+	375, 0, 435, 0, 440, 442, -2, -2, 0, 478,
+	479, 480, 481, 482, 0, 0, 0, 0, 0, 0,
+	0, 0, 506, 507, 508, 509, 591, 592, 593, 594,
+	595, 596, 597, 598, 444, 445, 588, 649, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 579, 0, 549, 549, 549, 549, 549, 549,
+	549, 549, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 55, 57, 425, 61, 0, 972,
+	653, -2, -2, 0, 0, 695, 696, -2, 833, -2,
+	701, 702, 703, 704, 705, 706, 707, 708, 709, 710,
+	711, 712, 713, 714, 715, 716, 717, 718, 719, 720,
+	721, 722, 723, 724, 725, 726, 727, 728, 729, 730,
+	731, 732, 733, 734, 735, 736, 737, 738, 739, 740,
+	741, 742, 743, 744, 745, 746, 747, 748, 749, 750,
+	751, 752, 753, 754, 755, 756, 757, 758, 759, 760,
+	761, 762, 763, 764, 765, 766, 767, 768, 769, 770,
+	771, 772, 773, 774, 775, 776, 777, 778, 779, 780,
+	781, 782, 783, 784, 785, 786, 787, 788, 789, 790,
+	791, 792, 793, 794, 795, 796, 797, 798, 799, 800,
+	801, 802, 803, 804, 805, 806, 807, 808, 809, 810,
+	811, 812, 813, 814, 815, 816, 817, 818, 819, 0,
+	0, 97, 0, 95, 0, 996, 0, 0, 0, 0,
+	669, 0, 996, 996, 996, 0, 0, 0, 0, 262,
+	0, 667, 0, 0, 0, 0, 0, 270, 0, 272,
+	996, 310, 275, 0, 0, 996, 996, 996, 0, 996,
+	996, 282, 997, 998, 284, 285, 287, 996, 996, 289,
+	0, 307, 305, 306, 301, 302, 0, 313, 296, 297,
+	300, 324, 945, 327, 328, 332, -2, 0, 342, 343,
+	344, 333, 334, 335, 0, 349, 350, 41, 994, 29,
+	0, 0, 626, 0, 618, 619, 622, 625, 40, 359,
+	0, 365, 363, 364, 355, 35, 36, 0, 0, 638,
+	0, 372, 0, 0, 0, 376, 0, 378, 379, 0,
+	438, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	462, 463, 464, 465, 466, 467, 468, 441, 0, 455,
+	0, 0, 498, 499, 500, 501, 502, 503, 504, 0,
+	367, 40, 0, 0, 476, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 362, 0,
+	580, 0, 533, 541, 0, 534, 542, 535, 543, 536,
+	0, 537, 544, 538, 545, 539, 540, 546, 0, 0,
+	0, 367, 367, 0, 0, 59, 0, 424, 0, 382,
+	384, 385, 386, -2, 0, 408, -2, 0, 0, 0,
+	53, 54, 0, 0, 0, 0, 62, 972, 64, 65,
+	0, 0, 0, 175, 662, 663, 664, 660, 219, 0,
+	0, 163, 159, 103, 104, 105, 152, 107, 152, 152,
+	152, 152, 172, 172, 172, 172, 135, 136, 137, 138,
+	139, 0, 0, 122, 152, 152, 152, 126, 142, 143,
+	144, 145, 146, 147, 148, 149, 108, 109, 110, 111,
+	112, 113, 114, 115, 116, 154, 154, 154, 156, 156,
+	691, 79, 0, 996, 0, 996, 0, 0, 233, 235,
+	236, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 996, 668, 268, 269, 426, 699, 700, 273, 274,
+	311, 312, 276, 277, 278, 279, 280, 281, 288, 292,
+	0, 310, 0, 0, 294, 295, 0, 0, 325, -2,
+	337, 338, 339, 630, 0, 0, 0, 0, 0, 621,
+	623, 624, 629, 43, 362, 0, 599, 0, 0, 0,
+	366, 0, 0, 0, 38, 436, 437, 439, 456, 0,
+	458, 460, 377, 373, 0, 589, -2, 446, 447, 471,
+	472, 473, 0, 0, 0, 0, 469, 451, 0, 483,
+	484, 485, 486, 487, 488, 489, 490, 491, 492, 493,
+	494, 497, 564, 565, 0, 495, 496, 505, 0, 0,
+	0, 368, 369, 474, 40, 0, 648, 40, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 480, 591, 0,
+	480, 591, 0, 0, 0, 586, 583, 0, 0, 588,
+	0, 550, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 423, 0, 0, 0, 0, 0, 0, 413,
+	0, 0, 416, 0, 0, 0, 0, 407, 0, 0,
+	428, 914, 409, 0, 411, 412, 433, 0, 433, 56,
+	433, 58, 0, 427, 654, 63, 0, 0, 68, 69,
+	655, 656, 657, 658, 0, 94, 220, 222, 225, 226,
+	227, 98, 99, 100, 0, 0, 207, 0, 0, 201,
+	201, 0, 199, 200, 96, 166, 164, 0, 161, 160,
+	106, 0, 172, 172, 129, 130, 175, 0, 175, 175,
+	175, 0, 0, 123, 124, 125, 117, 0, 118, 119,
+	120, 0, 121, 0, 0, 996, 81, 670, 82, 91,
+	995, 0, 0, 683, 234, 673, 674, 675, 676, 677,
+	678, 679, 680, 681, 682, 0, 83, 238, 240, 239,
+	243, 0, 0, 0, 263, 265, 996, 267, 307, 291,
+	308, 309, 314, 298, 0, 627, 628, 0, 620, 30,
+	0, 665, 666, 600, 601, 380, 0, 640, 639, 457,
+	459, 461, 0, 367, 448, 469, 452, 0, 449, 0,
+	0, 443, 510, 0, 0, 0, 475, 477, -2, 515,
+	516, 0, 517, 518, 0, 0, 521, 0, 0, 0,
+	0, 0, 557, 0, 0, 558, 0, 617, 0, 584,
+	0, 0, 532, 0, 551, 0, 0, 552, 553, 554,
+	555, 556, 642, 0, 0, -2, 0, 0, 433, 650,
+	0, 383, 402, 404, 0, 399, 414, 415, 417, 0,
+	419, 0, 421, 422, 387, 389, 390, 0, 0, 0,
+	0, 410, 617, 0, 433, 51, 52, 0, 66, 67,
+	0, 0, 73, 176, 177, 0, 223, 0, 0, 0,
+	194, 201, 201, 197, 202, 198, 0, 168, 0, 165,
+	102, 162, 0, 175, 175, 131, 0, 132, 133, 134,
+	0, 150, 0, 0, 0, 0, 692, 80, 228, 995,
+	245, 246, 247, 248, 249, 250, 251, 252, 253, 254,
+	255, 256, 995, 0, 995, 684, 685, 686, 687, 0,
+	86, 0, 0, 0, 0, 0, 266, 310, 631, 0,
+	31, 433, 0, 37, 0, 374, 590, 0, 450, 0,
+	470, 453, 511, 512, 513, 370, 615, 612, 519, 520,
+	0, 152, 152, 569, 152, 156, 572, 152, 574, 152,
+	577, 0, 0, 0, 0, 0, 0, 0, 581, 531,
+	587, 0, 589, 0, 0, 44, 0, 642, 632, 644,
+	646, 0, 40, 0, 0, 394, 617, 0, 0, 396,
+	403, 0, 0, 397, 0, 398, 418, 420, 0, 0,
+	0, 0, 625, 434, 50, 70, 71, 72, 221, 224,
+	0, 203, 152, 206, 195, 196, 0, 170, 0, 167,
+	153, 127, 128, 173, 174, 172, 0, 172, 0, 157,
+	0, 996, 229, 230, 231, 232, 0, 237, 0, 84,
+	85, 0, 0, 242, 264, 290, 602, 381, 641, 514,
+	454, 0, 617, 615, 914, 522, 566, 172, 570, 571,
+	573, 575, 576, 578, 524, 523, 0, 0, 0, 0,
+	0, 625, 0, 585, 0, 0, 0, 45, 0, 647,
+	-2, 0, 60, 0, 625, 651, 652, 400, 0, 405,
+	0, 0, 0, 408, 49, 186, 0, 205, 0, 392,
+	178, 171, 0, 175, 151, 175, 0, 0, 78, 0,
+	87, 88, 0, 0, 0, 604, 0, 611, 613, 617,
+	0, 0, 567, 568, 0, 0, 0, 0, 559, 0,
+	582, 0, 0, 0, 645, 0, -2, 0, 395, 48,
+	0, 0, 430, 0, 0, 428, 185, 187, 0, 192,
+	0, 204, 0, 0, 183, 0, 180, 182, 169, 140,
+	141, 155, 158, 0, 0, 0, 0, 244, 606, 0,
+	0, 614, 616, 525, 527, 526, 528, 0, 0, 0,
+	530, 547, 548, 0, 635, 40, 401, 429, 431, 432,
+	391, 188, 189, 0, 193, 191, 0, 393, 101, 0,
+	179, 181, 0, 258, 0, 89, 90, 83, 39, 0,
+	605, 603, 529, 0, 0, 0, 643, -2, 190, 0,
+	184, 257, 0, 0, 86, 607, 608, 0, 560, 0,
+	563, 0, 259, 0, 241, 0, 0, 561, 0, 0,
+	609, 615, 0, 208, 0, 0, 0, 209, 210, 0,
+	0, 610, 562, 211, 0, 0, 0, 0, 0, 212,
+	214, 215, 0, 0, 213, 260, 261, 216, 217, 218,
 }
 var yyTok1 = [...]int{
 
@@ -3273,7 +3595,7 @@ var yyTok1 = [...]int{
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 76, 3, 3, 3, 103, 95, 3,
 	55, 57, 100, 98, 56, 99, 113, 101, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 348,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 354,
 	84, 83, 85, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
@@ -3326,7 +3648,8 @@ var yyTok3 = [...]int{
 	57655, 330, 57656, 331, 57657, 332, 57658, 333, 57659, 334,
 	57660, 335, 57661, 336, 57662, 337, 57663, 338, 57664, 339,
 	57665, 340, 57666, 341, 57667, 342, 57668, 343, 57669, 344,
-	57670, 345, 57671, 346, 57672, 347, 0,
+	57670, 345, 57671, 346, 57672, 347, 57673, 348, 57674, 349,
+	57675, 350, 57676, 351, 57677, 352, 57678, 353, 0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -3668,35 +3991,42 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:324
+//line sql.y:349
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:329
+//line sql.y:354
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:330
+//line sql.y:355
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:334
+//line sql.y:359
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
-	case 23:
+	case 5:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:363
+		{
+			yyDollar[2].selStmt.SetWith(yyDollar[1].with)
+			yyVAL.statement = yyDollar[2].selStmt
+		}
+	case 28:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:356
+//line sql.y:390
 		{
 			setParseTree(yylex, nil)
 		}
-	case 24:
+	case 29:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:362
+//line sql.y:396
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -3704,57 +4034,93 @@ yydefault:
 			sel.Lock = yyDollar[4].str
 			yyVAL.selStmt = sel
 		}
-	case 25:
+	case 30:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:370
+//line sql.y:404
 		{
 			yyVAL.selStmt = &Union{Type: yyDollar[2].str, Left: yyDollar[1].selStmt, Right: yyDollar[3].selStmt, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].str}
 		}
-	case 26:
+	case 31:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:374
+//line sql.y:408
 		{
 			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, SelectExprs: SelectExprs{Nextval{Expr: yyDollar[5].expr}}, From: TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}}
 		}
-	case 27:
+	case 32:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:414
+		{
+			yyVAL.with = &With{CTEs: yyDollar[2].ctes}
+		}
+	case 33:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:418
+		{
+			yyVAL.with = &With{Recursive: true, CTEs: yyDollar[3].ctes}
+		}
+	case 34:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:424
+		{
+			yyVAL.ctes = []*CommonTableExpr{yyDollar[1].cte}
+		}
+	case 35:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:428
+		{
+			yyVAL.ctes = append(yyDollar[1].ctes, yyDollar[3].cte)
+		}
+	case 36:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:434
+		{
+			yyVAL.cte = &CommonTableExpr{Name: yyDollar[1].tableIdent, Subquery: yyDollar[3].subquery}
+		}
+	case 37:
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:438
+		{
+			yyVAL.cte = &CommonTableExpr{Name: yyDollar[1].tableIdent, Columns: yyDollar[3].columns, Subquery: yyDollar[6].subquery}
+		}
+	case 38:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:380
+//line sql.y:444
 		{
 			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
 		}
-	case 28:
-		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:387
+	case 39:
+		yyDollar = yyS[yypt-11 : yypt+1]
+//line sql.y:451
 		{
-			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr)}
+			yyVAL.selStmt = &Select{Comments: Comments(yyDollar[2].bytes2), Cache: yyDollar[3].str, Distinct: yyDollar[4].str, Hints: yyDollar[5].str, SelectExprs: yyDollar[6].selectExprs, From: yyDollar[7].tableExprs, Where: NewWhere(WhereStr, yyDollar[8].expr), GroupBy: GroupBy(yyDollar[9].exprs), Having: NewWhere(HavingStr, yyDollar[10].expr), Windows: yyDollar[11].namedWindows}
 		}
-	case 29:
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:393
+//line sql.y:457
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 30:
+	case 41:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:397
+//line sql.y:461
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 31:
+	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:403
+//line sql.y:467
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 32:
+	case 43:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:407
+//line sql.y:471
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 33:
+	case 44:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:414
+//line sql.y:478
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -3766,9 +4132,9 @@ yydefault:
 			ins.OnDup = OnDup(yyDollar[7].updateExprs)
 			yyVAL.statement = ins
 		}
-	case 34:
+	case 45:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:426
+//line sql.y:490
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -3778,334 +4144,346 @@ yydefault:
 			}
 			yyVAL.statement = &Insert{Action: yyDollar[1].str, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 35:
+	case 46:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:438
+//line sql.y:502
 		{
 			yyVAL.str = InsertStr
 		}
-	case 36:
+	case 47:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:442
+//line sql.y:506
 		{
 			yyVAL.str = ReplaceStr
 		}
-	case 37:
+	case 48:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:448
+//line sql.y:512
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].str, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].updateExprs, Where: NewWhere(WhereStr, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
-	case 38:
+	case 49:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:454
+//line sql.y:518
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[4].tableName}}, Partitions: yyDollar[5].partitions, Where: NewWhere(WhereStr, yyDollar[6].expr), OrderBy: yyDollar[7].orderBy, Limit: yyDollar[8].limit}
 		}
-	case 39:
+	case 50:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:458
+//line sql.y:522
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereStr, yyDollar[7].expr)}
 		}
-	case 40:
+	case 51:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:462
+//line sql.y:526
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 41:
+	case 52:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:466
+//line sql.y:530
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Targets: yyDollar[3].tableNames, TableExprs: yyDollar[5].tableExprs, Where: NewWhere(WhereStr, yyDollar[6].expr)}
 		}
-	case 42:
+	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:471
+//line sql.y:535
 		{
 		}
-	case 43:
+	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:472
+//line sql.y:536
 		{
 		}
-	case 44:
+	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:476
+//line sql.y:540
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 45:
+	case 56:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:480
+//line sql.y:544
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 46:
+	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:486
+//line sql.y:550
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 47:
+	case 58:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:490
+//line sql.y:554
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 48:
+	case 59:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:495
+//line sql.y:559
 		{
 			yyVAL.partitions = nil
 		}
-	case 49:
+	case 60:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:499
+//line sql.y:563
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
-	case 50:
+	case 61:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:505
+//line sql.y:569
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
-	case 51:
+	case 62:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:509
+//line sql.y:573
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[4].setExprs}
 		}
-	case 52:
+	case 63:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:513
+//line sql.y:577
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].str, Exprs: yyDollar[5].setExprs}
 		}
-	case 53:
+	case 64:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:517
+//line sql.y:581
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[4].setExprs}
 		}
-	case 54:
+	case 65:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:523
+//line sql.y:587
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 55:
+	case 66:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:527
+//line sql.y:591
 		{
 			yyVAL.setExprs = append(yyVAL.setExprs, yyDollar[3].setExpr)
 		}
-	case 56:
+	case 67:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:533
+//line sql.y:597
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(yyDollar[3].str))}
 		}
-	case 57:
+	case 68:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:537
+//line sql.y:601
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(TxReadWrite))}
 		}
-	case 58:
+	case 69:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:541
+//line sql.y:605
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(TransactionStr), Expr: NewStrVal([]byte(TxReadOnly))}
 		}
-	case 59:
+	case 70:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:547
+//line sql.y:611
 		{
 			yyVAL.str = IsolationLevelRepeatableRead
 		}
-	case 60:
+	case 71:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:551
+//line sql.y:615
 		{
 			yyVAL.str = IsolationLevelReadCommitted
 		}
-	case 61:
+	case 72:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:555
+//line sql.y:619
 		{
 			yyVAL.str = IsolationLevelReadUncommitted
 		}
-	case 62:
+	case 73:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:559
+//line sql.y:623
 		{
 			yyVAL.str = IsolationLevelSerializable
 		}
-	case 63:
+	case 74:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:565
+//line sql.y:629
 		{
 			yyVAL.str = SessionStr
 		}
-	case 64:
+	case 75:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:569
+//line sql.y:633
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 65:
+	case 76:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:575
+//line sql.y:639
 		{
 			yyDollar[1].ddl.TableSpec = yyDollar[2].TableSpec
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 66:
+	case 77:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:580
+//line sql.y:644
 		{
 			// Create table [name] like [name]
 			yyDollar[1].ddl.OptLike = yyDollar[2].optLike
 			yyVAL.statement = yyDollar[1].ddl
 		}
-	case 67:
+	case 78:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:586
+//line sql.y:650
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[7].tableName}
 		}
-	case 68:
+	case 79:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:591
+//line sql.y:655
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 69:
+	case 80:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:595
+//line sql.y:659
 		{
 			yyVAL.statement = &DDL{Action: CreateStr, Table: yyDollar[5].tableName.ToViewName()}
 		}
-	case 70:
+	case 81:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:599
+//line sql.y:663
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 71:
+	case 82:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:603
+//line sql.y:667
 		{
 			yyVAL.statement = &DBDDL{Action: CreateStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 72:
+	case 83:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:608
+//line sql.y:672
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 73:
+	case 84:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:612
+//line sql.y:676
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 74:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:618
+//line sql.y:682
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 75:
+	case 86:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:623
+//line sql.y:687
 		{
 			var v []VindexParam
 			yyVAL.vindexParams = v
 		}
-	case 76:
+	case 87:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:628
+//line sql.y:692
 		{
 			yyVAL.vindexParams = yyDollar[2].vindexParams
 		}
-	case 77:
+	case 88:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:634
+//line sql.y:698
 		{
 			yyVAL.vindexParams = make([]VindexParam, 0, 4)
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
 		}
-	case 78:
+	case 89:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:639
+//line sql.y:703
 		{
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
-	case 79:
+	case 90:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:645
+//line sql.y:709
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 80:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:651
+	case 91:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:715
 		{
-			yyVAL.ddl = &DDL{Action: CreateStr, Table: yyDollar[4].tableName}
+			yyVAL.ddl = &DDL{Action: CreateStr, Table: yyDollar[5].tableName, Temporary: bool(yyDollar[2].boolVal)}
 			setDDL(yylex, yyVAL.ddl)
 		}
-	case 81:
+	case 92:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:721
+		{
+			yyVAL.boolVal = BoolVal(false)
+		}
+	case 93:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:725
+		{
+			yyVAL.boolVal = BoolVal(true)
+		}
+	case 94:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:658
+//line sql.y:731
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].str
 		}
-	case 82:
+	case 95:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:665
+//line sql.y:738
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
-	case 83:
+	case 96:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:669
+//line sql.y:742
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
-	case 84:
+	case 97:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:675
+//line sql.y:748
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 85:
+	case 98:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:680
+//line sql.y:753
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
-	case 86:
+	case 99:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:684
+//line sql.y:757
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
-	case 87:
+	case 100:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:688
+//line sql.y:761
 		{
 			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
 		}
-	case 88:
+	case 101:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:694
+//line sql.y:767
 		{
 			yyDollar[2].columnType.NotNull = yyDollar[3].boolVal
 			yyDollar[2].columnType.Default = yyDollar[4].optVal
@@ -4115,826 +4493,826 @@ yydefault:
 			yyDollar[2].columnType.Comment = yyDollar[8].sqlVal
 			yyVAL.columnDefinition = &ColumnDefinition{Name: NewColIdent(string(yyDollar[1].bytes)), Type: yyDollar[2].columnType}
 		}
-	case 89:
+	case 102:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:705
+//line sql.y:778
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolVal
 			yyVAL.columnType.Zerofill = yyDollar[3].boolVal
 		}
-	case 93:
+	case 106:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:716
+//line sql.y:789
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].sqlVal
 		}
-	case 94:
+	case 107:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:721
+//line sql.y:794
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 95:
+	case 108:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:727
+//line sql.y:800
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 96:
+	case 109:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:731
+//line sql.y:804
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 97:
+	case 110:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:735
+//line sql.y:808
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 98:
+	case 111:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:739
+//line sql.y:812
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 99:
+	case 112:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:743
+//line sql.y:816
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 100:
+	case 113:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:747
+//line sql.y:820
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 101:
+	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:751
+//line sql.y:824
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 102:
+	case 115:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:755
+//line sql.y:828
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 103:
+	case 116:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:759
+//line sql.y:832
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 104:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:765
+//line sql.y:838
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 105:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:771
+//line sql.y:844
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 106:
+	case 119:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:777
+//line sql.y:850
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 107:
+	case 120:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:783
+//line sql.y:856
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 108:
+	case 121:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:789
+//line sql.y:862
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 109:
+	case 122:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:797
+//line sql.y:870
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 110:
+	case 123:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:801
+//line sql.y:874
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 111:
+	case 124:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:805
+//line sql.y:878
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 112:
+	case 125:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:809
+//line sql.y:882
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 113:
+	case 126:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:813
+//line sql.y:886
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 114:
+	case 127:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:819
+//line sql.y:892
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 115:
+	case 128:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:823
+//line sql.y:896
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 116:
+	case 129:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:827
+//line sql.y:900
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 117:
+	case 130:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:831
+//line sql.y:904
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 118:
+	case 131:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:835
+//line sql.y:908
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 119:
+	case 132:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:839
+//line sql.y:912
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 120:
+	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:843
+//line sql.y:916
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 121:
+	case 134:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:847
+//line sql.y:920
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 122:
+	case 135:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:851
+//line sql.y:924
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 123:
+	case 136:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:855
+//line sql.y:928
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 124:
+	case 137:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:859
+//line sql.y:932
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 125:
+	case 138:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:863
+//line sql.y:936
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 126:
+	case 139:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:867
+//line sql.y:940
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 127:
+	case 140:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:871
+//line sql.y:944
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 128:
+	case 141:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:876
+//line sql.y:949
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 129:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:882
+//line sql.y:955
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 130:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:886
+//line sql.y:959
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 131:
+	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:890
+//line sql.y:963
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 132:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:894
+//line sql.y:967
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 133:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:898
+//line sql.y:971
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 134:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:902
+//line sql.y:975
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 135:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:906
+//line sql.y:979
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 136:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:910
+//line sql.y:983
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 137:
+	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:916
+//line sql.y:989
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 138:
+	case 151:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:921
+//line sql.y:994
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 139:
+	case 152:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:926
+//line sql.y:999
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 140:
+	case 153:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:930
+//line sql.y:1003
 		{
 			yyVAL.sqlVal = NewIntVal(yyDollar[2].bytes)
 		}
-	case 141:
+	case 154:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:935
+//line sql.y:1008
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 142:
+	case 155:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:939
+//line sql.y:1012
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 143:
+	case 156:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:947
+//line sql.y:1020
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 144:
+	case 157:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:951
+//line sql.y:1024
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 			}
 		}
-	case 145:
+	case 158:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:957
+//line sql.y:1030
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntVal(yyDollar[2].bytes),
 				Scale:  NewIntVal(yyDollar[4].bytes),
 			}
 		}
-	case 146:
+	case 159:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:965
+//line sql.y:1038
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 147:
+	case 160:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:969
+//line sql.y:1042
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 148:
+	case 161:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:974
+//line sql.y:1047
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 149:
+	case 162:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:978
+//line sql.y:1051
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 150:
+	case 163:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:984
+//line sql.y:1057
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 151:
+	case 164:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:988
+//line sql.y:1061
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 152:
+	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:992
+//line sql.y:1065
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 153:
+	case 166:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:997
+//line sql.y:1070
 		{
 			yyVAL.optVal = nil
 		}
-	case 154:
+	case 167:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1001
+//line sql.y:1074
 		{
 			yyVAL.optVal = yyDollar[2].expr
 		}
-	case 155:
+	case 168:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1006
+//line sql.y:1079
 		{
 			yyVAL.optVal = nil
 		}
-	case 156:
+	case 169:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1010
+//line sql.y:1083
 		{
 			yyVAL.optVal = yyDollar[3].expr
 		}
-	case 157:
+	case 170:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1015
+//line sql.y:1088
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 158:
+	case 171:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1019
+//line sql.y:1092
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 159:
+	case 172:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1024
+//line sql.y:1097
 		{
 			yyVAL.str = ""
 		}
-	case 160:
+	case 173:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1028
+//line sql.y:1101
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 161:
+	case 174:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1032
+//line sql.y:1105
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 162:
+	case 175:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1037
+//line sql.y:1110
 		{
 			yyVAL.str = ""
 		}
-	case 163:
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1041
+//line sql.y:1114
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 164:
+	case 177:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1045
+//line sql.y:1118
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 165:
+	case 178:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1050
+//line sql.y:1123
 		{
 			yyVAL.colKeyOpt = colKeyNone
 		}
-	case 166:
+	case 179:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1054
+//line sql.y:1127
 		{
 			yyVAL.colKeyOpt = colKeyPrimary
 		}
-	case 167:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1058
+//line sql.y:1131
 		{
 			yyVAL.colKeyOpt = colKey
 		}
-	case 168:
+	case 181:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1062
+//line sql.y:1135
 		{
 			yyVAL.colKeyOpt = colKeyUniqueKey
 		}
-	case 169:
+	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1066
+//line sql.y:1139
 		{
 			yyVAL.colKeyOpt = colKeyUnique
 		}
-	case 170:
+	case 183:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1071
+//line sql.y:1144
 		{
 			yyVAL.sqlVal = nil
 		}
-	case 171:
+	case 184:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1075
+//line sql.y:1148
 		{
 			yyVAL.sqlVal = NewStrVal(yyDollar[2].bytes)
 		}
-	case 172:
+	case 185:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1081
+//line sql.y:1154
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
 		}
-	case 173:
+	case 186:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1085
+//line sql.y:1158
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns}
 		}
-	case 174:
+	case 187:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1091
+//line sql.y:1164
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 175:
+	case 188:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1095
+//line sql.y:1168
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
-	case 176:
+	case 189:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1101
+//line sql.y:1174
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Using: string(yyDollar[2].bytes)}
 		}
-	case 177:
+	case 190:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1105
+//line sql.y:1178
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntVal(yyDollar[3].bytes)}
 		}
-	case 178:
+	case 191:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1110
+//line sql.y:1183
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrVal(yyDollar[2].bytes)}
 		}
-	case 179:
+	case 192:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1116
+//line sql.y:1189
 		{
 			yyVAL.str = ""
 		}
-	case 180:
+	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1120
+//line sql.y:1193
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 181:
+	case 194:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1126
+//line sql.y:1199
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 182:
+	case 195:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1130
+//line sql.y:1203
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
-	case 183:
+	case 196:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1134
+//line sql.y:1207
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Unique: true}
 		}
-	case 184:
+	case 197:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1138
+//line sql.y:1211
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes), Name: NewColIdent(yyDollar[2].str), Unique: true}
 		}
-	case 185:
+	case 198:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1142
+//line sql.y:1215
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
-	case 186:
+	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1148
+//line sql.y:1221
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 187:
+	case 200:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1152
+//line sql.y:1225
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 188:
+	case 201:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1157
+//line sql.y:1230
 		{
 			yyVAL.str = ""
 		}
-	case 189:
+	case 202:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1161
+//line sql.y:1234
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 190:
+	case 203:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1167
+//line sql.y:1240
 		{
 			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 191:
+	case 204:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1171
+//line sql.y:1244
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 192:
+	case 205:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1177
+//line sql.y:1250
 		{
 			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].sqlVal}
 		}
-	case 193:
+	case 206:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1183
+//line sql.y:1256
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].bytes), Details: yyDollar[3].constraintInfo}
 		}
-	case 194:
+	case 207:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1187
+//line sql.y:1260
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
-	case 195:
+	case 208:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:1194
+//line sql.y:1267
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
 		}
-	case 196:
+	case 209:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1198
+//line sql.y:1271
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
 		}
-	case 197:
+	case 210:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1202
+//line sql.y:1275
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
 		}
-	case 198:
+	case 211:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1206
+//line sql.y:1279
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
 		}
-	case 199:
+	case 212:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1212
+//line sql.y:1285
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 200:
+	case 213:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1218
+//line sql.y:1291
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 201:
+	case 214:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1224
+//line sql.y:1297
 		{
 			yyVAL.ReferenceAction = Restrict
 		}
-	case 202:
+	case 215:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1228
+//line sql.y:1301
 		{
 			yyVAL.ReferenceAction = Cascade
 		}
-	case 203:
+	case 216:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1232
+//line sql.y:1305
 		{
 			yyVAL.ReferenceAction = NoAction
 		}
-	case 204:
+	case 217:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1236
+//line sql.y:1309
 		{
 			yyVAL.ReferenceAction = SetDefault
 		}
-	case 205:
+	case 218:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1240
+//line sql.y:1313
 		{
 			yyVAL.ReferenceAction = SetNull
 		}
-	case 206:
+	case 219:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1245
+//line sql.y:1318
 		{
 			yyVAL.str = ""
 		}
-	case 207:
+	case 220:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1249
+//line sql.y:1322
 		{
 			yyVAL.str = " " + string(yyDollar[1].str)
 		}
-	case 208:
+	case 221:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1253
+//line sql.y:1326
 		{
 			yyVAL.str = string(yyDollar[1].str) + ", " + string(yyDollar[3].str)
 		}
-	case 209:
+	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1261
+//line sql.y:1334
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 210:
+	case 223:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1265
+//line sql.y:1338
 		{
 			yyVAL.str = yyDollar[1].str + " " + yyDollar[2].str
 		}
-	case 211:
+	case 224:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1269
+//line sql.y:1342
 		{
 			yyVAL.str = yyDollar[1].str + "=" + yyDollar[3].str
 		}
-	case 212:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1275
+//line sql.y:1348
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 213:
+	case 226:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1279
+//line sql.y:1352
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 214:
+	case 227:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1283
+//line sql.y:1356
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 215:
+	case 228:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1289
+//line sql.y:1362
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 216:
+	case 229:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1293
+//line sql.y:1366
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 217:
+	case 230:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1297
+//line sql.y:1370
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 218:
+	case 231:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1301
+//line sql.y:1374
 		{
 			// Change this to a rename statement
 			yyVAL.statement = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[4].tableName}, ToTables: TableNames{yyDollar[7].tableName}}
 		}
-	case 219:
+	case 232:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1306
+//line sql.y:1379
 		{
 			// Rename an index can just be an alter
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName}
 		}
-	case 220:
+	case 233:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1311
+//line sql.y:1384
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName.ToViewName()}
 		}
-	case 221:
+	case 234:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1315
+//line sql.y:1388
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[4].tableName, PartitionSpec: yyDollar[5].partSpec}
 		}
-	case 222:
+	case 235:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1319
+//line sql.y:1392
 		{
 			yyVAL.statement = &DBDDL{Action: AlterStr, DBName: string(yyDollar[3].bytes)}
 		}
-	case 223:
+	case 236:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1323
+//line sql.y:1396
 		{
 			yyVAL.statement = &DBDDL{Action: AlterStr, DBName: string(yyDollar[3].bytes)}
 		}
-	case 224:
+	case 237:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1327
+//line sql.y:1400
 		{
 			yyVAL.statement = &DDL{
 				Action: CreateVindexStr,
@@ -4946,9 +5324,9 @@ yydefault:
 				},
 			}
 		}
-	case 225:
+	case 238:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1339
+//line sql.y:1412
 		{
 			yyVAL.statement = &DDL{
 				Action: DropVindexStr,
@@ -4958,21 +5336,21 @@ yydefault:
 				},
 			}
 		}
-	case 226:
+	case 239:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1349
+//line sql.y:1422
 		{
 			yyVAL.statement = &DDL{Action: AddVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 227:
+	case 240:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1353
+//line sql.y:1426
 		{
 			yyVAL.statement = &DDL{Action: DropVschemaTableStr, Table: yyDollar[5].tableName}
 		}
-	case 228:
+	case 241:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1357
+//line sql.y:1430
 		{
 			yyVAL.statement = &DDL{
 				Action: AddColVindexStr,
@@ -4985,9 +5363,9 @@ yydefault:
 				VindexCols: yyDollar[9].columns,
 			}
 		}
-	case 229:
+	case 242:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1370
+//line sql.y:1443
 		{
 			yyVAL.statement = &DDL{
 				Action: DropColVindexStr,
@@ -4997,15 +5375,15 @@ yydefault:
 				},
 			}
 		}
-	case 230:
+	case 243:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1380
+//line sql.y:1453
 		{
 			yyVAL.statement = &DDL{Action: AddSequenceStr, Table: yyDollar[5].tableName}
 		}
-	case 231:
+	case 244:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:1384
+//line sql.y:1457
 		{
 			yyVAL.statement = &DDL{
 				Action: AddAutoIncStr,
@@ -5016,76 +5394,76 @@ yydefault:
 				},
 			}
 		}
-	case 244:
+	case 257:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1411
+//line sql.y:1484
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeStr, Name: yyDollar[3].colIdent, Definitions: yyDollar[6].partDefs}
 		}
-	case 245:
+	case 258:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1417
+//line sql.y:1490
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 246:
+	case 259:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1421
+//line sql.y:1494
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 247:
+	case 260:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1427
+//line sql.y:1500
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 248:
+	case 261:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:1431
+//line sql.y:1504
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 249:
+	case 262:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1437
+//line sql.y:1510
 		{
 			yyVAL.statement = yyDollar[3].ddl
 		}
-	case 250:
+	case 263:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1443
+//line sql.y:1516
 		{
 			yyVAL.ddl = &DDL{Action: RenameStr, FromTables: TableNames{yyDollar[1].tableName}, ToTables: TableNames{yyDollar[3].tableName}}
 		}
-	case 251:
+	case 264:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1447
+//line sql.y:1520
 		{
 			yyVAL.ddl = yyDollar[1].ddl
 			yyVAL.ddl.FromTables = append(yyVAL.ddl.FromTables, yyDollar[3].tableName)
 			yyVAL.ddl.ToTables = append(yyVAL.ddl.ToTables, yyDollar[5].tableName)
 		}
-	case 252:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1455
+	case 265:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:1528
 		{
 			var exists bool
-			if yyDollar[3].byt != 0 {
+			if yyDollar[4].byt != 0 {
 				exists = true
 			}
-			yyVAL.statement = &DDL{Action: DropStr, FromTables: yyDollar[4].tableNames, IfExists: exists}
+			yyVAL.statement = &DDL{Action: DropStr, FromTables: yyDollar[5].tableNames, IfExists: exists, Temporary: bool(yyDollar[2].boolVal)}
 		}
-	case 253:
+	case 266:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1463
+//line sql.y:1536
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[5].tableName}
 		}
-	case 254:
+	case 267:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1468
+//line sql.y:1541
 		{
 			var exists bool
 			if yyDollar[3].byt != 0 {
@@ -5093,804 +5471,907 @@ yydefault:
 			}
 			yyVAL.statement = &DDL{Action: DropStr, FromTables: TableNames{yyDollar[4].tableName.ToViewName()}, IfExists: exists}
 		}
-	case 255:
+	case 268:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1476
+//line sql.y:1549
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 256:
+	case 269:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1480
+//line sql.y:1553
 		{
 			yyVAL.statement = &DBDDL{Action: DropStr, DBName: string(yyDollar[4].bytes)}
 		}
-	case 257:
+	case 270:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1486
+//line sql.y:1559
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[3].tableName}
 		}
-	case 258:
+	case 271:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1490
+//line sql.y:1563
 		{
 			yyVAL.statement = &DDL{Action: TruncateStr, Table: yyDollar[2].tableName}
 		}
-	case 259:
+	case 272:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1495
+//line sql.y:1568
 		{
 			yyVAL.statement = &DDL{Action: AlterStr, Table: yyDollar[3].tableName}
 		}
-	case 260:
+	case 273:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1501
+//line sql.y:1574
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 261:
+	case 274:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1506
+//line sql.y:1579
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilter}
 			yyVAL.statement = &Show{Type: CharsetStr, ShowTablesOpt: showTablesOpt}
 		}
-	case 262:
+	case 275:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1511
+//line sql.y:1584
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilter}
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowTablesOpt: showTablesOpt}
 		}
-	case 263:
+	case 276:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1516
+//line sql.y:1589
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 264:
+	case 277:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1521
+//line sql.y:1594
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 265:
+	case 278:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1525
+//line sql.y:1598
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 266:
+	case 279:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1529
+//line sql.y:1602
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName}
 		}
-	case 267:
+	case 280:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1533
+//line sql.y:1606
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 268:
+	case 281:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1537
+//line sql.y:1610
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 269:
+	case 282:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1541
+//line sql.y:1614
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 270:
+	case 283:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1545
+//line sql.y:1618
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 271:
+	case 284:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1549
+//line sql.y:1622
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 272:
+	case 285:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1553
+//line sql.y:1626
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 273:
+	case 286:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1557
+//line sql.y:1630
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 274:
+	case 287:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1561
+//line sql.y:1634
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 275:
+	case 288:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1565
+//line sql.y:1638
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 276:
+	case 289:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1569
+//line sql.y:1642
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 277:
+	case 290:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1573
+//line sql.y:1646
 		{
 			showTablesOpt := &ShowTablesOpt{Full: yyDollar[2].str, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
 			yyVAL.statement = &Show{Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName}
 		}
-	case 278:
+	case 291:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1578
+//line sql.y:1651
 		{
 			// this is ugly, but I couldn't find a better way for now
 			if yyDollar[3].str == "processlist" {
-				yyVAL.statement = &Show{Type: yyDollar[3].str}
+				yyVAL.statement = &Show{Type: yyDollar[3].str, ShowTablesOpt: &ShowTablesOpt{Full: yyDollar[2].str}}
 			} else {
 				showTablesOpt := &ShowTablesOpt{Full: yyDollar[2].str, DbName: yyDollar[4].str, Filter: yyDollar[5].showFilter}
 				yyVAL.statement = &Show{Type: yyDollar[3].str, ShowTablesOpt: showTablesOpt}
 			}
 		}
-	case 279:
+	case 292:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1588
+//line sql.y:1661
 		{
 			yyVAL.statement = &Show{Scope: yyDollar[2].str, Type: string(yyDollar[3].bytes)}
 		}
-	case 280:
+	case 293:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1592
+//line sql.y:1665
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 281:
+	case 294:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1596
+//line sql.y:1669
 		{
 			// Cannot dereference $4 directly, or else the parser stackcannot be pooled. See yyParsePooled
 			showCollationFilterOpt := yyDollar[4].expr
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes), ShowCollationFilterOpt: &showCollationFilterOpt}
 		}
-	case 282:
+	case 295:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1602
+//line sql.y:1675
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilter}
 			yyVAL.statement = &Show{Scope: string(yyDollar[2].bytes), Type: string(yyDollar[3].bytes), ShowTablesOpt: showTablesOpt}
 		}
-	case 283:
+	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1607
+//line sql.y:1680
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 284:
+	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1611
+//line sql.y:1684
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes)}
 		}
-	case 285:
+	case 298:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1615
+//line sql.y:1688
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), OnTable: yyDollar[5].tableName}
 		}
-	case 286:
+	case 299:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1619
+//line sql.y:1692
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 287:
+	case 300:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1633
+//line sql.y:1706
 		{
 			yyVAL.statement = &Show{Type: string(yyDollar[2].bytes)}
 		}
-	case 288:
+	case 301:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1639
+//line sql.y:1712
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 289:
+	case 302:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1643
+//line sql.y:1716
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 290:
+	case 303:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1649
+//line sql.y:1722
 		{
 			yyVAL.str = ""
 		}
-	case 291:
+	case 304:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1653
+//line sql.y:1726
 		{
 			yyVAL.str = "full "
 		}
-	case 292:
+	case 305:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1659
+//line sql.y:1732
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 293:
+	case 306:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1663
+//line sql.y:1736
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 294:
+	case 307:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1669
+//line sql.y:1742
 		{
 			yyVAL.str = ""
 		}
-	case 295:
+	case 308:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1673
+//line sql.y:1746
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 296:
+	case 309:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1677
+//line sql.y:1750
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 297:
+	case 310:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1683
+//line sql.y:1756
 		{
 			yyVAL.showFilter = nil
 		}
-	case 298:
+	case 311:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1687
+//line sql.y:1760
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 299:
+	case 312:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1691
+//line sql.y:1764
 		{
 			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 300:
+	case 313:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1697
+//line sql.y:1770
 		{
 			yyVAL.showFilter = nil
 		}
-	case 301:
+	case 314:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1701
+//line sql.y:1774
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 302:
+	case 315:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1707
+//line sql.y:1780
 		{
 			yyVAL.str = ""
 		}
-	case 303:
+	case 316:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1711
+//line sql.y:1784
 		{
 			yyVAL.str = SessionStr
 		}
-	case 304:
+	case 317:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1715
+//line sql.y:1788
 		{
 			yyVAL.str = GlobalStr
 		}
-	case 305:
+	case 318:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1721
+//line sql.y:1794
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 306:
+	case 319:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1725
+//line sql.y:1798
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 307:
+	case 320:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1731
+//line sql.y:1804
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 308:
+	case 321:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1735
+//line sql.y:1808
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 309:
+	case 322:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1741
+//line sql.y:1814
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 310:
+	case 323:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1747
+//line sql.y:1820
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 311:
+	case 324:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1824
+		{
+			yyVAL.statement = &SRollback{Name: yyDollar[3].colIdent}
+		}
+	case 325:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1828
+		{
+			yyVAL.statement = &SRollback{Name: yyDollar[4].colIdent}
+		}
+	case 326:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1753
+//line sql.y:1834
+		{
+			yyVAL.statement = &Savepoint{Name: yyDollar[2].colIdent}
+		}
+	case 327:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1840
+		{
+			yyVAL.statement = &Release{Name: yyDollar[3].colIdent}
+		}
+	case 328:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1846
+		{
+			yyVAL.statement = &Kill{Type: yyDollar[2].str, ID: NewIntVal(yyDollar[3].bytes)}
+		}
+	case 329:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1852
+		{
+			yyVAL.str = KillConnectionStr
+		}
+	case 330:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1856
+		{
+			yyVAL.str = KillConnectionStr
+		}
+	case 331:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1860
+		{
+			yyVAL.str = KillQueryStr
+		}
+	case 332:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1866
+		{
+			yyVAL.statement = &Explain{Type: yyDollar[2].str, Statement: yyDollar[3].statement}
+		}
+	case 333:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1870
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 312:
+	case 334:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1874
+		{
+			yyVAL.statement = &OtherRead{}
+		}
+	case 335:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1878
+		{
+			yyVAL.statement = &OtherRead{}
+		}
+	case 336:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:1883
+		{
+			yyVAL.str = ""
+		}
+	case 337:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1887
+		{
+			yyVAL.str = yyDollar[3].str
+		}
+	case 338:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1893
+		{
+			yyVAL.str = strings.ToLower(string(yyDollar[1].bytes))
+		}
+	case 339:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1897
+		{
+			yyVAL.str = strings.ToLower(string(yyDollar[1].bytes))
+		}
+	case 340:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1903
+		{
+			yyVAL.statement = yyDollar[1].selStmt
+		}
+	case 341:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1907
+		{
+			yyDollar[2].selStmt.SetWith(yyDollar[1].with)
+			yyVAL.statement = yyDollar[2].selStmt
+		}
+	case 345:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1757
+//line sql.y:1917
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 313:
+	case 346:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1761
+//line sql.y:1921
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 314:
+	case 347:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1765
+//line sql.y:1925
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 315:
+	case 348:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1769
+//line sql.y:1929
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 316:
+	case 349:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1773
+//line sql.y:1933
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 317:
+	case 350:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1777
+//line sql.y:1937
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 318:
+	case 351:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1783
+//line sql.y:1943
 		{
 			yyVAL.statement = &DDL{Action: FlushStr}
 		}
-	case 319:
+	case 352:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1787
+//line sql.y:1947
 		{
 			setAllowComments(yylex, true)
 		}
-	case 320:
+	case 353:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1791
+//line sql.y:1951
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 321:
+	case 354:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1797
+//line sql.y:1957
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 322:
+	case 355:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1801
+//line sql.y:1961
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 323:
+	case 356:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1807
+//line sql.y:1967
 		{
 			yyVAL.str = UnionStr
 		}
-	case 324:
+	case 357:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1811
+//line sql.y:1971
 		{
 			yyVAL.str = UnionAllStr
 		}
-	case 325:
+	case 358:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1815
+//line sql.y:1975
 		{
 			yyVAL.str = UnionDistinctStr
 		}
-	case 326:
+	case 359:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1820
+//line sql.y:1980
 		{
 			yyVAL.str = ""
 		}
-	case 327:
+	case 360:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1824
+//line sql.y:1984
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 328:
+	case 361:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1828
+//line sql.y:1988
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 329:
+	case 362:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1833
+//line sql.y:1993
 		{
 			yyVAL.str = ""
 		}
-	case 330:
+	case 363:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1837
+//line sql.y:1997
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 331:
+	case 364:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1841
+//line sql.y:2001
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 332:
+	case 365:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1846
+//line sql.y:2006
 		{
 			yyVAL.str = ""
 		}
-	case 333:
+	case 366:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1850
+//line sql.y:2010
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 334:
+	case 367:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1855
+//line sql.y:2015
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 335:
+	case 368:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1859
+//line sql.y:2019
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 336:
+	case 369:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1865
+//line sql.y:2025
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 337:
+	case 370:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1869
+//line sql.y:2029
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 338:
+	case 371:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1875
+//line sql.y:2035
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 339:
+	case 372:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1879
+//line sql.y:2039
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 340:
+	case 373:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1883
+//line sql.y:2043
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 341:
+	case 374:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1887
+//line sql.y:2047
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 342:
+	case 375:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1892
+//line sql.y:2052
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 343:
+	case 376:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1896
+//line sql.y:2056
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 344:
+	case 377:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1900
+//line sql.y:2060
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 346:
+	case 379:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1907
+//line sql.y:2067
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 347:
+	case 380:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1912
+//line sql.y:2072
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 348:
+	case 381:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1916
+//line sql.y:2076
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 349:
+	case 382:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1922
+//line sql.y:2082
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 350:
+	case 383:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1926
+//line sql.y:2086
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 353:
+	case 386:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1936
+//line sql.y:2096
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 354:
+	case 387:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1940
+//line sql.y:2100
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].subquery, As: yyDollar[3].tableIdent}
 		}
-	case 355:
+	case 388:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1944
+//line sql.y:2104
 		{
 			// missed alias for subquery
 			yylex.Error("Every derived table must have its own alias")
 			return 1
 		}
-	case 356:
+	case 389:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1950
+//line sql.y:2110
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 357:
+	case 390:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1956
+//line sql.y:2116
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 358:
+	case 391:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1960
+//line sql.y:2120
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 359:
+	case 392:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1966
+//line sql.y:2126
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 360:
+	case 393:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1970
+//line sql.y:2130
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 361:
+	case 394:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1976
+//line sql.y:2136
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 362:
+	case 395:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1980
+//line sql.y:2140
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 363:
+	case 396:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1993
+//line sql.y:2153
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 364:
+	case 397:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1997
+//line sql.y:2157
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 365:
+	case 398:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2001
+//line sql.y:2161
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 366:
+	case 399:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2005
+//line sql.y:2165
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].str, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 367:
+	case 400:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2011
+//line sql.y:2171
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 368:
+	case 401:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2013
+//line sql.y:2173
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 369:
+	case 402:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2017
+//line sql.y:2177
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 370:
+	case 403:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2019
+//line sql.y:2179
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 371:
+	case 404:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2023
+//line sql.y:2183
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 372:
+	case 405:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2025
+//line sql.y:2185
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 373:
+	case 406:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2028
+//line sql.y:2188
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 374:
+	case 407:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2030
+//line sql.y:2190
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 375:
+	case 408:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2033
+//line sql.y:2193
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 376:
+	case 409:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2037
+//line sql.y:2197
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 377:
+	case 410:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2041
+//line sql.y:2201
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 379:
+	case 412:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2048
+//line sql.y:2208
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 380:
+	case 413:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2054
+//line sql.y:2214
 		{
 			yyVAL.str = JoinStr
 		}
-	case 381:
+	case 414:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2058
+//line sql.y:2218
 		{
 			yyVAL.str = JoinStr
 		}
-	case 382:
+	case 415:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2062
+//line sql.y:2222
 		{
 			yyVAL.str = JoinStr
 		}
-	case 383:
+	case 416:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2068
+//line sql.y:2228
 		{
 			yyVAL.str = StraightJoinStr
 		}
-	case 384:
+	case 417:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2074
+//line sql.y:2234
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 385:
+	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2078
+//line sql.y:2238
 		{
 			yyVAL.str = LeftJoinStr
 		}
-	case 386:
+	case 419:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2082
+//line sql.y:2242
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 387:
+	case 420:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2086
+//line sql.y:2246
 		{
 			yyVAL.str = RightJoinStr
 		}
-	case 388:
+	case 421:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2092
+//line sql.y:2252
 		{
 			yyVAL.str = NaturalJoinStr
 		}
-	case 389:
+	case 422:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2096
+//line sql.y:2256
 		{
 			if yyDollar[2].str == LeftJoinStr {
 				yyVAL.str = NaturalLeftJoinStr
@@ -5898,471 +6379,478 @@ yydefault:
 				yyVAL.str = NaturalRightJoinStr
 			}
 		}
-	case 390:
+	case 423:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2106
+//line sql.y:2266
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 391:
+	case 424:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2110
+//line sql.y:2270
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 392:
+	case 425:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2116
+//line sql.y:2276
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 393:
+	case 426:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2120
+//line sql.y:2280
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 394:
+	case 427:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2126
+//line sql.y:2286
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 395:
+	case 428:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2131
+//line sql.y:2291
 		{
 			yyVAL.indexHints = nil
 		}
-	case 396:
+	case 429:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2135
+//line sql.y:2295
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr, Indexes: yyDollar[4].columns}
 		}
-	case 397:
+	case 430:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2139
+//line sql.y:2299
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseStr}
 		}
-	case 398:
+	case 431:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2143
+//line sql.y:2303
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreStr, Indexes: yyDollar[4].columns}
 		}
-	case 399:
+	case 432:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2147
+//line sql.y:2307
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceStr, Indexes: yyDollar[4].columns}
 		}
-	case 400:
+	case 433:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2152
+//line sql.y:2312
 		{
 			yyVAL.expr = nil
 		}
-	case 401:
+	case 434:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2156
+//line sql.y:2316
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 402:
+	case 435:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2162
+//line sql.y:2322
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 403:
+	case 436:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2166
+//line sql.y:2326
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 404:
+	case 437:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2170
+//line sql.y:2330
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 405:
+	case 438:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2174
+//line sql.y:2334
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 406:
+	case 439:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2178
+//line sql.y:2338
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].str, Expr: yyDollar[1].expr}
 		}
-	case 407:
+	case 440:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2182
+//line sql.y:2342
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 408:
+	case 441:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2186
+//line sql.y:2346
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 409:
+	case 442:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2192
+//line sql.y:2352
 		{
 			yyVAL.str = ""
 		}
-	case 410:
+	case 443:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2196
+//line sql.y:2356
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 411:
+	case 444:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2202
+//line sql.y:2362
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 412:
+	case 445:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2206
+//line sql.y:2366
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 413:
+	case 446:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2212
+//line sql.y:2372
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].str, Right: yyDollar[3].expr}
 		}
-	case 414:
+	case 447:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2216
+//line sql.y:2376
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InStr, Right: yyDollar[3].colTuple}
 		}
-	case 415:
+	case 448:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2220
+//line sql.y:2380
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInStr, Right: yyDollar[4].colTuple}
 		}
-	case 416:
+	case 449:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2224
+//line sql.y:2384
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeStr, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 417:
+	case 450:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2228
+//line sql.y:2388
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeStr, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 418:
+	case 451:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2232
+//line sql.y:2392
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpStr, Right: yyDollar[3].expr}
 		}
-	case 419:
+	case 452:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2236
+//line sql.y:2396
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpStr, Right: yyDollar[4].expr}
 		}
-	case 420:
+	case 453:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2240
+//line sql.y:2400
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenStr, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 421:
+	case 454:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2244
+//line sql.y:2404
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenStr, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 422:
+	case 455:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2248
+//line sql.y:2408
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 423:
+	case 456:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2254
+//line sql.y:2414
 		{
 			yyVAL.str = IsNullStr
 		}
-	case 424:
+	case 457:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2258
+//line sql.y:2418
 		{
 			yyVAL.str = IsNotNullStr
 		}
-	case 425:
+	case 458:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2262
+//line sql.y:2422
 		{
 			yyVAL.str = IsTrueStr
 		}
-	case 426:
+	case 459:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2266
+//line sql.y:2426
 		{
 			yyVAL.str = IsNotTrueStr
 		}
-	case 427:
+	case 460:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2270
+//line sql.y:2430
 		{
 			yyVAL.str = IsFalseStr
 		}
-	case 428:
+	case 461:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2274
+//line sql.y:2434
 		{
 			yyVAL.str = IsNotFalseStr
 		}
-	case 429:
+	case 462:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2280
+//line sql.y:2440
 		{
 			yyVAL.str = EqualStr
 		}
-	case 430:
+	case 463:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2284
+//line sql.y:2444
 		{
 			yyVAL.str = LessThanStr
 		}
-	case 431:
+	case 464:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2288
+//line sql.y:2448
 		{
 			yyVAL.str = GreaterThanStr
 		}
-	case 432:
+	case 465:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2292
+//line sql.y:2452
 		{
 			yyVAL.str = LessEqualStr
 		}
-	case 433:
+	case 466:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2296
+//line sql.y:2456
 		{
 			yyVAL.str = GreaterEqualStr
 		}
-	case 434:
+	case 467:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2300
+//line sql.y:2460
 		{
 			yyVAL.str = NotEqualStr
 		}
-	case 435:
+	case 468:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2304
+//line sql.y:2464
 		{
 			yyVAL.str = NullSafeEqualStr
 		}
-	case 436:
+	case 469:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2309
+//line sql.y:2469
 		{
 			yyVAL.expr = nil
 		}
-	case 437:
+	case 470:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2313
+//line sql.y:2473
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 438:
+	case 471:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2319
+//line sql.y:2479
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 439:
+	case 472:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2323
+//line sql.y:2483
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 440:
+	case 473:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2327
+//line sql.y:2487
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 441:
+	case 474:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2333
+//line sql.y:2493
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 442:
+	case 475:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2497
+		{
+			yyDollar[3].selStmt.SetWith(yyDollar[2].with)
+			yyVAL.subquery = &Subquery{yyDollar[3].selStmt}
+		}
+	case 476:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2339
+//line sql.y:2504
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 443:
+	case 477:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2343
+//line sql.y:2508
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 444:
+	case 478:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2349
+//line sql.y:2514
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 445:
+	case 479:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2353
+//line sql.y:2518
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 446:
+	case 480:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2357
+//line sql.y:2522
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 447:
+	case 481:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2361
+//line sql.y:2526
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 448:
+	case 482:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2365
+//line sql.y:2530
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 449:
+	case 483:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2369
+//line sql.y:2534
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndStr, Right: yyDollar[3].expr}
 		}
-	case 450:
+	case 484:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2373
+//line sql.y:2538
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrStr, Right: yyDollar[3].expr}
 		}
-	case 451:
+	case 485:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2377
+//line sql.y:2542
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorStr, Right: yyDollar[3].expr}
 		}
-	case 452:
+	case 486:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2381
+//line sql.y:2546
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusStr, Right: yyDollar[3].expr}
 		}
-	case 453:
+	case 487:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2385
+//line sql.y:2550
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusStr, Right: yyDollar[3].expr}
 		}
-	case 454:
+	case 488:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2389
+//line sql.y:2554
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultStr, Right: yyDollar[3].expr}
 		}
-	case 455:
+	case 489:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2393
+//line sql.y:2558
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivStr, Right: yyDollar[3].expr}
 		}
-	case 456:
+	case 490:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2397
+//line sql.y:2562
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivStr, Right: yyDollar[3].expr}
 		}
-	case 457:
+	case 491:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2401
+//line sql.y:2566
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 458:
+	case 492:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2405
+//line sql.y:2570
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModStr, Right: yyDollar[3].expr}
 		}
-	case 459:
+	case 493:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2409
+//line sql.y:2574
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftStr, Right: yyDollar[3].expr}
 		}
-	case 460:
+	case 494:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2413
+//line sql.y:2578
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightStr, Right: yyDollar[3].expr}
 		}
-	case 461:
+	case 495:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2417
+//line sql.y:2582
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
-	case 462:
+	case 496:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2421
+//line sql.y:2586
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
-	case 463:
+	case 497:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2425
+//line sql.y:2590
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 464:
+	case 498:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2429
+//line sql.y:2594
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 465:
+	case 499:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2433
+//line sql.y:2598
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryStr, Expr: yyDollar[2].expr}
 		}
-	case 466:
+	case 500:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2437
+//line sql.y:2602
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Str, Expr: yyDollar[2].expr}
 		}
-	case 467:
+	case 501:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2441
+//line sql.y:2606
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -6370,9 +6858,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 468:
+	case 502:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2449
+//line sql.y:2614
 		{
 			if num, ok := yyDollar[2].expr.(*SQLVal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -6386,21 +6874,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusStr, Expr: yyDollar[2].expr}
 			}
 		}
-	case 469:
+	case 503:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2463
+//line sql.y:2628
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaStr, Expr: yyDollar[2].expr}
 		}
-	case 470:
+	case 504:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2467
+//line sql.y:2632
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangStr, Expr: yyDollar[2].expr}
 		}
-	case 471:
+	case 505:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2471
+//line sql.y:2636
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -6408,497 +6896,533 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 476:
+	case 510:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2489
+//line sql.y:2654
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
 		}
-	case 477:
+	case 511:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2493
+//line sql.y:2658
+		{
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].windowSpec}
+		}
+	case 512:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2662
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 478:
+	case 513:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2497
+//line sql.y:2666
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 479:
+	case 514:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2501
+//line sql.y:2670
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 480:
+	case 515:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2511
+//line sql.y:2680
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 481:
+	case 516:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2684
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("row_number"), Over: yyDollar[4].windowSpec}
+		}
+	case 517:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2688
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("rank"), Over: yyDollar[4].windowSpec}
+		}
+	case 518:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:2692
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("dense_rank"), Over: yyDollar[4].windowSpec}
+		}
+	case 519:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2696
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("lag"), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].windowSpec}
+		}
+	case 520:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:2700
+		{
+			yyVAL.expr = &FuncExpr{Name: NewColIdent("lead"), Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].windowSpec}
+		}
+	case 521:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2515
+//line sql.y:2704
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 482:
+	case 522:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2519
+//line sql.y:2708
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 483:
+	case 523:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2523
+//line sql.y:2712
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 484:
+	case 524:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2527
+//line sql.y:2716
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 485:
+	case 525:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2531
+//line sql.y:2720
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 486:
+	case 526:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2535
+//line sql.y:2724
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 487:
+	case 527:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2539
+//line sql.y:2728
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 488:
+	case 528:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2543
+//line sql.y:2732
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrVal(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 489:
+	case 529:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2547
+//line sql.y:2736
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].str}
 		}
-	case 490:
+	case 530:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2551
+//line sql.y:2740
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].str, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str, Limit: yyDollar[7].limit}
 		}
-	case 491:
+	case 531:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2555
+//line sql.y:2744
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 492:
+	case 532:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2559
+//line sql.y:2748
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
-	case 493:
+	case 533:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2569
+//line sql.y:2758
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 494:
+	case 534:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2573
+//line sql.y:2762
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 495:
+	case 535:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2577
+//line sql.y:2766
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 496:
+	case 536:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2582
+//line sql.y:2771
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 497:
+	case 537:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2587
+//line sql.y:2776
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 498:
+	case 538:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2592
+//line sql.y:2781
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 499:
+	case 539:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2598
+//line sql.y:2787
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 500:
+	case 540:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2603
+//line sql.y:2792
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 501:
+	case 541:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2608
+//line sql.y:2797
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 502:
+	case 542:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2612
+//line sql.y:2801
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 503:
+	case 543:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2616
+//line sql.y:2805
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].expr}
 		}
-	case 504:
+	case 544:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2621
+//line sql.y:2810
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].expr}
 		}
-	case 505:
+	case 545:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2626
+//line sql.y:2815
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 506:
+	case 546:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2631
+//line sql.y:2820
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].expr}
 		}
-	case 507:
+	case 547:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2635
+//line sql.y:2824
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 508:
+	case 548:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2639
+//line sql.y:2828
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 511:
+	case 551:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2649
+//line sql.y:2838
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 512:
+	case 552:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2659
+//line sql.y:2848
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 513:
+	case 553:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2663
+//line sql.y:2852
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 514:
+	case 554:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2667
+//line sql.y:2856
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 515:
+	case 555:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2671
+//line sql.y:2860
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 516:
+	case 556:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2675
+//line sql.y:2864
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 517:
+	case 557:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2679
+//line sql.y:2868
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 518:
+	case 558:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2683
+//line sql.y:2872
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 519:
+	case 559:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2689
+//line sql.y:2878
 		{
 			yyVAL.str = ""
 		}
-	case 520:
+	case 560:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2693
+//line sql.y:2882
 		{
 			yyVAL.str = BooleanModeStr
 		}
-	case 521:
+	case 561:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2697
+//line sql.y:2886
 		{
 			yyVAL.str = NaturalLanguageModeStr
 		}
-	case 522:
+	case 562:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2701
+//line sql.y:2890
 		{
 			yyVAL.str = NaturalLanguageModeWithQueryExpansionStr
 		}
-	case 523:
+	case 563:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2705
+//line sql.y:2894
 		{
 			yyVAL.str = QueryExpansionStr
 		}
-	case 524:
+	case 564:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2711
+//line sql.y:2900
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 525:
+	case 565:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2715
+//line sql.y:2904
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 526:
+	case 566:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2721
+//line sql.y:2910
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 527:
+	case 567:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2725
+//line sql.y:2914
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: yyDollar[3].str, Operator: CharacterSetStr}
 		}
-	case 528:
+	case 568:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2729
+//line sql.y:2918
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal, Charset: string(yyDollar[3].bytes)}
 		}
-	case 529:
+	case 569:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2733
+//line sql.y:2922
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 530:
+	case 570:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2737
+//line sql.y:2926
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 531:
+	case 571:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2741
+//line sql.y:2930
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 532:
+	case 572:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2747
+//line sql.y:2936
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 533:
+	case 573:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2751
+//line sql.y:2940
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 534:
+	case 574:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2755
+//line sql.y:2944
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 535:
+	case 575:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2759
+//line sql.y:2948
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 536:
+	case 576:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2763
+//line sql.y:2952
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].sqlVal}
 		}
-	case 537:
+	case 577:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2767
+//line sql.y:2956
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 538:
+	case 578:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2771
+//line sql.y:2960
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 539:
+	case 579:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2776
+//line sql.y:2965
 		{
 			yyVAL.expr = nil
 		}
-	case 540:
+	case 580:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2780
+//line sql.y:2969
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 541:
+	case 581:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2785
+//line sql.y:2974
 		{
 			yyVAL.str = string("")
 		}
-	case 542:
+	case 582:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2789
+//line sql.y:2978
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 543:
+	case 583:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2795
+//line sql.y:2984
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 544:
+	case 584:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2799
+//line sql.y:2988
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 545:
+	case 585:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2805
+//line sql.y:2994
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 546:
+	case 586:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2810
+//line sql.y:2999
 		{
 			yyVAL.expr = nil
 		}
-	case 547:
+	case 587:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2814
+//line sql.y:3003
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 548:
+	case 588:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2820
+//line sql.y:3009
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 549:
+	case 589:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2824
+//line sql.y:3013
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 550:
+	case 590:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2828
+//line sql.y:3017
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 551:
+	case 591:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2834
+//line sql.y:3023
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 552:
+	case 592:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2838
+//line sql.y:3027
 		{
 			yyVAL.expr = NewHexVal(yyDollar[1].bytes)
 		}
-	case 553:
+	case 593:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2842
+//line sql.y:3031
 		{
 			yyVAL.expr = NewBitVal(yyDollar[1].bytes)
 		}
-	case 554:
+	case 594:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2846
+//line sql.y:3035
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 555:
+	case 595:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2850
+//line sql.y:3039
 		{
 			yyVAL.expr = NewFloatVal(yyDollar[1].bytes)
 		}
-	case 556:
+	case 596:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2854
+//line sql.y:3043
 		{
 			yyVAL.expr = NewHexNum(yyDollar[1].bytes)
 		}
-	case 557:
+	case 597:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2858
+//line sql.y:3047
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 558:
+	case 598:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2862
+//line sql.y:3051
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 559:
+	case 599:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2868
+//line sql.y:3057
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -6907,239 +7431,305 @@ yydefault:
 			}
 			yyVAL.expr = NewIntVal([]byte("1"))
 		}
-	case 560:
+	case 600:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2877
+//line sql.y:3066
 		{
 			yyVAL.expr = NewIntVal(yyDollar[1].bytes)
 		}
-	case 561:
+	case 601:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2881
+//line sql.y:3070
 		{
 			yyVAL.expr = NewValArg(yyDollar[1].bytes)
 		}
-	case 562:
+	case 602:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2886
+//line sql.y:3075
 		{
 			yyVAL.exprs = nil
 		}
-	case 563:
+	case 603:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2890
+//line sql.y:3079
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 564:
+	case 604:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2895
+//line sql.y:3084
 		{
 			yyVAL.expr = nil
 		}
-	case 565:
+	case 605:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2899
+//line sql.y:3088
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 566:
+	case 606:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2904
+//line sql.y:3093
+		{
+			yyVAL.namedWindows = nil
+		}
+	case 607:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3097
+		{
+			yyVAL.namedWindows = yyDollar[2].namedWindows
+		}
+	case 608:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:3103
+		{
+			yyVAL.namedWindows = NamedWindows{yyDollar[1].namedWindow}
+		}
+	case 609:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3107
+		{
+			yyVAL.namedWindows = append(yyDollar[1].namedWindows, yyDollar[3].namedWindow)
+		}
+	case 610:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3113
+		{
+			yyVAL.namedWindow = &NamedWindow{Name: yyDollar[1].colIdent, Spec: yyDollar[4].windowSpec}
+		}
+	case 611:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3119
+		{
+			yyVAL.windowSpec = yyDollar[3].windowSpec
+		}
+	case 612:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3123
+		{
+			yyVAL.windowSpec = &WindowSpec{Name: yyDollar[2].colIdent}
+		}
+	case 613:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:3129
+		{
+			yyVAL.windowSpec = &WindowSpec{PartitionBy: yyDollar[1].exprs, OrderBy: yyDollar[2].orderBy}
+		}
+	case 614:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3133
+		{
+			yyVAL.windowSpec = &WindowSpec{Name: yyDollar[1].colIdent, PartitionBy: yyDollar[2].exprs, OrderBy: yyDollar[3].orderBy}
+		}
+	case 615:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3138
+		{
+			yyVAL.exprs = nil
+		}
+	case 616:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:3142
+		{
+			yyVAL.exprs = yyDollar[3].exprs
+		}
+	case 617:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:3147
 		{
 			yyVAL.orderBy = nil
 		}
-	case 567:
+	case 618:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2908
+//line sql.y:3151
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 568:
+	case 619:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2914
+//line sql.y:3157
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 569:
+	case 620:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2918
+//line sql.y:3161
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 570:
+	case 621:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2924
+//line sql.y:3167
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].str}
 		}
-	case 571:
+	case 622:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2929
+//line sql.y:3172
 		{
 			yyVAL.str = AscScr
 		}
-	case 572:
+	case 623:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2933
+//line sql.y:3176
 		{
 			yyVAL.str = AscScr
 		}
-	case 573:
+	case 624:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2937
+//line sql.y:3180
 		{
 			yyVAL.str = DescScr
 		}
-	case 574:
+	case 625:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2942
+//line sql.y:3185
 		{
 			yyVAL.limit = nil
 		}
-	case 575:
+	case 626:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2946
+//line sql.y:3189
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 576:
+	case 627:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2950
+//line sql.y:3193
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 577:
+	case 628:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2954
+//line sql.y:3197
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 578:
+	case 629:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2959
+//line sql.y:3202
 		{
 			yyVAL.str = ""
 		}
-	case 579:
+	case 630:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2963
+//line sql.y:3206
 		{
 			yyVAL.str = ForUpdateStr
 		}
-	case 580:
+	case 631:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2967
+//line sql.y:3210
 		{
 			yyVAL.str = ShareModeStr
 		}
-	case 581:
+	case 632:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2980
+//line sql.y:3223
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 582:
+	case 633:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2984
+//line sql.y:3227
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 583:
+	case 634:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2988
+//line sql.y:3231
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Rows: yyDollar[2].selStmt}
 		}
-	case 584:
+	case 635:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2993
+//line sql.y:3236
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 585:
+	case 636:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2997
+//line sql.y:3240
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 586:
+	case 637:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3001
+//line sql.y:3244
 		{
 			// Drop the redundant parenthesis.
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].selStmt}
 		}
-	case 587:
+	case 638:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3008
+//line sql.y:3251
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 588:
+	case 639:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3012
+//line sql.y:3255
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 589:
+	case 640:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3016
+//line sql.y:3259
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 590:
+	case 641:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3020
+//line sql.y:3263
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 591:
+	case 642:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3025
+//line sql.y:3268
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 592:
+	case 643:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3029
+//line sql.y:3272
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 593:
+	case 644:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3035
+//line sql.y:3278
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 594:
+	case 645:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3039
+//line sql.y:3282
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 595:
+	case 646:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3045
+//line sql.y:3288
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 596:
+	case 647:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3049
+//line sql.y:3292
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 597:
+	case 648:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3055
+//line sql.y:3298
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 598:
+	case 649:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3061
+//line sql.y:3304
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = &ParenExpr{yyDollar[1].valTuple[0]}
@@ -7147,312 +7737,312 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 599:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3071
+//line sql.y:3314
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 600:
+	case 651:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3075
+//line sql.y:3318
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 601:
+	case 652:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3081
+//line sql.y:3324
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 602:
+	case 653:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3087
+//line sql.y:3330
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 603:
+	case 654:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3091
+//line sql.y:3334
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 604:
+	case 655:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3097
+//line sql.y:3340
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("on"))}
 		}
-	case 605:
+	case 656:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3101
+//line sql.y:3344
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: NewStrVal([]byte("off"))}
 		}
-	case 606:
+	case 657:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3105
+//line sql.y:3348
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Expr: yyDollar[3].expr}
 		}
-	case 607:
+	case 658:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3109
+//line sql.y:3352
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Expr: yyDollar[2].expr}
 		}
-	case 609:
+	case 660:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3116
+//line sql.y:3359
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 611:
+	case 662:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3123
+//line sql.y:3366
 		{
 			yyVAL.expr = NewStrVal([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 612:
+	case 663:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3127
+//line sql.y:3370
 		{
 			yyVAL.expr = NewStrVal(yyDollar[1].bytes)
 		}
-	case 613:
+	case 664:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3131
+//line sql.y:3374
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 616:
+	case 667:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3140
+//line sql.y:3383
 		{
 			yyVAL.byt = 0
 		}
-	case 617:
+	case 668:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3142
+//line sql.y:3385
 		{
 			yyVAL.byt = 1
 		}
-	case 618:
+	case 669:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3145
+//line sql.y:3388
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 619:
+	case 670:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3147
+//line sql.y:3390
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 620:
+	case 671:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3150
+//line sql.y:3393
 		{
 			yyVAL.str = ""
 		}
-	case 621:
+	case 672:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3152
+//line sql.y:3395
 		{
 			yyVAL.str = IgnoreStr
 		}
-	case 622:
+	case 673:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3156
+//line sql.y:3399
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 623:
+	case 674:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3158
+//line sql.y:3401
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 624:
+	case 675:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3160
+//line sql.y:3403
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 625:
+	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3162
+//line sql.y:3405
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 626:
+	case 677:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3164
+//line sql.y:3407
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 627:
+	case 678:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3166
+//line sql.y:3409
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 628:
+	case 679:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3168
+//line sql.y:3411
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 629:
+	case 680:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3170
+//line sql.y:3413
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 630:
+	case 681:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3172
+//line sql.y:3415
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 631:
+	case 682:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3174
+//line sql.y:3417
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 632:
+	case 683:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3177
+//line sql.y:3420
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 633:
+	case 684:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3179
+//line sql.y:3422
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 634:
+	case 685:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3181
+//line sql.y:3424
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 635:
+	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3185
+//line sql.y:3428
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 636:
+	case 687:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3187
+//line sql.y:3430
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 637:
+	case 688:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3190
+//line sql.y:3433
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 638:
+	case 689:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3192
+//line sql.y:3435
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 639:
+	case 690:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3194
+//line sql.y:3437
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 640:
+	case 691:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3197
+//line sql.y:3440
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 641:
+	case 692:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3199
+//line sql.y:3442
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 642:
+	case 693:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3203
+//line sql.y:3446
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 643:
+	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3207
+//line sql.y:3450
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 645:
+	case 696:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3214
+//line sql.y:3457
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 646:
+	case 697:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3220
+//line sql.y:3463
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 647:
+	case 698:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3224
+//line sql.y:3467
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 649:
+	case 700:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3231
+//line sql.y:3474
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 937:
+	case 993:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3544
+//line sql.y:3792
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 938:
+	case 994:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3553
+//line sql.y:3801
 		{
 			decNesting(yylex)
 		}
-	case 939:
+	case 995:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3558
+//line sql.y:3806
 		{
 			skipToEnd(yylex)
 		}
-	case 940:
+	case 996:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3563
+//line sql.y:3811
 		{
 			skipToEnd(yylex)
 		}
-	case 941:
+	case 997:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3567
+//line sql.y:3815
 		{
 			skipToEnd(yylex)
 		}
-	case 942:
+	case 998:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3571
+//line sql.y:3819
 		{
 			skipToEnd(yylex)
 		}
@@ -17,6 +17,8 @@ limitations under the License.
 %{
 package sqlparser
 
+import "strings"
+
 func setParseTree(yylex interface{}, stmt Statement) {
   yylex.(*Tokenizer).ParseTree = stmt
 }
@@ -80,6 +82,12 @@ func skipToEnd(yylex interface{}) {
   values        Values
   valTuple      ValTuple
   subquery      *Subquery
+  with          *With
+  cte           *CommonTableExpr
+  ctes          []*CommonTableExpr
+  windowSpec    *WindowSpec
+  namedWindows  NamedWindows
+  namedWindow   *NamedWindow
   whens         []*When
   when          *When
   orderBy       OrderBy
@@ -159,16 +167,19 @@ func skipToEnd(yylex interface{}) {
 
 // DDL Tokens
 %token <bytes> CREATE ALTER DROP RENAME ANALYZE ADD FLUSH
-%token <bytes> SCHEMA TABLE INDEX VIEW TO IGNORE IF UNIQUE PRIMARY COLUMN SPATIAL FULLTEXT KEY_BLOCK_SIZE CHECK
+%token <bytes> SCHEMA TABLE TEMPORARY INDEX VIEW TO IGNORE IF UNIQUE PRIMARY COLUMN SPATIAL FULLTEXT KEY_BLOCK_SIZE CHECK
 %token <bytes> ACTION CASCADE CONSTRAINT FOREIGN NO REFERENCES RESTRICT
-%token <bytes> SHOW DESCRIBE EXPLAIN DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
+%token <bytes> SHOW DESCRIBE EXPLAIN FORMAT DATE ESCAPE REPAIR OPTIMIZE TRUNCATE
 %token <bytes> MAXVALUE PARTITION REORGANIZE LESS THAN PROCEDURE TRIGGER
 %token <bytes> VINDEX VINDEXES
 %token <bytes> STATUS VARIABLES WARNINGS
 %token <bytes> SEQUENCE
 
 // Transaction Tokens
-%token <bytes> BEGIN START TRANSACTION COMMIT ROLLBACK
+%token <bytes> BEGIN START TRANSACTION COMMIT ROLLBACK SAVEPOINT RELEASE
+
+// Kill Tokens
+%token <bytes> KILL CONNECTION
 
 // Type Tokens
 %token <bytes> BIT TINYINT SMALLINT MEDIUMINT INT INTEGER BIGINT INTNUM
@@ -202,8 +213,11 @@ func skipToEnd(yylex interface{}) {
 %token <bytes> MATCH AGAINST BOOLEAN LANGUAGE WITH QUERY EXPANSION
 
 // MySQL reserved words that are unused by this grammar will map to this token.
-%token <bytes> UNUSED ARRAY CUME_DIST DESCRIPTION DENSE_RANK EMPTY EXCEPT FIRST_VALUE GROUPING GROUPS JSON_TABLE LAG LAST_VALUE LATERAL LEAD MEMBER
-%token <bytes> NTH_VALUE NTILE OF OVER PERCENT_RANK RANK RECURSIVE ROW_NUMBER SYSTEM WINDOW
+%token <bytes> UNUSED ARRAY CUME_DIST DESCRIPTION EMPTY EXCEPT FIRST_VALUE GROUPING GROUPS JSON_TABLE LAST_VALUE LATERAL MEMBER
+%token <bytes> NTH_VALUE NTILE OF PERCENT_RANK RECURSIVE SYSTEM
+
+// Window functions
+%token <bytes> OVER WINDOW ROW_NUMBER RANK DENSE_RANK LAG LEAD
 %token <bytes> ACTIVE ADMIN BUCKETS CLONE COMPONENT DEFINITION ENFORCED EXCLUDE FOLLOWING GEOMCOLLECTION GET_MASTER_PUBLIC_KEY HISTOGRAM HISTORY
 %token <bytes> INACTIVE INVISIBLE LOCKED MASTER_COMPRESSION_ALGORITHMS MASTER_PUBLIC_KEY_PATH MASTER_TLS_CIPHERSUITES MASTER_ZSTD_COMPRESSION_LEVEL
 %token <bytes> NESTED NETWORK_NAMESPACE NOWAIT NULLS OJ OLD OPTIONAL ORDINALITY ORGANIZATION OTHERS PATH PERSIST PERSIST_ONLY PRECEDING PRIVILEGE_CHECKS_USER PROCESS
@@ -212,11 +226,22 @@ func skipToEnd(yylex interface{}) {
 
 %type <statement> command
 %type <selStmt> select_statement base_select union_lhs union_rhs
+%type <with> with_clause
+%type <ctes> cte_list
+%type <cte> common_table_expr
+%type <windowSpec> over_clause window_spec
+%type <namedWindows> window_opt named_window_list
+%type <namedWindow> named_window
+%type <exprs> partition_by_opt
 %type <statement> stream_statement insert_statement update_statement delete_statement set_statement
 %type <statement> create_statement alter_statement rename_statement drop_statement truncate_statement flush_statement
 %type <ddl> create_table_prefix rename_list
 %type <statement> analyze_statement show_statement use_statement other_statement
-%type <statement> begin_statement commit_statement rollback_statement
+%type <statement> explain_statement explainable_statement
+%type <str> explain_format_opt explain_format
+%type <statement> begin_statement commit_statement rollback_statement savepoint_statement release_statement
+%type <statement> kill_statement
+%type <str> kill_type_opt
 %type <bytes2> comment_opt comment_list
 %type <str> union_op insert_or_replace
 %type <str> distinct_opt straight_join_opt cache_opt match_option separator_opt
@@ -286,7 +311,7 @@ func skipToEnd(yylex interface{}) {
 %type <sqlVal> length_opt column_comment_opt
 %type <optVal> column_default_opt on_update_opt
 %type <str> charset_opt collate_opt
-%type <boolVal> unsigned_opt zero_fill_opt
+%type <boolVal> unsigned_opt zero_fill_opt temporary_opt
 %type <LengthScaleOption> float_length_opt decimal_length_opt
 %type <boolVal> null_opt auto_increment_opt
 %type <colKeyOpt> column_key_opt
@@ -334,6 +359,11 @@ command:
   {
     $$ = $1
   }
+| with_clause select_statement
+  {
+    $2.SetWith($1)
+    $$ = $2
+  }
 | stream_statement
 | insert_statement
 | update_statement
@@ -350,6 +380,10 @@ command:
 | begin_statement
 | commit_statement
 | rollback_statement
+| savepoint_statement
+| release_statement
+| kill_statement
+| explain_statement
 | other_statement
 | flush_statement
 | /*empty*/
@@ -375,6 +409,36 @@ select_statement:
     $$ = &Select{Comments: Comments($2), Cache: $3, SelectExprs: SelectExprs{Nextval{Expr: $5}}, From: TableExprs{&AliasedTableExpr{Expr: $7}}}
   }
 
+with_clause:
+  WITH cte_list
+  {
+    $$ = &With{CTEs: $2}
+  }
+| WITH RECURSIVE cte_list
+  {
+    $$ = &With{Recursive: true, CTEs: $3}
+  }
+
+cte_list:
+  common_table_expr
+  {
+    $$ = []*CommonTableExpr{$1}
+  }
+| cte_list ',' common_table_expr
+  {
+    $$ = append($1, $3)
+  }
+
+common_table_expr:
+  table_id AS subquery
+  {
+    $$ = &CommonTableExpr{Name: $1, Subquery: $3}
+  }
+| table_id openb ins_column_list closeb AS subquery
+  {
+    $$ = &CommonTableExpr{Name: $1, Columns: $3, Subquery: $6}
+  }
+
 stream_statement:
   STREAM comment_opt select_expression FROM table_name
   {
@@ -383,9 +447,9 @@ stream_statement:
 
 // base_select is an unparenthesized SELECT with no order by clause or beyond.
 base_select:
-  SELECT comment_opt cache_opt distinct_opt straight_join_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt
+  SELECT comment_opt cache_opt distinct_opt straight_join_opt select_expression_list from_opt where_expression_opt group_by_opt having_opt window_opt
   {
-    $$ = &Select{Comments: Comments($2), Cache: $3, Distinct: $4, Hints: $5, SelectExprs: $6, From: $7, Where: NewWhere(WhereStr, $8), GroupBy: GroupBy($9), Having: NewWhere(HavingStr, $10)}
+    $$ = &Select{Comments: Comments($2), Cache: $3, Distinct: $4, Hints: $5, SelectExprs: $6, From: $7, Where: NewWhere(WhereStr, $8), GroupBy: GroupBy($9), Having: NewWhere(HavingStr, $10), Windows: $11}
   }
 
 union_lhs:
@@ -647,12 +711,21 @@ vindex_param:
   }
 
 create_table_prefix:
-  CREATE TABLE not_exists_opt table_name
+  CREATE temporary_opt TABLE not_exists_opt table_name
   {
-    $$ = &DDL{Action: CreateStr, Table: $4}
+    $$ = &DDL{Action: CreateStr, Table: $5, Temporary: bool($2)}
     setDDL(yylex, $$)
   }
 
+temporary_opt:
+  {
+    $$ = BoolVal(false)
+  }
+| TEMPORARY
+  {
+    $$ = BoolVal(true)
+  }
+
 table_spec:
   '(' table_column_list ')' table_option_list
   {
@@ -1451,13 +1524,13 @@ rename_list:
   }
 
 drop_statement:
-  DROP TABLE exists_opt table_name_list
+  DROP temporary_opt TABLE exists_opt table_name_list
   {
     var exists bool
-    if $3 != 0 {
+    if $4 != 0 {
       exists = true
     }
-    $$ = &DDL{Action: DropStr, FromTables: $4, IfExists: exists}
+    $$ = &DDL{Action: DropStr, FromTables: $5, IfExists: exists, Temporary: bool($2)}
   }
 | DROP INDEX ID ON table_name ddl_skip_to_end
   {
@@ -1578,7 +1651,7 @@ show_statement:
   {
     // this is ugly, but I couldn't find a better way for now
     if $3 == "processlist" {
-      $$ = &Show{Type: $3}
+      $$ = &Show{Type: $3, ShowTablesOpt: &ShowTablesOpt{Full:$2}}
     } else {
     showTablesOpt := &ShowTablesOpt{Full:$2, DbName:$4, Filter:$5}
       $$ = &Show{Type: $3, ShowTablesOpt: showTablesOpt}
@@ -1747,17 +1820,104 @@ rollback_statement:
   {
     $$ = &Rollback{}
   }
+| ROLLBACK TO sql_id
+  {
+    $$ = &SRollback{Name: $3}
+  }
+| ROLLBACK TO SAVEPOINT sql_id
+  {
+    $$ = &SRollback{Name: $4}
+  }
 
-other_statement:
-  DESC skip_to_end
+savepoint_statement:
+  SAVEPOINT sql_id
+  {
+    $$ = &Savepoint{Name: $2}
+  }
+
+release_statement:
+  RELEASE SAVEPOINT sql_id
+  {
+    $$ = &Release{Name: $3}
+  }
+
+kill_statement:
+  KILL kill_type_opt INTEGRAL
+  {
+    $$ = &Kill{Type: $2, ID: NewIntVal($3)}
+  }
+
+kill_type_opt:
+  /* empty */
+  {
+    $$ = KillConnectionStr
+  }
+| CONNECTION
+  {
+    $$ = KillConnectionStr
+  }
+| QUERY
+  {
+    $$ = KillQueryStr
+  }
+
+explain_statement:
+  EXPLAIN explain_format_opt explainable_statement
+  {
+    $$ = &Explain{Type: $2, Statement: $3}
+  }
+| EXPLAIN table_name ddl_skip_to_end
   {
     $$ = &OtherRead{}
   }
-| DESCRIBE skip_to_end
+| EXPLAIN ANALYZE skip_to_end
   {
     $$ = &OtherRead{}
   }
-| EXPLAIN skip_to_end
+| EXPLAIN FOR skip_to_end
+  {
+    $$ = &OtherRead{}
+  }
+
+explain_format_opt:
+  {
+    $$ = ""
+  }
+| FORMAT '=' explain_format
+  {
+    $$ = $3
+  }
+
+explain_format:
+  ID
+  {
+    $$ = strings.ToLower(string($1))
+  }
+| JSON
+  {
+    $$ = strings.ToLower(string($1))
+  }
+
+explainable_statement:
+  select_statement
+  {
+    $$ = $1
+  }
+| with_clause select_statement
+  {
+    $2.SetWith($1)
+    $$ = $2
+  }
+| insert_statement
+| update_statement
+| delete_statement
+
+other_statement:
+  DESC skip_to_end
+  {
+    $$ = &OtherRead{}
+  }
+| DESCRIBE skip_to_end
   {
     $$ = &OtherRead{}
   }
@@ -2333,6 +2493,11 @@ subquery:
   {
     $$ = &Subquery{$2}
   }
+| openb with_clause select_statement closeb
+  {
+    $3.SetWith($2)
+    $$ = &Subquery{$3}
+  }
 
 expression_list:
   expression
@@ -2489,6 +2654,10 @@ function_call_generic:
   {
     $$ = &FuncExpr{Name: $1, Exprs: $3}
   }
+| sql_id openb select_expression_list_opt closeb over_clause
+  {
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Over: $5}
+  }
 | sql_id openb DISTINCT select_expression_list closeb
   {
     $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4}
@@ -2511,6 +2680,26 @@ function_call_keyword:
   {
     $$ = &FuncExpr{Name: NewColIdent("left"), Exprs: $3}
   }
+| ROW_NUMBER openb closeb over_clause
+  {
+    $$ = &FuncExpr{Name: NewColIdent("row_number"), Over: $4}
+  }
+| RANK openb closeb over_clause
+  {
+    $$ = &FuncExpr{Name: NewColIdent("rank"), Over: $4}
+  }
+| DENSE_RANK openb closeb over_clause
+  {
+    $$ = &FuncExpr{Name: NewColIdent("dense_rank"), Over: $4}
+  }
+| LAG openb select_expression_list closeb over_clause
+  {
+    $$ = &FuncExpr{Name: NewColIdent("lag"), Exprs: $3, Over: $5}
+  }
+| LEAD openb select_expression_list closeb over_clause
+  {
+    $$ = &FuncExpr{Name: NewColIdent("lead"), Exprs: $3, Over: $5}
+  }
 | RIGHT openb select_expression_list closeb
   {
     $$ = &FuncExpr{Name: NewColIdent("right"), Exprs: $3}
@@ -2900,6 +3089,60 @@ having_opt:
     $$ = $2
   }
 
+window_opt:
+  {
+    $$ = nil
+  }
+| WINDOW named_window_list
+  {
+    $$ = $2
+  }
+
+named_window_list:
+  named_window
+  {
+    $$ = NamedWindows{$1}
+  }
+| named_window_list ',' named_window
+  {
+    $$ = append($1, $3)
+  }
+
+named_window:
+  sql_id AS openb window_spec closeb
+  {
+    $$ = &NamedWindow{Name: $1, Spec: $4}
+  }
+
+over_clause:
+  OVER openb window_spec closeb
+  {
+    $$ = $3
+  }
+| OVER sql_id
+  {
+    $$ = &WindowSpec{Name: $2}
+  }
+
+window_spec:
+  partition_by_opt order_by_opt
+  {
+    $$ = &WindowSpec{PartitionBy: $1, OrderBy: $2}
+  }
+| sql_id partition_by_opt order_by_opt
+  {
+    $$ = &WindowSpec{Name: $1, PartitionBy: $2, OrderBy: $3}
+  }
+
+partition_by_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
 order_by_opt:
   {
     $$ = nil
@@ -3360,6 +3603,7 @@ reserved_keyword:
 | WHEN
 | WHERE
 | WINDOW
+| WITH
 
 /*
   These are non-reserved Vitess, because they don't cause conflicts in the grammar.
@@ -3392,6 +3636,7 @@ non_reserved_keyword:
 | COMMIT
 | COMMITTED
 | COMPONENT
+| CONNECTION
 | DATE
 | DATETIME
 | DECIMAL
@@ -3409,6 +3654,7 @@ non_reserved_keyword:
 | FLUSH
 | FOLLOWING
 | FOREIGN
+| FORMAT
 | FULLTEXT
 | GEOMCOLLECTION
 | GEOMETRY
@@ -3488,9 +3734,11 @@ non_reserved_keyword:
 | RESPECT
 | RESTART
 | RETAIN
+| RELEASE
 | REUSE
 | ROLE
 | ROLLBACK
+| SAVEPOINT
 | SECONDARY
 | SECONDARY_ENGINE
 | SECONDARY_LOAD
@@ -3507,6 +3755,7 @@ non_reserved_keyword:
 | START
 | STATUS
 | TABLES
+| TEMPORARY
 | TEXT
 | THAN
 | THREAD_PRIORITY
@@ -3534,7 +3783,6 @@ non_reserved_keyword:
 | VITESS_METADATA
 | VSCHEMA
 | WARNINGS
-| WITH
 | WRITE
 | YEAR
 | ZEROFILL
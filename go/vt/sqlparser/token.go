@@ -125,9 +125,16 @@ var keywords = map[string]int{
 	"committed":           COMMITTED,
 	"commit":              COMMIT,
 	"condition":           UNUSED,
+	"connection":          CONNECTION,
 	"constraint":          CONSTRAINT,
 	"continue":            UNUSED,
 	"convert":             CONVERT,
+	"dense_rank":          DENSE_RANK,
+	"lag":                 LAG,
+	"lead":                LEAD,
+	"over":                OVER,
+	"rank":                RANK,
+	"row_number":          ROW_NUMBER,
 	"substr":              SUBSTR,
 	"substring":           SUBSTRING,
 	"create":              CREATE,
@@ -183,6 +190,7 @@ var keywords = map[string]int{
 	"for":                 FOR,
 	"force":               FORCE,
 	"foreign":             FOREIGN,
+	"format":              FORMAT,
 	"from":                FROM,
 	"full":                FULL,
 	"fulltext":            FULLTEXT,
@@ -226,7 +234,7 @@ var keywords = map[string]int{
 	"key":                 KEY,
 	"keys":                KEYS,
 	"key_block_size":      KEY_BLOCK_SIZE,
-	"kill":                UNUSED,
+	"kill":                KILL,
 	"language":            LANGUAGE,
 	"last_insert_id":      LAST_INSERT_ID,
 	"leading":             UNUSED,
@@ -299,9 +307,10 @@ var keywords = map[string]int{
 	"reads":               UNUSED,
 	"read_write":          UNUSED,
 	"real":                REAL,
+	"recursive":           RECURSIVE,
 	"references":          REFERENCES,
 	"regexp":              REGEXP,
-	"release":             UNUSED,
+	"release":             RELEASE,
 	"rename":              RENAME,
 	"reorganize":          REORGANIZE,
 	"repair":              REPAIR,
@@ -316,6 +325,7 @@ var keywords = map[string]int{
 	"right":               RIGHT,
 	"rlike":               REGEXP,
 	"rollback":            ROLLBACK,
+	"savepoint":           SAVEPOINT,
 	"schema":              SCHEMA,
 	"second_microsecond":  UNUSED,
 	"select":              SELECT,
@@ -351,6 +361,7 @@ var keywords = map[string]int{
 	"table":               TABLE,
 	"tables":              TABLES,
 	"terminated":          UNUSED,
+	"temporary":           TEMPORARY,
 	"text":                TEXT,
 	"than":                THAN,
 	"then":                THEN,
@@ -396,6 +407,7 @@ var keywords = map[string]int{
 	"when":                WHEN,
 	"where":               WHERE,
 	"while":               UNUSED,
+	"window":              WINDOW,
 	"with":                WITH,
 	"write":               WRITE,
 	"xor":                 UNUSED,
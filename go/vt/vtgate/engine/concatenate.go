@@ -0,0 +1,167 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*Concatenate)(nil)
+
+// Concatenate is a primitive that executes each of its sources
+// independently and returns the concatenation of their results.
+// It's used for UNION ALL of sources that cannot be merged into
+// a single route. The field info is taken from the first source.
+type Concatenate struct {
+	Sources []Primitive
+}
+
+// MarshalJSON serializes the Concatenate into a JSON representation.
+// It's used for testing and diagnostics.
+func (c *Concatenate) MarshalJSON() ([]byte, error) {
+	marshalConcatenate := struct {
+		Opcode  string
+		Sources []Primitive
+	}{
+		Opcode:  "Concatenate",
+		Sources: c.Sources,
+	}
+	return json.Marshal(marshalConcatenate)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (c *Concatenate) RouteType() string {
+	return "Concatenate"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (c *Concatenate) GetKeyspaceName() string {
+	var names []string
+	seen := make(map[string]bool)
+	for _, source := range c.Sources {
+		name := source.GetKeyspaceName()
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	return strings.Join(names, "_")
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (c *Concatenate) GetTableName() string {
+	names := make([]string, 0, len(c.Sources))
+	for _, source := range c.Sources {
+		names = append(names, source.GetTableName())
+	}
+	return strings.Join(names, "_")
+}
+
+// Execute performs a non-streaming exec.
+func (c *Concatenate) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{}
+	for i, source := range c.Sources {
+		qr, err := source.Execute(vcursor, bindVars, wantfields)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			result.Fields = qr.Fields
+		} else if err := checkColumnCount(result.Fields, qr.Fields); err != nil {
+			return nil, err
+		}
+		result.Rows = append(result.Rows, qr.Rows...)
+		result.RowsAffected += qr.RowsAffected
+		if len(result.Rows) > vcursor.MaxMemoryRows() {
+			return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+	}
+	return result, nil
+}
+
+// StreamExecute performs a streaming exec.
+func (c *Concatenate) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var fields []*querypb.Field
+	for i, source := range c.Sources {
+		first := i == 0
+		err := source.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
+			if len(qr.Fields) != 0 {
+				if first {
+					fields = qr.Fields
+					return callback(qr)
+				}
+				if err := checkColumnCount(fields, qr.Fields); err != nil {
+					return err
+				}
+				// The field info of the subsequent sources must not be sent.
+				if len(qr.Rows) == 0 {
+					return nil
+				}
+				return callback(&sqltypes.Result{Rows: qr.Rows})
+			}
+			return callback(qr)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetFields fetches the field info.
+func (c *Concatenate) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	var result *sqltypes.Result
+	for _, source := range c.Sources {
+		qr, err := source.GetFields(vcursor, bindVars)
+		if err != nil {
+			return nil, err
+		}
+		if result == nil {
+			result = qr
+			continue
+		}
+		if err := checkColumnCount(result.Fields, qr.Fields); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// Inputs returns the input primitives for this concatenate
+func (c *Concatenate) Inputs() []Primitive {
+	return c.Sources
+}
+
+// checkColumnCount verifies that the results of two sources can be
+// concatenated. The check is skipped if either side has no field info.
+func checkColumnCount(lfields, rfields []*querypb.Field) error {
+	if len(lfields) == 0 || len(rfields) == 0 {
+		return nil
+	}
+	if len(lfields) != len(rfields) {
+		return mysql.NewSQLError(mysql.ERWrongNumberOfColumnsInSelect, mysql.SSWrongNumberOfColumns, "The used SELECT statements have a different number of columns")
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestConcatenateExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col1|col2",
+		"int64|varchar",
+	)
+	lp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"1|a",
+			"2|b",
+		)},
+	}
+	rp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"2|b",
+			"3|c",
+		)},
+	}
+	c := &Concatenate{
+		Sources: []Primitive{lp, rp},
+	}
+
+	bv := map[string]*querypb.BindVariable{"a": sqltypes.Int64BindVariable(10)}
+	r, err := c.Execute(noopVCursor{}, bv, true)
+	require.NoError(t, err)
+	lp.ExpectLog(t, []string{`Execute a: type:INT64 value:"10"  true`})
+	rp.ExpectLog(t, []string{`Execute a: type:INT64 value:"10"  true`})
+	expectResult(t, "c.Execute", r, sqltypes.MakeTestResult(
+		fields,
+		"1|a",
+		"2|b",
+		"2|b",
+		"3|c",
+	))
+
+	// Streaming.
+	lp.rewind()
+	rp.rewind()
+	r, err = wrapStreamExecute(c, noopVCursor{}, bv, true)
+	require.NoError(t, err)
+	lp.ExpectLog(t, []string{`StreamExecute a: type:INT64 value:"10"  true`})
+	rp.ExpectLog(t, []string{`StreamExecute a: type:INT64 value:"10"  true`})
+	expectResult(t, "c.StreamExecute", r, sqltypes.MakeTestResult(
+		fields,
+		"1|a",
+		"2|b",
+		"2|b",
+		"3|c",
+	))
+
+	// GetFields.
+	lp.rewind()
+	rp.rewind()
+	r, err = c.GetFields(noopVCursor{}, bv)
+	require.NoError(t, err)
+	lp.ExpectLog(t, []string{
+		`GetFields a: type:INT64 value:"10" `,
+		`Execute a: type:INT64 value:"10"  true`,
+	})
+	expectResult(t, "c.GetFields", r, sqltypes.MakeTestResult(
+		fields,
+		"1|a",
+		"2|b",
+	))
+}
+
+func TestConcatenateColumnCountMismatch(t *testing.T) {
+	lp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col1|col2",
+				"int64|varchar",
+			),
+			"1|a",
+		)},
+	}
+	rp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col1",
+				"int64",
+			),
+			"1",
+		)},
+	}
+	c := &Concatenate{
+		Sources: []Primitive{lp, rp},
+	}
+	want := "The used SELECT statements have a different number of columns (errno 1222) (sqlstate 21000)"
+
+	_, err := c.Execute(noopVCursor{}, nil, true)
+	expectError(t, "c.Execute", err, want)
+
+	lp.rewind()
+	rp.rewind()
+	_, err = wrapStreamExecute(c, noopVCursor{}, nil, true)
+	expectError(t, "c.StreamExecute", err, want)
+
+	lp.rewind()
+	rp.rewind()
+	_, err = c.GetFields(noopVCursor{}, nil)
+	expectError(t, "c.GetFields", err, want)
+}
+
+func TestConcatenateSourceError(t *testing.T) {
+	lp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col1",
+				"int64",
+			),
+			"1",
+		)},
+	}
+	rp := &fakePrimitive{
+		sendErr: errors.New("err"),
+	}
+	c := &Concatenate{
+		Sources: []Primitive{lp, rp},
+	}
+
+	_, err := c.Execute(noopVCursor{}, nil, true)
+	expectError(t, "c.Execute", err, "err")
+
+	lp.rewind()
+	_, err = wrapStreamExecute(c, noopVCursor{}, nil, true)
+	expectError(t, "c.StreamExecute", err, "err")
+}
+
+func TestConcatenateMaxMemoryRows(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 3
+	defer func() { testMaxMemoryRows = save }()
+
+	fields := sqltypes.MakeTestFields(
+		"col1",
+		"int64",
+	)
+	c := &Concatenate{
+		Sources: []Primitive{
+			&fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, "1", "2")}},
+			&fakePrimitive{results: []*sqltypes.Result{sqltypes.MakeTestResult(fields, "3", "4")}},
+		},
+	}
+
+	_, err := c.Execute(noopVCursor{}, nil, true)
+	expectError(t, "c.Execute", err, "in-memory row count exceeded allowed limit of 3")
+}
@@ -37,9 +37,6 @@ var _ Primitive = (*Delete)(nil)
 // Delete represents the instructions to perform a delete.
 type Delete struct {
 	DML
-
-	// Delete does not take inputs
-	noInputs
 }
 
 // MarshalJSON serializes the Delete into a JSON representation.
@@ -66,6 +63,7 @@ func (del *Delete) MarshalJSON() ([]byte, error) {
 		KsidVindex           string               `json:",omitempty"`
 		MultiShardAutocommit bool                 `json:",omitempty"`
 		QueryTimeout         int                  `json:",omitempty"`
+		Input                Primitive            `json:",omitempty"`
 	}{
 		Opcode:               del.RouteType(),
 		Keyspace:             del.Keyspace,
@@ -77,6 +75,7 @@ func (del *Delete) MarshalJSON() ([]byte, error) {
 		KsidVindex:           ksidVindexName,
 		MultiShardAutocommit: del.MultiShardAutocommit,
 		QueryTimeout:         del.QueryTimeout,
+		Input:                del.Input,
 	}
 	return jsonutil.MarshalNoEscape(marshalDelete)
 }
@@ -86,6 +85,8 @@ var delName = map[DMLOpcode]string{
 	Equal:         "DeleteEqual",
 	Scatter:       "DeleteScatter",
 	ByDestination: "DeleteByDestination",
+	In:            "DeleteIn",
+	ScatterLimit:  "DeleteScatterLimit",
 }
 
 // RouteType returns a description of the query routing type used by the primitive
@@ -122,6 +123,10 @@ func (del *Delete) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 		return del.execDeleteByDestination(vcursor, bindVars, key.DestinationAllShards{})
 	case ByDestination:
 		return del.execDeleteByDestination(vcursor, bindVars, del.TargetDestination)
+	case In:
+		return del.execDeleteIn(vcursor, bindVars)
+	case ScatterLimit:
+		return del.execDeleteScatterLimit(vcursor, bindVars)
 	default:
 		// Unreachable.
 		return nil, fmt.Errorf("unsupported opcode: %v", del)
@@ -138,6 +143,14 @@ func (del *Delete) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindV
 	return nil, fmt.Errorf("BUG: unreachable code for %q", del.Query)
 }
 
+// Inputs returns the input primitives for this delete.
+func (del *Delete) Inputs() []Primitive {
+	if del.Input == nil {
+		return nil
+	}
+	return []Primitive{del.Input}
+}
+
 func (del *Delete) execDeleteUnsharded(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
 	rss, _, err := vcursor.ResolveDestinations(del.Keyspace.Name, nil, []key.Destination{key.DestinationAllShards{}})
 	if err != nil {
@@ -162,7 +175,7 @@ func (del *Delete) execDeleteEqual(vcursor VCursor, bindVars map[string]*querypb
 		return &sqltypes.Result{}, nil
 	}
 	if del.OwnedVindexQuery != "" {
-		err = del.deleteVindexEntries(vcursor, bindVars, []*srvtopo.ResolvedShard{rs})
+		err = del.deleteVindexEntries(vcursor, []*srvtopo.ResolvedShard{rs}, sameVars(bindVars, 1))
 		if err != nil {
 			return nil, vterrors.Wrap(err, "execDeleteEqual")
 		}
@@ -174,10 +187,10 @@ func (del *Delete) execDeleteEqual(vcursor VCursor, bindVars map[string]*querypb
 // deleteVindexEntries performs an delete if table owns vindex.
 // Note: the commit order may be different from the DML order because it's possible
 // for DMLs to reuse existing transactions.
-func (del *Delete) deleteVindexEntries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) error {
+func (del *Delete) deleteVindexEntries(vcursor VCursor, rss []*srvtopo.ResolvedShard, shardVars []map[string]*querypb.BindVariable) error {
 	queries := make([]*querypb.BoundQuery, len(rss))
 	for i := range rss {
-		queries[i] = &querypb.BoundQuery{Sql: del.OwnedVindexQuery, BindVariables: bindVars}
+		queries[i] = &querypb.BoundQuery{Sql: del.OwnedVindexQuery, BindVariables: shardVars[i]}
 	}
 	subQueryResults, errors := vcursor.ExecuteMultiShard(rss, queries, false, false)
 	for _, err := range errors {
@@ -218,18 +231,42 @@ func (del *Delete) execDeleteByDestination(vcursor VCursor, bindVars map[string]
 	if err != nil {
 		return nil, vterrors.Wrap(err, "execDeleteScatter")
 	}
+	return del.execDeleteMultiShard(vcursor, rss, sameVars(bindVars, len(rss)))
+}
 
+func (del *Delete) execDeleteIn(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	rss, err := del.resolveShardsIn(vcursor, bindVars)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execDeleteIn")
+	}
+	if len(rss) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+	return del.execDeleteMultiShard(vcursor, rss, sameVars(bindVars, len(rss)))
+}
+
+func (del *Delete) execDeleteScatterLimit(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	rss, shardVars, err := del.resolveShardsLimit(vcursor, bindVars)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execDeleteScatterLimit")
+	}
+	if len(rss) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+	return del.execDeleteMultiShard(vcursor, rss, shardVars)
+}
+
+func (del *Delete) execDeleteMultiShard(vcursor VCursor, rss []*srvtopo.ResolvedShard, shardVars []map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
 	queries := make([]*querypb.BoundQuery, len(rss))
 	sql := sqlannotation.AnnotateIfDML(del.Query, nil)
 	for i := range rss {
 		queries[i] = &querypb.BoundQuery{
 			Sql:           sql,
-			BindVariables: bindVars,
+			BindVariables: shardVars[i],
 		}
 	}
 	if len(del.Table.Owned) > 0 {
-		err = del.deleteVindexEntries(vcursor, bindVars, rss)
-		if err != nil {
+		if err := del.deleteVindexEntries(vcursor, rss, shardVars); err != nil {
 			return nil, err
 		}
 	}
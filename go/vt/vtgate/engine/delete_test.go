@@ -20,6 +20,8 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
@@ -264,6 +266,96 @@ func TestDeleteSharded(t *testing.T) {
 	expectError(t, "Execute", err, "execDeleteScatter: shard_error")
 }
 
+func TestDeleteIn(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	del := &Delete{
+		DML: DML{
+			Opcode:   In,
+			Keyspace: ks.Keyspace,
+			Query:    "dummy_delete",
+			Vindex:   ks.Vindexes["hash"].(vindexes.SingleColumn),
+			Values:   []sqltypes.PlanValue{{ListKey: "__sq1"}},
+			Table:    ks.Tables["t2"],
+		},
+	}
+	bv := map[string]*querypb.BindVariable{
+		"__sq1": sqltypes.TestBindVariable([]interface{}{1, 2}),
+	}
+
+	vc := &loggingVCursor{shards: []string{"-20", "20-"}}
+	_, err := del.Execute(vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [type:INT64 value:"1"  type:INT64 value:"2" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard sharded.-20: dummy_delete {__sq1: type:TUPLE values:<type:INT64 value:"1" > values:<type:INT64 value:"2" > } true true`,
+	})
+
+	// Failure case
+	_, err = del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, "execDeleteIn: missing bind var __sq1")
+}
+
+func TestDeleteScatterLimit(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|col",
+				"int64|int64",
+			),
+			"1|10",
+			"2|11",
+			"3|12",
+		)},
+	}
+	del := &Delete{
+		DML: DML{
+			Opcode:   ScatterLimit,
+			Keyspace: ks.Keyspace,
+			Query:    "dummy_delete",
+			Vindex:   ks.Vindexes["hash"].(vindexes.SingleColumn),
+			Table:    ks.Tables["t2"],
+			Input:    input,
+		},
+	}
+
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"-20", "20-", "-20"},
+	}
+	_, err := del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	input.ExpectLog(t, []string{
+		`Execute  false`,
+	})
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [type:INT64 value:"1"  type:INT64 value:"2"  type:INT64 value:"3" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f),DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		`ExecuteMultiShard sharded.-20: dummy_delete {__dml_limit: type:INT64 value:"2" } sharded.20-: dummy_delete {__dml_limit: type:INT64 value:"1" } true false`,
+	})
+
+	// No rows selected
+	input = &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|col",
+				"int64|int64",
+			),
+		)},
+	}
+	del.Input = input
+	vc.Rewind()
+	_, err = del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:`,
+	})
+
+	// Failure case
+	del.Input = &fakePrimitive{sendErr: errors.New("input err")}
+	_, err = del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, "execDeleteScatterLimit: input err")
+}
+
 func TestDeleteNoStream(t *testing.T) {
 	del := &Delete{}
 	err := del.StreamExecute(nil, nil, false, nil)
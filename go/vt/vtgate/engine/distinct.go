@@ -0,0 +1,247 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*Distinct)(nil)
+
+// Distinct is a primitive that removes duplicate rows
+// from the results of its source. It's used for UNION
+// of sources that cannot be merged into a single route.
+// Numeric values are compared by value. Text values are
+// compared using the collation of their column, which is
+// why the fields are always requested from the source.
+type Distinct struct {
+	Source Primitive
+}
+
+// MarshalJSON serializes the Distinct into a JSON representation.
+// It's used for testing and diagnostics.
+func (d *Distinct) MarshalJSON() ([]byte, error) {
+	marshalDistinct := struct {
+		Opcode string
+		Source Primitive
+	}{
+		Opcode: "Distinct",
+		Source: d.Source,
+	}
+	return json.Marshal(marshalDistinct)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (d *Distinct) RouteType() string {
+	return d.Source.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (d *Distinct) GetKeyspaceName() string {
+	return d.Source.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (d *Distinct) GetTableName() string {
+	return d.Source.GetTableName()
+}
+
+// Execute performs a non-streaming exec.
+func (d *Distinct) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	input, err := d.Source.Execute(vcursor, bindVars, true)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	result := &sqltypes.Result{}
+	if wantfields {
+		result.Fields = input.Fields
+	}
+	result.Rows = d.filter(seen, fieldCollations(input.Fields), input.Rows)
+	result.RowsAffected = uint64(len(result.Rows))
+	return result, nil
+}
+
+// StreamExecute performs a streaming exec.
+func (d *Distinct) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	seen := make(map[string]bool)
+	var collations []evalengine.Collation
+	return d.Source.StreamExecute(vcursor, bindVars, true, func(input *sqltypes.Result) error {
+		var fields []*querypb.Field
+		if input.Fields != nil {
+			collations = fieldCollations(input.Fields)
+			if wantfields {
+				fields = input.Fields
+			}
+		}
+		rows := d.filter(seen, collations, input.Rows)
+		if len(seen) > vcursor.MaxMemoryRows() {
+			return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+		if len(fields) == 0 && len(rows) == 0 {
+			return nil
+		}
+		return callback(&sqltypes.Result{Fields: fields, Rows: rows})
+	})
+}
+
+// GetFields fetches the field info.
+func (d *Distinct) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return d.Source.GetFields(vcursor, bindVars)
+}
+
+// Inputs returns the input to this primitive
+func (d *Distinct) Inputs() []Primitive {
+	return []Primitive{d.Source}
+}
+
+// filter returns the rows that are not already in seen,
+// and adds them to it.
+func (d *Distinct) filter(seen map[string]bool, collations []evalengine.Collation, rows [][]sqltypes.Value) [][]sqltypes.Value {
+	var out [][]sqltypes.Value
+	for _, row := range rows {
+		key := distinctKey(row, collations)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		out = append(out, row)
+	}
+	return out
+}
+
+// fieldCollations returns the collations of the fields.
+func fieldCollations(fields []*querypb.Field) []evalengine.Collation {
+	collations := make([]evalengine.Collation, 0, len(fields))
+	for _, field := range fields {
+		collations = append(collations, evalengine.FieldCollation(field))
+	}
+	return collations
+}
+
+// distinctKey builds a key for the row such that two rows
+// that are considered equal produce the same key. Text values
+// are compared using the collation of their column. They're
+// compared byte-wise if collations doesn't cover the column.
+func distinctKey(row []sqltypes.Value, collations []evalengine.Collation) string {
+	var buf []byte
+	for i, v := range row {
+		var val []byte
+		switch {
+		case v.IsNull():
+			buf = append(buf, 'n')
+			continue
+		case v.IsIntegral():
+			buf = append(buf, 'i')
+			val = v.Raw()
+		case v.Type() == sqltypes.Decimal:
+			var whole bool
+			val, whole = normalizeDecimal(v.Raw())
+			switch {
+			case val == nil:
+				buf = append(buf, 's')
+				val = v.Raw()
+			case whole:
+				buf = append(buf, 'i')
+			default:
+				buf = append(buf, 'f')
+			}
+		case v.IsFloat():
+			f, err := sqltypes.ToFloat64(v)
+			if err != nil {
+				buf = append(buf, 's')
+				val = v.Raw()
+				break
+			}
+			if f == math.Trunc(f) {
+				// Make whole numbers match their integral representation.
+				buf = append(buf, 'i')
+				val = strconv.AppendFloat(nil, f, 'f', -1, 64)
+				break
+			}
+			buf = append(buf, 'f')
+			val = strconv.AppendFloat(nil, f, 'f', -1, 64)
+		case i < len(collations):
+			buf = append(buf, 's')
+			val = evalengine.Weight(v, collations[i])
+		default:
+			buf = append(buf, 's')
+			val = v.Raw()
+		}
+		var l [binary.MaxVarintLen64]byte
+		buf = append(buf, l[:binary.PutUvarint(l[:], uint64(len(val)))]...)
+		buf = append(buf, val...)
+	}
+	return string(buf)
+}
+
+// normalizeDecimal returns the text of a decimal without leading
+// zeros, trailing fractional zeros and the sign of zero, so that
+// equal decimals have the same text, and whole decimals have the
+// text of the equal integral. It also returns whether the decimal
+// is whole. It returns nil if raw isn't a decimal.
+func normalizeDecimal(raw []byte) ([]byte, bool) {
+	neg := false
+	if len(raw) > 0 && (raw[0] == '-' || raw[0] == '+') {
+		neg = raw[0] == '-'
+		raw = raw[1:]
+	}
+	intPart, frac := raw, []byte(nil)
+	if i := bytes.IndexByte(raw, '.'); i >= 0 {
+		intPart, frac = raw[:i], raw[i+1:]
+	}
+	if len(intPart)+len(frac) == 0 || !isDigits(intPart) || !isDigits(frac) {
+		return nil, false
+	}
+	intPart = bytes.TrimLeft(intPart, "0")
+	frac = bytes.TrimRight(frac, "0")
+	if len(intPart) == 0 {
+		if len(frac) == 0 {
+			return []byte("0"), true
+		}
+		intPart = []byte("0")
+	}
+	var val []byte
+	if neg {
+		val = append(val, '-')
+	}
+	val = append(val, intPart...)
+	if len(frac) == 0 {
+		return val, true
+	}
+	val = append(val, '.')
+	return append(val, frac...), false
+}
+
+func isDigits(b []byte) bool {
+	for _, c := range b {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,227 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestDistinctExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col1|col2",
+		"int64|varchar",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"1|a",
+			"2|b",
+			"1|a",
+			"1|b",
+			"null|a",
+			"null|a",
+			"2|b",
+		)},
+	}
+	d := &Distinct{Source: fp}
+	want := sqltypes.MakeTestResult(
+		fields,
+		"1|a",
+		"2|b",
+		"1|b",
+		"null|a",
+	)
+
+	r, err := d.Execute(noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	expectResult(t, "d.Execute", r, want)
+
+	// Streaming: duplicates span multiple packets.
+	fp.rewind()
+	r, err = wrapStreamExecute(d, noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	expectResult(t, "d.StreamExecute", r, want)
+}
+
+func TestDistinctNumericValues(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col1",
+		"decimal",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"1",
+			"1.0",
+			"1.50",
+			"1.5",
+			"2",
+		)},
+	}
+	d := &Distinct{Source: fp}
+
+	r, err := d.Execute(noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	expectResult(t, "d.Execute", r, sqltypes.MakeTestResult(
+		fields,
+		"1",
+		"1.50",
+		"2",
+	))
+}
+
+func TestDistinctDecimalPrecision(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col1|col2",
+		"decimal|int64",
+	)
+	// The decimals differ beyond the precision of float64.
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"12345678901234567.1|1",
+			"12345678901234567.2|1",
+			"12345678901234567.10|1",
+			"9007199254740993.00|1",
+			"9007199254740992|1",
+			"-0.0|1",
+			"0|1",
+		)},
+	}
+	d := &Distinct{Source: fp}
+
+	r, err := d.Execute(noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	expectResult(t, "d.Execute", r, sqltypes.MakeTestResult(
+		fields,
+		"12345678901234567.1|1",
+		"12345678901234567.2|1",
+		"9007199254740993.00|1",
+		"9007199254740992|1",
+		"-0.0|1",
+	))
+}
+
+func TestDistinctCollation(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col1|col2",
+		"varchar|varchar",
+	)
+	// col2 has a binary collation.
+	fields[1].Flags = uint32(querypb.MySqlFlag_BINARY_FLAG)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|x",
+			"A|x",
+			"a |x",
+			"b|x",
+			"b|X",
+		)},
+	}
+	d := &Distinct{Source: fp}
+	want := sqltypes.MakeTestResult(
+		fields,
+		"a|x",
+		"b|x",
+		"b|X",
+	)
+
+	r, err := d.Execute(noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	expectResult(t, "d.Execute", r, want)
+
+	fp.rewind()
+	r, err = wrapStreamExecute(d, noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	expectResult(t, "d.StreamExecute", r, want)
+}
+
+func TestDistinctStreamExecute(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col1",
+		"varchar",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a",
+			"b",
+			"B",
+			"A",
+			"c",
+		)},
+	}
+	d := &Distinct{Source: fp}
+
+	// The fields are needed for the collations,
+	// even if the caller doesn't want them.
+	var results []*sqltypes.Result
+	err := d.StreamExecute(noopVCursor{}, nil, false, func(qr *sqltypes.Result) error {
+		results = append(results, qr)
+		return nil
+	})
+	require.NoError(t, err)
+	fp.ExpectLog(t, []string{
+		`StreamExecute  true`,
+	})
+	// The source sends two rows at a time. The second
+	// packet only has duplicates, and isn't sent.
+	require.Equal(t, []*sqltypes.Result{
+		{Rows: sqltypes.MakeTestResult(fields, "a", "b").Rows},
+		{Rows: sqltypes.MakeTestResult(fields, "c").Rows},
+	}, results)
+
+	fp.rewind()
+	r, err := d.Execute(noopVCursor{}, nil, false)
+	require.NoError(t, err)
+	fp.ExpectLog(t, []string{
+		`Execute  true`,
+	})
+	expectResult(t, "d.Execute", r, &sqltypes.Result{
+		Rows:         sqltypes.MakeTestResult(fields, "a", "b", "c").Rows,
+		RowsAffected: 3,
+	})
+}
+
+func TestDistinctMaxMemoryRows(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() { testMaxMemoryRows = save }()
+
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col1",
+				"int64",
+			),
+			"1",
+			"2",
+			"3",
+		)},
+	}
+	d := &Distinct{Source: fp}
+
+	_, err := wrapStreamExecute(d, noopVCursor{}, nil, true)
+	expectError(t, "d.StreamExecute", err, "in-memory row count exceeded allowed limit of 2")
+}
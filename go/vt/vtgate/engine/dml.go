@@ -19,7 +19,10 @@ package engine
 import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 // DML contains the common elements between Update and Delete plans
@@ -40,7 +43,8 @@ type DML struct {
 	Vindex vindexes.SingleColumn
 
 	// Values specifies the vindex values to use for routing.
-	// For now, only one value is specified.
+	// For now, only one value is specified. It's a list
+	// for the In opcode.
 	Values []sqltypes.PlanValue
 
 	// Keyspace Id Vindex
@@ -58,6 +62,10 @@ type DML struct {
 
 	// QueryTimeout contains the optional timeout (in milliseconds) to apply to this query
 	QueryTimeout int
+
+	// Input selects the vindex values of the rows to be
+	// changed. It's used by the ScatterLimit opcode.
+	Input Primitive
 }
 
 // DMLOpcode is a number representing the opcode
@@ -81,4 +89,63 @@ const (
 	// in the clause:
 	// e.g: UPDATE `keyspace[-]`.x1 SET foo=1
 	ByDestination
+	// In is for routing a statement to the shards of
+	// a list of values: Requires: A Vindex, and a single
+	// list Value. This is used when the values come from
+	// a subquery that was pulled out.
+	In
+	// ScatterLimit is for routing a multi-shard statement
+	// with a LIMIT clause: Requires: A Vindex, and an Input.
+	// The Input selects the vindex values of the rows to be
+	// changed, with the ordering and limit of the statement.
+	// The statement is then sent to the shards of those rows,
+	// limited to the number of rows selected from each shard.
+	ScatterLimit
 )
+
+// resolveShardsIn resolves the shards for the list
+// of vindex values of the In opcode.
+func (dml *DML) resolveShardsIn(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, error) {
+	keys, err := dml.Values[0].ResolveList(bindVars)
+	if err != nil {
+		return nil, err
+	}
+	rss, _, err := resolveShards(vcursor, dml.Vindex, dml.Keyspace, keys)
+	return rss, err
+}
+
+// resolveShardsLimit executes the Input of the ScatterLimit opcode
+// and resolves the shards of the vindex values it returns. It also
+// returns the bind variables for each shard, which restrict the
+// statement to the number of rows that were selected from it.
+func (dml *DML) resolveShardsLimit(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	result, err := dml.Input.Execute(vcursor, bindVars, false)
+	if err != nil {
+		return nil, nil, err
+	}
+	keys := make([]sqltypes.Value, 0, len(result.Rows))
+	for _, row := range result.Rows {
+		keys = append(keys, row[0])
+	}
+	rss, values, err := resolveShards(vcursor, dml.Vindex, dml.Keyspace, keys)
+	if err != nil {
+		return nil, nil, err
+	}
+	shardVars := make([]map[string]*querypb.BindVariable, len(rss))
+	for i := range rss {
+		shardVars[i] = combineVars(bindVars, map[string]*querypb.BindVariable{
+			DMLLimitVarName: sqltypes.Int64BindVariable(int64(len(values[i]))),
+		})
+	}
+	return rss, shardVars, nil
+}
+
+// sameVars returns the bind variables for
+// a statement that's sent to count shards.
+func sameVars(bindVars map[string]*querypb.BindVariable, count int) []map[string]*querypb.BindVariable {
+	shardVars := make([]map[string]*querypb.BindVariable, count)
+	for i := range shardVars {
+		shardVars[i] = bindVars
+	}
+	return shardVars
+}
@@ -39,6 +39,10 @@ import (
 
 var testMaxMemoryRows = 100
 
+var testSpillConfig SpillConfig
+
+var testInsertSelectBatchSize = 500
+
 // noopVCursor is used to build other vcursors.
 type noopVCursor struct {
 }
@@ -51,6 +55,14 @@ func (t noopVCursor) MaxMemoryRows() int {
 	return testMaxMemoryRows
 }
 
+func (t noopVCursor) SpillConfig() SpillConfig {
+	return testSpillConfig
+}
+
+func (t noopVCursor) InsertSelectBatchSize() int {
+	return testInsertSelectBatchSize
+}
+
 func (t noopVCursor) SetContextTimeout(timeout time.Duration) context.CancelFunc {
 	return func() {}
 }
@@ -70,6 +82,10 @@ func (t noopVCursor) AutocommitApproval() bool {
 	panic("unimplemented")
 }
 
+func (t noopVCursor) InMultiShardTransaction() bool {
+	panic("unimplemented")
+}
+
 func (t noopVCursor) ExecuteStandalone(query string, bindvars map[string]*querypb.BindVariable, rs *srvtopo.ResolvedShard) (*sqltypes.Result, error) {
 	panic("unimplemented")
 }
@@ -106,6 +122,10 @@ type loggingVCursor struct {
 	// multi-shard queries
 	multiShardErrs []error
 
+	// singleShardTx makes the session be in a
+	// transaction that cannot span multiple shards.
+	singleShardTx bool
+
 	log []string
 }
 
@@ -151,6 +171,10 @@ func (f *loggingVCursor) AutocommitApproval() bool {
 	return true
 }
 
+func (f *loggingVCursor) InMultiShardTransaction() bool {
+	return !f.singleShardTx
+}
+
 func (f *loggingVCursor) ExecuteStandalone(query string, bindvars map[string]*querypb.BindVariable, rs *srvtopo.ResolvedShard) (*sqltypes.Result, error) {
 	f.log = append(f.log, fmt.Sprintf("ExecuteStandalone %s %v %s %s", query, printBindVars(bindvars), rs.Target.Keyspace, rs.Target.Shard))
 	return f.nextResult()
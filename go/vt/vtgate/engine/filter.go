@@ -0,0 +1,155 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*Filter)(nil)
+
+// Filter is a primitive that returns only the rows of its
+// input for which the predicate is true. It's used for
+// HAVING clauses that reference the results of aggregates
+// computed by vtgate.
+type Filter struct {
+	Predicate evalengine.Expr
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	TruncateColumnCount int
+
+	// Input is the primitive that will feed into this Primitive.
+	Input Primitive
+}
+
+// MarshalJSON serializes the Filter into a JSON representation.
+// It's used for testing and diagnostics.
+func (f *Filter) MarshalJSON() ([]byte, error) {
+	marshalFilter := struct {
+		Opcode              string
+		Predicate           string
+		TruncateColumnCount int `json:",omitempty"`
+		Input               Primitive
+	}{
+		Opcode:              "Filter",
+		Predicate:           f.Predicate.String(),
+		TruncateColumnCount: f.TruncateColumnCount,
+		Input:               f.Input,
+	}
+	return json.Marshal(marshalFilter)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (f *Filter) RouteType() string {
+	return f.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (f *Filter) GetKeyspaceName() string {
+	return f.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (f *Filter) GetTableName() string {
+	return f.Input.GetTableName()
+}
+
+// SetTruncateColumnCount sets the truncate column count.
+func (f *Filter) SetTruncateColumnCount(count int) {
+	f.TruncateColumnCount = count
+}
+
+// Execute performs a non-streaming exec.
+// The fields of the input are always requested because
+// the evaluation of the predicate depends on them.
+func (f *Filter) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	input, err := f.Input.Execute(vcursor, bindVars, true)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{}
+	result.Rows, err = f.filter(bindVars, input.Fields, input.Rows)
+	if err != nil {
+		return nil, err
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	if wantfields {
+		result.Fields = input.Fields
+	}
+	return result.Truncate(f.TruncateColumnCount), nil
+}
+
+// StreamExecute performs a streaming exec.
+func (f *Filter) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var inputFields []*querypb.Field
+	return f.Input.StreamExecute(vcursor, bindVars, true, func(input *sqltypes.Result) error {
+		result := &sqltypes.Result{}
+		if input.Fields != nil {
+			inputFields = input.Fields
+			if wantfields {
+				result.Fields = inputFields
+			}
+		}
+		var err error
+		result.Rows, err = f.filter(bindVars, inputFields, input.Rows)
+		if err != nil {
+			return err
+		}
+		if result.Fields == nil && len(result.Rows) == 0 {
+			return nil
+		}
+		return callback(result.Truncate(f.TruncateColumnCount))
+	})
+}
+
+// GetFields fetches the field info.
+func (f *Filter) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := f.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return qr.Truncate(f.TruncateColumnCount), nil
+}
+
+// Inputs returns the input to this primitive
+func (f *Filter) Inputs() []Primitive {
+	return []Primitive{f.Input}
+}
+
+// filter returns the rows for which the predicate is true.
+func (f *Filter) filter(bindVars map[string]*querypb.BindVariable, fields []*querypb.Field, rows [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	var out [][]sqltypes.Value
+	env := evalengine.ExpressionEnv{BindVars: bindVars, Fields: fields}
+	for _, row := range rows {
+		env.Row = row
+		ok, err := evalengine.IsSatisfied(f.Predicate, env)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			out = append(out, row)
+		}
+	}
+	return out, nil
+}
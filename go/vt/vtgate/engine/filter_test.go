@@ -0,0 +1,101 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestFilterExecute(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"customer|count(*)",
+				"varchar|int64",
+			),
+			"a|11",
+			"b|10",
+			"c|null",
+			"d|20",
+			"e|3",
+		)},
+	}
+	// count(*) > :n
+	f := &Filter{
+		Predicate: &evalengine.Comparison{
+			Op:    evalengine.GreaterThan,
+			Left:  &evalengine.Column{Offset: 1},
+			Right: &evalengine.BindVariable{Key: "n"},
+		},
+		TruncateColumnCount: 1,
+		Input:               fp,
+	}
+	bv := map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(10)}
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"customer",
+			"varchar",
+		),
+		"a",
+		"d",
+	)
+
+	r, err := f.Execute(noopVCursor{}, bv, true)
+	require.NoError(t, err)
+	expectResult(t, "f.Execute", r, want)
+
+	// Streaming: rows are filtered per packet.
+	fp.rewind()
+	r, err = wrapStreamExecute(f, noopVCursor{}, bv, true)
+	require.NoError(t, err)
+	expectResult(t, "f.StreamExecute", r, want)
+
+	fp.rewind()
+	r, err = f.GetFields(noopVCursor{}, bv)
+	require.NoError(t, err)
+	expectResult(t, "f.GetFields", &sqltypes.Result{Fields: r.Fields}, &sqltypes.Result{Fields: want.Fields})
+}
+
+func TestFilterError(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"a",
+				"int64",
+			),
+			"1",
+		)},
+	}
+	f := &Filter{
+		Predicate: &evalengine.BindVariable{Key: "b"},
+		Input:     fp,
+	}
+
+	_, err := f.Execute(noopVCursor{}, nil, false)
+	expectError(t, "f.Execute", err, "missing bind var b")
+
+	fp.rewind()
+	_, err = wrapStreamExecute(f, noopVCursor{}, nil, false)
+	expectError(t, "f.StreamExecute", err, "missing bind var b")
+}
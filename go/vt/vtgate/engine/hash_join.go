@@ -0,0 +1,375 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*HashJoin)(nil)
+
+// HashJoin specifies the parameters for a hash join primitive.
+// Unlike Join, which executes the RHS once for every row of the
+// LHS, HashJoin executes each side only once. The rows of the RHS
+// are loaded in memory and indexed by the values of RHSKeys. The
+// rows of the LHS are then matched against them using LHSKeys.
+// When streaming, an RHS that doesn't fit in memory can be spilled
+// to disk, and is then matched in chunks.
+type HashJoin struct {
+	// Left and Right are the LHS and RHS primitives
+	// of the Join. They can be any primitive.
+	Left, Right Primitive
+
+	// Cols defines which columns from the left
+	// or right results should be used to build the
+	// return result. The values follow the same
+	// convention as Join.Cols.
+	Cols []int
+
+	// LHSKeys and RHSKeys are the columns of the left and
+	// right results that must be equal for two rows to join.
+	LHSKeys, RHSKeys []int
+}
+
+// MarshalJSON serializes the HashJoin into a JSON representation.
+// It's used for testing and diagnostics.
+func (hj *HashJoin) MarshalJSON() ([]byte, error) {
+	marshalHashJoin := struct {
+		Opcode  string
+		Left    Primitive
+		Right   Primitive
+		Cols    []int
+		LHSKeys []int
+		RHSKeys []int
+	}{
+		Opcode:  "HashJoin",
+		Left:    hj.Left,
+		Right:   hj.Right,
+		Cols:    hj.Cols,
+		LHSKeys: hj.LHSKeys,
+		RHSKeys: hj.RHSKeys,
+	}
+	return json.Marshal(marshalHashJoin)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (hj *HashJoin) RouteType() string {
+	return "HashJoin"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (hj *HashJoin) GetKeyspaceName() string {
+	if hj.Left.GetKeyspaceName() == hj.Right.GetKeyspaceName() {
+		return hj.Left.GetKeyspaceName()
+	}
+	return hj.Left.GetKeyspaceName() + "_" + hj.Right.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (hj *HashJoin) GetTableName() string {
+	return hj.Left.GetTableName() + "_" + hj.Right.GetTableName()
+}
+
+// Execute performs a non-streaming exec.
+// The fields of both sides are always requested
+// because they tell how the keys must be compared.
+func (hj *HashJoin) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	rresult, err := hj.Right.Execute(vcursor, bindVars, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(rresult.Rows) > vcursor.MaxMemoryRows() {
+		return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+	}
+	result := &sqltypes.Result{}
+	if len(rresult.Rows) == 0 {
+		// Nothing can match. There's no need to execute the LHS.
+		if wantfields {
+			lresult, err := hj.Left.GetFields(vcursor, bindVars)
+			if err != nil {
+				return nil, err
+			}
+			result.Fields = joinFields(lresult.Fields, rresult.Fields, hj.Cols)
+		}
+		return result, nil
+	}
+	lresult, err := hj.Left.Execute(vcursor, bindVars, true)
+	if err != nil {
+		return nil, err
+	}
+	if wantfields {
+		result.Fields = joinFields(lresult.Fields, rresult.Fields, hj.Cols)
+	}
+	table := hj.newHashTable(newKeyComparison(lresult.Fields, rresult.Fields, hj.LHSKeys, hj.RHSKeys), rresult.Rows)
+	for _, lrow := range lresult.Rows {
+		result.Rows = append(result.Rows, table.probe(hj, lrow)...)
+		if len(result.Rows) > vcursor.MaxMemoryRows() {
+			return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	return result, nil
+}
+
+// StreamExecute performs a streaming exec.
+// Only the RHS is loaded in memory. The results
+// are streamed as the LHS rows are received.
+// If the RHS exceeds max_memory_rows, it's spilled to
+// disk if it's enabled by the SpillConfig of the VCursor.
+func (hj *HashJoin) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var spill *spillFile
+	if config := vcursor.SpillConfig(); config.Enabled() {
+		spill = newSpillFile(config)
+		defer spill.close()
+	}
+	var rfields []*querypb.Field
+	var rrows [][]sqltypes.Value
+	err := hj.Right.StreamExecute(vcursor, bindVars, true, func(rresult *sqltypes.Result) error {
+		if rresult.Fields != nil {
+			rfields = rresult.Fields
+		}
+		rrows = append(rrows, rresult.Rows...)
+		if len(rrows) <= vcursor.MaxMemoryRows() {
+			return nil
+		}
+		if spill == nil {
+			return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+		// The rows are written as a chunk of the RHS.
+		err := spill.writeRun(rrows)
+		rrows = nil
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if spill != nil && len(spill.runs) != 0 {
+		if err := spill.writeRun(rrows); err != nil {
+			return err
+		}
+		return hj.streamSpilled(vcursor, bindVars, wantfields, rfields, spill, callback)
+	}
+
+	var table *hashTable
+	return hj.Left.StreamExecute(vcursor, bindVars, true, func(lresult *sqltypes.Result) error {
+		result := &sqltypes.Result{}
+		if table == nil {
+			// The fields are sent before the rows.
+			table = hj.newHashTable(newKeyComparison(lresult.Fields, rfields, hj.LHSKeys, hj.RHSKeys), rrows)
+		}
+		if wantfields && lresult.Fields != nil {
+			wantfields = false
+			result.Fields = joinFields(lresult.Fields, rfields, hj.Cols)
+		}
+		for _, lrow := range lresult.Rows {
+			result.Rows = append(result.Rows, table.probe(hj, lrow)...)
+		}
+		if result.Fields == nil && len(result.Rows) == 0 {
+			return nil
+		}
+		return callback(result)
+	})
+}
+
+// streamSpilled joins the LHS with the RHS that was spilled in
+// chunks. The LHS rows are buffered, up to max_memory_rows, and
+// matched against the chunks one after the other, so that only
+// one chunk is in memory at a time. The joined rows are still
+// sent in the order of the LHS rows.
+func (hj *HashJoin) streamSpilled(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, rfields []*querypb.Field, spill *spillFile, callback func(*sqltypes.Result) error) error {
+	var kc *keyComparison
+	var lrows [][]sqltypes.Value
+	flush := func() error {
+		if len(lrows) == 0 {
+			return nil
+		}
+		matches := make([][][]sqltypes.Value, len(lrows))
+		for i := range spill.runs {
+			rrows, err := spill.readRun(i)
+			if err != nil {
+				return err
+			}
+			table := hj.newHashTable(kc, rrows)
+			for j, lrow := range lrows {
+				matches[j] = append(matches[j], table.probe(hj, lrow)...)
+			}
+		}
+		lrows = nil
+		result := &sqltypes.Result{}
+		for _, rows := range matches {
+			result.Rows = append(result.Rows, rows...)
+		}
+		if len(result.Rows) == 0 {
+			return nil
+		}
+		return callback(result)
+	}
+	err := hj.Left.StreamExecute(vcursor, bindVars, true, func(lresult *sqltypes.Result) error {
+		if kc == nil {
+			// The fields are sent before the rows.
+			kc = newKeyComparison(lresult.Fields, rfields, hj.LHSKeys, hj.RHSKeys)
+		}
+		if wantfields && lresult.Fields != nil {
+			wantfields = false
+			if err := callback(&sqltypes.Result{Fields: joinFields(lresult.Fields, rfields, hj.Cols)}); err != nil {
+				return err
+			}
+		}
+		lrows = append(lrows, lresult.Rows...)
+		if len(lrows) < vcursor.MaxMemoryRows() {
+			return nil
+		}
+		return flush()
+	})
+	if err != nil {
+		return err
+	}
+	return flush()
+}
+
+// GetFields fetches the field info.
+func (hj *HashJoin) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	lresult, err := hj.Left.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	rresult, err := hj.Right.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: joinFields(lresult.Fields, rresult.Fields, hj.Cols)}, nil
+}
+
+// Inputs returns the input primitives for this join
+func (hj *HashJoin) Inputs() []Primitive {
+	return []Primitive{hj.Left, hj.Right}
+}
+
+// hashTable contains the RHS rows indexed by their keys.
+type hashTable struct {
+	kc   *keyComparison
+	rows map[string][][]sqltypes.Value
+}
+
+// newHashTable indexes the RHS rows. Rows whose keys
+// contain a NULL are discarded because they cannot
+// match anything.
+func (hj *HashJoin) newHashTable(kc *keyComparison, rrows [][]sqltypes.Value) *hashTable {
+	table := &hashTable{
+		kc:   kc,
+		rows: make(map[string][][]sqltypes.Value),
+	}
+	for _, rrow := range rrows {
+		key, ok := kc.key(rrow, hj.RHSKeys)
+		if !ok {
+			continue
+		}
+		table.rows[key] = append(table.rows[key], rrow)
+	}
+	return table
+}
+
+// probe returns the joined rows for the LHS row.
+func (table *hashTable) probe(hj *HashJoin, lrow []sqltypes.Value) [][]sqltypes.Value {
+	key, ok := table.kc.key(lrow, hj.LHSKeys)
+	if !ok {
+		return nil
+	}
+	var out [][]sqltypes.Value
+	for _, rrow := range table.rows[key] {
+		out = append(out, joinRows(lrow, rrow, hj.Cols))
+	}
+	return out
+}
+
+// keyComparison tells how the keys of the two sides of a join are
+// compared. Like mysql, a number and a string are compared as numbers,
+// and text is compared using the collation of the columns, which is
+// binary if one of them is binary.
+type keyComparison struct {
+	numeric    []bool
+	collations []evalengine.Collation
+}
+
+// newKeyComparison returns the keyComparison of the key columns
+// lkeys and rkeys, based on the fields of their results.
+func newKeyComparison(lfields, rfields []*querypb.Field, lkeys, rkeys []int) *keyComparison {
+	kc := &keyComparison{
+		numeric:    make([]bool, len(lkeys)),
+		collations: make([]evalengine.Collation, len(lkeys)),
+	}
+	for i := range lkeys {
+		lfield, rfield := fieldAt(lfields, lkeys[i]), fieldAt(rfields, rkeys[i])
+		kc.numeric[i] = isNumberField(lfield) || isNumberField(rfield)
+		kc.collations[i] = evalengine.CollationDefault
+		if evalengine.FieldCollation(lfield) == evalengine.CollationBinary || evalengine.FieldCollation(rfield) == evalengine.CollationBinary {
+			kc.collations[i] = evalengine.CollationBinary
+		}
+	}
+	return kc
+}
+
+// key returns the hash key for the specified columns of the row.
+// It returns false if any of the values is NULL.
+func (kc *keyComparison) key(row []sqltypes.Value, keys []int) (string, bool) {
+	vals := make([]sqltypes.Value, 0, len(keys))
+	for i, col := range keys {
+		v := row[col]
+		if v.IsNull() {
+			return "", false
+		}
+		if kc.numeric[i] && !v.IsIntegral() && !v.IsFloat() && v.Type() != sqltypes.Decimal {
+			f, err := evalengine.ToFloat64(v)
+			if err != nil {
+				return "", false
+			}
+			v = sqltypes.NewFloat64(f)
+		}
+		vals = append(vals, v)
+	}
+	return distinctKey(vals, kc.collations), true
+}
+
+func fieldAt(fields []*querypb.Field, col int) *querypb.Field {
+	if col < len(fields) {
+		return fields[col]
+	}
+	return nil
+}
+
+func isNumberField(field *querypb.Field) bool {
+	return field != nil && (sqltypes.IsIntegral(field.Type) || sqltypes.IsFloat(field.Type) || field.Type == sqltypes.Decimal)
+}
+
+// joinKey returns the hash key for the specified columns
+// of the row. It returns false if any of the values is NULL.
+func joinKey(row []sqltypes.Value, keys []int) (string, bool) {
+	vals := make([]sqltypes.Value, 0, len(keys))
+	for _, col := range keys {
+		if row[col].IsNull() {
+			return "", false
+		}
+		vals = append(vals, row[col])
+	}
+	return distinctKey(vals, nil), true
+}
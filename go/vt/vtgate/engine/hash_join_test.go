@@ -0,0 +1,553 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestHashJoinExecute(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+				"1|a",
+				"2|b",
+				"null|c",
+				"3|d",
+				"1|e",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3|col4",
+					"int64|varchar",
+				),
+				"1|x",
+				"3|y",
+				"null|z",
+				"1|w",
+			),
+		},
+	}
+	hj := &HashJoin{
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-2, 2},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col2|col4",
+			"varchar|varchar",
+		),
+		"a|x",
+		"a|w",
+		"d|y",
+		"e|x",
+		"e|w",
+	)
+
+	r, err := hj.Execute(noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	rightPrim.ExpectLog(t, []string{
+		`Execute  true`,
+	})
+	leftPrim.ExpectLog(t, []string{
+		`Execute  true`,
+	})
+	expectResult(t, "hj.Execute", r, want)
+
+	// The fields are still requested because they
+	// tell how the keys must be compared.
+	leftPrim.rewind()
+	rightPrim.rewind()
+	r, err = hj.Execute(noopVCursor{}, nil, false)
+	require.NoError(t, err)
+	rightPrim.ExpectLog(t, []string{
+		`Execute  true`,
+	})
+	leftPrim.ExpectLog(t, []string{
+		`Execute  true`,
+	})
+	expectResult(t, "hj.Execute", r, &sqltypes.Result{Rows: want.Rows, RowsAffected: want.RowsAffected})
+
+	leftPrim.rewind()
+	rightPrim.rewind()
+	r, err = hj.GetFields(noopVCursor{}, nil)
+	require.NoError(t, err)
+	expectResult(t, "hj.GetFields", r, &sqltypes.Result{Fields: want.Fields})
+}
+
+func TestHashJoinStreamExecute(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+				"1|a",
+				"2|b",
+				"null|c",
+				"3|d",
+				"1|e",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3|col4",
+					"int64|varchar",
+				),
+				"1|x",
+				"3|y",
+				"null|z",
+				"1|w",
+				"2|v",
+			),
+		},
+	}
+	hj := &HashJoin{
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, -2, 2},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+
+	// The LHS rows are received two at a time,
+	// and the joined rows are sent as they come.
+	var results []*sqltypes.Result
+	err := hj.StreamExecute(noopVCursor{}, nil, true, func(r *sqltypes.Result) error {
+		results = append(results, r)
+		return nil
+	})
+	require.NoError(t, err)
+	rightPrim.ExpectLog(t, []string{
+		`StreamExecute  true`,
+	})
+	leftPrim.ExpectLog(t, []string{
+		`StreamExecute  true`,
+	})
+	fields := sqltypes.MakeTestFields(
+		"col1|col2|col4",
+		"int64|varchar|varchar",
+	)
+	wantResults := sqltypes.MakeTestStreamingResults(
+		fields,
+		"1|a|x",
+		"1|a|w",
+		"2|b|v",
+		"---",
+		"3|d|y",
+		"---",
+		"1|e|x",
+		"1|e|w",
+	)
+	require.Len(t, results, len(wantResults))
+	for i := range results {
+		expectResult(t, "hj.StreamExecute", results[i], wantResults[i])
+	}
+
+	// Without fields, the fields packet is not sent.
+	leftPrim.rewind()
+	rightPrim.rewind()
+	r, err := wrapStreamExecute(hj, noopVCursor{}, nil, false)
+	require.NoError(t, err)
+	rightPrim.ExpectLog(t, []string{
+		`StreamExecute  true`,
+	})
+	leftPrim.ExpectLog(t, []string{
+		`StreamExecute  true`,
+	})
+	want := sqltypes.MakeTestResult(
+		fields,
+		"1|a|x",
+		"1|a|w",
+		"2|b|v",
+		"3|d|y",
+		"1|e|x",
+		"1|e|w",
+	)
+	want.Fields = nil
+	expectResult(t, "hj.StreamExecute", r, want)
+}
+
+func TestHashJoinNumericKeys(t *testing.T) {
+	// Like mysql, a number and a string are compared as numbers.
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|val",
+					"int64|varchar",
+				),
+				"1|a",
+				"0|b",
+				"2|c",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|val",
+					"varchar|varchar",
+				),
+				"1|x",
+				"01|y",
+				"1.0|z",
+				"abc|w",
+				"2e0|v",
+			),
+		},
+	}
+	hj := &HashJoin{
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-2, 2},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"val|val",
+			"varchar|varchar",
+		),
+		"a|x",
+		"a|y",
+		"a|z",
+		"b|w",
+		"c|v",
+	)
+
+	r, err := hj.Execute(noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	expectResult(t, "hj.Execute", r, want)
+
+	leftPrim.rewind()
+	rightPrim.rewind()
+	r, err = wrapStreamExecute(hj, noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	expectResult(t, "hj.StreamExecute", r, want)
+}
+
+func TestHashJoinCollation(t *testing.T) {
+	leftFields := sqltypes.MakeTestFields(
+		"name|id",
+		"varchar|int64",
+	)
+	rightFields := sqltypes.MakeTestFields(
+		"name|id",
+		"varchar|int64",
+	)
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				leftFields,
+				"abc|1",
+				"Def|2",
+			),
+		},
+	}
+	rresult := sqltypes.MakeTestResult(
+		rightFields,
+		"ABC|10",
+		"def|30",
+		"abd|40",
+	)
+	// MakeTestResult trims spaces.
+	rresult.Rows = append(rresult.Rows, []sqltypes.Value{sqltypes.NewVarChar("abc "), sqltypes.NewInt64(20)})
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{rresult},
+	}
+	hj := &HashJoin{
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-2, 2},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|id",
+			"int64|int64",
+		),
+		"1|10",
+		"1|20",
+		"2|30",
+	)
+
+	// The default collation is case insensitive
+	// and ignores trailing spaces.
+	r, err := hj.Execute(noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	expectResult(t, "hj.Execute", r, want)
+
+	leftPrim.rewind()
+	rightPrim.rewind()
+	r, err = wrapStreamExecute(hj, noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	expectResult(t, "hj.StreamExecute", r, want)
+
+	// A binary collation on either side makes the comparison exact.
+	rightFields[0].Flags = uint32(querypb.MySqlFlag_BINARY_FLAG)
+	leftPrim.rewind()
+	rightPrim.rewind()
+	r, err = hj.Execute(noopVCursor{}, nil, false)
+	require.NoError(t, err)
+	expectResult(t, "hj.Execute", r, &sqltypes.Result{})
+}
+
+func TestHashJoinExecuteNoRHSRows(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+				"1|a",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3|col4",
+					"int64|varchar",
+				),
+			),
+		},
+	}
+	hj := &HashJoin{
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-2, 2},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+
+	r, err := hj.Execute(noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	leftPrim.ExpectLog(t, []string{
+		`GetFields `,
+		`Execute  true`,
+	})
+	expectResult(t, "hj.Execute", r, &sqltypes.Result{
+		Fields: sqltypes.MakeTestFields(
+			"col2|col4",
+			"varchar|varchar",
+		),
+	})
+}
+
+func TestHashJoinExecuteMaxMemoryRows(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() { testMaxMemoryRows = save }()
+
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+				"1|a",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3|col4",
+					"int64|varchar",
+				),
+				"1|x",
+				"2|y",
+				"3|z",
+			),
+		},
+	}
+	hj := &HashJoin{
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-2, 2},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+
+	_, err := hj.Execute(noopVCursor{}, nil, true)
+	expectError(t, "hj.Execute", err, "in-memory row count exceeded allowed limit of 2")
+
+	rightPrim.rewind()
+	_, err = wrapStreamExecute(hj, noopVCursor{}, nil, true)
+	expectError(t, "hj.StreamExecute", err, "in-memory row count exceeded allowed limit of 2")
+}
+
+func TestHashJoinStreamExecuteSpill(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() { testMaxMemoryRows = save }()
+
+	dir, err := ioutil.TempDir("", "spill")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	testSpillConfig = SpillConfig{Dir: dir, MaxBytes: 1 << 20}
+	defer func() { testSpillConfig = SpillConfig{} }()
+
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+				"1|a",
+				"2|b",
+				"null|c",
+				"3|d",
+				"1|e",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3|col4",
+					"int64|varchar",
+				),
+				"1|x",
+				"3|y",
+				"null|z",
+				"1|w",
+				"2|v",
+				"1|u",
+			),
+		},
+	}
+	hj := &HashJoin{
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-1, -2, 2},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+
+	// The RHS is spilled in two chunks. The joined rows
+	// are still in the order of the LHS and then the RHS.
+	r, err := wrapStreamExecute(hj, noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2|col4",
+			"int64|varchar|varchar",
+		),
+		"1|a|x",
+		"1|a|w",
+		"1|a|u",
+		"2|b|v",
+		"3|d|y",
+		"1|e|x",
+		"1|e|w",
+		"1|e|u",
+	)
+	expectResult(t, "hj.StreamExecute", r, want)
+
+	// The spill file is removed at the end of the query.
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+
+	// The disk budget applies.
+	leftPrim.rewind()
+	rightPrim.rewind()
+	testSpillConfig.MaxBytes = 10
+	_, err = wrapStreamExecute(hj, noopVCursor{}, nil, true)
+	expectError(t, "hj.StreamExecute", err, "spilled row size exceeded allowed limit of 10 bytes")
+}
+
+func TestHashJoinExecuteErrors(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"int64|varchar",
+				),
+				"1|a",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		sendErr: errors.New("right err"),
+	}
+	hj := &HashJoin{
+		Left:    leftPrim,
+		Right:   rightPrim,
+		Cols:    []int{-2, 2},
+		LHSKeys: []int{0},
+		RHSKeys: []int{0},
+	}
+
+	_, err := hj.Execute(noopVCursor{}, nil, true)
+	expectError(t, "hj.Execute", err, "right err")
+
+	_, err = wrapStreamExecute(hj, noopVCursor{}, nil, true)
+	expectError(t, "hj.StreamExecute", err, "right err")
+
+	hj.Left = &fakePrimitive{
+		sendErr: errors.New("left err"),
+	}
+	hj.Right = &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3|col4",
+					"int64|varchar",
+				),
+				"1|x",
+			),
+		},
+	}
+	_, err = hj.Execute(noopVCursor{}, nil, true)
+	expectError(t, "hj.Execute", err, "left err")
+}
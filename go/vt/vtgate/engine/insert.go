@@ -81,8 +81,26 @@ type Insert struct {
 	// QueryTimeout contains the optional timeout (in milliseconds) to apply to this query
 	QueryTimeout int
 
-	// Insert does not take inputs
-	noInputs
+	// Input is set for INSERT ... SELECT statements into sharded
+	// tables. The rows it returns are the ones to be inserted. Their
+	// columns follow the column list of the insert.
+	Input Primitive
+
+	// VindexValueOffset is set along with Input. It contains,
+	// for each colVindex, the offsets of its columns in the
+	// rows returned by Input. An offset that's beyond the
+	// columns of the rows is for a column that was appended
+	// to the column list. Its values are NULL.
+	VindexValueOffset [][]int
+
+	// ReplaceColumns is set for a REPLACE into a table with owned
+	// vindexes. It's the column list of the rows. ReplaceValues are
+	// their values, one list for each row, unless the rows are returned
+	// by the Input. They're used to find the rows that MySQL replaces
+	// through the unique keys of the table, whose lookup vindex entries
+	// must be deleted.
+	ReplaceColumns []string
+	ReplaceValues  []sqltypes.PlanValue
 }
 
 // NewQueryInsert creates an Insert with a query string.
@@ -135,6 +153,10 @@ func (ins *Insert) MarshalJSON() ([]byte, error) {
 		Suffix               string               `json:",omitempty"`
 		MultiShardAutocommit bool                 `json:",omitempty"`
 		QueryTimeout         int                  `json:",omitempty"`
+		VindexValueOffset    [][]int              `json:",omitempty"`
+		ReplaceColumns       []string             `json:",omitempty"`
+		ReplaceValues        []sqltypes.PlanValue `json:",omitempty"`
+		Input                Primitive            `json:",omitempty"`
 	}{
 		Opcode:               ins.Opcode,
 		Keyspace:             ins.Keyspace,
@@ -147,6 +169,10 @@ func (ins *Insert) MarshalJSON() ([]byte, error) {
 		Suffix:               ins.Suffix,
 		MultiShardAutocommit: ins.MultiShardAutocommit,
 		QueryTimeout:         ins.QueryTimeout,
+		VindexValueOffset:    ins.VindexValueOffset,
+		ReplaceColumns:       ins.ReplaceColumns,
+		ReplaceValues:        ins.ReplaceValues,
+		Input:                ins.Input,
 	}
 	return jsonutil.MarshalNoEscape(marshalInsert)
 }
@@ -161,6 +187,11 @@ type Generate struct {
 	// values will be generated based on how many were not
 	// supplied (NULL).
 	Values sqltypes.PlanValue
+	// Offset is used instead of Values for inserts with
+	// a select. It's the offset of the column in the rows
+	// returned by the select. Like for VindexValueOffset,
+	// it can be for a column that was appended.
+	Offset int `json:",omitempty"`
 }
 
 // InsertOpcode is a number representing the opcode
@@ -179,12 +210,18 @@ const (
 	// InsertShardedIgnore is for INSERT IGNORE and
 	// INSERT...ON DUPLICATE KEY constructs.
 	InsertShardedIgnore
+	// InsertShardedReplace is for REPLACE statements.
+	// The owned lookup vindex entries of the rows that
+	// the new rows replace are deleted before the new
+	// rows are inserted.
+	InsertShardedReplace
 )
 
 var insName = map[InsertOpcode]string{
-	InsertUnsharded:     "InsertUnsharded",
-	InsertSharded:       "InsertSharded",
-	InsertShardedIgnore: "InsertShardedIgnore",
+	InsertUnsharded:      "InsertUnsharded",
+	InsertSharded:        "InsertSharded",
+	InsertShardedIgnore:  "InsertShardedIgnore",
+	InsertShardedReplace: "InsertShardedReplace",
 }
 
 // MarshalJSON serializes the InsertOpcode as a JSON string.
@@ -221,7 +258,10 @@ func (ins *Insert) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 	switch ins.Opcode {
 	case InsertUnsharded:
 		return ins.execInsertUnsharded(vcursor, bindVars)
-	case InsertSharded, InsertShardedIgnore:
+	case InsertSharded, InsertShardedIgnore, InsertShardedReplace:
+		if ins.Input != nil {
+			return ins.execInsertSelect(vcursor, bindVars)
+		}
 		return ins.execInsertSharded(vcursor, bindVars)
 	default:
 		// Unreachable.
@@ -239,6 +279,14 @@ func (ins *Insert) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindV
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unreachable code for %q", ins.Query)
 }
 
+// Inputs returns the input of an insert with a select.
+func (ins *Insert) Inputs() []Primitive {
+	if ins.Input == nil {
+		return nil
+	}
+	return []Primitive{ins.Input}
+}
+
 func (ins *Insert) execInsertUnsharded(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
 	insertID, err := ins.processGenerate(vcursor, bindVars)
 	if err != nil {
@@ -272,16 +320,88 @@ func (ins *Insert) execInsertSharded(vcursor VCursor, bindVars map[string]*query
 	if err != nil {
 		return nil, vterrors.Wrap(err, "execInsertSharded")
 	}
-	rss, queries, err := ins.getInsertShardedRoute(vcursor, bindVars)
+	rss, queries, replaced, err := ins.getInsertShardedRoute(vcursor, bindVars)
 	if err != nil {
 		return nil, vterrors.Wrap(err, "execInsertSharded")
 	}
 
-	autocommit := (len(rss) == 1 || ins.MultiShardAutocommit) && vcursor.AutocommitApproval()
+	autocommit := (len(rss) == 1 || ins.MultiShardAutocommit) && ins.Opcode != InsertShardedReplace && vcursor.AutocommitApproval()
 	result, errs := vcursor.ExecuteMultiShard(rss, queries, true /* isDML */, autocommit)
 	if errs != nil {
 		return nil, vterrors.Wrap(vterrors.Aggregate(errs), "execInsertSharded")
 	}
+	result.RowsAffected += replaced
+
+	if insertID != 0 {
+		result.InsertID = uint64(insertID)
+	}
+	return result, nil
+}
+
+// execInsertSelect streams the rows of the Input, and inserts them
+// in batches of at most InsertSelectBatchSize rows. Each row is sent
+// to the shard its keyspace id maps to. Like other streaming queries,
+// the Input is read outside of the transaction of the session.
+func (ins *Insert) execInsertSelect(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	batchSize := vcursor.InsertSelectBatchSize()
+	result := &sqltypes.Result{}
+	batches := 0
+	insertBatch := func(rows [][]sqltypes.Value, canAutocommit bool) error {
+		qr, err := ins.insertSelectBatch(vcursor, bindVars, rows, canAutocommit)
+		if err != nil {
+			return err
+		}
+		batches++
+		result.RowsAffected += qr.RowsAffected
+		// Like mysql, the insert id is the first generated value.
+		if result.InsertID == 0 {
+			result.InsertID = qr.InsertID
+		}
+		return nil
+	}
+	var rows [][]sqltypes.Value
+	err := ins.Input.StreamExecute(vcursor, bindVars, false, func(qr *sqltypes.Result) error {
+		rows = append(rows, qr.Rows...)
+		// A batch is inserted only once more rows follow it,
+		// because only a single batch can be autocommitted.
+		for len(rows) > batchSize {
+			if err := insertBatch(rows[:batchSize], false); err != nil {
+				return err
+			}
+			rows = rows[batchSize:]
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execInsertSelect")
+	}
+	if len(rows) > 0 {
+		if err := insertBatch(rows, batches == 0); err != nil {
+			return nil, vterrors.Wrap(err, "execInsertSelect")
+		}
+	}
+	return result, nil
+}
+
+// insertSelectBatch inserts a batch of the rows returned by the Input.
+// The batch may be autocommitted only if canAutocommit is true.
+func (ins *Insert) insertSelectBatch(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rows [][]sqltypes.Value, canAutocommit bool) (*sqltypes.Result, error) {
+	ins.appendColumns(rows)
+	insertID, err := ins.processGenerateRows(vcursor, rows)
+	if err != nil {
+		return nil, err
+	}
+	rss, queries, replaced, err := ins.getInsertSelectRoute(vcursor, bindVars, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	autocommit := canAutocommit && (len(rss) == 1 || ins.MultiShardAutocommit) && ins.Opcode != InsertShardedReplace && vcursor.AutocommitApproval()
+	result, errs := vcursor.ExecuteMultiShard(rss, queries, true /* isDML */, autocommit)
+	if errs != nil {
+		return nil, vterrors.Aggregate(errs)
+	}
+	result.RowsAffected += replaced
 
 	if insertID != 0 {
 		result.InsertID = uint64(insertID)
@@ -289,6 +409,28 @@ func (ins *Insert) execInsertSharded(vcursor VCursor, bindVars map[string]*query
 	return result, nil
 }
 
+// appendColumns appends NULL values to the rows for
+// the columns that were appended to the column list.
+func (ins *Insert) appendColumns(rows [][]sqltypes.Value) {
+	colCount := 0
+	for _, offsets := range ins.VindexValueOffset {
+		for _, offset := range offsets {
+			if offset >= colCount {
+				colCount = offset + 1
+			}
+		}
+	}
+	if ins.Generate != nil && ins.Generate.Offset >= colCount {
+		colCount = ins.Generate.Offset + 1
+	}
+	for i, row := range rows {
+		for len(row) < colCount {
+			row = append(row, sqltypes.NULL)
+		}
+		rows[i] = row
+	}
+}
+
 // processGenerate generates new values using a sequence if necessary.
 // If no value was generated, it returns 0. Values are generated only
 // for cases where none are supplied.
@@ -303,6 +445,41 @@ func (ins *Insert) processGenerate(vcursor VCursor, bindVars map[string]*querypb
 	if err != nil {
 		return 0, vterrors.Wrap(err, "processGenerate")
 	}
+	insertID, err = ins.generate(vcursor, resolved)
+	if err != nil {
+		return 0, err
+	}
+	for i, v := range resolved {
+		bindVars[SeqVarName+strconv.Itoa(i)] = sqltypes.ValueBindVariable(v)
+	}
+	return insertID, nil
+}
+
+// processGenerateRows is the processGenerate for the rows returned
+// by the Input. The generated values are stored in the rows.
+func (ins *Insert) processGenerateRows(vcursor VCursor, rows [][]sqltypes.Value) (insertID int64, err error) {
+	if ins.Generate == nil {
+		return 0, nil
+	}
+
+	values := make([]sqltypes.Value, len(rows))
+	for i, row := range rows {
+		values[i] = row[ins.Generate.Offset]
+	}
+	insertID, err = ins.generate(vcursor, values)
+	if err != nil {
+		return 0, err
+	}
+	for i, v := range values {
+		rows[i][ins.Generate.Offset] = v
+	}
+	return insertID, nil
+}
+
+// generate replaces the NULL values with new values
+// from the sequence. It returns the first generated value,
+// or 0 if none were generated.
+func (ins *Insert) generate(vcursor VCursor, resolved []sqltypes.Value) (insertID int64, err error) {
 	count := int64(0)
 	for _, val := range resolved {
 		if val.IsNull() {
@@ -336,10 +513,8 @@ func (ins *Insert) processGenerate(vcursor VCursor, bindVars map[string]*querypb
 	cur := insertID
 	for i, v := range resolved {
 		if v.IsNull() {
-			bindVars[SeqVarName+strconv.Itoa(i)] = sqltypes.Int64BindVariable(cur)
+			resolved[i] = sqltypes.NewInt64(cur)
 			cur++
-		} else {
-			bindVars[SeqVarName+strconv.Itoa(i)] = sqltypes.ValueBindVariable(v)
 		}
 	}
 	return insertID, nil
@@ -352,7 +527,7 @@ func (ins *Insert) processGenerate(vcursor VCursor, bindVars map[string]*querypb
 // For unowned vindexes with no input values, it reverse maps.
 // For unowned vindexes with values, it validates.
 // If it's an IGNORE or ON DUPLICATE key insert, it drops unroutable rows.
-func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, error) {
+func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, uint64, error) {
 	// vindexRowsValues builds the values of all vindex columns.
 	// the 3-d structure indexes are colVindex, row, col. Note that
 	// ins.Values indexes are colVindex, col, row. So, the conversion
@@ -363,23 +538,23 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 	rowCount := 0
 	for vIdx, vColValues := range ins.VindexValues {
 		if len(vColValues.Values) != len(ins.Table.ColumnVindexes[vIdx].Columns) {
-			return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: supplied vindex column values don't match vschema: %v %v", vColValues, ins.Table.ColumnVindexes[vIdx].Columns)
+			return nil, nil, 0, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: supplied vindex column values don't match vschema: %v %v", vColValues, ins.Table.ColumnVindexes[vIdx].Columns)
 		}
 		for colIdx, colValues := range vColValues.Values {
 			rowsResolvedValues, err := colValues.ResolveList(bindVars)
 			if err != nil {
-				return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
+				return nil, nil, 0, vterrors.Wrap(err, "getInsertShardedRoute")
 			}
 			// This is the first iteration: allocate for transpose.
 			if colIdx == 0 {
 				if len(rowsResolvedValues) == 0 {
-					return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: rowcount is zero for inserts: %v", rowsResolvedValues)
+					return nil, nil, 0, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: rowcount is zero for inserts: %v", rowsResolvedValues)
 				}
 				if rowCount == 0 {
 					rowCount = len(rowsResolvedValues)
 				}
 				if rowCount != len(rowsResolvedValues) {
-					return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: uneven row values for inserts: %d %d", rowCount, len(rowsResolvedValues))
+					return nil, nil, 0, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: uneven row values for inserts: %d %d", rowCount, len(rowsResolvedValues))
 				}
 				vindexRowsValues[vIdx] = make([][]sqltypes.Value, rowCount)
 			}
@@ -390,13 +565,81 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 		}
 	}
 
+	var rows [][]sqltypes.Value
+	if ins.ReplaceValues != nil {
+		rows = make([][]sqltypes.Value, len(ins.ReplaceValues))
+		for rowNum, rowValues := range ins.ReplaceValues {
+			row, err := rowValues.ResolveList(bindVars)
+			if err != nil {
+				return nil, nil, 0, vterrors.Wrap(err, "getInsertShardedRoute")
+			}
+			rows[rowNum] = row
+		}
+	}
+
+	rss, queries, replaced, err := ins.routeRows(vcursor, bindVars, vindexRowsValues, ins.Mid, rows)
+	if err != nil {
+		return nil, nil, 0, vterrors.Wrap(err, "getInsertShardedRoute")
+	}
+	return rss, queries, replaced, nil
+}
+
+// getInsertSelectRoute is the getInsertShardedRoute for the rows
+// returned by the Input. The values of the rows are passed as bind
+// variables. The values of the vindex columns are passed the same
+// way as for the other inserts.
+func (ins *Insert) getInsertSelectRoute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rows [][]sqltypes.Value) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, uint64, error) {
+	vindexRowsValues := make([][][]sqltypes.Value, len(ins.VindexValueOffset))
+	vindexCols := make(map[int]sqlparser.ColIdent)
+	for vIdx, offsets := range ins.VindexValueOffset {
+		vindexRowsValues[vIdx] = make([][]sqltypes.Value, len(rows))
+		for colIdx, offset := range offsets {
+			vindexCols[offset] = ins.Table.ColumnVindexes[vIdx].Columns[colIdx]
+			for rowNum, row := range rows {
+				vindexRowsValues[vIdx][rowNum] = append(vindexRowsValues[vIdx][rowNum], row[offset])
+			}
+		}
+	}
+
+	bindVars = combineVars(bindVars, nil)
+	mids := make([]string, len(rows))
+	for rowNum, row := range rows {
+		vals := make([]string, len(row))
+		for colNum, val := range row {
+			if col, ok := vindexCols[colNum]; ok {
+				vals[colNum] = ":" + insertVarName(col, rowNum)
+				continue
+			}
+			name := insertSelectVarName(rowNum, colNum)
+			bindVars[name] = sqltypes.ValueBindVariable(val)
+			vals[colNum] = ":" + name
+		}
+		mids[rowNum] = "(" + strings.Join(vals, ", ") + ")"
+	}
+	return ins.routeRows(vcursor, bindVars, vindexRowsValues, mids, rows)
+}
+
+// routeRows performs the vindex related work for the rows of an
+// insert, and returns the queries to send to each shard. For a
+// REPLACE, it also returns the number of rows it deleted.
+// The vindex values are indexed by colVindex, row and col.
+// Mids contains the value tuple of each row. Rows contains
+// the values of the rows of a REPLACE, if they're needed.
+func (ins *Insert) routeRows(vcursor VCursor, bindVars map[string]*querypb.BindVariable, vindexRowsValues [][][]sqltypes.Value, mids []string, rows [][]sqltypes.Value) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, uint64, error) {
 	// The output from the following 'process' functions is a list of
 	// keyspace ids. For regular inserts, a failure to find a route
 	// results in an error. For 'ignore' type inserts, the keyspace
 	// id is returned as nil, which is used later to drop the corresponding rows.
 	keyspaceIDs, err := ins.processPrimary(vcursor, vindexRowsValues[0], ins.Table.ColumnVindexes[0])
 	if err != nil {
-		return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
+		return nil, nil, 0, err
+	}
+
+	var replaced uint64
+	if ins.Opcode == InsertShardedReplace && len(ins.Table.Owned) != 0 {
+		if replaced, err = ins.deleteReplaced(vcursor, vindexRowsValues, keyspaceIDs, rows); err != nil {
+			return nil, nil, 0, err
+		}
 	}
 
 	for vIdx := 1; vIdx < len(ins.Table.ColumnVindexes); vIdx++ {
@@ -408,7 +651,7 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 			err = ins.processUnowned(vcursor, vindexRowsValues[vIdx], colVindex, keyspaceIDs)
 		}
 		if err != nil {
-			return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
+			return nil, nil, 0, err
 		}
 	}
 
@@ -443,26 +686,26 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 	if len(destinations) == 0 {
 		// In this case, all we have is nil KeyspaceIds, we don't do
 		// anything at all.
-		return nil, nil, nil
+		return nil, nil, 0, nil
 	}
 
 	rss, indexesPerRss, err := vcursor.ResolveDestinations(ins.Keyspace.Name, indexes, destinations)
 	if err != nil {
-		return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
+		return nil, nil, 0, err
 	}
 
 	queries := make([]*querypb.BoundQuery, len(rss))
 	for i := range rss {
 		var ksids [][]byte
-		var mids []string
+		var shardMids []string
 		for _, indexValue := range indexesPerRss[i] {
 			index, _ := strconv.ParseInt(string(indexValue.Value), 0, 64)
 			if keyspaceIDs[index] != nil {
 				ksids = append(ksids, keyspaceIDs[index])
-				mids = append(mids, ins.Mid[index])
+				shardMids = append(shardMids, mids[index])
 			}
 		}
-		rewritten := ins.Prefix + strings.Join(mids, ",") + ins.Suffix
+		rewritten := ins.Prefix + strings.Join(shardMids, ",") + ins.Suffix
 		rewritten = sqlannotation.AddKeyspaceIDs(rewritten, ksids, "")
 		queries[i] = &querypb.BoundQuery{
 			Sql:           rewritten,
@@ -470,7 +713,232 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 		}
 	}
 
-	return rss, queries, nil
+	return rss, queries, replaced, nil
+}
+
+// uniqueKeysQuery selects the columns of the primary and unique keys
+// of a table, which are the ones through which a REPLACE replaces rows.
+const uniqueKeysQuery = "select index_name, column_name from information_schema.statistics " +
+	"where table_schema = database() and table_name = :table_name and non_unique = 0 " +
+	"order by index_name, seq_in_index"
+
+// deleteReplaced deletes the owned lookup vindex entries of the rows
+// that are replaced by the rows of a REPLACE. MySQL replaces the rows
+// of the shard of a new row that have the same values for its primary
+// key or for one of its unique keys. Those rows are selected the same
+// way. A new row also replaces the rows that have the same values for
+// a unique owned lookup vindex, which may be in a different shard.
+// Those rows are deleted here. It returns the number of rows deleted.
+func (ins *Insert) deleteReplaced(vcursor VCursor, vindexRowsValues [][][]sqltypes.Value, keyspaceIDs [][]byte, rows [][]sqltypes.Value) (uint64, error) {
+	var keys [][]int
+	if rows != nil {
+		var err error
+		if keys, err = ins.uniqueKeys(vcursor, keyspaceIDs); err != nil {
+			return 0, err
+		}
+	}
+
+	// Each condition matches the rows that a new row replaces through a
+	// key. It's sent to the shard of the keyspace id of those rows. The
+	// rows that are matched through a lookup vindex are also deleted.
+	var conditions []string
+	var deleted []bool
+	var ids []*querypb.Value
+	var destinations []key.Destination
+	bindVars := make(map[string]*querypb.BindVariable)
+	addCondition := func(cols []sqlparser.ColIdent, values []sqltypes.Value, ksid []byte, delete bool) {
+		buf := sqlparser.NewTrackedBuffer(nil)
+		for colIdx, col := range cols {
+			name := replaceVarName(len(conditions), colIdx)
+			bindVars[name] = sqltypes.ValueBindVariable(values[colIdx])
+			if colIdx != 0 {
+				buf.WriteString(" and ")
+			}
+			buf.Myprintf("%v = :%s", col, name)
+		}
+		ids = append(ids, &querypb.Value{
+			Value: strconv.AppendInt(nil, int64(len(conditions)), 10),
+		})
+		conditions = append(conditions, buf.String())
+		deleted = append(deleted, delete)
+		destinations = append(destinations, key.DestinationKeyspaceID(ksid))
+	}
+	for rowNum, ksid := range keyspaceIDs {
+		if ksid == nil {
+			continue
+		}
+	nextKey:
+		for _, keyCols := range keys {
+			cols := make([]sqlparser.ColIdent, 0, len(keyCols))
+			values := make([]sqltypes.Value, 0, len(keyCols))
+			for _, colNum := range keyCols {
+				// NULL values never conflict.
+				if colNum >= len(rows[rowNum]) || rows[rowNum][colNum].IsNull() {
+					continue nextKey
+				}
+				cols = append(cols, sqlparser.NewColIdent(ins.ReplaceColumns[colNum]))
+				values = append(values, rows[rowNum][colNum])
+			}
+			addCondition(cols, values, ksid, false)
+		}
+	}
+	// The columns of the primary vindex and of the owned vindexes
+	// are selected, to delete the owned lookup vindex entries.
+	var columns []string
+	for vIdx, colVindex := range ins.Table.ColumnVindexes {
+		if vIdx != 0 && !colVindex.Owned {
+			continue
+		}
+		for _, col := range colVindex.Columns {
+			columns = append(columns, sqlparser.String(col))
+		}
+		if vIdx == 0 || !colVindex.Vindex.IsUnique() {
+			continue
+		}
+		rowsDestinations, err := vindexes.Map(colVindex.Vindex, vcursor, vindexRowsValues[vIdx])
+		if err != nil {
+			return 0, err
+		}
+		for rowNum, destination := range rowsDestinations {
+			if ksid, ok := destination.(key.DestinationKeyspaceID); ok && keyspaceIDs[rowNum] != nil {
+				addCondition(colVindex.Columns, vindexRowsValues[vIdx][rowNum], ksid, true)
+			}
+		}
+	}
+	if len(conditions) == 0 {
+		return 0, nil
+	}
+
+	rss, idsPerRss, err := vcursor.ResolveDestinations(ins.Keyspace.Name, ids, destinations)
+	if err != nil {
+		return 0, err
+	}
+	table := sqlparser.String(ins.Table.Name)
+	selects := make([]*querypb.BoundQuery, len(rss))
+	var deleteRss []*srvtopo.ResolvedShard
+	var deletes []*querypb.BoundQuery
+	for i := range rss {
+		var where, deleteWhere []string
+		for _, id := range idsPerRss[i] {
+			index, _ := strconv.ParseInt(string(id.Value), 0, 64)
+			where = append(where, "("+conditions[index]+")")
+			if deleted[index] {
+				deleteWhere = append(deleteWhere, "("+conditions[index]+")")
+			}
+		}
+		selects[i] = &querypb.BoundQuery{
+			Sql:           fmt.Sprintf("select %s from %s where %s for update", strings.Join(columns, ", "), table, strings.Join(where, " or ")),
+			BindVariables: bindVars,
+		}
+		if len(deleteWhere) != 0 {
+			deleteRss = append(deleteRss, rss[i])
+			deletes = append(deletes, &querypb.BoundQuery{
+				Sql:           fmt.Sprintf("delete from %s where %s", table, strings.Join(deleteWhere, " or ")),
+				BindVariables: bindVars,
+			})
+		}
+	}
+	result, errs := vcursor.ExecuteMultiShard(rss, selects, false /* isDML */, false /* autocommit */)
+	if errs != nil {
+		return 0, vterrors.Aggregate(errs)
+	}
+	if len(result.Rows) == 0 {
+		return 0, nil
+	}
+
+	for _, row := range result.Rows {
+		primary := ins.Table.ColumnVindexes[0]
+		colnum := len(primary.Columns)
+		destinations, err := vindexes.Map(primary.Vindex, vcursor, [][]sqltypes.Value{row[:colnum]})
+		if err != nil {
+			return 0, err
+		}
+		ksid, ok := destinations[0].(key.DestinationKeyspaceID)
+		if !ok {
+			return 0, fmt.Errorf("could not map %v to a unique keyspace id: %v", row[:colnum], destinations[0])
+		}
+		for _, colVindex := range ins.Table.Owned {
+			// Fetch the column values. colnum must keep incrementing.
+			fromIds := make([]sqltypes.Value, 0, len(colVindex.Columns))
+			for range colVindex.Columns {
+				fromIds = append(fromIds, row[colnum])
+				colnum++
+			}
+			if err := colVindex.Vindex.(vindexes.Lookup).Delete(vcursor, [][]sqltypes.Value{fromIds}, ksid); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	if len(deletes) == 0 {
+		return 0, nil
+	}
+	result, errs = vcursor.ExecuteMultiShard(deleteRss, deletes, true /* isDML */, false /* autocommit */)
+	if errs != nil {
+		return 0, vterrors.Aggregate(errs)
+	}
+	return result.RowsAffected, nil
+}
+
+// uniqueKeys returns the primary and unique keys of the table, as
+// offsets in ReplaceColumns. They're read from the shard of the first
+// new row. The keys whose columns are not all in ReplaceColumns are
+// skipped: the values of their columns aren't known.
+func (ins *Insert) uniqueKeys(vcursor VCursor, keyspaceIDs [][]byte) ([][]int, error) {
+	var destination key.Destination
+	for _, ksid := range keyspaceIDs {
+		if ksid != nil {
+			destination = key.DestinationKeyspaceID(ksid)
+			break
+		}
+	}
+	if destination == nil {
+		return nil, nil
+	}
+	rss, _, err := vcursor.ResolveDestinations(ins.Keyspace.Name, nil, []key.Destination{destination})
+	if err != nil {
+		return nil, err
+	}
+	query := &querypb.BoundQuery{
+		Sql: uniqueKeysQuery,
+		BindVariables: map[string]*querypb.BindVariable{
+			"table_name": sqltypes.StringBindVariable(ins.Table.Name.String()),
+		},
+	}
+	result, errs := vcursor.ExecuteMultiShard(rss, []*querypb.BoundQuery{query}, false /* isDML */, false /* autocommit */)
+	if errs != nil {
+		return nil, vterrors.Aggregate(errs)
+	}
+
+	var names []string
+	offsets := make(map[string][]int)
+	unknown := make(map[string]bool)
+	for _, row := range result.Rows {
+		name := row[0].ToString()
+		if _, ok := offsets[name]; !ok {
+			names = append(names, name)
+			offsets[name] = nil
+		}
+		colNum := -1
+		for i, col := range ins.ReplaceColumns {
+			if strings.EqualFold(col, row[1].ToString()) {
+				colNum = i
+				break
+			}
+		}
+		if colNum < 0 {
+			unknown[name] = true
+			continue
+		}
+		offsets[name] = append(offsets[name], colNum)
+	}
+	var keys [][]int
+	for _, name := range names {
+		if !unknown[name] {
+			keys = append(keys, offsets[name])
+		}
+	}
+	return keys, nil
 }
 
 // processPrimary maps the primary vindex values to the keyspace ids.
@@ -501,7 +969,7 @@ func (ins *Insert) processPrimary(vcursor VCursor, vindexColumnsKeys [][]sqltype
 
 // processOwned creates vindex entries for the values of an owned column.
 func (ins *Insert) processOwned(vcursor VCursor, vindexColumnsKeys [][]sqltypes.Value, colVindex *vindexes.ColumnVindex, ksids [][]byte) error {
-	if ins.Opcode == InsertSharded {
+	if ins.Opcode != InsertShardedIgnore {
 		return colVindex.Vindex.(vindexes.Lookup).Create(vcursor, vindexColumnsKeys, ksids, false /* ignoreMode */)
 	}
 
@@ -605,3 +1073,11 @@ func (ins *Insert) processUnowned(vcursor VCursor, vindexColumnsKeys [][]sqltype
 func insertVarName(col sqlparser.ColIdent, rowNum int) string {
 	return "_" + col.CompliantName() + strconv.Itoa(rowNum)
 }
+
+func replaceVarName(condNum, colNum int) string {
+	return "__r" + strconv.Itoa(condNum) + "_" + strconv.Itoa(colNum)
+}
+
+func insertSelectVarName(rowNum, colNum int) string {
+	return "__c" + strconv.Itoa(rowNum) + "_" + strconv.Itoa(colNum)
+}
@@ -20,6 +20,8 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
@@ -1032,6 +1034,294 @@ func TestInsertShardedIgnoreOwnedWithNull(t *testing.T) {
 	})
 }
 
+func TestInsertShardedReplace(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+					"onecol": {
+						Type: "lookup_unique",
+						Params: map[string]string{
+							"table": "lkp1",
+							"from":  "from",
+							"to":    "toc",
+						},
+						Owner: "t1",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}, {
+							Name:    "onecol",
+							Columns: []string{"c3"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	require.NoError(t, err)
+	ks := vs.Keyspaces["sharded"]
+
+	ins := NewInsert(
+		InsertShardedReplace,
+		ks.Keyspace,
+		[]sqltypes.PlanValue{{
+			// colVindex columns: id
+			Values: []sqltypes.PlanValue{{
+				// rows for id
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(1),
+				}, {
+					Value: sqltypes.NewInt64(2),
+				}},
+			}},
+		}, {
+			// colVindex columns: c3
+			Values: []sqltypes.PlanValue{{
+				// rows for c3
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(5),
+				}, {
+					Value: sqltypes.NewInt64(6),
+				}},
+			}},
+		}},
+		ks.Tables["t1"],
+		"prefix",
+		[]string{" mid1", " mid2"},
+		" suffix",
+	)
+	ins.ReplaceColumns = []string{"pk", "id", "c3"}
+	ins.ReplaceValues = []sqltypes.PlanValue{{
+		Values: []sqltypes.PlanValue{
+			{Value: sqltypes.NewInt64(10)},
+			{Value: sqltypes.NewInt64(1)},
+			{Value: sqltypes.NewInt64(5)},
+		},
+	}, {
+		Values: []sqltypes.PlanValue{
+			{Value: sqltypes.NewInt64(11)},
+			{Value: sqltypes.NewInt64(2)},
+			{Value: sqltypes.NewInt64(6)},
+		},
+	}}
+
+	ksid3 := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"toc",
+			"varbinary",
+		),
+		"N\xb1\x90\xc9\xa2\xfa\x16\x9c",
+	)
+	noresult := &sqltypes.Result{}
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"-20", "-20", "20-", "20-", "-20", "20-"},
+		results: []*sqltypes.Result{
+			// The unique keys of t1.
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"index_name|column_name",
+					"varchar|varchar",
+				),
+				"PRIMARY|pk",
+				"other|other",
+			),
+			// c3 = 5 is used by the row with id 3.
+			ksid3,
+			noresult,
+			// The rows that are replaced: the one with pk 10,
+			// and the one with c3 5.
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|c3",
+					"int64|int64",
+				),
+				"1|7",
+				"3|5",
+			),
+			// delete lkp1
+			noresult,
+			noresult,
+			// delete the row with c3 5
+			{RowsAffected: 1},
+			// insert lkp1
+			noresult,
+			// insert the rows, which replaces the row with pk 10
+			{RowsAffected: 3},
+		},
+	}
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		// The unique keys are read from the shard of the first row.
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: ` + uniqueKeysQuery + ` {table_name: type:VARCHAR value:"t1" } false false`,
+		`Execute select toc from lkp1 where from = :from from: type:INT64 value:"5"  false`,
+		`Execute select toc from lkp1 where from = :from from: type:INT64 value:"6"  false`,
+		// The rows are looked up by their pk, and by their c3 if it's in use.
+		`ResolveDestinations sharded [value:"0"  value:"1"  value:"2" ] ` +
+			`Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f),DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		`ExecuteMultiShard ` +
+			`sharded.-20: select id, c3 from t1 where (pk = :__r0_0) for update ` +
+			`{__r0_0: type:INT64 value:"10" __r1_0: type:INT64 value:"11" __r2_0: type:INT64 value:"5" } ` +
+			`sharded.20-: select id, c3 from t1 where (pk = :__r1_0) or (c3 = :__r2_0) for update ` +
+			`{__r0_0: type:INT64 value:"10" __r1_0: type:INT64 value:"11" __r2_0: type:INT64 value:"5" } ` +
+			`false false`,
+		// The lookup vindex entries of both rows are deleted.
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"7" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"5" toc: type:VARBINARY value:"N\261\220\311\242\372\026\234"  true`,
+		// Only the row with c3 5 is deleted: mysql replaces the other one.
+		`ExecuteMultiShard ` +
+			`sharded.20-: delete from t1 where (c3 = :__r2_0) ` +
+			`{__r0_0: type:INT64 value:"10" __r1_0: type:INT64 value:"11" __r2_0: type:INT64 value:"5" } ` +
+			`true false`,
+		`Execute insert into lkp1(from, toc) values(:from0, :toc0), (:from1, :toc1) ` +
+			`from0: type:INT64 value:"5" from1: type:INT64 value:"6" ` +
+			`toc0: type:VARBINARY value:"\026k@\264J\272K\326" toc1: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.-20: prefix mid1 suffix /* vtgate:: keyspace_id:166b40b44aba4bd6 */ ` +
+			`{_c30: type:INT64 value:"5" _c31: type:INT64 value:"6" _id0: type:INT64 value:"1" _id1: type:INT64 value:"2" } ` +
+			`sharded.20-: prefix mid2 suffix /* vtgate:: keyspace_id:06e7ea22ce92708f */ ` +
+			`{_c30: type:INT64 value:"5" _c31: type:INT64 value:"6" _id0: type:INT64 value:"1" _id1: type:INT64 value:"2" } ` +
+			`true false`,
+	})
+	// The row deleted for its lookup vindex is counted along with the
+	// rows affected by the replace.
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 4})
+}
+
+func TestInsertShardedReplaceSameVindexValue(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+					"onecol": {
+						Type: "lookup_unique",
+						Params: map[string]string{
+							"table": "lkp1",
+							"from":  "from",
+							"to":    "toc",
+						},
+						Owner: "t1",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}, {
+							Name:    "onecol",
+							Columns: []string{"c3"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	require.NoError(t, err)
+	ks := vs.Keyspaces["sharded"]
+
+	ins := NewInsert(
+		InsertShardedReplace,
+		ks.Keyspace,
+		[]sqltypes.PlanValue{{
+			// colVindex columns: id
+			Values: []sqltypes.PlanValue{{
+				// rows for id
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(1),
+				}},
+			}},
+		}, {
+			// colVindex columns: c3
+			Values: []sqltypes.PlanValue{{
+				// rows for c3
+				Values: []sqltypes.PlanValue{{
+					Value: sqltypes.NewInt64(5),
+				}},
+			}},
+		}},
+		ks.Tables["t1"],
+		"prefix",
+		[]string{" mid1"},
+		" suffix",
+	)
+	ins.ReplaceColumns = []string{"pk", "id", "c3"}
+	ins.ReplaceValues = []sqltypes.PlanValue{{
+		Values: []sqltypes.PlanValue{
+			{Value: sqltypes.NewInt64(10)},
+			{Value: sqltypes.NewInt64(1)},
+			{Value: sqltypes.NewInt64(5)},
+		},
+	}}
+
+	noresult := &sqltypes.Result{}
+	vc := &loggingVCursor{
+		shards: []string{"-20", "20-"},
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"index_name|column_name",
+					"varchar|varchar",
+				),
+				"PRIMARY|pk",
+			),
+			// c3 = 5 is not in use.
+			noresult,
+			// The rows with id 1 have pk 10 and 12. Only the
+			// one with pk 10 is replaced.
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|c3",
+					"int64|int64",
+				),
+				"1|7",
+			),
+			// delete lkp1
+			noresult,
+			// insert lkp1
+			noresult,
+			// replace the row with pk 10
+			{RowsAffected: 2},
+		},
+	}
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		// The unique keys are read from the shard of the first row.
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: ` + uniqueKeysQuery + ` {table_name: type:VARCHAR value:"t1" } false false`,
+		`Execute select toc from lkp1 where from = :from from: type:INT64 value:"5"  false`,
+		// The row is looked up by its pk, not by its id.
+		`ResolveDestinations sharded [value:"0" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: select id, c3 from t1 where (pk = :__r0_0) for update {__r0_0: type:INT64 value:"10" } false false`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"7" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		// No rows are deleted: mysql replaces the row with pk 10.
+		`Execute insert into lkp1(from, toc) values(:from0, :toc0) from0: type:INT64 value:"5" toc0: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`ResolveDestinations sharded [value:"0" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: prefix mid1 suffix /* vtgate:: keyspace_id:166b40b44aba4bd6 */ ` +
+			`{_c30: type:INT64 value:"5" _id0: type:INT64 value:"1" } true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 2})
+}
+
 func TestInsertShardedUnownedVerify(t *testing.T) {
 	invschema := &vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
@@ -1583,3 +1873,261 @@ func TestInsertShardedUnownedReverseMapFail(t *testing.T) {
 	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
 	expectError(t, "Execute", err, "execInsertSharded: getInsertShardedRoute: value must be supplied for column [c3]")
 }
+
+func TestInsertSelectOwned(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+					"onecol": {
+						Type: "lookup",
+						Params: map[string]string{
+							"table": "lkp1",
+							"from":  "from",
+							"to":    "toc",
+						},
+						Owner: "t1",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}, {
+							Name:    "onecol",
+							Columns: []string{"c3"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"c3|id",
+					"int64|int64",
+				),
+				"10|1",
+				"11|2",
+				"12|3",
+			),
+		},
+	}
+	ins := &Insert{
+		Opcode:            InsertSharded,
+		Keyspace:          ks.Keyspace,
+		Table:             ks.Tables["t1"],
+		Prefix:            "prefix ",
+		Suffix:            " suffix",
+		Input:             input,
+		VindexValueOffset: [][]int{{1}, {0}},
+		// The auto-increment column is absent from the rows.
+		Generate: &Generate{
+			Keyspace: &vindexes.Keyspace{
+				Name:    "ks2",
+				Sharded: false,
+			},
+			Query:  "dummy_generate",
+			Offset: 2,
+		},
+	}
+
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"20-", "-20", "20-"},
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"nextval",
+					"int64",
+				),
+				"4",
+			),
+		},
+	}
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	input.ExpectLog(t, []string{
+		`StreamExecute  false`,
+	})
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks2 [] Destinations:DestinationAnyShard()`,
+		`ExecuteStandalone dummy_generate n: type:INT64 value:"3"  ks2 -20`,
+		`Execute insert into lkp1(from, toc) values(:from0, :toc0), (:from1, :toc1), (:from2, :toc2) ` +
+			`from0: type:INT64 value:"10" from1: type:INT64 value:"11" from2: type:INT64 value:"12" ` +
+			`toc0: type:VARBINARY value:"\026k@\264J\272K\326" toc1: type:VARBINARY value:"\006\347\352\"\316\222p\217" toc2: type:VARBINARY value:"N\261\220\311\242\372\026\234"  true`,
+		// Based on shardForKsid, values returned will be 20-, -20, 20-.
+		`ResolveDestinations sharded [value:"0"  value:"1"  value:"2" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f),DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix (:_c30, :_id0, :__c0_2),(:_c32, :_id2, :__c2_2) suffix /* vtgate:: keyspace_id:166b40b44aba4bd6,4eb190c9a2fa169c */ ` +
+			`{__c0_2: type:INT64 value:"4" __c1_2: type:INT64 value:"5" __c2_2: type:INT64 value:"6" ` +
+			`_c30: type:INT64 value:"10" _c31: type:INT64 value:"11" _c32: type:INT64 value:"12" ` +
+			`_id0: type:INT64 value:"1" _id1: type:INT64 value:"2" _id2: type:INT64 value:"3" } ` +
+			`sharded.-20: prefix (:_c31, :_id1, :__c1_2) suffix /* vtgate:: keyspace_id:06e7ea22ce92708f */ ` +
+			`{__c0_2: type:INT64 value:"4" __c1_2: type:INT64 value:"5" __c2_2: type:INT64 value:"6" ` +
+			`_c30: type:INT64 value:"10" _c31: type:INT64 value:"11" _c32: type:INT64 value:"12" ` +
+			`_id0: type:INT64 value:"1" _id1: type:INT64 value:"2" _id2: type:INT64 value:"3" } ` +
+			`true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{InsertID: 4})
+}
+
+func TestInsertSelectNoRows(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id",
+					"int64",
+				),
+			),
+		},
+	}
+	ins := &Insert{
+		Opcode:            InsertSharded,
+		Keyspace:          ks.Keyspace,
+		Table:             ks.Tables["t1"],
+		Prefix:            "prefix ",
+		Input:             input,
+		VindexValueOffset: [][]int{{0}},
+	}
+
+	vc := &loggingVCursor{
+		shards: []string{"-20", "20-"},
+	}
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, nil)
+	expectResult(t, "Execute", result, &sqltypes.Result{})
+
+	input.sendErr = errors.New("input err")
+	input.results = nil
+	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, "execInsertSelect: input err")
+}
+
+func TestInsertSelectBatches(t *testing.T) {
+	save := testInsertSelectBatchSize
+	testInsertSelectBatchSize = 2
+	defer func() { testInsertSelectBatchSize = save }()
+	// The input is streamed, so it can return
+	// more rows than can be held in memory.
+	saveMaxMemoryRows := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() { testMaxMemoryRows = saveMaxMemoryRows }()
+
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id",
+					"int64",
+				),
+				"1",
+				"2",
+				"3",
+			),
+		},
+	}
+	ins := &Insert{
+		Opcode:            InsertSharded,
+		Keyspace:          ks.Keyspace,
+		Table:             ks.Tables["t1"],
+		Prefix:            "prefix ",
+		Input:             input,
+		VindexValueOffset: [][]int{{0}},
+		Generate: &Generate{
+			Keyspace: &vindexes.Keyspace{
+				Name:    "ks2",
+				Sharded: false,
+			},
+			Query:  "dummy_generate",
+			Offset: 1,
+		},
+	}
+
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"20-", "-20", "20-"},
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"nextval",
+					"int64",
+				),
+				"4",
+			),
+			{RowsAffected: 2},
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"nextval",
+					"int64",
+				),
+				"6",
+			),
+			{RowsAffected: 1},
+		},
+	}
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	// The rows are inserted in two batches,
+	// which are not autocommitted.
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks2 [] Destinations:DestinationAnyShard()`,
+		`ExecuteStandalone dummy_generate n: type:INT64 value:"2"  ks2 -20`,
+		`ResolveDestinations sharded [value:"0"  value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix (:_id0, :__c0_1) /* vtgate:: keyspace_id:166b40b44aba4bd6 */ ` +
+			`{__c0_1: type:INT64 value:"4" __c1_1: type:INT64 value:"5" _id0: type:INT64 value:"1" _id1: type:INT64 value:"2" } ` +
+			`sharded.-20: prefix (:_id1, :__c1_1) /* vtgate:: keyspace_id:06e7ea22ce92708f */ ` +
+			`{__c0_1: type:INT64 value:"4" __c1_1: type:INT64 value:"5" _id0: type:INT64 value:"1" _id1: type:INT64 value:"2" } ` +
+			`true false`,
+		`ResolveDestinations ks2 [] Destinations:DestinationAnyShard()`,
+		`ExecuteStandalone dummy_generate n: type:INT64 value:"1"  ks2 -20`,
+		`ResolveDestinations sharded [value:"0" ] Destinations:DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		`ExecuteMultiShard ` +
+			`sharded.20-: prefix (:_id0, :__c0_1) /* vtgate:: keyspace_id:4eb190c9a2fa169c */ ` +
+			`{__c0_1: type:INT64 value:"6" _id0: type:INT64 value:"3" } ` +
+			`true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 3, InsertID: 4})
+}
@@ -30,6 +30,8 @@ import (
 var _ Primitive = (*MemorySort)(nil)
 
 // MemorySort is a primitive that performs in-memory sorting.
+// When streaming, rows that exceed max_memory_rows are spilled
+// to disk if it's enabled by the SpillConfig of the VCursor.
 type MemorySort struct {
 	UpperLimit sqltypes.PlanValue
 	OrderBy    []OrderbyParams
@@ -45,15 +47,17 @@ type MemorySort struct {
 // It's used for testing and diagnostics.
 func (ms *MemorySort) MarshalJSON() ([]byte, error) {
 	marshalMemorySort := struct {
-		Opcode  string
-		MaxRows sqltypes.PlanValue
-		OrderBy []OrderbyParams
-		Input   Primitive
+		Opcode              string
+		MaxRows             sqltypes.PlanValue
+		OrderBy             []OrderbyParams
+		TruncateColumnCount int `json:",omitempty"`
+		Input               Primitive
 	}{
-		Opcode:  "MemorySort",
-		MaxRows: ms.UpperLimit,
-		OrderBy: ms.OrderBy,
-		Input:   ms.Input,
+		Opcode:              "MemorySort",
+		MaxRows:             ms.UpperLimit,
+		OrderBy:             ms.OrderBy,
+		TruncateColumnCount: ms.TruncateColumnCount,
+		Input:               ms.Input,
 	}
 	return json.Marshal(marshalMemorySort)
 }
@@ -116,6 +120,14 @@ func (ms *MemorySort) StreamExecute(vcursor VCursor, bindVars map[string]*queryp
 		return callback(qr.Truncate(ms.TruncateColumnCount))
 	}
 
+	// If the rows don't fit in memory, they're spilled to
+	// disk as sorted runs, which are merged at the end.
+	var spill *spillFile
+	if config := vcursor.SpillConfig(); config.Enabled() {
+		spill = newSpillFile(config)
+		defer spill.close()
+	}
+
 	// You have to reverse the ordering because the highest values
 	// must be dropped once the upper limit is reached.
 	sh := &sortHeap{
@@ -135,7 +147,10 @@ func (ms *MemorySort) StreamExecute(vcursor VCursor, bindVars map[string]*queryp
 			_ = heap.Pop(sh)
 		}
 		if len(sh.rows) > vcursor.MaxMemoryRows() {
-			return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+			if spill == nil {
+				return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+			}
+			return ms.spillRows(sh, spill)
 		}
 		return nil
 	})
@@ -152,7 +167,43 @@ func (ms *MemorySort) StreamExecute(vcursor VCursor, bindVars map[string]*queryp
 		// Unreachable.
 		return sh.err
 	}
-	return cb(&sqltypes.Result{Rows: sh.rows})
+	if spill == nil || len(spill.runs) == 0 {
+		return cb(&sqltypes.Result{Rows: sh.rows})
+	}
+
+	var rows [][]sqltypes.Value
+	err = spill.merge(sh.rows, ms.OrderBy, count, func(row []sqltypes.Value) error {
+		rows = append(rows, row)
+		if len(rows) < vcursor.MaxMemoryRows() {
+			return nil
+		}
+		err := cb(&sqltypes.Result{Rows: rows})
+		rows = nil
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	return cb(&sqltypes.Result{Rows: rows})
+}
+
+// spillRows writes the rows of the heap to the spill file
+// as a sorted run, and empties the heap.
+func (ms *MemorySort) spillRows(sh *sortHeap, spill *spillFile) error {
+	sh.reverse = false
+	sort.Sort(sh)
+	if sh.err != nil {
+		return sh.err
+	}
+	if err := spill.writeRun(sh.rows); err != nil {
+		return err
+	}
+	sh.rows = nil
+	sh.reverse = true
+	return nil
 }
 
 // GetFields satisfies the Primitive interface.
@@ -18,9 +18,13 @@ package engine
 
 import (
 	"encoding/json"
+	"io/ioutil"
+	"os"
 	"reflect"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"vitess.io/vitess/go/sqltypes"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -412,3 +416,133 @@ func TestMemorySortExecuteNoVarChar(t *testing.T) {
 		t.Errorf("StreamExecute err: %v, want %v", err, want)
 	}
 }
+
+func TestMemorySortStreamExecuteSpill(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 3
+	defer func() { testMaxMemoryRows = save }()
+
+	dir, err := ioutil.TempDir("", "spill")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	testSpillConfig = SpillConfig{Dir: dir, MaxBytes: 1 << 20}
+	defer func() { testSpillConfig = SpillConfig{} }()
+
+	fields := sqltypes.MakeTestFields(
+		"c1|c2",
+		"varbinary|decimal",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|1",
+			"b|2",
+			"a|1",
+			"c|4",
+			"c|3",
+			"null|5",
+			"d|0",
+			"e|null",
+			"f|3",
+		)},
+	}
+
+	ms := &MemorySort{
+		OrderBy: []OrderbyParams{{
+			Col: 1,
+		}, {
+			Col:  0,
+			Desc: true,
+		}},
+		Input: fp,
+	}
+
+	r, err := wrapStreamExecute(ms, noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	want := sqltypes.MakeTestResult(
+		fields,
+		"e|null",
+		"d|0",
+		"a|1",
+		"a|1",
+		"b|2",
+		"f|3",
+		"c|3",
+		"c|4",
+		"null|5",
+	)
+	expectResult(t, "ms.StreamExecute", r, want)
+
+	// The merged rows are sent in batches of at most max_memory_rows.
+	fp.rewind()
+	var batches []int
+	err = ms.StreamExecute(noopVCursor{}, nil, true, func(qr *sqltypes.Result) error {
+		if len(qr.Rows) != 0 {
+			batches = append(batches, len(qr.Rows))
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{3, 3, 3}, batches)
+
+	fp.rewind()
+	upperlimit, err := sqlparser.NewPlanValue(sqlparser.NewValArg([]byte(":__upper_limit")))
+	require.NoError(t, err)
+	ms.UpperLimit = upperlimit
+	bv := map[string]*querypb.BindVariable{"__upper_limit": sqltypes.Int64BindVariable(5)}
+	r, err = wrapStreamExecute(ms, noopVCursor{}, bv, true)
+	require.NoError(t, err)
+	want = sqltypes.MakeTestResult(
+		fields,
+		"e|null",
+		"d|0",
+		"a|1",
+		"a|1",
+		"b|2",
+	)
+	expectResult(t, "ms.StreamExecute", r, want)
+
+	// The spill files are removed at the end of the query.
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
+
+func TestMemorySortSpillBudget(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 1
+	defer func() { testMaxMemoryRows = save }()
+
+	dir, err := ioutil.TempDir("", "spill")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	testSpillConfig = SpillConfig{Dir: dir, MaxBytes: 10}
+	defer func() { testSpillConfig = SpillConfig{} }()
+
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"c1|c2",
+				"varbinary|decimal",
+			),
+			"a|1",
+			"b|2",
+			"c|3",
+			"d|4",
+		)},
+	}
+
+	ms := &MemorySort{
+		OrderBy: []OrderbyParams{{
+			Col: 1,
+		}},
+		Input: fp,
+	}
+
+	_, err = wrapStreamExecute(ms, noopVCursor{}, nil, true)
+	expectError(t, "ms.StreamExecute", err, "spilled row size exceeded allowed limit of 10 bytes")
+
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*MultiTableDML)(nil)
+
+// MultiTableDML is the primitive for a DELETE or UPDATE that
+// references multiple tables that can't be changed by a single
+// statement. The rows to be changed are selected first by the
+// Input, which returns, for each table, the values of the column
+// that joins it to the other tables. The statement is then split
+// into single-table DMLs that receive those values as a list.
+type MultiTableDML struct {
+	// Input selects the rows to be changed. Its
+	// columns correspond to the DMLs, in order.
+	Input Primitive
+
+	// ListVars are the names of the bind variables
+	// that receive the distinct values of the
+	// corresponding column of the Input.
+	ListVars []string
+
+	// DMLs are the single-table statements.
+	// They're executed in order.
+	DMLs []Primitive
+}
+
+// MarshalJSON serializes the MultiTableDML into a JSON representation.
+// It's used for testing and diagnostics.
+func (mt *MultiTableDML) MarshalJSON() ([]byte, error) {
+	marshalMultiTableDML := struct {
+		Opcode   string
+		ListVars []string
+		Input    Primitive
+		DMLs     []Primitive
+	}{
+		Opcode:   mt.RouteType(),
+		ListVars: mt.ListVars,
+		Input:    mt.Input,
+		DMLs:     mt.DMLs,
+	}
+	return json.Marshal(marshalMultiTableDML)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (mt *MultiTableDML) RouteType() string {
+	return "MultiTableDML"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (mt *MultiTableDML) GetKeyspaceName() string {
+	return mt.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (mt *MultiTableDML) GetTableName() string {
+	names := make([]string, 0, len(mt.DMLs))
+	for _, dml := range mt.DMLs {
+		names = append(names, dml.GetTableName())
+	}
+	return strings.Join(names, "_")
+}
+
+// Execute performs a non-streaming exec.
+func (mt *MultiTableDML) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	input, err := mt.Input.Execute(vcursor, bindVars, false)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{}
+	for i, dml := range mt.DMLs {
+		values := mt.listValues(input.Rows, i)
+		if len(values.Values) == 0 {
+			// None of the rows of this table were selected.
+			continue
+		}
+		qr, err := dml.Execute(vcursor, combineVars(bindVars, map[string]*querypb.BindVariable{mt.ListVars[i]: values}), false)
+		if err != nil {
+			return nil, err
+		}
+		result.RowsAffected += qr.RowsAffected
+	}
+	return result, nil
+}
+
+// StreamExecute performs a streaming exec.
+func (mt *MultiTableDML) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return fmt.Errorf("multi-table %s cannot be used for streaming", mt.GetTableName())
+}
+
+// GetFields fetches the field info.
+func (mt *MultiTableDML) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return nil, fmt.Errorf("BUG: unreachable code for multi-table %s", mt.GetTableName())
+}
+
+// Inputs returns the input primitives for this multi-table DML.
+func (mt *MultiTableDML) Inputs() []Primitive {
+	return append([]Primitive{mt.Input}, mt.DMLs...)
+}
+
+// listValues returns the distinct values of the
+// specified column of the rows. NULLs are skipped
+// because they can't match anything: the planner
+// doesn't allow the tables to be joined with <=>.
+func (mt *MultiTableDML) listValues(rows [][]sqltypes.Value, col int) *querypb.BindVariable {
+	values := &querypb.BindVariable{Type: querypb.Type_TUPLE}
+	seen := make(map[string]bool)
+	for _, row := range rows {
+		key, ok := joinKey(row, []int{col})
+		if !ok || seen[key] {
+			continue
+		}
+		seen[key] = true
+		values.Values = append(values.Values, sqltypes.ValueToProto(row[col]))
+	}
+	return values
+}
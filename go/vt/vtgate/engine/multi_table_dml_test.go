@@ -0,0 +1,95 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestMultiTableDMLExecute(t *testing.T) {
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2|col3",
+					"int64|varchar|int64",
+				),
+				"1|a|null",
+				"2|b|null",
+				"1|c|null",
+			),
+		},
+	}
+	dml1 := &fakePrimitive{results: []*sqltypes.Result{{RowsAffected: 2}}}
+	dml2 := &fakePrimitive{results: []*sqltypes.Result{{RowsAffected: 3}}}
+	dml3 := &fakePrimitive{results: []*sqltypes.Result{{RowsAffected: 4}}}
+	mt := &MultiTableDML{
+		Input:    input,
+		ListVars: []string{"__sq1", "__sq2", "__sq3"},
+		DMLs:     []Primitive{dml1, dml2, dml3},
+	}
+
+	r, err := mt.Execute(noopVCursor{}, nil, false)
+	require.NoError(t, err)
+	input.ExpectLog(t, []string{
+		`Execute  false`,
+	})
+	dml1.ExpectLog(t, []string{
+		`Execute __sq1: type:TUPLE values:<type:INT64 value:"1" > values:<type:INT64 value:"2" >  false`,
+	})
+	dml2.ExpectLog(t, []string{
+		`Execute __sq2: type:TUPLE values:<type:VARCHAR value:"a" > values:<type:VARCHAR value:"b" > values:<type:VARCHAR value:"c" >  false`,
+	})
+	// The third column has no values.
+	dml3.ExpectLog(t, nil)
+	expectResult(t, "mt.Execute", r, &sqltypes.Result{RowsAffected: 5})
+
+	_, err = wrapStreamExecute(mt, noopVCursor{}, nil, false)
+	require.Error(t, err)
+}
+
+func TestMultiTableDMLExecuteErrors(t *testing.T) {
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1",
+					"int64",
+				),
+				"1",
+			),
+		},
+	}
+	dml := &fakePrimitive{sendErr: errors.New("dml err")}
+	mt := &MultiTableDML{
+		Input:    input,
+		ListVars: []string{"__sq1"},
+		DMLs:     []Primitive{dml},
+	}
+
+	_, err := mt.Execute(noopVCursor{}, nil, false)
+	expectError(t, "mt.Execute", err, "dml err")
+
+	mt.Input = &fakePrimitive{sendErr: errors.New("input err")}
+	_, err = mt.Execute(noopVCursor{}, nil, false)
+	expectError(t, "mt.Execute", err, "input err")
+}
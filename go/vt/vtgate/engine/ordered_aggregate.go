@@ -18,6 +18,8 @@ package engine
 
 import (
 	"fmt"
+	"math"
+	"sort"
 
 	"vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -61,12 +63,32 @@ type AggregateParams struct {
 	Col    int
 	// Alias is set only for distinct opcodes.
 	Alias string `json:",omitempty"`
+	// DistinctCols contains the rest of the input columns of a
+	// distinct aggregate that has more than one expression, like
+	// count(distinct a, b). Col contains the first one.
+	DistinctCols []int `json:",omitempty"`
+	// Unordered is set for a distinct aggregate whose values are
+	// not sorted within a group. Such values are tracked in memory,
+	// which is bounded by max_memory_rows. When streaming, they're
+	// spilled to disk if it's enabled by the SpillConfig.
+	Unordered bool `json:",omitempty"`
 }
 
 func (ap AggregateParams) isDistinct() bool {
 	return ap.Opcode == AggregateCountDistinct || ap.Opcode == AggregateSumDistinct
 }
 
+// distinctValues returns the values of the row that
+// must be distinct for the aggregate.
+func (ap AggregateParams) distinctValues(row []sqltypes.Value) []sqltypes.Value {
+	vals := make([]sqltypes.Value, 0, len(ap.DistinctCols)+1)
+	vals = append(vals, row[ap.Col])
+	for _, col := range ap.DistinctCols {
+		vals = append(vals, row[col])
+	}
+	return vals
+}
+
 // AggregateOpcode is the aggregation Opcode.
 type AggregateOpcode int
 
@@ -160,10 +182,13 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 	}
 	// This code is similar to the one in StreamExecute.
 	var current []sqltypes.Value
-	var curDistinct sqltypes.Value
+	var distincts *distinctTracker
 	for _, row := range result.Rows {
 		if current == nil {
-			current, curDistinct = oa.convertRow(row)
+			current, distincts, err = oa.convertRow(vcursor, row, nil)
+			if err != nil {
+				return nil, err
+			}
 			continue
 		}
 
@@ -173,14 +198,17 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 		}
 
 		if equal {
-			current, curDistinct, err = oa.merge(result.Fields, current, row, curDistinct)
+			current, err = oa.merge(vcursor, result.Fields, current, row, distincts)
 			if err != nil {
 				return nil, err
 			}
 			continue
 		}
 		out.Rows = append(out.Rows, current)
-		current, curDistinct = oa.convertRow(row)
+		current, distincts, err = oa.convertRow(vcursor, row, nil)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if len(result.Rows) == 0 && len(oa.Keys) == 0 {
@@ -203,13 +231,32 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 // StreamExecute is a Primitive function.
 func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
 	var current []sqltypes.Value
-	var curDistinct sqltypes.Value
+	var distincts *distinctTracker
 	var fields []*querypb.Field
 
 	cb := func(qr *sqltypes.Result) error {
 		return callback(qr.Truncate(oa.TruncateColumnCount))
 	}
 
+	// The values of the unordered distinct aggregates of a group
+	// are spilled to disk if they don't fit in memory. The file is
+	// reused by every group.
+	var spill *spillFile
+	if oa.hasUnordered() {
+		if config := vcursor.SpillConfig(); config.Enabled() {
+			spill = newSpillFile(config)
+			defer spill.close()
+		}
+	}
+	// send sends the current row once its group is complete.
+	send := func() error {
+		row, err := oa.finishGroup(current, distincts)
+		if err != nil {
+			return err
+		}
+		return cb(&sqltypes.Result{Rows: [][]sqltypes.Value{row}})
+	}
+
 	err := oa.Input.StreamExecute(vcursor, bindVars, wantfields, func(qr *sqltypes.Result) error {
 		if len(qr.Fields) != 0 {
 			fields = oa.convertFields(qr.Fields)
@@ -219,8 +266,12 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 		}
 		// This code is similar to the one in Execute.
 		for _, row := range qr.Rows {
+			var err error
 			if current == nil {
-				current, curDistinct = oa.convertRow(row)
+				current, distincts, err = oa.convertRow(vcursor, row, spill)
+				if err != nil {
+					return err
+				}
 				continue
 			}
 
@@ -230,16 +281,19 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 			}
 
 			if equal {
-				current, curDistinct, err = oa.merge(fields, current, row, curDistinct)
+				current, err = oa.merge(vcursor, fields, current, row, distincts)
 				if err != nil {
 					return err
 				}
 				continue
 			}
-			if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{current}}); err != nil {
+			if err := send(); err != nil {
+				return err
+			}
+			current, distincts, err = oa.convertRow(vcursor, row, spill)
+			if err != nil {
 				return err
 			}
-			current, curDistinct = oa.convertRow(row)
 		}
 		return nil
 	})
@@ -248,7 +302,7 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 	}
 
 	if current != nil {
-		if err := cb(&sqltypes.Result{Rows: [][]sqltypes.Value{current}}); err != nil {
+		if err := send(); err != nil {
 			return err
 		}
 	}
@@ -272,31 +326,39 @@ func (oa *OrderedAggregate) convertFields(fields []*querypb.Field) []*querypb.Fi
 	return fields
 }
 
-func (oa *OrderedAggregate) convertRow(row []sqltypes.Value) (newRow []sqltypes.Value, curDistinct sqltypes.Value) {
+// convertRow converts the first row of a group. It also returns
+// the tracker for the distinct values seen in the group, which
+// spills them to spill if it's not nil.
+func (oa *OrderedAggregate) convertRow(vcursor VCursor, row []sqltypes.Value, spill *spillFile) (newRow []sqltypes.Value, distincts *distinctTracker, err error) {
 	if !oa.HasDistinct {
-		return row, sqltypes.NULL
+		return row, nil, nil
 	}
+	distincts = newDistinctTracker(len(oa.Aggregates), spill)
 	newRow = append(newRow, row...)
-	for _, aggr := range oa.Aggregates {
+	for i, aggr := range oa.Aggregates {
 		switch aggr.Opcode {
 		case AggregateCountDistinct:
-			curDistinct = row[aggr.Col]
+			added, err := distincts.add(vcursor, i, aggr, row)
+			if err != nil {
+				return nil, nil, err
+			}
 			// Type is int64. Ok to call MakeTrusted.
-			if row[aggr.Col].IsNull() {
-				newRow[aggr.Col] = countZero
-			} else {
+			if added {
 				newRow[aggr.Col] = countOne
+			} else {
+				newRow[aggr.Col] = countZero
 			}
 		case AggregateSumDistinct:
-			curDistinct = row[aggr.Col]
-			var err error
+			if _, err := distincts.add(vcursor, i, aggr, row); err != nil {
+				return nil, nil, err
+			}
 			newRow[aggr.Col], err = sqltypes.Cast(row[aggr.Col], opcodeType[aggr.Opcode])
 			if err != nil {
 				newRow[aggr.Col] = sumZero
 			}
 		}
 	}
-	return newRow, curDistinct
+	return newRow, distincts, nil
 }
 
 // GetFields is a Primitive function.
@@ -327,21 +389,17 @@ func (oa *OrderedAggregate) keysEqual(row1, row2 []sqltypes.Value) (bool, error)
 	return true, nil
 }
 
-func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes.Value, curDistinct sqltypes.Value) ([]sqltypes.Value, sqltypes.Value, error) {
+func (oa *OrderedAggregate) merge(vcursor VCursor, fields []*querypb.Field, row1, row2 []sqltypes.Value, distincts *distinctTracker) ([]sqltypes.Value, error) {
 	result := sqltypes.CopyRow(row1)
-	for _, aggr := range oa.Aggregates {
+	for i, aggr := range oa.Aggregates {
 		if aggr.isDistinct() {
-			if row2[aggr.Col].IsNull() {
-				continue
-			}
-			cmp, err := sqltypes.NullsafeCompare(curDistinct, row2[aggr.Col])
+			added, err := distincts.add(vcursor, i, aggr, row2)
 			if err != nil {
-				return nil, sqltypes.NULL, err
+				return nil, err
 			}
-			if cmp == 0 {
+			if !added {
 				continue
 			}
-			curDistinct = row2[aggr.Col]
 		}
 		var err error
 		switch aggr.Opcode {
@@ -356,13 +414,187 @@ func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes
 		case AggregateSumDistinct:
 			result[aggr.Col] = sqltypes.NullsafeAdd(row1[aggr.Col], row2[aggr.Col], opcodeType[aggr.Opcode])
 		default:
-			return nil, sqltypes.NULL, fmt.Errorf("BUG: Unexpected opcode: %v", aggr.Opcode)
+			return nil, fmt.Errorf("BUG: Unexpected opcode: %v", aggr.Opcode)
 		}
 		if err != nil {
-			return nil, sqltypes.NULL, err
+			return nil, err
 		}
 	}
-	return result, curDistinct, nil
+	return result, nil
+}
+
+// hasUnordered returns true if one of the aggregates is unordered.
+func (oa *OrderedAggregate) hasUnordered() bool {
+	for _, aggr := range oa.Aggregates {
+		if aggr.Unordered {
+			return true
+		}
+	}
+	return false
+}
+
+// finishGroup returns the row of a complete group. If values of
+// the group were spilled, the ones that add didn't aggregate are
+// aggregated, and the spill file is reset for the next group.
+func (oa *OrderedAggregate) finishGroup(row []sqltypes.Value, distincts *distinctTracker) ([]sqltypes.Value, error) {
+	if distincts == nil || !distincts.spilled() {
+		return row, nil
+	}
+	// The spilled rows are sorted by aggregate and key, and the
+	// values that were aggregated come first. So, only the first
+	// row of a key tells if it must be aggregated.
+	var last []sqltypes.Value
+	err := distincts.spill.merge(distincts.spillRows(), distinctSpillOrder, math.MaxInt64, func(srow []sqltypes.Value) error {
+		if last != nil {
+			equal, err := valuesEqual(last[:2], srow[:2])
+			if err != nil || equal {
+				return err
+			}
+		}
+		last = srow
+		if srow[2].ToString() != "1" {
+			return nil
+		}
+		i, err := sqltypes.ToInt64(srow[0])
+		if err != nil {
+			return err
+		}
+		aggr := oa.Aggregates[i]
+		switch aggr.Opcode {
+		case AggregateCountDistinct:
+			row[aggr.Col] = sqltypes.NullsafeAdd(row[aggr.Col], countOne, opcodeType[aggr.Opcode])
+		case AggregateSumDistinct:
+			row[aggr.Col] = sqltypes.NullsafeAdd(row[aggr.Col], srow[3], opcodeType[aggr.Opcode])
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return row, distincts.spill.reset()
+}
+
+// distinctTracker tracks the values of the distinct
+// aggregates that were seen in the current group.
+type distinctTracker struct {
+	// last contains the last values of the ordered aggregates.
+	last [][]sqltypes.Value
+	// seen contains the values of the unordered aggregates
+	// that are in memory.
+	seen  []map[string][]sqltypes.Value
+	count int
+	// spill receives the values of seen when they exceed
+	// max_memory_rows. Spilling is disabled if it's nil.
+	spill *spillFile
+}
+
+// distinctSpillOrder is the order of the rows spilled by a
+// distinctTracker: by aggregate, key, and pending flag.
+var distinctSpillOrder = []OrderbyParams{{Col: 0}, {Col: 1}, {Col: 2}}
+
+func newDistinctTracker(size int, spill *spillFile) *distinctTracker {
+	return &distinctTracker{
+		last:  make([][]sqltypes.Value, size),
+		seen:  make([]map[string][]sqltypes.Value, size),
+		spill: spill,
+	}
+}
+
+// add records the values of the row for the i'th aggregate.
+// It returns false if the values were already seen in the group,
+// or if one of them is NULL. An error is returned if the tracked
+// values exceed the allowed number of rows and can't be spilled.
+// Once values were spilled, add also returns false for the new
+// ones, which are aggregated by finishGroup instead because they
+// may be among the spilled ones.
+func (dt *distinctTracker) add(vcursor VCursor, i int, aggr AggregateParams, row []sqltypes.Value) (bool, error) {
+	vals := aggr.distinctValues(row)
+	for _, val := range vals {
+		if val.IsNull() {
+			return false, nil
+		}
+	}
+	if !aggr.Unordered {
+		// The values are sorted. So, they only need
+		// to be compared against the previous ones.
+		if dt.last[i] != nil {
+			equal, err := valuesEqual(dt.last[i], vals)
+			if err != nil || equal {
+				return false, err
+			}
+		}
+		dt.last[i] = vals
+		return true, nil
+	}
+	if dt.seen[i] == nil {
+		dt.seen[i] = make(map[string][]sqltypes.Value)
+	}
+	key := distinctKey(vals, nil)
+	if _, ok := dt.seen[i][key]; ok {
+		return false, nil
+	}
+	dt.seen[i][key] = vals
+	dt.count++
+	added := !dt.spilled()
+	if dt.count > vcursor.MaxMemoryRows() {
+		if dt.spill == nil {
+			return false, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+		if err := dt.spill.writeRun(dt.spillRows()); err != nil {
+			return false, err
+		}
+		for j := range dt.seen {
+			if dt.seen[j] != nil {
+				dt.seen[j] = make(map[string][]sqltypes.Value)
+			}
+		}
+		dt.count = 0
+	}
+	return added, nil
+}
+
+// spilled returns true if values of the group were spilled.
+func (dt *distinctTracker) spilled() bool {
+	return dt.spill != nil && len(dt.spill.runs) != 0
+}
+
+// spillRows returns the values in memory as rows sorted by
+// distinctSpillOrder. A row contains the aggregate number, the
+// key of the values, and a flag that is 1 if the values are
+// pending, which means add didn't aggregate them. The values
+// follow if they're pending.
+func (dt *distinctTracker) spillRows() [][]sqltypes.Value {
+	pending := dt.spilled()
+	var rows [][]sqltypes.Value
+	for i, seen := range dt.seen {
+		keys := make([]string, 0, len(seen))
+		for key := range seen {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			row := []sqltypes.Value{sqltypes.NewInt64(int64(i)), sqltypes.NewVarBinary(key), countZero}
+			if pending {
+				row[2] = countOne
+				row = append(row, seen[key]...)
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+func valuesEqual(vals1, vals2 []sqltypes.Value) (bool, error) {
+	for i := range vals1 {
+		cmp, err := sqltypes.NullsafeCompare(vals1[i], vals2[i])
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
 }
 
 // creates the empty row for the case when we are missing grouping keys and have empty input table
@@ -18,6 +18,8 @@ package engine
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
 	"reflect"
 	"testing"
 
@@ -538,6 +540,169 @@ func TestOrderedAggregateSumDistinctTolerateError(t *testing.T) {
 	assert.Equal(wantResult, result)
 }
 
+func TestOrderedAggregateMultipleDistinct(t *testing.T) {
+	assert := assert.New(t)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col1|col2|col3|col4",
+				"varbinary|int64|varbinary|int64",
+			),
+			// Only the values of col2 are sorted within a group.
+			"a|null|z|null",
+			"a|1|x|1",
+			"a|1|y|1",
+			"a|2|x|1",
+			"a|2|x|2",
+			"b|1|x|1",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		HasDistinct: true,
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCountDistinct,
+			Col:    1,
+			Alias:  "count(distinct col2)",
+		}, {
+			Opcode:       AggregateCountDistinct,
+			Col:          2,
+			Alias:        "count(distinct col3, col4)",
+			DistinctCols: []int{3},
+			Unordered:    true,
+		}},
+		Keys:                []int{0},
+		TruncateColumnCount: 3,
+		Input:               fp,
+	}
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|count(distinct col2)|count(distinct col3, col4)",
+			"varbinary|int64|int64",
+		),
+		"a|2|3",
+		"b|1|1",
+	)
+
+	result, err := oa.Execute(noopVCursor{}, nil, false)
+	assert.NoError(err)
+	assert.Equal(wantResult, result)
+
+	fp.rewind()
+	result, err = wrapStreamExecute(oa, noopVCursor{}, nil, false)
+	assert.NoError(err)
+	assert.Equal(wantResult, result)
+}
+
+func TestOrderedAggregateDistinctMaxMemoryRows(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() { testMaxMemoryRows = save }()
+
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col1|col2",
+				"varbinary|int64",
+			),
+			"a|1",
+			"a|2",
+			"a|3",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		HasDistinct: true,
+		Aggregates: []AggregateParams{{
+			Opcode:    AggregateCountDistinct,
+			Col:       1,
+			Alias:     "count(distinct col2)",
+			Unordered: true,
+		}},
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	_, err := oa.Execute(noopVCursor{}, nil, false)
+	expectError(t, "oa.Execute", err, "in-memory row count exceeded allowed limit of 2")
+
+	fp.rewind()
+	_, err = wrapStreamExecute(oa, noopVCursor{}, nil, false)
+	expectError(t, "oa.StreamExecute", err, "in-memory row count exceeded allowed limit of 2")
+}
+
+func TestOrderedAggregateDistinctSpill(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() { testMaxMemoryRows = save }()
+
+	dir, err := ioutil.TempDir("", "spill")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	testSpillConfig = SpillConfig{Dir: dir, MaxBytes: 1 << 20}
+	defer func() { testSpillConfig = SpillConfig{} }()
+
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"col1|col2|col3",
+				"varbinary|int64|decimal",
+			),
+			"a|1|1",
+			"a|2|2",
+			"a|1|1",
+			"a|3|3",
+			"a|2|2",
+			"a|4|1",
+			"a|null|5",
+			"a|1|3",
+			"b|1|1",
+			"b|1|1",
+			"b|2|2",
+		)},
+	}
+
+	oa := &OrderedAggregate{
+		HasDistinct: true,
+		Aggregates: []AggregateParams{{
+			Opcode:    AggregateCountDistinct,
+			Col:       1,
+			Alias:     "count(distinct col2)",
+			Unordered: true,
+		}, {
+			Opcode:    AggregateSumDistinct,
+			Col:       2,
+			Alias:     "sum(distinct col3)",
+			Unordered: true,
+		}},
+		Keys:  []int{0},
+		Input: fp,
+	}
+
+	// Non-streaming queries don't spill.
+	_, err = oa.Execute(noopVCursor{}, nil, false)
+	expectError(t, "oa.Execute", err, "in-memory row count exceeded allowed limit of 2")
+
+	fp.rewind()
+	result, err := wrapStreamExecute(oa, noopVCursor{}, nil, true)
+	assert.NoError(t, err)
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|count(distinct col2)|sum(distinct col3)",
+			"varbinary|int64|decimal",
+		),
+		"a|4|11",
+		"b|2|3",
+	)
+	expectResult(t, "oa.StreamExecute", result, wantResult)
+
+	// The spill file is removed at the end of the query.
+	files, err := ioutil.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Empty(t, files)
+}
+
 func TestOrderedAggregateKeysFail(t *testing.T) {
 	fields := sqltypes.MakeTestFields(
 		"col|count(*)",
@@ -655,13 +820,13 @@ func TestMerge(t *testing.T) {
 		"1|3|2.8|2|bc",
 	)
 
-	merged, _, err := oa.merge(fields, r.Rows[0], r.Rows[1], sqltypes.NULL)
+	merged, err := oa.merge(nil, fields, r.Rows[0], r.Rows[1], nil)
 	assert.NoError(err)
 	want := sqltypes.MakeTestResult(fields, "1|5|6|2|bc").Rows[0]
 	assert.Equal(want, merged)
 
 	// swap and retry
-	merged, _, err = oa.merge(fields, r.Rows[1], r.Rows[0], sqltypes.NULL)
+	merged, err = oa.merge(nil, fields, r.Rows[1], r.Rows[0], nil)
 	assert.NoError(err)
 	assert.Equal(want, merged)
 }
@@ -39,6 +39,10 @@ const (
 	// This is used for sending different IN clause values
 	// to different shards.
 	ListVarName = "__vals"
+	// DMLLimitVarName is a reserved bind var name for the
+	// number of rows a multi-shard DML with a LIMIT can
+	// affect in each shard.
+	DMLLimitVarName = "__dml_limit"
 )
 
 // VCursor defines the interface the engine will use
@@ -50,6 +54,15 @@ type VCursor interface {
 	// MaxMemoryRows returns the maxMemoryRows flag value.
 	MaxMemoryRows() int
 
+	// SpillConfig returns the configuration for spilling the rows
+	// of a streaming query to disk once MaxMemoryRows is exceeded.
+	SpillConfig() SpillConfig
+
+	// InsertSelectBatchSize returns the maximum number of rows of
+	// an INSERT ... SELECT that are inserted with one statement
+	// per shard.
+	InsertSelectBatchSize() int
+
 	// SetContextTimeout updates the context and sets a timeout.
 	SetContextTimeout(timeout time.Duration) context.CancelFunc
 
@@ -60,6 +73,10 @@ type VCursor interface {
 	Execute(method string, query string, bindvars map[string]*querypb.BindVariable, isDML bool, co vtgatepb.CommitOrder) (*sqltypes.Result, error)
 	AutocommitApproval() bool
 
+	// InMultiShardTransaction returns true if the session is
+	// in a transaction that is allowed to span multiple shards.
+	InMultiShardTransaction() bool
+
 	// Shard-level functions.
 	ExecuteMultiShard(rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, isDML, canAutocommit bool) (*sqltypes.Result, []error)
 	ExecuteStandalone(query string, bindvars map[string]*querypb.BindVariable, rs *srvtopo.ResolvedShard) (*sqltypes.Result, error)
@@ -96,6 +113,9 @@ type Plan struct {
 	Rows uint64 `json:",omitempty"`
 	// Total number of errors
 	Errors uint64 `json:",omitempty"`
+	// NeedsReservedConn is set if the query uses the state of
+	// the connection, like a named lock or a temporary table.
+	NeedsReservedConn bool `json:",omitempty"`
 	// Stores BindVars needed to be provided as part of expression rewriting
 	sqlparser.BindVarNeeds `json:"-"`
 }
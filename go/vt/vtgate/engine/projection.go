@@ -0,0 +1,183 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*Projection)(nil)
+
+// Projection is a primitive that evaluates expressions
+// on the rows returned by its input. Each output column
+// is the result of one expression.
+type Projection struct {
+	// Cols contains the names of the output columns.
+	Cols []string
+	// Exprs contains the expressions to evaluate for each row.
+	Exprs []evalengine.Expr
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	TruncateColumnCount int
+
+	// Input is the primitive that will feed into this Primitive.
+	Input Primitive
+}
+
+// MarshalJSON serializes the Projection into a JSON representation.
+// It's used for testing and diagnostics.
+func (p *Projection) MarshalJSON() ([]byte, error) {
+	exprs := make([]string, 0, len(p.Exprs))
+	for _, expr := range p.Exprs {
+		exprs = append(exprs, expr.String())
+	}
+	marshalProjection := struct {
+		Opcode              string
+		Cols                []string
+		Exprs               []string
+		TruncateColumnCount int `json:",omitempty"`
+		Input               Primitive
+	}{
+		Opcode:              "Projection",
+		Cols:                p.Cols,
+		Exprs:               exprs,
+		TruncateColumnCount: p.TruncateColumnCount,
+		Input:               p.Input,
+	}
+	return json.Marshal(marshalProjection)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (p *Projection) RouteType() string {
+	return p.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (p *Projection) GetKeyspaceName() string {
+	return p.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (p *Projection) GetTableName() string {
+	return p.Input.GetTableName()
+}
+
+// SetTruncateColumnCount sets the truncate column count.
+func (p *Projection) SetTruncateColumnCount(count int) {
+	p.TruncateColumnCount = count
+}
+
+// Execute performs a non-streaming exec.
+// The fields of the input are always requested because
+// the types of the expressions depend on them.
+func (p *Projection) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	input, err := p.Input.Execute(vcursor, bindVars, true)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{RowsAffected: input.RowsAffected}
+	result.Rows, err = p.evaluate(bindVars, input.Fields, input.Rows)
+	if err != nil {
+		return nil, err
+	}
+	if wantfields {
+		result.Fields = p.fields(bindVars, input.Fields)
+	}
+	return result.Truncate(p.TruncateColumnCount), nil
+}
+
+// StreamExecute performs a streaming exec.
+func (p *Projection) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var inputFields []*querypb.Field
+	return p.Input.StreamExecute(vcursor, bindVars, true, func(input *sqltypes.Result) error {
+		result := &sqltypes.Result{}
+		if input.Fields != nil {
+			inputFields = input.Fields
+			if wantfields {
+				result.Fields = p.fields(bindVars, inputFields)
+			}
+		}
+		var err error
+		result.Rows, err = p.evaluate(bindVars, inputFields, input.Rows)
+		if err != nil {
+			return err
+		}
+		if result.Fields == nil && len(result.Rows) == 0 {
+			return nil
+		}
+		return callback(result.Truncate(p.TruncateColumnCount))
+	})
+}
+
+// GetFields fetches the field info.
+func (p *Projection) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	input, err := p.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{Fields: p.fields(bindVars, input.Fields)}
+	return result.Truncate(p.TruncateColumnCount), nil
+}
+
+// Inputs returns the input to this primitive
+func (p *Projection) Inputs() []Primitive {
+	return []Primitive{p.Input}
+}
+
+// evaluate computes the output rows for the input rows.
+func (p *Projection) evaluate(bindVars map[string]*querypb.BindVariable, fields []*querypb.Field, rows [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	var out [][]sqltypes.Value
+	env := evalengine.ExpressionEnv{BindVars: bindVars, Fields: fields}
+	for _, row := range rows {
+		env.Row = row
+		outRow := make([]sqltypes.Value, 0, len(p.Exprs))
+		for _, expr := range p.Exprs {
+			v, err := expr.Evaluate(env)
+			if err != nil {
+				return nil, err
+			}
+			outRow = append(outRow, v)
+		}
+		out = append(out, outRow)
+	}
+	return out, nil
+}
+
+// fields returns the output fields. A column that is passed
+// through keeps the field of the input.
+func (p *Projection) fields(bindVars map[string]*querypb.BindVariable, inputFields []*querypb.Field) []*querypb.Field {
+	env := evalengine.ExpressionEnv{BindVars: bindVars, Fields: inputFields}
+	fields := make([]*querypb.Field, 0, len(p.Exprs))
+	for i, expr := range p.Exprs {
+		if col, ok := expr.(*evalengine.Column); ok && col.Offset < len(inputFields) {
+			fields = append(fields, inputFields[col.Offset])
+			continue
+		}
+		fields = append(fields, &querypb.Field{
+			Name: p.Cols[i],
+			Type: expr.Type(env),
+		})
+	}
+	return fields
+}
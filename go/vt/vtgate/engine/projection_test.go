@@ -0,0 +1,142 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+func TestProjectionExecute(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|sum(a)|count(*)",
+				"int64|decimal|int64",
+			),
+			"1|10|4",
+			"2|3|0",
+			"3|null|2",
+		)},
+	}
+	p := &Projection{
+		Cols: []string{"id", "sum(a) / count(*)"},
+		Exprs: []evalengine.Expr{
+			&evalengine.Column{Offset: 0},
+			&evalengine.Arithmetic{
+				Op:    evalengine.Divide,
+				Left:  &evalengine.Column{Offset: 1},
+				Right: &evalengine.Column{Offset: 2},
+			},
+		},
+		Input: fp,
+	}
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|sum(a) / count(*)",
+			"int64|decimal",
+		),
+		"1|2.5000",
+		"2|null",
+		"3|null",
+	)
+
+	r, err := p.Execute(noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	expectResult(t, "p.Execute", r, want)
+
+	fp.rewind()
+	r, err = wrapStreamExecute(p, noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	expectResult(t, "p.StreamExecute", r, want)
+
+	// Fields are still fetched from the input
+	// if the caller doesn't want them.
+	fp.rewind()
+	r, err = p.Execute(noopVCursor{}, nil, false)
+	require.NoError(t, err)
+	want.Fields = nil
+	expectResult(t, "p.Execute", r, want)
+	fp.ExpectLog(t, []string{"Execute  true"})
+
+	fp.rewind()
+	r, err = p.GetFields(noopVCursor{}, nil)
+	require.NoError(t, err)
+	expectResult(t, "p.GetFields", r, &sqltypes.Result{Fields: sqltypes.MakeTestFields(
+		"id|sum(a) / count(*)",
+		"int64|decimal",
+	)})
+}
+
+func TestProjectionTruncate(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"price|qty",
+				"int64|int64",
+			),
+			"2|3",
+		)},
+	}
+	p := &Projection{
+		Cols: []string{"price", "price * qty"},
+		Exprs: []evalengine.Expr{
+			&evalengine.Column{Offset: 0},
+			&evalengine.Arithmetic{
+				Op:    evalengine.Multiply,
+				Left:  &evalengine.Column{Offset: 0},
+				Right: &evalengine.Column{Offset: 1},
+			},
+		},
+		TruncateColumnCount: 1,
+		Input:               fp,
+	}
+
+	r, err := p.Execute(noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	expectResult(t, "p.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"price",
+			"int64",
+		),
+		"2",
+	))
+}
+
+func TestProjectionError(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"a",
+				"int64",
+			),
+			"1",
+		)},
+	}
+	p := &Projection{
+		Cols:  []string{":b"},
+		Exprs: []evalengine.Expr{&evalengine.BindVariable{Key: "b"}},
+		Input: fp,
+	}
+
+	_, err := p.Execute(noopVCursor{}, nil, true)
+	expectError(t, "p.Execute", err, "missing bind var b")
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*SemiJoin)(nil)
+
+// semiJoinBatchSize is the maximum number of LHS values
+// that are sent to the RHS in a single list.
+var semiJoinBatchSize = 500
+
+// SemiJoin specifies the parameters for a semi-join or an anti-join.
+// It's used for correlated EXISTS and NOT EXISTS subqueries that
+// cannot be sent to the same shards as the outer query.
+// The rows of the LHS are fetched first, and the distinct values
+// of their LHSValue column are passed to the RHS as lists.
+// The RHS is therefore executed once for every batch of LHS
+// values. The LHS rows are returned depending on whether the RHS
+// produced a matching row or not. No RHS columns are returned.
+type SemiJoin struct {
+	Opcode SemiJoinOpcode
+
+	// Left and Right are the LHS and RHS primitives
+	// of the SemiJoin. They can be any primitive.
+	Left, Right Primitive
+
+	// ListVar is the name of the bind variable that receives
+	// the list of LHSValue values. The RHS is expected to use
+	// it to restrict its rows to the ones that can match.
+	ListVar string
+
+	// LHSValue is the column of the LHS whose values are
+	// sent to the RHS.
+	LHSValue int
+
+	// LHSKey and RHSKey are the columns of the left and right results
+	// that are compared to find matches. LHSKey is usually the same as
+	// LHSValue. They differ if weight_strings are compared instead of
+	// the actual values.
+	LHSKey, RHSKey int
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	TruncateColumnCount int
+}
+
+// MarshalJSON serializes the SemiJoin into a JSON representation.
+// It's used for testing and diagnostics.
+func (sj *SemiJoin) MarshalJSON() ([]byte, error) {
+	marshalSemiJoin := struct {
+		Opcode              SemiJoinOpcode
+		Left                Primitive
+		Right               Primitive
+		ListVar             string
+		LHSValue            int
+		LHSKey              int
+		RHSKey              int
+		TruncateColumnCount int `json:",omitempty"`
+	}{
+		Opcode:              sj.Opcode,
+		Left:                sj.Left,
+		Right:               sj.Right,
+		ListVar:             sj.ListVar,
+		LHSValue:            sj.LHSValue,
+		LHSKey:              sj.LHSKey,
+		RHSKey:              sj.RHSKey,
+		TruncateColumnCount: sj.TruncateColumnCount,
+	}
+	return json.Marshal(marshalSemiJoin)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (sj *SemiJoin) RouteType() string {
+	return sj.Opcode.String()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (sj *SemiJoin) GetKeyspaceName() string {
+	if sj.Left.GetKeyspaceName() == sj.Right.GetKeyspaceName() {
+		return sj.Left.GetKeyspaceName()
+	}
+	return sj.Left.GetKeyspaceName() + "_" + sj.Right.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (sj *SemiJoin) GetTableName() string {
+	return sj.Left.GetTableName() + "_" + sj.Right.GetTableName()
+}
+
+// Execute performs a non-streaming exec.
+// The fields of the LHS are always requested
+// because they tell how the keys must be compared.
+func (sj *SemiJoin) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	lresult, err := sj.Left.Execute(vcursor, bindVars, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(lresult.Rows) > vcursor.MaxMemoryRows() {
+		return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+	}
+	result, err := sj.filter(vcursor, bindVars, lresult.Fields, lresult.Rows)
+	if err != nil {
+		return nil, err
+	}
+	if wantfields {
+		result.Fields = lresult.Fields
+	}
+	return result.Truncate(sj.TruncateColumnCount), nil
+}
+
+// StreamExecute performs a streaming exec.
+// Every packet received from the LHS is
+// sent to the RHS as separate batches.
+// Packets are split in parts of at most max_memory_rows
+// rows, so that any number of LHS rows can be joined.
+func (sj *SemiJoin) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var lfields []*querypb.Field
+	return sj.Left.StreamExecute(vcursor, bindVars, true, func(lresult *sqltypes.Result) error {
+		if lresult.Fields != nil {
+			lfields = lresult.Fields
+		}
+		lrows := lresult.Rows
+		for {
+			part := lrows
+			if max := vcursor.MaxMemoryRows(); max > 0 && len(part) > max {
+				part = part[:max]
+			}
+			lrows = lrows[len(part):]
+			result, err := sj.filter(vcursor, bindVars, lfields, part)
+			if err != nil {
+				return err
+			}
+			if wantfields && lresult.Fields != nil {
+				wantfields = false
+				result.Fields = lresult.Fields
+			}
+			if result.Fields != nil || len(result.Rows) != 0 {
+				if err := callback(result.Truncate(sj.TruncateColumnCount)); err != nil {
+					return err
+				}
+			}
+			if len(lrows) == 0 {
+				return nil
+			}
+		}
+	})
+}
+
+// GetFields fetches the field info.
+func (sj *SemiJoin) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := sj.Left.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return qr.Truncate(sj.TruncateColumnCount), nil
+}
+
+// Inputs returns the input primitives for this join
+func (sj *SemiJoin) Inputs() []Primitive {
+	return []Primitive{sj.Left, sj.Right}
+}
+
+// filter returns the LHS rows that satisfy the join.
+// The fields of the result are not set.
+func (sj *SemiJoin) filter(vcursor VCursor, bindVars map[string]*querypb.BindVariable, lfields []*querypb.Field, lrows [][]sqltypes.Value) (*sqltypes.Result, error) {
+	matches, err := sj.findMatches(vcursor, bindVars, lfields, lrows)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{}
+	for i, lrow := range lrows {
+		if matches[i] == (sj.Opcode == SemiJoinExists) {
+			result.Rows = append(result.Rows, lrow)
+		}
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	return result, nil
+}
+
+// findMatches executes the RHS for the LHS rows and returns,
+// for each of them, whether a matching RHS row was found.
+// The distinct values of the rows are sent to the RHS in batches
+// of at most semiJoinBatchSize values. Rows with a NULL value
+// cannot match anything. If none of the rows have a value, the
+// RHS is not executed. The keys are compared like mysql does,
+// based on the fields of both sides.
+func (sj *SemiJoin) findMatches(vcursor VCursor, bindVars map[string]*querypb.BindVariable, lfields []*querypb.Field, lrows [][]sqltypes.Value) ([]bool, error) {
+	matches := make([]bool, len(lrows))
+	var values []*querypb.Value
+	seen := make(map[string]bool)
+	for _, lrow := range lrows {
+		// The values are only deduplicated byte-wise.
+		// The RHS is the one that compares them.
+		key, ok := joinKey(lrow, []int{sj.LHSValue})
+		if !ok || seen[key] {
+			continue
+		}
+		seen[key] = true
+		values = append(values, sqltypes.ValueToProto(lrow[sj.LHSValue]))
+	}
+	if len(values) == 0 {
+		return matches, nil
+	}
+
+	var kc *keyComparison
+	found := make(map[string]bool)
+	for start := 0; start < len(values); start += semiJoinBatchSize {
+		end := start + semiJoinBatchSize
+		if end > len(values) {
+			end = len(values)
+		}
+		list := &querypb.BindVariable{Type: querypb.Type_TUPLE, Values: values[start:end]}
+		rresult, err := sj.Right.Execute(vcursor, combineVars(bindVars, map[string]*querypb.BindVariable{sj.ListVar: list}), kc == nil)
+		if err != nil {
+			return nil, err
+		}
+		if kc == nil {
+			kc = newKeyComparison(lfields, rresult.Fields, []int{sj.LHSKey}, []int{sj.RHSKey})
+			// Only the keys of the LHS rows are tracked, so found
+			// can't be larger than lrows, whatever the RHS returns.
+			for _, lrow := range lrows {
+				if key, ok := kc.key(lrow, []int{sj.LHSKey}); ok {
+					found[key] = false
+				}
+			}
+		}
+		for _, rrow := range rresult.Rows {
+			key, ok := kc.key(rrow, []int{sj.RHSKey})
+			if !ok {
+				continue
+			}
+			if _, ok := found[key]; ok {
+				found[key] = true
+			}
+		}
+	}
+	for i, lrow := range lrows {
+		if key, ok := kc.key(lrow, []int{sj.LHSKey}); ok {
+			matches[i] = found[key]
+		}
+	}
+	return matches, nil
+}
+
+// SemiJoinOpcode is a number representing the opcode
+// for the SemiJoin primitive.
+type SemiJoinOpcode int
+
+// This is the list of SemiJoinOpcode values.
+const (
+	// SemiJoinExists returns the LHS rows that have a match.
+	SemiJoinExists = SemiJoinOpcode(iota)
+	// SemiJoinNotExists returns the LHS rows that have no match.
+	SemiJoinNotExists
+)
+
+var semiJoinName = map[SemiJoinOpcode]string{
+	SemiJoinExists:    "SemiJoin",
+	SemiJoinNotExists: "AntiJoin",
+}
+
+func (code SemiJoinOpcode) String() string {
+	return semiJoinName[code]
+}
+
+// MarshalJSON serializes the SemiJoinOpcode as a JSON string.
+// It's used for testing and diagnostics.
+func (code SemiJoinOpcode) MarshalJSON() ([]byte, error) {
+	return ([]byte)(fmt.Sprintf("\"%s\"", code.String())), nil
+}
@@ -0,0 +1,584 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestSemiJoinExecute(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"varchar|int64",
+				),
+				"a|1",
+				"b|2",
+				"c|null",
+				"d|3",
+				"e|1",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3",
+					"int64",
+				),
+				"1",
+				"3",
+				"1",
+			),
+		},
+	}
+	sj := &SemiJoin{
+		Opcode:              SemiJoinExists,
+		Left:                leftPrim,
+		Right:               rightPrim,
+		ListVar:             "__sq1",
+		LHSValue:            1,
+		LHSKey:              1,
+		RHSKey:              0,
+		TruncateColumnCount: 1,
+	}
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1",
+			"varchar",
+		),
+		"a",
+		"d",
+		"e",
+	)
+
+	r, err := sj.Execute(noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	leftPrim.ExpectLog(t, []string{
+		`Execute  true`,
+	})
+	rightPrim.ExpectLog(t, []string{
+		`Execute __sq1: type:TUPLE values:<type:INT64 value:"1" > values:<type:INT64 value:"2" > values:<type:INT64 value:"3" >  true`,
+	})
+	expectResult(t, "sj.Execute", r, want)
+
+	// The fields of the LHS are still requested because
+	// they tell how the keys must be compared.
+	leftPrim.rewind()
+	rightPrim.rewind()
+	r, err = sj.Execute(noopVCursor{}, nil, false)
+	require.NoError(t, err)
+	leftPrim.ExpectLog(t, []string{
+		`Execute  true`,
+	})
+	expectResult(t, "sj.Execute", r, &sqltypes.Result{Rows: want.Rows, RowsAffected: want.RowsAffected})
+
+	leftPrim.rewind()
+	rightPrim.rewind()
+	r, err = sj.GetFields(noopVCursor{}, nil)
+	require.NoError(t, err)
+	rightPrim.ExpectLog(t, nil)
+	expectResult(t, "sj.GetFields", &sqltypes.Result{Fields: r.Fields}, &sqltypes.Result{Fields: want.Fields})
+}
+
+func TestSemiJoinExecuteNotExists(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"varchar|int64",
+				),
+				"a|1",
+				"b|2",
+				"c|null",
+				"d|3",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3",
+					"int64",
+				),
+				"1",
+				"3",
+			),
+		},
+	}
+	sj := &SemiJoin{
+		Opcode:   SemiJoinNotExists,
+		Left:     leftPrim,
+		Right:    rightPrim,
+		ListVar:  "__sq1",
+		LHSValue: 1,
+		LHSKey:   1,
+		RHSKey:   0,
+	}
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2",
+			"varchar|int64",
+		),
+		"b|2",
+		"c|null",
+	)
+
+	r, err := sj.Execute(noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	expectResult(t, "sj.Execute", r, want)
+}
+
+func TestSemiJoinStreamExecute(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"varchar|int64",
+				),
+				"a|1",
+				"b|2",
+				"c|3",
+				"d|4",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3",
+					"int64",
+				),
+				"2",
+			),
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3",
+					"int64",
+				),
+				"3",
+			),
+		},
+	}
+	sj := &SemiJoin{
+		Opcode:   SemiJoinExists,
+		Left:     leftPrim,
+		Right:    rightPrim,
+		ListVar:  "__sq1",
+		LHSValue: 1,
+		LHSKey:   1,
+		RHSKey:   0,
+	}
+	fields := sqltypes.MakeTestFields(
+		"col1|col2",
+		"varchar|int64",
+	)
+
+	var results []*sqltypes.Result
+	err := sj.StreamExecute(noopVCursor{}, nil, true, func(r *sqltypes.Result) error {
+		results = append(results, r)
+		return nil
+	})
+	require.NoError(t, err)
+	leftPrim.ExpectLog(t, []string{
+		`StreamExecute  true`,
+	})
+	// The fakePrimitive streams two rows at a time.
+	// Each packet is sent to the RHS as a separate batch.
+	rightPrim.ExpectLog(t, []string{
+		`Execute __sq1: type:TUPLE values:<type:INT64 value:"1" > values:<type:INT64 value:"2" >  true`,
+		`Execute __sq1: type:TUPLE values:<type:INT64 value:"3" > values:<type:INT64 value:"4" >  true`,
+	})
+	wantResults := sqltypes.MakeTestStreamingResults(
+		fields,
+		"b|2",
+		"---",
+		"c|3",
+	)
+	require.Len(t, results, len(wantResults))
+	for i := range results {
+		wantResults[i].RowsAffected = uint64(len(wantResults[i].Rows))
+		expectResult(t, "sj.StreamExecute", results[i], wantResults[i])
+	}
+
+	// The fields of the LHS are still requested,
+	// but they're not sent.
+	leftPrim.rewind()
+	rightPrim.rewind()
+	r, err := wrapStreamExecute(sj, noopVCursor{}, nil, false)
+	require.NoError(t, err)
+	leftPrim.ExpectLog(t, []string{
+		`StreamExecute  true`,
+	})
+	want := sqltypes.MakeTestResult(
+		fields,
+		"b|2",
+		"c|3",
+	)
+	want.Fields = nil
+	expectResult(t, "sj.StreamExecute", r, want)
+}
+
+func TestSemiJoinExecuteBatches(t *testing.T) {
+	save := semiJoinBatchSize
+	semiJoinBatchSize = 2
+	defer func() { semiJoinBatchSize = save }()
+
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"varchar|int64",
+				),
+				"a|1",
+				"b|2",
+				"c|1",
+				"d|3",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3",
+					"int64",
+				),
+				"1",
+			),
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3",
+					"int64",
+				),
+				"3",
+			),
+		},
+	}
+	sj := &SemiJoin{
+		Opcode:   SemiJoinExists,
+		Left:     leftPrim,
+		Right:    rightPrim,
+		ListVar:  "__sq1",
+		LHSValue: 1,
+		LHSKey:   1,
+		RHSKey:   0,
+	}
+
+	r, err := sj.Execute(noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	// The three distinct values are sent in two batches.
+	rightPrim.ExpectLog(t, []string{
+		`Execute __sq1: type:TUPLE values:<type:INT64 value:"1" > values:<type:INT64 value:"2" >  true`,
+		`Execute __sq1: type:TUPLE values:<type:INT64 value:"3" >  false`,
+	})
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2",
+			"varchar|int64",
+		),
+		"a|1",
+		"c|1",
+		"d|3",
+	))
+}
+
+func TestSemiJoinExecuteCollation(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|name",
+					"int64|varchar",
+				),
+				"1|abc",
+				"2|Def",
+				"3|ghi",
+			),
+		},
+	}
+	rightFields := sqltypes.MakeTestFields(
+		"name",
+		"varchar",
+	)
+	rresult := sqltypes.MakeTestResult(
+		rightFields,
+		"ABC",
+	)
+	// MakeTestResult trims spaces.
+	rresult.Rows = append(rresult.Rows, []sqltypes.Value{sqltypes.NewVarChar("def ")})
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{rresult},
+	}
+	sj := &SemiJoin{
+		Opcode:   SemiJoinExists,
+		Left:     leftPrim,
+		Right:    rightPrim,
+		ListVar:  "__sq1",
+		LHSValue: 1,
+		LHSKey:   1,
+		RHSKey:   0,
+	}
+
+	// The default collation is case insensitive
+	// and ignores trailing spaces.
+	r, err := sj.Execute(noopVCursor{}, nil, false)
+	require.NoError(t, err)
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|name",
+			"int64|varchar",
+		),
+		"1|abc",
+		"2|Def",
+	)
+	want.Fields = nil
+	expectResult(t, "sj.Execute", r, want)
+
+	// A binary collation on either side makes the comparison exact.
+	rightFields[0].Flags = uint32(querypb.MySqlFlag_BINARY_FLAG)
+	leftPrim.rewind()
+	rightPrim.rewind()
+	r, err = sj.Execute(noopVCursor{}, nil, false)
+	require.NoError(t, err)
+	expectResult(t, "sj.Execute", r, &sqltypes.Result{})
+}
+
+func TestSemiJoinExecuteNumericKeys(t *testing.T) {
+	// Like mysql, a number and a string are compared as numbers.
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"id|val",
+					"int64|varchar",
+				),
+				"1|a",
+				"2|b",
+				"3|c",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"val",
+					"varchar",
+				),
+				"01",
+				"3.0",
+			),
+		},
+	}
+	sj := &SemiJoin{
+		Opcode:   SemiJoinExists,
+		Left:     leftPrim,
+		Right:    rightPrim,
+		ListVar:  "__sq1",
+		LHSValue: 0,
+		LHSKey:   0,
+		RHSKey:   0,
+	}
+
+	r, err := sj.Execute(noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|val",
+			"int64|varchar",
+		),
+		"1|a",
+		"3|c",
+	))
+}
+
+func TestSemiJoinExecuteNoLHSValues(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"varchar|int64",
+				),
+				"a|null",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col3",
+					"int64",
+				),
+				"1",
+			),
+		},
+	}
+	sj := &SemiJoin{
+		Opcode:   SemiJoinExists,
+		Left:     leftPrim,
+		Right:    rightPrim,
+		ListVar:  "__sq1",
+		LHSValue: 1,
+		LHSKey:   1,
+		RHSKey:   0,
+	}
+
+	r, err := sj.Execute(noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	rightPrim.ExpectLog(t, nil)
+	expectResult(t, "sj.Execute", r, &sqltypes.Result{
+		Fields: sqltypes.MakeTestFields(
+			"col1|col2",
+			"varchar|int64",
+		),
+	})
+
+	leftPrim.rewind()
+	sj.Opcode = SemiJoinNotExists
+	r, err = sj.Execute(noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	rightPrim.ExpectLog(t, nil)
+	expectResult(t, "sj.Execute", r, sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2",
+			"varchar|int64",
+		),
+		"a|null",
+	))
+}
+
+func TestSemiJoinExecuteMaxMemoryRows(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 1
+	defer func() { testMaxMemoryRows = save }()
+
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"varchar|int64",
+				),
+				"a|1",
+				"b|2",
+				"c|3",
+			),
+		},
+	}
+	rresult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col3",
+			"int64",
+		),
+		"1",
+		"2",
+		"3",
+	)
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{rresult, rresult, rresult},
+	}
+	sj := &SemiJoin{
+		Opcode:   SemiJoinExists,
+		Left:     leftPrim,
+		Right:    rightPrim,
+		ListVar:  "__sq1",
+		LHSValue: 1,
+		LHSKey:   1,
+		RHSKey:   0,
+	}
+
+	// The LHS rows are held in memory.
+	_, err := sj.Execute(noopVCursor{}, nil, true)
+	expectError(t, "sj.Execute", err, "in-memory row count exceeded allowed limit of 1")
+
+	// When streaming, the packets are split so that
+	// they don't exceed the limit.
+	leftPrim.rewind()
+	r, err := wrapStreamExecute(sj, noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	rightPrim.ExpectLog(t, []string{
+		`Execute __sq1: type:TUPLE values:<type:INT64 value:"1" >  true`,
+		`Execute __sq1: type:TUPLE values:<type:INT64 value:"2" >  true`,
+		`Execute __sq1: type:TUPLE values:<type:INT64 value:"3" >  true`,
+	})
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"col1|col2",
+			"varchar|int64",
+		),
+		"a|1",
+		"b|2",
+		"c|3",
+	)
+	expectResult(t, "sj.StreamExecute", r, want)
+}
+
+func TestSemiJoinExecuteErrors(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			sqltypes.MakeTestResult(
+				sqltypes.MakeTestFields(
+					"col1|col2",
+					"varchar|int64",
+				),
+				"a|1",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		sendErr: errors.New("right err"),
+	}
+	sj := &SemiJoin{
+		Opcode:   SemiJoinExists,
+		Left:     leftPrim,
+		Right:    rightPrim,
+		ListVar:  "__sq1",
+		LHSValue: 1,
+		LHSKey:   1,
+		RHSKey:   0,
+	}
+
+	_, err := sj.Execute(noopVCursor{}, nil, true)
+	expectError(t, "sj.Execute", err, "right err")
+
+	leftPrim.rewind()
+	_, err = wrapStreamExecute(sj, noopVCursor{}, nil, true)
+	expectError(t, "sj.StreamExecute", err, "right err")
+
+	leftPrim.sendErr = errors.New("left err")
+	leftPrim.results = nil
+	_, err = sj.Execute(noopVCursor{}, nil, true)
+	expectError(t, "sj.Execute", err, "left err")
+}
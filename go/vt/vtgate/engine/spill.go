@@ -0,0 +1,320 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// SpillConfig specifies how the rows held in memory by a streaming
+// query are spilled to disk once max_memory_rows is exceeded. The
+// MemorySort, the RHS of a HashJoin and the unordered distinct
+// aggregates of an OrderedAggregate spill. Non-streaming queries
+// never spill: they keep failing when the limit is exceeded.
+type SpillConfig struct {
+	// Dir is the directory of the temporary files.
+	// Spilling is disabled if it's empty.
+	Dir string
+	// MaxBytes is the number of bytes a primitive of
+	// a query can spill. There's no limit if it's 0.
+	MaxBytes int64
+	// MaxTotalBytes is the number of bytes all the queries
+	// together can spill. There's no limit if it's 0.
+	MaxTotalBytes int64
+}
+
+// Enabled returns true if rows can be spilled to disk.
+func (config SpillConfig) Enabled() bool {
+	return config.Dir != ""
+}
+
+// spillFilePrefix is the prefix of the names of the spill files.
+const spillFilePrefix = "vtgate-spill-"
+
+// RemoveSpillFiles removes the spill files in dir. They're left
+// behind if vtgate doesn't exit cleanly. So, it must be called at
+// startup, before any query can spill, and dir must not be shared
+// with another vtgate.
+func RemoveSpillFiles(dir string) error {
+	names, err := filepath.Glob(filepath.Join(dir, spillFilePrefix+"*"))
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := os.Remove(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var (
+	spillBytesInUse sync2.AtomicInt64
+
+	spillQueries        = stats.NewCounter("SpillQueries", "Count of primitives of queries that spilled rows to disk")
+	spillBytes          = stats.NewCounter("SpillBytes", "Count of bytes spilled to disk")
+	spillBudgetExceeded = stats.NewCounter("SpillBudgetExceeded", "Count of queries that failed because they exceeded the spill disk budget")
+	_                   = stats.NewGaugeFunc("SpillBytesInUse", "Bytes of spill files that are currently on disk", spillBytesInUse.Get)
+)
+
+// spillFile stores runs of rows in a temporary file. Sorted
+// runs are merged back in order by merge, and a run can be read
+// back on its own by readRun. The file is created by the first
+// run, and removed by close.
+type spillFile struct {
+	config SpillConfig
+	file   *os.File
+	w      *bufio.Writer
+	size   int64
+	runs   []spillRun
+	buf    []byte
+}
+
+// spillRun is the section of the file that contains a run.
+type spillRun struct {
+	offset, size int64
+}
+
+func newSpillFile(config SpillConfig) *spillFile {
+	return &spillFile{config: config}
+}
+
+// writeRun writes the rows as a new run. They must be
+// sorted if the runs are merged.
+func (sf *spillFile) writeRun(rows [][]sqltypes.Value) error {
+	if sf.file == nil {
+		file, err := ioutil.TempFile(sf.config.Dir, spillFilePrefix)
+		if err != nil {
+			return err
+		}
+		sf.file = file
+		sf.w = bufio.NewWriter(file)
+		spillQueries.Add(1)
+	}
+	run := spillRun{offset: sf.size}
+	for _, row := range rows {
+		sf.buf = encodeRow(sf.buf[:0], row)
+		if err := sf.reserve(int64(len(sf.buf))); err != nil {
+			return err
+		}
+		if _, err := sf.w.Write(sf.buf); err != nil {
+			return err
+		}
+	}
+	if err := sf.w.Flush(); err != nil {
+		return err
+	}
+	run.size = sf.size - run.offset
+	sf.runs = append(sf.runs, run)
+	return nil
+}
+
+// reserve accounts for n more bytes in the file. It returns
+// an error if the disk budget of the query or the total disk
+// budget is exceeded.
+func (sf *spillFile) reserve(n int64) error {
+	if sf.config.MaxBytes != 0 && sf.size+n > sf.config.MaxBytes {
+		spillBudgetExceeded.Add(1)
+		return fmt.Errorf("spilled row size exceeded allowed limit of %d bytes", sf.config.MaxBytes)
+	}
+	if total := spillBytesInUse.Add(n); sf.config.MaxTotalBytes != 0 && total > sf.config.MaxTotalBytes {
+		spillBytesInUse.Add(-n)
+		spillBudgetExceeded.Add(1)
+		return fmt.Errorf("total spilled row size exceeded allowed limit of %d bytes", sf.config.MaxTotalBytes)
+	}
+	sf.size += n
+	spillBytes.Add(n)
+	return nil
+}
+
+// merge merges the runs and the rows, which must also be sorted,
+// using the orderBy criteria. The callback is called for every
+// row in order, until count rows have been sent.
+func (sf *spillFile) merge(rows [][]sqltypes.Value, orderBy []OrderbyParams, count int, callback func([]sqltypes.Value) error) error {
+	readers := make([]*bufio.Reader, len(sf.runs))
+	for i, run := range sf.runs {
+		readers[i] = bufio.NewReader(io.NewSectionReader(sf.file, run.offset, run.size))
+	}
+	// The rows in memory are the last source.
+	next := func(id int) ([]sqltypes.Value, error) {
+		if id < len(readers) {
+			return decodeRow(readers[id])
+		}
+		if len(rows) == 0 {
+			return nil, io.EOF
+		}
+		row := rows[0]
+		rows = rows[1:]
+		return row, nil
+	}
+
+	sh := &scatterHeap{orderBy: orderBy}
+	for id := 0; id <= len(readers); id++ {
+		row, err := next(id)
+		if err == io.EOF {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		sh.rows = append(sh.rows, streamRow{row: row, id: id})
+	}
+	heap.Init(sh)
+	if sh.err != nil {
+		return sh.err
+	}
+	for sent := 0; sent < count && len(sh.rows) != 0; sent++ {
+		sr := heap.Pop(sh).(streamRow)
+		if sh.err != nil {
+			return sh.err
+		}
+		if err := callback(sr.row); err != nil {
+			return err
+		}
+		row, err := next(sr.id)
+		if err == io.EOF {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		sr.row = row
+		heap.Push(sh, sr)
+		if sh.err != nil {
+			return sh.err
+		}
+	}
+	return nil
+}
+
+// readRun returns the rows of the i'th run.
+func (sf *spillFile) readRun(i int) ([][]sqltypes.Value, error) {
+	r := bufio.NewReader(io.NewSectionReader(sf.file, sf.runs[i].offset, sf.runs[i].size))
+	var rows [][]sqltypes.Value
+	for {
+		row, err := decodeRow(r)
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		rows = append(rows, row)
+	}
+}
+
+// reset removes the runs, so that the file can be reused.
+func (sf *spillFile) reset() error {
+	if sf.file == nil {
+		return nil
+	}
+	if err := sf.file.Truncate(0); err != nil {
+		return err
+	}
+	if _, err := sf.file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	spillBytesInUse.Add(-sf.size)
+	sf.size = 0
+	sf.runs = nil
+	return nil
+}
+
+// close removes the file.
+func (sf *spillFile) close() {
+	if sf.file == nil {
+		return
+	}
+	sf.file.Close()
+	os.Remove(sf.file.Name())
+	spillBytesInUse.Add(-sf.size)
+	sf.file = nil
+}
+
+// encodeRow appends the encoded row to buf. The row is encoded as
+// its number of values followed by each value. A value is encoded
+// as its type, followed by its length and bytes if it's not NULL.
+func encodeRow(buf []byte, row []sqltypes.Value) []byte {
+	var scratch [binary.MaxVarintLen64]byte
+	put := func(x uint64) {
+		n := binary.PutUvarint(scratch[:], x)
+		buf = append(buf, scratch[:n]...)
+	}
+	put(uint64(len(row)))
+	for _, val := range row {
+		put(uint64(val.Type()))
+		if val.IsNull() {
+			continue
+		}
+		raw := val.Raw()
+		put(uint64(len(raw)))
+		buf = append(buf, raw...)
+	}
+	return buf
+}
+
+// decodeRow reads the next row encoded by encodeRow.
+// It returns io.EOF if there are no more rows.
+func decodeRow(r *bufio.Reader) ([]sqltypes.Value, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	row := make([]sqltypes.Value, n)
+	for i := range row {
+		typ, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		if querypb.Type(typ) == sqltypes.Null {
+			row[i] = sqltypes.NULL
+			continue
+		}
+		size, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		raw := make([]byte, size)
+		if _, err := io.ReadFull(r, raw); err != nil {
+			return nil, unexpectedEOF(err)
+		}
+		row[i] = sqltypes.MakeTrusted(querypb.Type(typ), raw)
+	}
+	return row, nil
+}
+
+// unexpectedEOF converts io.EOF to io.ErrUnexpectedEOF
+// for the errors that happen in the middle of a row.
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
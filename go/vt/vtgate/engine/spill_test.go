@@ -0,0 +1,96 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestSpillRowEncoding(t *testing.T) {
+	rows := [][]sqltypes.Value{
+		{sqltypes.NewInt64(1), sqltypes.NewVarChar("abc"), sqltypes.NULL},
+		{},
+		{sqltypes.NewVarBinary(""), sqltypes.NewFloat64(1.5)},
+	}
+	var buf []byte
+	for _, row := range rows {
+		buf = encodeRow(buf, row)
+	}
+
+	r := bufio.NewReader(bytes.NewReader(buf))
+	for _, want := range rows {
+		got, err := decodeRow(r)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+	_, err := decodeRow(r)
+	require.Equal(t, io.EOF, err)
+
+	_, err = decodeRow(bufio.NewReader(bytes.NewReader(buf[:3])))
+	require.Equal(t, io.ErrUnexpectedEOF, err)
+}
+
+func TestSpillFileTotalBudget(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spill")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	require.Zero(t, spillBytesInUse.Get())
+	config := SpillConfig{Dir: dir, MaxTotalBytes: 20}
+	sf1 := newSpillFile(config)
+	defer sf1.close()
+	sf2 := newSpillFile(config)
+	defer sf2.close()
+
+	row := []sqltypes.Value{sqltypes.NewVarChar("0123456789")}
+	require.NoError(t, sf1.writeRun([][]sqltypes.Value{row}))
+	err = sf2.writeRun([][]sqltypes.Value{row})
+	expectError(t, "sf2.writeRun", err, "total spilled row size exceeded allowed limit of 20 bytes")
+
+	// The budget is released when a file is closed.
+	sf1.close()
+	require.NoError(t, sf2.writeRun([][]sqltypes.Value{row}))
+}
+
+func TestRemoveSpillFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "spill")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	sf := newSpillFile(SpillConfig{Dir: dir})
+	defer sf.close()
+	require.NoError(t, sf.writeRun([][]sqltypes.Value{{sqltypes.NewInt64(1)}}))
+	// The file is left behind, as if vtgate didn't exit cleanly.
+	sf.file.Close()
+	other := filepath.Join(dir, "other")
+	require.NoError(t, ioutil.WriteFile(other, nil, 0600))
+
+	require.NoError(t, RemoveSpillFiles(dir))
+	names, err := filepath.Glob(filepath.Join(dir, "*"))
+	require.NoError(t, err)
+	require.Equal(t, []string{other}, names)
+}
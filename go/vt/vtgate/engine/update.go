@@ -18,12 +18,14 @@ package engine
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"vitess.io/vitess/go/jsonutil"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/sqlannotation"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
@@ -44,8 +46,21 @@ type Update struct {
 	// ChangedVindexValues contains values for updated Vindexes during an update statement.
 	ChangedVindexValues map[string]VindexValues
 
-	// Update does not take inputs
-	noInputs
+	// MoveRows is set if the update changes the primary vindex
+	// columns. The rows are then moved to the shards of their new
+	// keyspace ids instead of being updated in place.
+	MoveRows *MoveRows
+}
+
+// MoveRows contains the instructions to move the rows of an update
+// that changes the primary vindex columns. The OwnedVindexQuery of
+// the update selects all the columns of the rows. They're deleted
+// from their current shards, and re-inserted with their new values.
+type MoveRows struct {
+	// DeleteQuery deletes the rows from their current shards.
+	DeleteQuery string
+	// Values contains the new values of all the updated columns.
+	Values map[string]sqltypes.PlanValue
 }
 
 // MarshalJSON serializes the Update into a JSON representation.
@@ -73,6 +88,8 @@ func (upd *Update) MarshalJSON() ([]byte, error) {
 		KsidVindex           string                  `json:",omitempty"`
 		MultiShardAutocommit bool                    `json:",omitempty"`
 		QueryTimeout         int                     `json:",omitempty"`
+		Input                Primitive               `json:",omitempty"`
+		MoveRows             *MoveRows               `json:",omitempty"`
 	}{
 		Opcode:               upd.RouteType(),
 		Keyspace:             upd.Keyspace,
@@ -85,6 +102,8 @@ func (upd *Update) MarshalJSON() ([]byte, error) {
 		KsidVindex:           ksidVindexName,
 		MultiShardAutocommit: upd.MultiShardAutocommit,
 		QueryTimeout:         upd.QueryTimeout,
+		Input:                upd.Input,
+		MoveRows:             upd.MoveRows,
 	}
 	return jsonutil.MarshalNoEscape(marshalUpdate)
 }
@@ -94,6 +113,8 @@ var updName = map[DMLOpcode]string{
 	Equal:         "UpdateEqual",
 	Scatter:       "UpdateScatter",
 	ByDestination: "UpdateByDestination",
+	In:            "UpdateIn",
+	ScatterLimit:  "UpdateScatterLimit",
 }
 
 // RouteType returns a description of the query routing type used by the primitive
@@ -130,6 +151,10 @@ func (upd *Update) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 		return upd.execUpdateByDestination(vcursor, bindVars, key.DestinationAllShards{})
 	case ByDestination:
 		return upd.execUpdateByDestination(vcursor, bindVars, upd.TargetDestination)
+	case In:
+		return upd.execUpdateIn(vcursor, bindVars)
+	case ScatterLimit:
+		return upd.execUpdateScatterLimit(vcursor, bindVars)
 	default:
 		// Unreachable.
 		return nil, fmt.Errorf("unsupported opcode: %v", upd)
@@ -146,6 +171,14 @@ func (upd *Update) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindV
 	return nil, fmt.Errorf("BUG: unreachable code for %q", upd.Query)
 }
 
+// Inputs returns the input primitives for this update.
+func (upd *Update) Inputs() []Primitive {
+	if upd.Input == nil {
+		return nil
+	}
+	return []Primitive{upd.Input}
+}
+
 func (upd *Update) execUpdateUnsharded(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
 	rss, _, err := vcursor.ResolveDestinations(upd.Keyspace.Name, nil, []key.Destination{key.DestinationAllShards{}})
 	if err != nil {
@@ -169,8 +202,11 @@ func (upd *Update) execUpdateEqual(vcursor VCursor, bindVars map[string]*querypb
 	if len(ksid) == 0 {
 		return &sqltypes.Result{}, nil
 	}
+	if upd.MoveRows != nil {
+		return upd.moveRows(vcursor, bindVars, []*srvtopo.ResolvedShard{rs}, sameVars(bindVars, 1))
+	}
 	if len(upd.ChangedVindexValues) != 0 {
-		if err := upd.updateVindexEntries(vcursor, bindVars, []*srvtopo.ResolvedShard{rs}); err != nil {
+		if err := upd.updateVindexEntries(vcursor, bindVars, []*srvtopo.ResolvedShard{rs}, sameVars(bindVars, 1)); err != nil {
 			return nil, vterrors.Wrap(err, "execUpdateEqual")
 		}
 	}
@@ -184,10 +220,11 @@ func (upd *Update) execUpdateEqual(vcursor VCursor, bindVars map[string]*querypb
 // for DMLs to reuse existing transactions.
 // Note 2: While changes are being committed, the changing row could be
 // unreachable by either the new or old column values.
-func (upd *Update) updateVindexEntries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard) error {
+// The OwnedVindexQuery is sent to each shard with its shardVars.
+func (upd *Update) updateVindexEntries(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard, shardVars []map[string]*querypb.BindVariable) error {
 	queries := make([]*querypb.BoundQuery, len(rss))
 	for i := range rss {
-		queries[i] = &querypb.BoundQuery{Sql: upd.OwnedVindexQuery, BindVariables: bindVars}
+		queries[i] = &querypb.BoundQuery{Sql: upd.OwnedVindexQuery, BindVariables: shardVars[i]}
 	}
 	subQueryResult, errors := vcursor.ExecuteMultiShard(rss, queries, false, false)
 	for _, err := range errors {
@@ -245,20 +282,49 @@ func (upd *Update) execUpdateByDestination(vcursor VCursor, bindVars map[string]
 	if err != nil {
 		return nil, vterrors.Wrap(err, "execUpdateByDestination")
 	}
+	return upd.execUpdateMultiShard(vcursor, bindVars, rss, sameVars(bindVars, len(rss)))
+}
+
+func (upd *Update) execUpdateIn(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	rss, err := upd.resolveShardsIn(vcursor, bindVars)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execUpdateIn")
+	}
+	if len(rss) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+	return upd.execUpdateMultiShard(vcursor, bindVars, rss, sameVars(bindVars, len(rss)))
+}
+
+func (upd *Update) execUpdateScatterLimit(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	rss, shardVars, err := upd.resolveShardsLimit(vcursor, bindVars)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execUpdateScatterLimit")
+	}
+	if len(rss) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+	return upd.execUpdateMultiShard(vcursor, bindVars, rss, shardVars)
+}
+
+func (upd *Update) execUpdateMultiShard(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard, shardVars []map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	if upd.MoveRows != nil {
+		return upd.moveRows(vcursor, bindVars, rss, shardVars)
+	}
 
 	queries := make([]*querypb.BoundQuery, len(rss))
 	sql := sqlannotation.AnnotateIfDML(upd.Query, nil)
 	for i := range rss {
 		queries[i] = &querypb.BoundQuery{
 			Sql:           sql,
-			BindVariables: bindVars,
+			BindVariables: shardVars[i],
 		}
 	}
 
 	// update any owned vindexes
 	if len(upd.ChangedVindexValues) != 0 {
-		if err := upd.updateVindexEntries(vcursor, bindVars, rss); err != nil {
-			return nil, vterrors.Wrap(err, "execUpdateByDestination")
+		if err := upd.updateVindexEntries(vcursor, bindVars, rss, shardVars); err != nil {
+			return nil, vterrors.Wrap(err, "execUpdateMultiShard")
 		}
 	}
 
@@ -266,3 +332,109 @@ func (upd *Update) execUpdateByDestination(vcursor VCursor, bindVars map[string]
 	result, errs := vcursor.ExecuteMultiShard(rss, queries, true /* isDML */, autocommit)
 	return result, vterrors.Aggregate(errs)
 }
+
+// moveRows performs an update that changes the primary vindex columns.
+// The rows are selected with all their columns, and deleted along with
+// their lookup vindex entries. They're then re-inserted with their new
+// values, which sends them to their new shards and creates their new
+// lookup vindex entries. All of this requires a transaction that can
+// span multiple shards.
+func (upd *Update) moveRows(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rss []*srvtopo.ResolvedShard, shardVars []map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	if !vcursor.InMultiShardTransaction() {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "changing the primary vindex of %s requires a transaction that can span multiple shards", upd.GetTableName())
+	}
+	queries := make([]*querypb.BoundQuery, len(rss))
+	for i := range rss {
+		queries[i] = &querypb.BoundQuery{Sql: upd.OwnedVindexQuery, BindVariables: shardVars[i]}
+	}
+	selected, errs := vcursor.ExecuteMultiShard(rss, queries, false, false)
+	if errs != nil {
+		return nil, vterrors.Wrap(vterrors.Aggregate(errs), "moveRows")
+	}
+	if len(selected.Rows) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+
+	fieldColNumMap := make(map[string]int)
+	columns := make(sqlparser.Columns, len(selected.Fields))
+	for colNum, field := range selected.Fields {
+		fieldColNumMap[strings.ToLower(field.Name)] = colNum
+		columns[colNum] = sqlparser.NewColIdent(field.Name)
+	}
+	newValues := make(map[int]sqltypes.Value)
+	for col, pv := range upd.MoveRows.Values {
+		colNum, ok := fieldColNumMap[strings.ToLower(col)]
+		if !ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unknown column '%s' in update of %s", col, upd.GetTableName())
+		}
+		val, err := pv.ResolveValue(bindVars)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "moveRows")
+		}
+		newValues[colNum] = val
+	}
+	vindexValueOffset := make([][]int, len(upd.Table.ColumnVindexes))
+	for vIdx, colVindex := range upd.Table.ColumnVindexes {
+		for _, col := range colVindex.Columns {
+			colNum, ok := fieldColNumMap[col.Lowered()]
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: vindex column %v of %s was not selected", col, upd.GetTableName())
+			}
+			vindexValueOffset[vIdx] = append(vindexValueOffset[vIdx], colNum)
+		}
+	}
+
+	rows := make([][]sqltypes.Value, 0, len(selected.Rows))
+	for _, row := range selected.Rows {
+		ksid, err := resolveKeyspaceID(vcursor, upd.KsidVindex, row[vindexValueOffset[0][0]])
+		if err != nil {
+			return nil, vterrors.Wrap(err, "moveRows")
+		}
+		for vIdx, colVindex := range upd.Table.ColumnVindexes {
+			if !colVindex.Owned {
+				continue
+			}
+			fromIds := make([]sqltypes.Value, 0, len(colVindex.Columns))
+			for _, colNum := range vindexValueOffset[vIdx] {
+				fromIds = append(fromIds, row[colNum])
+			}
+			if err := colVindex.Vindex.(vindexes.Lookup).Delete(vcursor, [][]sqltypes.Value{fromIds}, ksid); err != nil {
+				return nil, vterrors.Wrap(err, "moveRows")
+			}
+		}
+		newRow := make([]sqltypes.Value, len(row))
+		copy(newRow, row)
+		for colNum, val := range newValues {
+			newRow[colNum] = val
+		}
+		rows = append(rows, newRow)
+	}
+
+	for i := range rss {
+		queries[i] = &querypb.BoundQuery{Sql: upd.MoveRows.DeleteQuery, BindVariables: shardVars[i]}
+	}
+	result, errs := vcursor.ExecuteMultiShard(rss, queries, true /* isDML */, false /* autocommit */)
+	if errs != nil {
+		return nil, vterrors.Wrap(vterrors.Aggregate(errs), "moveRows")
+	}
+
+	// The rows are re-inserted the same way as
+	// the rows returned by an insert with a select.
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("insert into %v%v values ", upd.Table.Name, columns)
+	ins := &Insert{
+		Opcode:            InsertSharded,
+		Keyspace:          upd.Keyspace,
+		Table:             upd.Table,
+		Prefix:            buf.String(),
+		VindexValueOffset: vindexValueOffset,
+	}
+	irss, iqueries, _, err := ins.getInsertSelectRoute(vcursor, bindVars, rows)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "moveRows")
+	}
+	if _, errs := vcursor.ExecuteMultiShard(irss, iqueries, true /* isDML */, false /* autocommit */); errs != nil {
+		return nil, vterrors.Wrap(vterrors.Aggregate(errs), "moveRows")
+	}
+	return &sqltypes.Result{RowsAffected: result.RowsAffected}, nil
+}
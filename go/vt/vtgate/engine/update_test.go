@@ -137,6 +137,85 @@ func TestUpdateScatter(t *testing.T) {
 	})
 }
 
+func TestUpdateIn(t *testing.T) {
+	vindex, _ := vindexes.NewHash("", nil)
+	upd := &Update{
+		DML: DML{
+			Opcode: In,
+			Keyspace: &vindexes.Keyspace{
+				Name:    "ks",
+				Sharded: true,
+			},
+			Query:  "dummy_update",
+			Vindex: vindex.(vindexes.SingleColumn),
+			Values: []sqltypes.PlanValue{{ListKey: "__sq1"}},
+		},
+	}
+	bv := map[string]*querypb.BindVariable{
+		"__sq1": sqltypes.TestBindVariable([]interface{}{1, 2}),
+	}
+
+	vc := &loggingVCursor{shards: []string{"-20", "20-"}}
+	_, err := upd.Execute(vc, bv, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [type:INT64 value:"1"  type:INT64 value:"2" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ks.-20: dummy_update {__sq1: type:TUPLE values:<type:INT64 value:"1" > values:<type:INT64 value:"2" > } true true`,
+	})
+
+	// Failure case
+	_, err = upd.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, "execUpdateIn: missing bind var __sq1")
+}
+
+func TestUpdateScatterLimit(t *testing.T) {
+	vindex, _ := vindexes.NewHash("", nil)
+	input := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id",
+				"int64",
+			),
+			"1",
+			"2",
+		)},
+	}
+	upd := &Update{
+		DML: DML{
+			Opcode: ScatterLimit,
+			Keyspace: &vindexes.Keyspace{
+				Name:    "ks",
+				Sharded: true,
+			},
+			Query:  "dummy_update",
+			Vindex: vindex.(vindexes.SingleColumn),
+			Input:  input,
+		},
+	}
+	bv := map[string]*querypb.BindVariable{
+		"n": sqltypes.Int64BindVariable(2),
+	}
+
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"20-", "20-"},
+	}
+	_, err := upd.Execute(vc, bv, false)
+	require.NoError(t, err)
+	input.ExpectLog(t, []string{
+		`Execute n: type:INT64 value:"2"  false`,
+	})
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [type:INT64 value:"1"  type:INT64 value:"2" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard ks.20-: dummy_update {__dml_limit: type:INT64 value:"2" n: type:INT64 value:"2" } true true`,
+	})
+
+	// Failure case
+	upd.Input = &fakePrimitive{sendErr: errors.New("input err")}
+	_, err = upd.Execute(vc, bv, false)
+	expectError(t, "Execute", err, "execUpdateScatterLimit: input err")
+}
+
 func TestUpdateEqualNoRoute(t *testing.T) {
 	vindex, _ := vindexes.NewLookupUnique("", map[string]string{
 		"table": "lkp",
@@ -404,6 +483,108 @@ func TestUpdateScatterChangedVindex(t *testing.T) {
 
 }
 
+func TestUpdateEqualMoveRows(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	upd := &Update{
+		DML: DML{
+			Opcode:           Equal,
+			Keyspace:         ks.Keyspace,
+			Query:            "dummy_update",
+			Vindex:           ks.Vindexes["hash"].(vindexes.SingleColumn),
+			Values:           []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}},
+			Table:            ks.Tables["t1"],
+			OwnedVindexQuery: "dummy_subquery",
+			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+		},
+		ChangedVindexValues: map[string]VindexValues{
+			"hash": {
+				"id": {Value: sqltypes.NewInt64(2)},
+			},
+		},
+		MoveRows: &MoveRows{
+			DeleteQuery: "dummy_delete",
+			Values: map[string]sqltypes.PlanValue{
+				"id":  {Value: sqltypes.NewInt64(2)},
+				"col": {Value: sqltypes.NewVarChar("b")},
+			},
+		},
+	}
+
+	results := []*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|c1|c2|c3|col",
+				"int64|int64|int64|int64|varchar",
+			),
+			"1|4|5|6|a",
+		),
+		// The results of the lookup vindex deletes.
+		{},
+		{},
+		{RowsAffected: 1},
+	}
+	vc := &loggingVCursor{
+		shards:       []string{"-20", "20-"},
+		shardForKsid: []string{"-20", "20-"},
+		results:      results,
+	}
+
+	result, err := upd.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		// The row is selected with all its columns.
+		`ExecuteMultiShard sharded.-20: dummy_subquery {} false false`,
+		// The lookup vindex entries of the old row are deleted.
+		`Execute delete from lkp2 where from1 = :from1 and from2 = :from2 and toc = :toc from1: type:INT64 value:"4" from2: type:INT64 value:"5" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		`Execute delete from lkp1 where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\026k@\264J\272K\326"  true`,
+		// The old row is deleted from its shard.
+		`ExecuteMultiShard sharded.-20: dummy_delete {} true false`,
+		// The new row maps to a new keyspace id. Its lookup
+		// vindex entries are created, and it's inserted.
+		`Execute insert into lkp2(from1, from2, toc) values(:from10, :from20, :toc0) from10: type:INT64 value:"4" from20: type:INT64 value:"5" toc0: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`Execute insert into lkp1(from, toc) values(:from0, :toc0) from0: type:INT64 value:"6" toc0: type:VARBINARY value:"\006\347\352\"\316\222p\217"  true`,
+		`ResolveDestinations sharded [value:"0" ] Destinations:DestinationKeyspaceID(06e7ea22ce92708f)`,
+		`ExecuteMultiShard sharded.20-: insert into t1(id, c1, c2, c3, col) values (:_id0, :_c10, :_c20, :_c30, :__c0_4) /* vtgate:: keyspace_id:06e7ea22ce92708f */ ` +
+			`{__c0_4: type:VARCHAR value:"b" _c10: type:INT64 value:"4" _c20: type:INT64 value:"5" _c30: type:INT64 value:"6" _id0: type:INT64 value:"2" } true false`,
+	})
+	expectResult(t, "upd.Execute", result, &sqltypes.Result{RowsAffected: 1})
+
+	// No rows changing.
+	vc = &loggingVCursor{
+		shards: []string{"-20", "20-"},
+	}
+	result, err = upd.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ExecuteMultiShard sharded.-20: dummy_subquery {} false false`,
+	})
+	expectResult(t, "upd.Execute", result, &sqltypes.Result{})
+
+	// Failure case: unknown column.
+	upd.MoveRows.Values["c4"] = sqltypes.PlanValue{Value: sqltypes.NewInt64(1)}
+	vc = &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: results,
+	}
+	_, err = upd.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "upd.Execute", err, "unknown column 'c4' in update of t1")
+
+	// Failure case: the transaction cannot span multiple shards.
+	delete(upd.MoveRows.Values, "c4")
+	vc = &loggingVCursor{
+		shards:        []string{"-20", "20-"},
+		results:       results,
+		singleShardTx: true,
+	}
+	_, err = upd.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "upd.Execute", err, "changing the primary vindex of t1 requires a transaction that can span multiple shards")
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+	})
+}
+
 func TestUpdateNoStream(t *testing.T) {
 	upd := &Update{}
 	err := upd.StreamExecute(nil, nil, false, nil)
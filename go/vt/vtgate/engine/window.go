@@ -0,0 +1,336 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*Window)(nil)
+
+// Window is a primitive that evaluates window functions over
+// the rows of its input. The input must be sorted by the
+// PARTITION BY columns followed by the ORDER BY columns of the
+// window. This makes the rows of a partition consecutive, which
+// allows each partition to be evaluated as soon as it's complete.
+// Like Distinct, text values are compared using the collation
+// of their column.
+type Window struct {
+	// PartitionBy contains the input columns of
+	// the PARTITION BY clause of the window.
+	PartitionBy []int
+	// OrderBy contains the input columns of the ORDER BY
+	// clause of the window. Rows with equal values are peers,
+	// which get the same rank.
+	OrderBy []int
+	// Functions are the window functions to evaluate.
+	Functions []*WindowFunction
+	// Cols defines the result columns. A non-negative value
+	// refers to an input column. A negative value -n refers
+	// to the (n-1)th window function.
+	Cols  []int
+	Input Primitive
+}
+
+// WindowFunction specifies a window function to evaluate.
+type WindowFunction struct {
+	Opcode WindowOpcode
+	// Col is the input column that's the argument of LAG and LEAD.
+	Col int `json:",omitempty"`
+	// Offset is the number of rows LAG and LEAD look
+	// behind or ahead in the partition.
+	Offset int `json:",omitempty"`
+	// Default is the value returned by LAG and LEAD if the row
+	// at the offset is outside the partition. It's NULL if unset.
+	Default sqltypes.Value
+	// Alias is the name of the result column.
+	Alias string
+}
+
+// WindowOpcode is the window function Opcode.
+type WindowOpcode int
+
+// These constants list the possible window function opcodes.
+const (
+	WindowRowNumber = WindowOpcode(iota)
+	WindowRank
+	WindowDenseRank
+	WindowLag
+	WindowLead
+)
+
+// SupportedWindowFunctions maps the window functions that
+// can be evaluated by vtgate to their opcodes.
+var SupportedWindowFunctions = map[string]WindowOpcode{
+	"row_number": WindowRowNumber,
+	"rank":       WindowRank,
+	"dense_rank": WindowDenseRank,
+	"lag":        WindowLag,
+	"lead":       WindowLead,
+}
+
+func (code WindowOpcode) String() string {
+	for k, v := range SupportedWindowFunctions {
+		if v == code {
+			return k
+		}
+	}
+	panic("unreachable")
+}
+
+// MarshalJSON serializes the WindowOpcode as a JSON string.
+// It's used for testing and diagnostics.
+func (code WindowOpcode) MarshalJSON() ([]byte, error) {
+	return ([]byte)(fmt.Sprintf("\"%s\"", code.String())), nil
+}
+
+// MarshalJSON serializes the Window into a JSON representation.
+// It's used for testing and diagnostics.
+func (w *Window) MarshalJSON() ([]byte, error) {
+	marshalWindow := struct {
+		Opcode      string
+		PartitionBy []int             `json:",omitempty"`
+		OrderBy     []int             `json:",omitempty"`
+		Functions   []*WindowFunction `json:",omitempty"`
+		Cols        []int
+		Input       Primitive
+	}{
+		Opcode:      "Window",
+		PartitionBy: w.PartitionBy,
+		OrderBy:     w.OrderBy,
+		Functions:   w.Functions,
+		Cols:        w.Cols,
+		Input:       w.Input,
+	}
+	return json.Marshal(marshalWindow)
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (w *Window) RouteType() string {
+	return w.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (w *Window) GetKeyspaceName() string {
+	return w.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (w *Window) GetTableName() string {
+	return w.Input.GetTableName()
+}
+
+// Execute performs a non-streaming exec.
+func (w *Window) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	input, err := w.Input.Execute(vcursor, bindVars, true)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{
+		Rows:         w.evaluate(fieldCollations(input.Fields), input.Rows),
+		RowsAffected: input.RowsAffected,
+	}
+	if wantfields {
+		result.Fields = w.fields(input.Fields)
+	}
+	return result, nil
+}
+
+// StreamExecute performs a streaming exec.
+// The rows of a partition are held in memory until the
+// first row of the next partition is received.
+func (w *Window) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var pending [][]sqltypes.Value
+	var collations []evalengine.Collation
+	err := w.Input.StreamExecute(vcursor, bindVars, true, func(qr *sqltypes.Result) error {
+		if len(qr.Fields) != 0 {
+			collations = fieldCollations(qr.Fields)
+			if wantfields {
+				if err := callback(&sqltypes.Result{Fields: w.fields(qr.Fields)}); err != nil {
+					return err
+				}
+			}
+		}
+		if len(qr.Rows) == 0 {
+			return nil
+		}
+		pending = append(pending, qr.Rows...)
+		if len(pending) > vcursor.MaxMemoryRows() {
+			return fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+		}
+		// The last partition may continue in the next result.
+		last := len(pending) - 1
+		key := w.partitionKey(collations, pending[last])
+		for last > 0 && w.partitionKey(collations, pending[last-1]) == key {
+			last--
+		}
+		if last == 0 {
+			return nil
+		}
+		rows := w.evaluate(collations, pending[:last])
+		pending = pending[last:]
+		return callback(&sqltypes.Result{Rows: rows})
+	})
+	if err != nil {
+		return err
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	return callback(&sqltypes.Result{Rows: w.evaluate(collations, pending)})
+}
+
+// GetFields fetches the field info.
+func (w *Window) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := w.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: w.fields(qr.Fields)}, nil
+}
+
+// Inputs returns the input to this primitive
+func (w *Window) Inputs() []Primitive {
+	return []Primitive{w.Input}
+}
+
+// fields returns the fields of the result columns.
+func (w *Window) fields(inputFields []*querypb.Field) []*querypb.Field {
+	fields := make([]*querypb.Field, 0, len(w.Cols))
+	for _, col := range w.Cols {
+		if col >= 0 {
+			fields = append(fields, inputFields[col])
+			continue
+		}
+		f := w.Functions[-col-1]
+		switch f.Opcode {
+		case WindowLag, WindowLead:
+			fields = append(fields, &querypb.Field{Name: f.Alias, Type: inputFields[f.Col].Type})
+		default:
+			fields = append(fields, &querypb.Field{Name: f.Alias, Type: sqltypes.Uint64})
+		}
+	}
+	return fields
+}
+
+// evaluate evaluates the window functions over the rows, which
+// must consist of complete partitions, and returns the result rows.
+// The collations are the ones of the input columns.
+func (w *Window) evaluate(collations []evalengine.Collation, rows [][]sqltypes.Value) [][]sqltypes.Value {
+	values := make([][]sqltypes.Value, len(w.Functions))
+	orderCollations := selectCollations(collations, w.OrderBy)
+	for start := 0; start < len(rows); {
+		key := w.partitionKey(collations, rows[start])
+		end := start + 1
+		for end < len(rows) && w.partitionKey(collations, rows[end]) == key {
+			end++
+		}
+		for i, f := range w.Functions {
+			values[i] = append(values[i], f.evaluate(rows[start:end], w.OrderBy, orderCollations)...)
+		}
+		start = end
+	}
+
+	out := make([][]sqltypes.Value, 0, len(rows))
+	for i, row := range rows {
+		outRow := make([]sqltypes.Value, 0, len(w.Cols))
+		for _, col := range w.Cols {
+			if col >= 0 {
+				outRow = append(outRow, row[col])
+				continue
+			}
+			outRow = append(outRow, values[-col-1][i])
+		}
+		out = append(out, outRow)
+	}
+	return out
+}
+
+// partitionKey returns a key that's the same for the rows of a partition.
+func (w *Window) partitionKey(collations []evalengine.Collation, row []sqltypes.Value) string {
+	return distinctKey(selectColumns(row, w.PartitionBy), selectCollations(collations, w.PartitionBy))
+}
+
+// evaluate returns the values of the function for the rows of a partition.
+// The collations are the ones of the orderBy columns.
+func (f *WindowFunction) evaluate(partition [][]sqltypes.Value, orderBy []int, collations []evalengine.Collation) []sqltypes.Value {
+	values := make([]sqltypes.Value, 0, len(partition))
+	switch f.Opcode {
+	case WindowRowNumber:
+		for i := range partition {
+			values = append(values, sqltypes.NewUint64(uint64(i+1)))
+		}
+	case WindowRank, WindowDenseRank:
+		var rank, denseRank int
+		var prev string
+		for i, row := range partition {
+			key := distinctKey(selectColumns(row, orderBy), collations)
+			if i == 0 || key != prev {
+				rank = i + 1
+				denseRank++
+				prev = key
+			}
+			if f.Opcode == WindowRank {
+				values = append(values, sqltypes.NewUint64(uint64(rank)))
+			} else {
+				values = append(values, sqltypes.NewUint64(uint64(denseRank)))
+			}
+		}
+	case WindowLag, WindowLead:
+		offset := f.Offset
+		if f.Opcode == WindowLag {
+			offset = -offset
+		}
+		for i := range partition {
+			j := i + offset
+			if j < 0 || j >= len(partition) {
+				values = append(values, f.Default)
+				continue
+			}
+			values = append(values, partition[j][f.Col])
+		}
+	}
+	return values
+}
+
+// selectColumns returns the values of the specified columns of the row.
+func selectColumns(row []sqltypes.Value, cols []int) []sqltypes.Value {
+	vals := make([]sqltypes.Value, 0, len(cols))
+	for _, col := range cols {
+		vals = append(vals, row[col])
+	}
+	return vals
+}
+
+// selectCollations returns the collations of the specified columns.
+// The columns that collations doesn't cover are compared byte-wise.
+func selectCollations(collations []evalengine.Collation, cols []int) []evalengine.Collation {
+	selected := make([]evalengine.Collation, 0, len(cols))
+	for _, col := range cols {
+		if col >= len(collations) {
+			break
+		}
+		selected = append(selected, collations[col])
+	}
+	return selected
+}
@@ -0,0 +1,273 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestWindowExecute(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|grp|val",
+				"int64|varchar|int64",
+			),
+			"1|a|10",
+			"2|a|10",
+			"3|a|20",
+			"4|b|5",
+			"5|b|6",
+			"6|null|1",
+		)},
+	}
+	w := &Window{
+		PartitionBy: []int{1},
+		OrderBy:     []int{2},
+		Functions: []*WindowFunction{{
+			Opcode: WindowRowNumber,
+			Alias:  "rn",
+		}, {
+			Opcode: WindowRank,
+			Alias:  "rk",
+		}, {
+			Opcode: WindowDenseRank,
+			Alias:  "drk",
+		}, {
+			Opcode: WindowLag,
+			Col:    2,
+			Offset: 1,
+			Alias:  "prev",
+		}, {
+			Opcode:  WindowLead,
+			Col:     0,
+			Offset:  2,
+			Default: sqltypes.NewInt64(0),
+			Alias:   "next",
+		}},
+		Cols:  []int{0, -1, -2, -3, -4, -5},
+		Input: fp,
+	}
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|rn|rk|drk|prev|next",
+			"int64|uint64|uint64|uint64|int64|int64",
+		),
+		"1|1|1|1|null|3",
+		"2|2|1|1|10|0",
+		"3|3|3|2|10|0",
+		"4|1|1|1|null|0",
+		"5|2|2|2|5|0",
+		"6|1|1|1|null|0",
+	)
+
+	r, err := w.Execute(noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	expectResult(t, "w.Execute", r, want)
+
+	// The fields of the input are still needed for the collations.
+	fp.rewind()
+	r, err = w.Execute(noopVCursor{}, nil, false)
+	require.NoError(t, err)
+	want.Fields = nil
+	expectResult(t, "w.Execute", r, want)
+	fp.ExpectLog(t, []string{
+		`Execute  true`,
+	})
+
+	fp.rewind()
+	r, err = w.GetFields(noopVCursor{}, nil)
+	require.NoError(t, err)
+	expectResult(t, "w.GetFields", r, &sqltypes.Result{Fields: sqltypes.MakeTestFields(
+		"id|rn|rk|drk|prev|next",
+		"int64|uint64|uint64|uint64|int64|int64",
+	)})
+}
+
+func TestWindowStreamExecute(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|grp",
+				"int64|varchar",
+			),
+			"1|a",
+			"2|a",
+			"3|a",
+			"4|b",
+			"5|b",
+			"6|null",
+		)},
+	}
+	w := &Window{
+		PartitionBy: []int{1},
+		Functions: []*WindowFunction{{
+			Opcode: WindowRowNumber,
+			Alias:  "rn",
+		}},
+		Cols:  []int{0, -1},
+		Input: fp,
+	}
+	fields := sqltypes.MakeTestFields(
+		"id|rn",
+		"int64|uint64",
+	)
+
+	var results []*sqltypes.Result
+	err := w.StreamExecute(noopVCursor{}, nil, true, func(r *sqltypes.Result) error {
+		results = append(results, r)
+		return nil
+	})
+	require.NoError(t, err)
+	// The fakePrimitive streams two rows at a time. A partition
+	// is sent once the first row of the next one is received.
+	wantResults := []*sqltypes.Result{
+		{Fields: fields},
+		sqltypes.MakeTestResult(fields, "1|1", "2|2", "3|3"),
+		sqltypes.MakeTestResult(fields, "4|1", "5|2"),
+		sqltypes.MakeTestResult(fields, "6|1"),
+	}
+	for _, r := range wantResults[1:] {
+		r.Fields = nil
+		r.RowsAffected = 0
+	}
+	require.Equal(t, len(wantResults), len(results))
+	for i, r := range results {
+		expectResult(t, "w.StreamExecute", r, wantResults[i])
+	}
+
+	// No fields are sent if they're not wanted.
+	fp.rewind()
+	results = nil
+	err = w.StreamExecute(noopVCursor{}, nil, false, func(r *sqltypes.Result) error {
+		results = append(results, r)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, len(wantResults)-1, len(results))
+	for i, r := range results {
+		expectResult(t, "w.StreamExecute", r, wantResults[i+1])
+	}
+	fp.ExpectLog(t, []string{
+		`StreamExecute  true`,
+	})
+}
+
+func TestWindowCollation(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"id|grp|val",
+		"int64|varchar|varchar",
+	)
+	// grp has a binary collation.
+	fields[1].Flags = uint32(querypb.MySqlFlag_BINARY_FLAG)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"1|a|x",
+			"2|a|X",
+			"3|a|y",
+			"4|A|x",
+		)},
+	}
+	w := &Window{
+		PartitionBy: []int{1},
+		OrderBy:     []int{2},
+		Functions: []*WindowFunction{{
+			Opcode: WindowRank,
+			Alias:  "rk",
+		}},
+		Cols:  []int{0, -1},
+		Input: fp,
+	}
+	// 'x' and 'X' have the same rank, while 'a' and 'A'
+	// are different partitions.
+	want := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"id|rk",
+			"int64|uint64",
+		),
+		"1|1",
+		"2|1",
+		"3|3",
+		"4|1",
+	)
+
+	r, err := w.Execute(noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	expectResult(t, "w.Execute", r, want)
+
+	fp.rewind()
+	r, err = wrapStreamExecute(w, noopVCursor{}, nil, true)
+	require.NoError(t, err)
+	expectResult(t, "w.StreamExecute", r, want)
+}
+
+func TestWindowStreamExecuteMaxMemoryRows(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() { testMaxMemoryRows = save }()
+
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"id|grp",
+				"int64|varchar",
+			),
+			"1|a",
+			"2|a",
+			"3|a",
+			"4|b",
+		)},
+	}
+	w := &Window{
+		PartitionBy: []int{1},
+		Functions: []*WindowFunction{{
+			Opcode: WindowRowNumber,
+			Alias:  "rn",
+		}},
+		Cols:  []int{0, -1},
+		Input: fp,
+	}
+	_, err := wrapStreamExecute(w, noopVCursor{}, nil, true)
+	expectError(t, "w.StreamExecute", err, "in-memory row count exceeded allowed limit of 2")
+}
+
+func TestWindowInputError(t *testing.T) {
+	w := &Window{
+		PartitionBy: []int{1},
+		Functions: []*WindowFunction{{
+			Opcode: WindowRowNumber,
+			Alias:  "rn",
+		}},
+		Cols:  []int{0, -1},
+		Input: &fakePrimitive{sendErr: errors.New("input err")},
+	}
+	_, err := w.Execute(noopVCursor{}, nil, true)
+	expectError(t, "w.Execute", err, "input err")
+
+	_, err = wrapStreamExecute(w, noopVCursor{}, nil, true)
+	expectError(t, "w.StreamExecute", err, "input err")
+
+	_, err = w.GetFields(noopVCursor{}, nil)
+	expectError(t, "w.GetFields", err, "input err")
+}
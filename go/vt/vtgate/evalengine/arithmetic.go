@@ -0,0 +1,372 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var (
+	_ Expr = (*Arithmetic)(nil)
+	_ Expr = (*Negate)(nil)
+)
+
+// ArithmeticOp is an arithmetic operator.
+type ArithmeticOp int
+
+// These are the supported arithmetic operators.
+const (
+	Add = ArithmeticOp(iota)
+	Subtract
+	Multiply
+	Divide
+	IntDivide
+	Modulo
+)
+
+var arithmeticOpNames = map[ArithmeticOp]string{
+	Add:       "+",
+	Subtract:  "-",
+	Multiply:  "*",
+	Divide:    "/",
+	IntDivide: "div",
+	Modulo:    "%",
+}
+
+func (op ArithmeticOp) String() string {
+	return arithmeticOpNames[op]
+}
+
+// Arithmetic is a binary arithmetic operation.
+// If either operand is NULL, the result is NULL.
+type Arithmetic struct {
+	Op          ArithmeticOp
+	Left, Right Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (a *Arithmetic) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	lv, err := a.Left.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	rv, err := a.Right.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if lv.IsNull() || rv.IsNull() {
+		return sqltypes.NULL, nil
+	}
+	typ := a.Type(env)
+	switch {
+	case sqltypes.IsFloat(typ):
+		return floatArithmetic(a.Op, lv, rv, typ)
+	case typ == sqltypes.Decimal:
+		return decimalArithmetic(a.Op, lv, rv)
+	}
+	var result sqltypes.Value
+	switch a.Op {
+	case Add:
+		result, err = sqltypes.Add(lv, rv)
+	case Subtract:
+		result, err = sqltypes.Subtract(lv, rv)
+	case Multiply:
+		result, err = sqltypes.Multiply(lv, rv)
+	case IntDivide, Modulo:
+		return integralDivide(a.Op, lv, rv, typ)
+	default:
+		return sqltypes.NULL, fmt.Errorf("BUG: unexpected arithmetic operator: %v", a.Op)
+	}
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	return sqltypes.Cast(result, typ)
+}
+
+// Type satisfies the Expr interface.
+// The rules follow mysql: division produces a decimal,
+// any approximate or string operand produces a double,
+// and integral operations stay integral.
+func (a *Arithmetic) Type(env ExpressionEnv) querypb.Type {
+	ltype, rtype := a.Left.Type(env), a.Right.Type(env)
+	switch {
+	case a.Op == IntDivide:
+		if sqltypes.IsUnsigned(ltype) || sqltypes.IsUnsigned(rtype) {
+			return sqltypes.Uint64
+		}
+		return sqltypes.Int64
+	case !isNumber(ltype) || !isNumber(rtype) || sqltypes.IsFloat(ltype) || sqltypes.IsFloat(rtype):
+		return sqltypes.Float64
+	case a.Op == Divide || ltype == sqltypes.Decimal || rtype == sqltypes.Decimal:
+		return sqltypes.Decimal
+	case sqltypes.IsUnsigned(ltype) || sqltypes.IsUnsigned(rtype):
+		return sqltypes.Uint64
+	}
+	return sqltypes.Int64
+}
+
+// String satisfies the Expr interface.
+func (a *Arithmetic) String() string {
+	return fmt.Sprintf("%s %s %s", paren(a.Left), a.Op, paren(a.Right))
+}
+
+// Negate is the unary minus operator.
+type Negate struct {
+	Expr Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (n *Negate) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	v, err := n.Expr.Evaluate(env)
+	if err != nil || v.IsNull() {
+		return v, err
+	}
+	switch typ := n.Type(env); {
+	case sqltypes.IsSigned(typ):
+		i, err := sqltypes.ToInt64(v)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if i == math.MinInt64 {
+			return sqltypes.NULL, fmt.Errorf("BIGINT value is out of range in -(%d)", i)
+		}
+		return sqltypes.NewInt64(-i), nil
+	case typ == sqltypes.Decimal:
+		d, err := newDecimal(v)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		return d.neg().toValue(), nil
+	default:
+		f, err := ToFloat64(v)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		return castFloat(-f, typ)
+	}
+}
+
+// Type satisfies the Expr interface.
+func (n *Negate) Type(env ExpressionEnv) querypb.Type {
+	switch typ := n.Expr.Type(env); {
+	case sqltypes.IsUnsigned(typ):
+		return sqltypes.Decimal
+	case sqltypes.IsSigned(typ):
+		return sqltypes.Int64
+	case typ == sqltypes.Decimal:
+		return sqltypes.Decimal
+	}
+	return sqltypes.Float64
+}
+
+// String satisfies the Expr interface.
+func (n *Negate) String() string {
+	return "-" + paren(n.Expr)
+}
+
+// floatArithmetic performs the operation using float64
+// and returns the result as typ.
+func floatArithmetic(op ArithmeticOp, lv, rv sqltypes.Value, typ querypb.Type) (sqltypes.Value, error) {
+	lf, err := ToFloat64(lv)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	rf, err := ToFloat64(rv)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	var result float64
+	switch op {
+	case Add:
+		result = lf + rf
+	case Subtract:
+		result = lf - rf
+	case Multiply:
+		result = lf * rf
+	case Divide:
+		if rf == 0 {
+			return sqltypes.NULL, nil
+		}
+		result = lf / rf
+	case Modulo:
+		if rf == 0 {
+			return sqltypes.NULL, nil
+		}
+		result = math.Mod(lf, rf)
+	default:
+		return sqltypes.NULL, fmt.Errorf("BUG: unexpected arithmetic operator: %v", op)
+	}
+	if math.IsInf(result, 0) || math.IsNaN(result) {
+		return sqltypes.NULL, fmt.Errorf("DOUBLE value is out of range in %v %v %v", lv, op, rv)
+	}
+	return castFloat(result, typ)
+}
+
+// integralDivide performs DIV and % on integral values, and DIV on
+// other values. Division by zero returns NULL.
+func integralDivide(op ArithmeticOp, lv, rv sqltypes.Value, typ querypb.Type) (sqltypes.Value, error) {
+	if isExact(lv) && isExact(rv) && (!lv.IsIntegral() || !rv.IsIntegral()) {
+		l, err := newDecimal(lv)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		r, err := newDecimal(rv)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if r.unscaled.Sign() == 0 {
+			return sqltypes.NULL, nil
+		}
+		// l DIV r = (lu * 10^rs) / (ru * 10^ls), truncated.
+		q := new(big.Int).Quo(l.unscaled.Mul(l.unscaled, pow10(r.scale)), r.unscaled.Mul(r.unscaled, pow10(l.scale)))
+		return sqltypes.Cast(sqltypes.MakeTrusted(sqltypes.Decimal, []byte(q.String())), typ)
+	}
+	if !lv.IsIntegral() || !rv.IsIntegral() {
+		lf, err := ToFloat64(lv)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		rf, err := ToFloat64(rv)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if rf == 0 {
+			return sqltypes.NULL, nil
+		}
+		return castFloat(math.Trunc(lf/rf), typ)
+	}
+	if sqltypes.IsUnsigned(typ) {
+		l, err := sqltypes.ToUint64(lv)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		r, err := sqltypes.ToUint64(rv)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if r == 0 {
+			return sqltypes.NULL, nil
+		}
+		if op == Modulo {
+			return sqltypes.NewUint64(l % r), nil
+		}
+		return sqltypes.NewUint64(l / r), nil
+	}
+	l, err := sqltypes.ToInt64(lv)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	r, err := sqltypes.ToInt64(rv)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if r == 0 {
+		return sqltypes.NULL, nil
+	}
+	if op == Modulo {
+		return sqltypes.NewInt64(l % r), nil
+	}
+	if l == math.MinInt64 && r == -1 {
+		return sqltypes.NULL, fmt.Errorf("BIGINT value is out of range in %d DIV %d", l, r)
+	}
+	return sqltypes.NewInt64(l / r), nil
+}
+
+// castFloat returns f as a value of type typ.
+func castFloat(f float64, typ querypb.Type) (sqltypes.Value, error) {
+	switch {
+	case sqltypes.IsSigned(typ):
+		if f < math.MinInt64 || f >= math.MaxInt64 {
+			return sqltypes.NULL, fmt.Errorf("BIGINT value is out of range: %v", f)
+		}
+		return sqltypes.NewInt64(int64(f)), nil
+	case sqltypes.IsUnsigned(typ):
+		if f < 0 || f >= math.MaxUint64 {
+			return sqltypes.NULL, fmt.Errorf("BIGINT UNSIGNED value is out of range: %v", f)
+		}
+		return sqltypes.NewUint64(uint64(f)), nil
+	case typ == sqltypes.Decimal:
+		return sqltypes.MakeTrusted(sqltypes.Decimal, strconv.AppendFloat(nil, f, 'f', -1, 64)), nil
+	}
+	return sqltypes.MakeTrusted(sqltypes.Float64, formatFloat(f)), nil
+}
+
+// isExact returns true if v is an integral or a decimal value.
+func isExact(v sqltypes.Value) bool {
+	return v.IsIntegral() || v.Type() == sqltypes.Decimal
+}
+
+func formatFloat(f float64) []byte {
+	return strconv.AppendFloat(nil, f, 'g', -1, 64)
+}
+
+// ToFloat64 converts v to a float64. Like mysql, strings are
+// converted using their longest numeric prefix, and strings
+// that don't start with a number are treated as 0.
+func ToFloat64(v sqltypes.Value) (float64, error) {
+	if v.IsNull() {
+		return 0, nil
+	}
+	if v.IsIntegral() || v.IsFloat() || v.Type() == sqltypes.Decimal {
+		return sqltypes.ToFloat64(v)
+	}
+	str := strings.TrimSpace(v.ToString())
+	f, _ := strconv.ParseFloat(str[:numericPrefix(str)], 64)
+	return f, nil
+}
+
+// numericPrefix returns the length of the longest prefix
+// of str that is a decimal number.
+func numericPrefix(str string) int {
+	digits := func(i int) int {
+		for i < len(str) && str[i] >= '0' && str[i] <= '9' {
+			i++
+		}
+		return i
+	}
+	i := 0
+	if i < len(str) && (str[i] == '+' || str[i] == '-') {
+		i++
+	}
+	start := i
+	i = digits(i)
+	if i < len(str) && str[i] == '.' {
+		i = digits(i + 1)
+	}
+	if i == start || (i == start+1 && str[start] == '.') {
+		return 0
+	}
+	end := i
+	if i < len(str) && (str[i] == 'e' || str[i] == 'E') {
+		i++
+		if i < len(str) && (str[i] == '+' || str[i] == '-') {
+			i++
+		}
+		if j := digits(i); j > i {
+			end = j
+		}
+	}
+	return end
+}
@@ -0,0 +1,295 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var (
+	_ Expr = (*Comparison)(nil)
+	_ Expr = (*In)(nil)
+	_ Expr = (*Collate)(nil)
+)
+
+// Collation specifies how text values are compared.
+type Collation int
+
+// These are the supported collations. CollationDefault is used
+// for text values without an explicit COLLATE clause. Like the
+// default mysql collations, it's case insensitive and ignores
+// trailing spaces. It doesn't ignore accents.
+const (
+	CollationDefault = Collation(iota)
+	CollationBinary
+)
+
+// CollationFromName returns the Collation for a mysql collation name.
+func CollationFromName(name string) (Collation, error) {
+	name = strings.ToLower(name)
+	switch {
+	case name == "binary" || strings.HasSuffix(name, "_bin") || strings.HasSuffix(name, "_cs"):
+		return CollationBinary, nil
+	case strings.HasSuffix(name, "_ci"):
+		return CollationDefault, nil
+	}
+	return CollationDefault, fmt.Errorf("unsupported collation: %s", name)
+}
+
+// Compare compares two non-null values. It returns 0 if v1==v2,
+// -1 if v1<v2, and 1 if v1>v2. If one of the values is a number,
+// the comparison is numeric. It's exact if both values are integral
+// or decimal. Text values are compared using the collation, unless
+// one of them is binary. Everything else is compared byte-wise.
+func Compare(v1, v2 sqltypes.Value, collation Collation) (int, error) {
+	switch {
+	case v1.IsIntegral() && v2.IsIntegral():
+		return sqltypes.NullsafeCompare(v1, v2)
+	case isExact(v1) && isExact(v2):
+		d1, err := newDecimal(v1)
+		if err != nil {
+			return 0, err
+		}
+		d2, err := newDecimal(v2)
+		if err != nil {
+			return 0, err
+		}
+		return d1.cmp(d2), nil
+	case isNumber(v1.Type()) || isNumber(v2.Type()):
+		f1, err := ToFloat64(v1)
+		if err != nil {
+			return 0, err
+		}
+		f2, err := ToFloat64(v2)
+		if err != nil {
+			return 0, err
+		}
+		switch {
+		case f1 < f2:
+			return -1, nil
+		case f1 > f2:
+			return 1, nil
+		}
+		return 0, nil
+	case v1.IsBinary() || v2.IsBinary():
+		return bytes.Compare(v1.ToBytes(), v2.ToBytes()), nil
+	}
+	return bytes.Compare(Weight(v1, collation), Weight(v2, collation)), nil
+}
+
+// Weight returns the weight of a non-null value that isn't a number,
+// for comparing it with other values of the same type. Two such values
+// are equal under the collation if and only if their weights are equal,
+// which makes the weight usable as a hash key.
+func Weight(v sqltypes.Value, collation Collation) []byte {
+	if collation == CollationDefault && !v.IsBinary() {
+		return []byte(strings.ToLower(strings.TrimRight(v.ToString(), " ")))
+	}
+	return v.ToBytes()
+}
+
+// FieldCollation returns the collation mysql uses for comparing the
+// values of the field. Text columns that have a binary collation are
+// flagged as BINARY. Without a field, the default collation is assumed.
+func FieldCollation(field *querypb.Field) Collation {
+	if field != nil && field.Flags&uint32(querypb.MySqlFlag_BINARY_FLAG) != 0 {
+		return CollationBinary
+	}
+	return CollationDefault
+}
+
+// ComparisonOp is a comparison operator.
+type ComparisonOp int
+
+// These are the supported comparison operators.
+const (
+	Equal = ComparisonOp(iota)
+	NotEqual
+	LessThan
+	LessEqual
+	GreaterThan
+	GreaterEqual
+	NullSafeEqual
+)
+
+var comparisonOpNames = map[ComparisonOp]string{
+	Equal:         "=",
+	NotEqual:      "!=",
+	LessThan:      "<",
+	LessEqual:     "<=",
+	GreaterThan:   ">",
+	GreaterEqual:  ">=",
+	NullSafeEqual: "<=>",
+}
+
+func (op ComparisonOp) String() string {
+	return comparisonOpNames[op]
+}
+
+// Comparison compares two values and returns 1 or 0.
+// If either value is NULL, the result is NULL, except
+// for NullSafeEqual.
+type Comparison struct {
+	Op          ComparisonOp
+	Left, Right Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (c *Comparison) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	lv, err := c.Left.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	rv, err := c.Right.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if lv.IsNull() || rv.IsNull() {
+		if c.Op == NullSafeEqual {
+			return boolValue(lv.IsNull() && rv.IsNull()), nil
+		}
+		return sqltypes.NULL, nil
+	}
+	cmp, err := Compare(lv, rv, collationOf(c.Left, c.Right))
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch c.Op {
+	case Equal, NullSafeEqual:
+		return boolValue(cmp == 0), nil
+	case NotEqual:
+		return boolValue(cmp != 0), nil
+	case LessThan:
+		return boolValue(cmp < 0), nil
+	case LessEqual:
+		return boolValue(cmp <= 0), nil
+	case GreaterThan:
+		return boolValue(cmp > 0), nil
+	case GreaterEqual:
+		return boolValue(cmp >= 0), nil
+	}
+	return sqltypes.NULL, fmt.Errorf("BUG: unexpected comparison operator: %v", c.Op)
+}
+
+// Type satisfies the Expr interface.
+func (c *Comparison) Type(env ExpressionEnv) querypb.Type {
+	return sqltypes.Int64
+}
+
+// String satisfies the Expr interface.
+func (c *Comparison) String() string {
+	return fmt.Sprintf("%s %s %s", paren(c.Left), c.Op, paren(c.Right))
+}
+
+// In is an IN or NOT IN expression with a list of values.
+type In struct {
+	Not   bool
+	Left  Expr
+	Right []Expr
+}
+
+// Evaluate satisfies the Expr interface.
+// Like in mysql, the result is NULL if there is no match
+// and the list contains a NULL.
+func (in *In) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	lv, err := in.Left.Evaluate(env)
+	if err != nil || lv.IsNull() {
+		return sqltypes.NULL, err
+	}
+	hasNull := false
+	for _, expr := range in.Right {
+		rv, err := expr.Evaluate(env)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if rv.IsNull() {
+			hasNull = true
+			continue
+		}
+		cmp, err := Compare(lv, rv, collationOf(in.Left, expr))
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if cmp == 0 {
+			return boolValue(!in.Not), nil
+		}
+	}
+	if hasNull {
+		return sqltypes.NULL, nil
+	}
+	return boolValue(in.Not), nil
+}
+
+// Type satisfies the Expr interface.
+func (in *In) Type(env ExpressionEnv) querypb.Type {
+	return sqltypes.Int64
+}
+
+// String satisfies the Expr interface.
+func (in *In) String() string {
+	list := make([]string, 0, len(in.Right))
+	for _, expr := range in.Right {
+		list = append(list, expr.String())
+	}
+	op := "in"
+	if in.Not {
+		op = "not in"
+	}
+	return fmt.Sprintf("%s %s (%s)", paren(in.Left), op, strings.Join(list, ", "))
+}
+
+// Collate is an expression with an explicit COLLATE clause.
+// It doesn't change the value, but it specifies the collation
+// used by the comparisons that it's part of.
+type Collate struct {
+	Expr      Expr
+	Name      string
+	Collation Collation
+}
+
+// Evaluate satisfies the Expr interface.
+func (c *Collate) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	return c.Expr.Evaluate(env)
+}
+
+// Type satisfies the Expr interface.
+func (c *Collate) Type(env ExpressionEnv) querypb.Type {
+	return c.Expr.Type(env)
+}
+
+// String satisfies the Expr interface.
+func (c *Collate) String() string {
+	return fmt.Sprintf("%s collate %s", paren(c.Expr), c.Name)
+}
+
+// collationOf returns the collation to be used for comparing
+// the results of the expressions. An explicit COLLATE takes
+// precedence.
+func collationOf(exprs ...Expr) Collation {
+	for _, expr := range exprs {
+		if c, ok := expr.(*Collate); ok {
+			return c.Collation
+		}
+	}
+	return CollationDefault
+}
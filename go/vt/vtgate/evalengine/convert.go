@@ -0,0 +1,316 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// ColumnResolver returns the offset of the column in the
+// rows the expression will be evaluated against.
+type ColumnResolver func(col *sqlparser.ColName) (int, error)
+
+var arithmeticOps = map[string]ArithmeticOp{
+	sqlparser.PlusStr:   Add,
+	sqlparser.MinusStr:  Subtract,
+	sqlparser.MultStr:   Multiply,
+	sqlparser.DivStr:    Divide,
+	sqlparser.IntDivStr: IntDivide,
+	sqlparser.ModStr:    Modulo,
+}
+
+var comparisonOps = map[string]ComparisonOp{
+	sqlparser.EqualStr:         Equal,
+	sqlparser.NotEqualStr:      NotEqual,
+	sqlparser.LessThanStr:      LessThan,
+	sqlparser.LessEqualStr:     LessEqual,
+	sqlparser.GreaterThanStr:   GreaterThan,
+	sqlparser.GreaterEqualStr:  GreaterEqual,
+	sqlparser.NullSafeEqualStr: NullSafeEqual,
+}
+
+var isOps = map[string]IsOp{
+	sqlparser.IsNullStr:     IsNull,
+	sqlparser.IsNotNullStr:  IsNotNull,
+	sqlparser.IsTrueStr:     IsTrue,
+	sqlparser.IsNotTrueStr:  IsNotTrue,
+	sqlparser.IsFalseStr:    IsFalse,
+	sqlparser.IsNotFalseStr: IsNotFalse,
+}
+
+// Convert converts a parsed expression into an Expr that can be
+// evaluated by vtgate. Column references are converted using resolve.
+// An error is returned if the expression contains constructs that
+// are not supported.
+func Convert(e sqlparser.Expr, resolve ColumnResolver) (Expr, error) {
+	switch e := e.(type) {
+	case *sqlparser.SQLVal:
+		return convertSQLVal(e)
+	case *sqlparser.NullVal:
+		return &Literal{Val: sqltypes.NULL}, nil
+	case sqlparser.BoolVal:
+		return &Literal{Val: boolValue(bool(e))}, nil
+	case *sqlparser.ColName:
+		offset, err := resolve(e)
+		if err != nil {
+			return nil, err
+		}
+		return &Column{Offset: offset}, nil
+	case *sqlparser.ParenExpr:
+		return Convert(e.Expr, resolve)
+	case *sqlparser.BinaryExpr:
+		op, ok := arithmeticOps[e.Operator]
+		if !ok {
+			return nil, unsupported(e)
+		}
+		left, right, err := convertPair(e.Left, e.Right, resolve)
+		if err != nil {
+			return nil, err
+		}
+		return &Arithmetic{Op: op, Left: left, Right: right}, nil
+	case *sqlparser.UnaryExpr:
+		expr, err := Convert(e.Expr, resolve)
+		if err != nil {
+			return nil, err
+		}
+		switch e.Operator {
+		case sqlparser.UPlusStr:
+			return expr, nil
+		case sqlparser.UMinusStr:
+			return &Negate{Expr: expr}, nil
+		case sqlparser.BangStr:
+			return &Not{Expr: expr}, nil
+		}
+		return nil, unsupported(e)
+	case *sqlparser.ComparisonExpr:
+		return convertComparison(e, resolve)
+	case *sqlparser.RangeCond:
+		left, from, err := convertPair(e.Left, e.From, resolve)
+		if err != nil {
+			return nil, err
+		}
+		to, err := Convert(e.To, resolve)
+		if err != nil {
+			return nil, err
+		}
+		var between Expr = &And{
+			Left:  &Comparison{Op: GreaterEqual, Left: left, Right: from},
+			Right: &Comparison{Op: LessEqual, Left: left, Right: to},
+		}
+		if e.Operator == sqlparser.NotBetweenStr {
+			between = &Not{Expr: between}
+		}
+		return between, nil
+	case *sqlparser.AndExpr:
+		left, right, err := convertPair(e.Left, e.Right, resolve)
+		if err != nil {
+			return nil, err
+		}
+		return &And{Left: left, Right: right}, nil
+	case *sqlparser.OrExpr:
+		left, right, err := convertPair(e.Left, e.Right, resolve)
+		if err != nil {
+			return nil, err
+		}
+		return &Or{Left: left, Right: right}, nil
+	case *sqlparser.NotExpr:
+		expr, err := Convert(e.Expr, resolve)
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Expr: expr}, nil
+	case *sqlparser.IsExpr:
+		op, ok := isOps[e.Operator]
+		if !ok {
+			return nil, unsupported(e)
+		}
+		expr, err := Convert(e.Expr, resolve)
+		if err != nil {
+			return nil, err
+		}
+		return &Is{Op: op, Expr: expr}, nil
+	case *sqlparser.CaseExpr:
+		return convertCase(e, resolve)
+	case *sqlparser.CollateExpr:
+		collation, err := CollationFromName(e.Charset)
+		if err != nil {
+			return nil, err
+		}
+		expr, err := Convert(e.Expr, resolve)
+		if err != nil {
+			return nil, err
+		}
+		return &Collate{Expr: expr, Name: strings.ToLower(e.Charset), Collation: collation}, nil
+	case *sqlparser.FuncExpr:
+		return convertFunc(e, resolve)
+	case *sqlparser.SubstrExpr:
+		var str sqlparser.Expr = e.StrVal
+		if e.Name != nil {
+			str = e.Name
+		}
+		args := []sqlparser.Expr{str, e.From}
+		if e.To != nil {
+			args = append(args, e.To)
+		}
+		return convertCall("substr", args, resolve)
+	}
+	return nil, unsupported(e)
+}
+
+func convertSQLVal(e *sqlparser.SQLVal) (Expr, error) {
+	switch e.Type {
+	case sqlparser.StrVal:
+		return &Literal{Val: sqltypes.MakeTrusted(sqltypes.VarChar, e.Val)}, nil
+	case sqlparser.IntVal:
+		v, err := sqltypes.NewIntegral(string(e.Val))
+		if err != nil {
+			return nil, err
+		}
+		return &Literal{Val: v}, nil
+	case sqlparser.FloatVal:
+		typ := sqltypes.Decimal
+		if strings.ContainsAny(string(e.Val), "eE") {
+			typ = sqltypes.Float64
+		}
+		v, err := sqltypes.NewValue(typ, e.Val)
+		if err != nil {
+			return nil, err
+		}
+		return &Literal{Val: v}, nil
+	case sqlparser.HexVal:
+		v, err := e.HexDecode()
+		if err != nil {
+			return nil, err
+		}
+		return &Literal{Val: sqltypes.MakeTrusted(sqltypes.VarBinary, v)}, nil
+	case sqlparser.ValArg:
+		return &BindVariable{Key: string(e.Val[1:])}, nil
+	}
+	return nil, unsupported(e)
+}
+
+func convertComparison(e *sqlparser.ComparisonExpr, resolve ColumnResolver) (Expr, error) {
+	switch e.Operator {
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := e.Right.(sqlparser.ValTuple)
+		if !ok {
+			return nil, unsupported(e)
+		}
+		left, err := Convert(e.Left, resolve)
+		if err != nil {
+			return nil, err
+		}
+		in := &In{Not: e.Operator == sqlparser.NotInStr, Left: left}
+		for _, val := range tuple {
+			expr, err := Convert(val, resolve)
+			if err != nil {
+				return nil, err
+			}
+			in.Right = append(in.Right, expr)
+		}
+		return in, nil
+	}
+	op, ok := comparisonOps[e.Operator]
+	if !ok {
+		return nil, unsupported(e)
+	}
+	left, right, err := convertPair(e.Left, e.Right, resolve)
+	if err != nil {
+		return nil, err
+	}
+	return &Comparison{Op: op, Left: left, Right: right}, nil
+}
+
+func convertCase(e *sqlparser.CaseExpr, resolve ColumnResolver) (Expr, error) {
+	c := &Case{}
+	var err error
+	if e.Expr != nil {
+		if c.Base, err = Convert(e.Expr, resolve); err != nil {
+			return nil, err
+		}
+	}
+	for _, when := range e.Whens {
+		cond, val, err := convertPair(when.Cond, when.Val, resolve)
+		if err != nil {
+			return nil, err
+		}
+		c.Whens = append(c.Whens, When{Cond: cond, Val: val})
+	}
+	if e.Else != nil {
+		if c.Else, err = Convert(e.Else, resolve); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+func convertFunc(e *sqlparser.FuncExpr, resolve ColumnResolver) (Expr, error) {
+	if !e.Qualifier.IsEmpty() || e.Distinct || e.IsAggregate() || e.IsWindowFunction() {
+		return nil, unsupported(e)
+	}
+	args := make([]sqlparser.Expr, 0, len(e.Exprs))
+	for _, selectExpr := range e.Exprs {
+		aliased, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, unsupported(e)
+		}
+		args = append(args, aliased.Expr)
+	}
+	if e.Name.Lowered() == "mod" {
+		if len(args) != 2 {
+			return nil, fmt.Errorf("incorrect parameter count in the call to native function 'mod'")
+		}
+		left, right, err := convertPair(args[0], args[1], resolve)
+		if err != nil {
+			return nil, err
+		}
+		return &Arithmetic{Op: Modulo, Left: left, Right: right}, nil
+	}
+	return convertCall(e.Name.Lowered(), args, resolve)
+}
+
+func convertCall(name string, args []sqlparser.Expr, resolve ColumnResolver) (Expr, error) {
+	exprs := make([]Expr, 0, len(args))
+	for _, arg := range args {
+		expr, err := Convert(arg, resolve)
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, expr)
+	}
+	return NewFunction(name, exprs)
+}
+
+func convertPair(left, right sqlparser.Expr, resolve ColumnResolver) (Expr, Expr, error) {
+	l, err := Convert(left, resolve)
+	if err != nil {
+		return nil, nil, err
+	}
+	r, err := Convert(right, resolve)
+	if err != nil {
+		return nil, nil, err
+	}
+	return l, r, nil
+}
+
+func unsupported(e sqlparser.SQLNode) error {
+	return fmt.Errorf("unsupported expression: %s", sqlparser.String(e))
+}
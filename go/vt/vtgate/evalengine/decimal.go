@@ -0,0 +1,209 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+// Like mysql, the scale of a decimal cannot exceed maxDecimalScale,
+// and a division adds divPrecisionIncrement digits to the scale of
+// the dividend, which is the default of div_precision_increment.
+const (
+	maxDecimalScale       = 30
+	divPrecisionIncrement = 4
+)
+
+var bigTen = big.NewInt(10)
+
+// decimal is an exact decimal number, whose value is
+// unscaled * 10^-scale. It's used for DECIMAL values,
+// which float64 cannot represent precisely.
+type decimal struct {
+	unscaled *big.Int
+	scale    int
+}
+
+// newDecimal converts a numeric value to a decimal. Integral and
+// decimal values are converted exactly. Other values are converted
+// through float64.
+func newDecimal(v sqltypes.Value) (decimal, error) {
+	str := v.ToString()
+	if !v.IsIntegral() && v.Type() != sqltypes.Decimal {
+		f, err := ToFloat64(v)
+		if err != nil {
+			return decimal{}, err
+		}
+		str = strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	digits, scale := str, 0
+	if i := strings.IndexByte(str, '.'); i >= 0 {
+		digits, scale = str[:i]+str[i+1:], len(str)-i-1
+	}
+	unscaled, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return decimal{}, fmt.Errorf("could not parse value: '%s'", str)
+	}
+	return decimal{unscaled: unscaled, scale: scale}, nil
+}
+
+// toValue returns the decimal as a DECIMAL value.
+func (d decimal) toValue() sqltypes.Value {
+	str := new(big.Int).Abs(d.unscaled).String()
+	if d.scale > 0 {
+		if len(str) <= d.scale {
+			str = strings.Repeat("0", d.scale-len(str)+1) + str
+		}
+		str = str[:len(str)-d.scale] + "." + str[len(str)-d.scale:]
+	}
+	if d.unscaled.Sign() < 0 {
+		str = "-" + str
+	}
+	return sqltypes.MakeTrusted(sqltypes.Decimal, []byte(str))
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+// quoRound returns num / den, rounded half away from zero.
+func quoRound(num, den *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	r.Abs(r).Lsh(r, 1)
+	if r.CmpAbs(den) >= 0 {
+		if num.Sign()*den.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+// round returns the decimal rounded half away from zero to the
+// scale. A negative scale rounds digits left of the decimal point.
+func (d decimal) round(scale int) decimal {
+	if scale >= d.scale {
+		return decimal{unscaled: new(big.Int).Mul(d.unscaled, pow10(scale-d.scale)), scale: scale}
+	}
+	unscaled := quoRound(d.unscaled, pow10(d.scale-scale))
+	if scale < 0 {
+		return decimal{unscaled: unscaled.Mul(unscaled, pow10(-scale)), scale: 0}
+	}
+	return decimal{unscaled: unscaled, scale: scale}
+}
+
+// floor returns the largest integer not greater than d.
+func (d decimal) floor() *big.Int {
+	q, r := new(big.Int).QuoRem(d.unscaled, pow10(d.scale), new(big.Int))
+	if r.Sign() < 0 {
+		q.Sub(q, big.NewInt(1))
+	}
+	return q
+}
+
+// ceil returns the smallest integer not less than d.
+func (d decimal) ceil() *big.Int {
+	q, r := new(big.Int).QuoRem(d.unscaled, pow10(d.scale), new(big.Int))
+	if r.Sign() > 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	return q
+}
+
+func (d decimal) neg() decimal {
+	return decimal{unscaled: new(big.Int).Neg(d.unscaled), scale: d.scale}
+}
+
+func (d decimal) abs() decimal {
+	return decimal{unscaled: new(big.Int).Abs(d.unscaled), scale: d.scale}
+}
+
+// cmp compares d and other. It returns 0 if d==other,
+// -1 if d<other, and 1 if d>other.
+func (d decimal) cmp(other decimal) int {
+	scale := d.scale
+	if other.scale > scale {
+		scale = other.scale
+	}
+	return d.round(scale).unscaled.Cmp(other.round(scale).unscaled)
+}
+
+// decimalArithmetic performs the operation exactly. The scale of
+// the result follows mysql: the larger scale of the operands for
+// additions, subtractions and modulos, their sum for multiplications,
+// and the scale of the dividend plus divPrecisionIncrement for
+// divisions. Division by zero returns NULL.
+func decimalArithmetic(op ArithmeticOp, lv, rv sqltypes.Value) (sqltypes.Value, error) {
+	l, err := newDecimal(lv)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	r, err := newDecimal(rv)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	scale := l.scale
+	if r.scale > scale {
+		scale = r.scale
+	}
+	var result decimal
+	switch op {
+	case Add:
+		l, r = l.round(scale), r.round(scale)
+		result = decimal{unscaled: l.unscaled.Add(l.unscaled, r.unscaled), scale: scale}
+	case Subtract:
+		l, r = l.round(scale), r.round(scale)
+		result = decimal{unscaled: l.unscaled.Sub(l.unscaled, r.unscaled), scale: scale}
+	case Multiply:
+		result = decimal{unscaled: new(big.Int).Mul(l.unscaled, r.unscaled), scale: l.scale + r.scale}
+	case Divide:
+		if r.unscaled.Sign() == 0 {
+			return sqltypes.NULL, nil
+		}
+		scale = l.scale + divPrecisionIncrement
+		if scale > maxDecimalScale {
+			scale = maxDecimalScale
+		}
+		// l/r = (lu * 10^(rs+scale-ls) / ru) * 10^-scale
+		num, den := new(big.Int).Set(l.unscaled), new(big.Int).Set(r.unscaled)
+		if exp := r.scale + scale - l.scale; exp >= 0 {
+			num.Mul(num, pow10(exp))
+		} else {
+			den.Mul(den, pow10(-exp))
+		}
+		result = decimal{unscaled: quoRound(num, den), scale: scale}
+	case Modulo:
+		if r.unscaled.Sign() == 0 {
+			return sqltypes.NULL, nil
+		}
+		l, r = l.round(scale), r.round(scale)
+		// Like mysql, the sign of the result is the sign of the dividend.
+		result = decimal{unscaled: l.unscaled.Rem(l.unscaled, r.unscaled), scale: scale}
+	default:
+		return sqltypes.NULL, fmt.Errorf("BUG: unexpected arithmetic operator: %v", op)
+	}
+	if result.scale > maxDecimalScale {
+		result = result.round(maxDecimalScale)
+	}
+	return result.toValue(), nil
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var testFields = sqltypes.MakeTestFields(
+	"a|b|price|qty|name|d|n",
+	"int64|int64|decimal|int64|varchar|datetime|int64",
+)
+
+var testRow = []sqltypes.Value{
+	sqltypes.NewInt64(7),
+	sqltypes.NewInt64(2),
+	sqltypes.MakeTrusted(sqltypes.Decimal, []byte("1.5")),
+	sqltypes.NewInt64(4),
+	sqltypes.NewVarChar("Foo "),
+	sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2020-03-04 05:06:07")),
+	sqltypes.NULL,
+}
+
+func testResolver(col *sqlparser.ColName) (int, error) {
+	for i, f := range testFields {
+		if col.Name.EqualString(f.Name) {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown column: %s", sqlparser.String(col))
+}
+
+func convertExpr(t *testing.T, query string) Expr {
+	t.Helper()
+	stmt, err := sqlparser.Parse("select " + query)
+	require.NoError(t, err)
+	aliased := stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr)
+	expr, err := Convert(aliased.Expr, testResolver)
+	require.NoError(t, err, query)
+	return expr
+}
+
+func TestEvaluate(t *testing.T) {
+	env := ExpressionEnv{
+		BindVars: map[string]*querypb.BindVariable{
+			"v": sqltypes.Int64BindVariable(10),
+		},
+		Row:    testRow,
+		Fields: testFields,
+	}
+	tcases := []struct {
+		expr string
+		out  string
+		typ  querypb.Type
+	}{
+		// Literals, columns and bind vars.
+		{expr: "1", out: "INT64(1)", typ: sqltypes.Int64},
+		{expr: "1.5", out: "DECIMAL(1.5)", typ: sqltypes.Decimal},
+		{expr: "1e2", out: "FLOAT64(1e2)", typ: sqltypes.Float64},
+		{expr: "'abc'", out: "VARCHAR(\"abc\")", typ: sqltypes.VarChar},
+		{expr: "null", out: "NULL", typ: sqltypes.Null},
+		{expr: "true", out: "INT64(1)", typ: sqltypes.Int64},
+		{expr: "a", out: "INT64(7)", typ: sqltypes.Int64},
+		{expr: ":v", out: "INT64(10)", typ: sqltypes.Int64},
+
+		// Arithmetic.
+		{expr: "a + b", out: "INT64(9)", typ: sqltypes.Int64},
+		{expr: "a - b * 2", out: "INT64(3)", typ: sqltypes.Int64},
+		{expr: "a / b", out: "DECIMAL(3.5000)", typ: sqltypes.Decimal},
+		{expr: "1 / 3", out: "DECIMAL(0.3333)", typ: sqltypes.Decimal},
+		{expr: "2 / 3", out: "DECIMAL(0.6667)", typ: sqltypes.Decimal},
+		{expr: "-2 / 3", out: "DECIMAL(-0.6667)", typ: sqltypes.Decimal},
+		{expr: "1.00 / 3", out: "DECIMAL(0.333333)", typ: sqltypes.Decimal},
+		{expr: "a / 0", out: "NULL", typ: sqltypes.Decimal},
+		{expr: "a div b", out: "INT64(3)", typ: sqltypes.Int64},
+		{expr: "a % b", out: "INT64(1)", typ: sqltypes.Int64},
+		{expr: "mod(a, b)", out: "INT64(1)", typ: sqltypes.Int64},
+		{expr: "price * qty", out: "DECIMAL(6.0)", typ: sqltypes.Decimal},
+		{expr: "0.1 + 0.2", out: "DECIMAL(0.3)", typ: sqltypes.Decimal},
+		{expr: "0.3 - 0.1", out: "DECIMAL(0.2)", typ: sqltypes.Decimal},
+		{expr: "1.10 * 1.1", out: "DECIMAL(1.210)", typ: sqltypes.Decimal},
+		{expr: "12345678901234567890.1 + 1", out: "DECIMAL(12345678901234567891.1)", typ: sqltypes.Decimal},
+		{expr: "-7.5 % 2", out: "DECIMAL(-1.5)", typ: sqltypes.Decimal},
+		{expr: "7.5 div 2", out: "INT64(3)", typ: sqltypes.Int64},
+		{expr: "-price", out: "DECIMAL(-1.5)", typ: sqltypes.Decimal},
+		{expr: "a + n", out: "NULL", typ: sqltypes.Int64},
+		{expr: "-a", out: "INT64(-7)", typ: sqltypes.Int64},
+		{expr: "'3abc' + 1", out: "FLOAT64(4)", typ: sqltypes.Float64},
+		{expr: "1e0 / 4", out: "FLOAT64(0.25)", typ: sqltypes.Float64},
+
+		// Comparisons and logic.
+		{expr: "a > b", out: "INT64(1)", typ: sqltypes.Int64},
+		{expr: "a = 7.0", out: "INT64(1)", typ: sqltypes.Int64},
+		{expr: "a = n", out: "NULL", typ: sqltypes.Int64},
+		{expr: "n <=> null", out: "INT64(1)", typ: sqltypes.Int64},
+		{expr: "name = 'foo'", out: "INT64(1)", typ: sqltypes.Int64},
+		{expr: "name = 'foo' collate utf8mb4_bin", out: "INT64(0)", typ: sqltypes.Int64},
+		{expr: "a in (1, 7)", out: "INT64(1)", typ: sqltypes.Int64},
+		{expr: "a not in (1, null)", out: "NULL", typ: sqltypes.Int64},
+		{expr: "a between 1 and 7", out: "INT64(1)", typ: sqltypes.Int64},
+		{expr: "a > 1 and n", out: "NULL", typ: sqltypes.Int64},
+		{expr: "a < 1 and n", out: "INT64(0)", typ: sqltypes.Int64},
+		{expr: "a > 1 or n", out: "INT64(1)", typ: sqltypes.Int64},
+		{expr: "not a", out: "INT64(0)", typ: sqltypes.Int64},
+		{expr: "n is null", out: "INT64(1)", typ: sqltypes.Int64},
+		{expr: "n is not true", out: "INT64(1)", typ: sqltypes.Int64},
+
+		// Case.
+		{expr: "case when a > 5 then 'big' else 'small' end", out: "VARCHAR(\"big\")", typ: sqltypes.VarChar},
+		{expr: "case b when 1 then 'one' when 2 then 'two' end", out: "VARCHAR(\"two\")", typ: sqltypes.VarChar},
+		{expr: "case b when 1 then 'one' end", out: "NULL", typ: sqltypes.VarChar},
+		{expr: "case when n then 1 else 2.5 end", out: "DECIMAL(2.5)", typ: sqltypes.Decimal},
+
+		// Functions.
+		{expr: "abs(-a)", out: "INT64(7)", typ: sqltypes.Int64},
+		{expr: "round(price)", out: "DECIMAL(2)", typ: sqltypes.Decimal},
+		{expr: "round(-2.5)", out: "DECIMAL(-3)", typ: sqltypes.Decimal},
+		{expr: "round(1.005, 2)", out: "DECIMAL(1.01)", typ: sqltypes.Decimal},
+		{expr: "round(1234.5, -2)", out: "DECIMAL(1200)", typ: sqltypes.Decimal},
+		{expr: "abs(-price)", out: "DECIMAL(1.5)", typ: sqltypes.Decimal},
+		{expr: "floor(price)", out: "INT64(1)", typ: sqltypes.Int64},
+		{expr: "floor(-price)", out: "INT64(-2)", typ: sqltypes.Int64},
+		{expr: "ceil(price)", out: "INT64(2)", typ: sqltypes.Int64},
+		{expr: "concat(name, a)", out: "VARCHAR(\"Foo 7\")", typ: sqltypes.VarChar},
+		{expr: "concat(name, n)", out: "NULL", typ: sqltypes.VarChar},
+		{expr: "upper(trim(name))", out: "VARCHAR(\"FOO\")", typ: sqltypes.VarChar},
+		{expr: "length(name)", out: "INT64(4)", typ: sqltypes.Int64},
+		{expr: "substr(name, 2)", out: "VARCHAR(\"oo \")", typ: sqltypes.VarChar},
+		{expr: "substring(name, 1, 2)", out: "VARCHAR(\"Fo\")", typ: sqltypes.VarChar},
+		{expr: "ifnull(n, a)", out: "INT64(7)", typ: sqltypes.Int64},
+		{expr: "coalesce(n, null, b)", out: "INT64(2)", typ: sqltypes.Int64},
+		{expr: "if(a > b, 'yes', 'no')", out: "VARCHAR(\"yes\")", typ: sqltypes.VarChar},
+		{expr: "greatest(a, b, 3)", out: "INT64(7)", typ: sqltypes.Int64},
+		{expr: "year(d)", out: "INT64(2020)", typ: sqltypes.Int64},
+		{expr: "month(d)", out: "INT64(3)", typ: sqltypes.Int64},
+		{expr: "second(d)", out: "INT64(7)", typ: sqltypes.Int64},
+		{expr: "date(d)", out: "DATE(\"2020-03-04\")", typ: sqltypes.Date},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.expr, func(t *testing.T) {
+			expr := convertExpr(t, tcase.expr)
+			got, err := expr.Evaluate(env)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.out, got.String())
+			assert.Equal(t, tcase.typ, expr.Type(env))
+		})
+	}
+}
+
+func TestEvaluateError(t *testing.T) {
+	env := ExpressionEnv{Row: testRow, Fields: testFields}
+	expr := convertExpr(t, ":missing + 1")
+	_, err := expr.Evaluate(env)
+	assert.EqualError(t, err, "missing bind var missing")
+
+	expr = convertExpr(t, "-(-9223372036854775807 - 1)")
+	_, err = expr.Evaluate(env)
+	assert.EqualError(t, err, "BIGINT value is out of range in -(-9223372036854775808)")
+}
+
+func TestConvertError(t *testing.T) {
+	tcases := []struct {
+		expr string
+		err  string
+	}{{
+		expr: "rand()",
+		err:  "unsupported function: rand",
+	}, {
+		expr: "count(a)",
+		err:  "unsupported expression: count(a)",
+	}, {
+		expr: "a & b",
+		err:  "unsupported expression: a & b",
+	}, {
+		expr: "abs(a, b)",
+		err:  "incorrect parameter count in the call to native function 'abs'",
+	}, {
+		expr: "name collate latin1_general",
+		err:  "unsupported collation: latin1_general",
+	}, {
+		expr: "a in (select 1)",
+		err:  "unsupported expression: a in (select 1 from dual)",
+	}, {
+		expr: "unknown + 1",
+		err:  "unknown column: unknown",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.expr, func(t *testing.T) {
+			stmt, err := sqlparser.Parse("select " + tcase.expr)
+			require.NoError(t, err)
+			aliased := stmt.(*sqlparser.Select).SelectExprs[0].(*sqlparser.AliasedExpr)
+			_, err = Convert(aliased.Expr, testResolver)
+			assert.EqualError(t, err, tcase.err)
+		})
+	}
+}
+
+func TestString(t *testing.T) {
+	tcases := []struct {
+		expr string
+		out  string
+	}{
+		{expr: "a + b * 2", out: "[COLUMN 0] + ([COLUMN 1] * 2)"},
+		{expr: "(a + b) * :v", out: "([COLUMN 0] + [COLUMN 1]) * :v"},
+		{expr: "name = 'x' collate utf8mb4_bin", out: "[COLUMN 4] = ('x' collate utf8mb4_bin)"},
+		{expr: "a in (1, 2)", out: "[COLUMN 0] in (1, 2)"},
+		{expr: "lower(name)", out: "lower([COLUMN 4])"},
+		{expr: "case when a then 1 else null end", out: "case when [COLUMN 0] then 1 else null end"},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.expr, func(t *testing.T) {
+			assert.Equal(t, tcase.out, convertExpr(t, tcase.expr).String())
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tcases := []struct {
+		v1, v2    sqltypes.Value
+		collation Collation
+		out       int
+	}{
+		{v1: sqltypes.NewInt64(1), v2: sqltypes.NewUint64(2), out: -1},
+		{v1: sqltypes.NewVarChar("10"), v2: sqltypes.NewInt64(9), out: 1},
+		{v1: sqltypes.MakeTrusted(sqltypes.Decimal, []byte("1.50")), v2: sqltypes.MakeTrusted(sqltypes.Decimal, []byte("1.5")), out: 0},
+		{v1: sqltypes.MakeTrusted(sqltypes.Decimal, []byte("12345678901234567.1")), v2: sqltypes.MakeTrusted(sqltypes.Decimal, []byte("12345678901234567.2")), out: -1},
+		{v1: sqltypes.MakeTrusted(sqltypes.Decimal, []byte("9007199254740993.0")), v2: sqltypes.NewInt64(9007199254740992), out: 1},
+		{v1: sqltypes.NewUint64(18446744073709551615), v2: sqltypes.MakeTrusted(sqltypes.Decimal, []byte("18446744073709551614.9")), out: 1},
+		{v1: sqltypes.MakeTrusted(sqltypes.Decimal, []byte("-0.1")), v2: sqltypes.NewInt64(0), out: -1},
+		{v1: sqltypes.NewVarChar("abc"), v2: sqltypes.NewVarChar("ABC  "), out: 0},
+		{v1: sqltypes.NewVarChar("abc"), v2: sqltypes.NewVarChar("ABC"), collation: CollationBinary, out: 1},
+		{v1: sqltypes.NewVarBinary("abc"), v2: sqltypes.NewVarChar("ABC"), out: 1},
+	}
+	for _, tcase := range tcases {
+		got, err := Compare(tcase.v1, tcase.v2, tcase.collation)
+		require.NoError(t, err)
+		assert.Equal(t, tcase.out, got, "Compare(%v, %v)", tcase.v1, tcase.v2)
+	}
+}
+
+func TestWeight(t *testing.T) {
+	assert.Equal(t, []byte("abc"), Weight(sqltypes.NewVarChar("ABC  "), CollationDefault))
+	assert.Equal(t, []byte("ABC  "), Weight(sqltypes.NewVarChar("ABC  "), CollationBinary))
+	assert.Equal(t, []byte("ABC"), Weight(sqltypes.NewVarBinary("ABC"), CollationDefault))
+
+	assert.Equal(t, CollationDefault, FieldCollation(nil))
+	assert.Equal(t, CollationDefault, FieldCollation(&querypb.Field{Type: sqltypes.VarChar}))
+	assert.Equal(t, CollationBinary, FieldCollation(&querypb.Field{Type: sqltypes.VarChar, Flags: uint32(querypb.MySqlFlag_BINARY_FLAG)}))
+}
@@ -0,0 +1,312 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package evalengine evaluates SQL expressions in vtgate. It's used
+// by primitives that need to compute values from the rows returned
+// by the shards, like the result of an expression that contains
+// aggregates, or an ORDER BY expression that cannot be pushed down.
+//
+// Expressions are built from a sqlparser.Expr by Convert. Values are
+// represented as sqltypes.Value. Arithmetic and comparisons on
+// integral and DECIMAL values are exact. Other numbers are handled
+// as float64.
+package evalengine
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// ExpressionEnv contains the environment an expression
+// is evaluated in: the bind variables, the current row
+// and the fields that describe the row.
+type ExpressionEnv struct {
+	BindVars map[string]*querypb.BindVariable
+	Row      []sqltypes.Value
+	Fields   []*querypb.Field
+}
+
+// Expr is an expression that can be evaluated by vtgate.
+type Expr interface {
+	// Evaluate computes the value of the expression for env.
+	Evaluate(env ExpressionEnv) (sqltypes.Value, error)
+	// Type returns the type of the values returned by Evaluate.
+	// Only env.BindVars and env.Fields are used.
+	Type(env ExpressionEnv) querypb.Type
+	// String returns a description of the expression.
+	String() string
+}
+
+var (
+	_ Expr = (*Literal)(nil)
+	_ Expr = (*BindVariable)(nil)
+	_ Expr = (*Column)(nil)
+	_ Expr = (*Case)(nil)
+)
+
+// Literal is a constant value.
+type Literal struct {
+	Val sqltypes.Value
+}
+
+// Evaluate satisfies the Expr interface.
+func (l *Literal) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	return l.Val, nil
+}
+
+// Type satisfies the Expr interface.
+func (l *Literal) Type(env ExpressionEnv) querypb.Type {
+	return l.Val.Type()
+}
+
+// String satisfies the Expr interface.
+func (l *Literal) String() string {
+	if l.Val.IsNull() {
+		return "null"
+	}
+	buf := &strings.Builder{}
+	l.Val.EncodeSQL(buf)
+	return buf.String()
+}
+
+// BindVariable is a reference to a bind variable.
+type BindVariable struct {
+	Key string
+}
+
+// Evaluate satisfies the Expr interface.
+func (b *BindVariable) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	bv, ok := env.BindVars[b.Key]
+	if !ok {
+		return sqltypes.NULL, fmt.Errorf("missing bind var %s", b.Key)
+	}
+	return sqltypes.BindVariableToValue(bv)
+}
+
+// Type satisfies the Expr interface.
+func (b *BindVariable) Type(env ExpressionEnv) querypb.Type {
+	bv, ok := env.BindVars[b.Key]
+	if !ok {
+		return sqltypes.Null
+	}
+	return bv.Type
+}
+
+// String satisfies the Expr interface.
+func (b *BindVariable) String() string {
+	return ":" + b.Key
+}
+
+// Column is a reference to a column of the current row.
+type Column struct {
+	Offset int
+}
+
+// Evaluate satisfies the Expr interface.
+func (c *Column) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	if c.Offset >= len(env.Row) {
+		return sqltypes.NULL, fmt.Errorf("column offset %d out of range: row has %d columns", c.Offset, len(env.Row))
+	}
+	return env.Row[c.Offset], nil
+}
+
+// Type satisfies the Expr interface.
+func (c *Column) Type(env ExpressionEnv) querypb.Type {
+	if c.Offset >= len(env.Fields) {
+		return sqltypes.Null
+	}
+	return env.Fields[c.Offset].Type
+}
+
+// String satisfies the Expr interface.
+func (c *Column) String() string {
+	return fmt.Sprintf("[COLUMN %d]", c.Offset)
+}
+
+// When is a WHEN ... THEN ... branch of a Case.
+type When struct {
+	Cond Expr
+	Val  Expr
+}
+
+// Case is a CASE expression. If Base is set, the conditions
+// are compared against it. Otherwise, they're evaluated as
+// booleans.
+type Case struct {
+	Base  Expr
+	Whens []When
+	Else  Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (c *Case) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	var base sqltypes.Value
+	if c.Base != nil {
+		var err error
+		if base, err = c.Base.Evaluate(env); err != nil {
+			return sqltypes.NULL, err
+		}
+	}
+	for _, when := range c.Whens {
+		cond, err := when.Cond.Evaluate(env)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		var matched bool
+		if c.Base != nil {
+			if base.IsNull() || cond.IsNull() {
+				continue
+			}
+			cmp, err := Compare(base, cond, collationOf(c.Base, when.Cond))
+			if err != nil {
+				return sqltypes.NULL, err
+			}
+			matched = cmp == 0
+		} else {
+			truth, err := isTrue(cond)
+			if err != nil {
+				return sqltypes.NULL, err
+			}
+			matched = truth
+		}
+		if matched {
+			return castTo(when.Val, env, c.Type(env))
+		}
+	}
+	if c.Else == nil {
+		return sqltypes.NULL, nil
+	}
+	return castTo(c.Else, env, c.Type(env))
+}
+
+// Type satisfies the Expr interface.
+func (c *Case) Type(env ExpressionEnv) querypb.Type {
+	exprs := make([]Expr, 0, len(c.Whens)+1)
+	for _, when := range c.Whens {
+		exprs = append(exprs, when.Val)
+	}
+	if c.Else != nil {
+		exprs = append(exprs, c.Else)
+	}
+	return mergeTypes(env, exprs)
+}
+
+// String satisfies the Expr interface.
+func (c *Case) String() string {
+	buf := &strings.Builder{}
+	buf.WriteString("case")
+	if c.Base != nil {
+		fmt.Fprintf(buf, " %s", c.Base)
+	}
+	for _, when := range c.Whens {
+		fmt.Fprintf(buf, " when %s then %s", when.Cond, when.Val)
+	}
+	if c.Else != nil {
+		fmt.Fprintf(buf, " else %s", c.Else)
+	}
+	buf.WriteString(" end")
+	return buf.String()
+}
+
+// mergeTypes returns the type that can represent
+// the values of all the expressions.
+func mergeTypes(env ExpressionEnv, exprs []Expr) querypb.Type {
+	result := sqltypes.Null
+	for _, expr := range exprs {
+		typ := expr.Type(env)
+		switch {
+		case typ == sqltypes.Null || typ == result:
+		case result == sqltypes.Null:
+			result = typ
+		case sqltypes.IsIntegral(typ) && sqltypes.IsIntegral(result):
+			if sqltypes.IsUnsigned(typ) != sqltypes.IsUnsigned(result) {
+				result = sqltypes.Decimal
+			} else {
+				result = sqltypes.Int64
+				if sqltypes.IsUnsigned(typ) {
+					result = sqltypes.Uint64
+				}
+			}
+		case isNumber(typ) && isNumber(result):
+			if sqltypes.IsFloat(typ) || sqltypes.IsFloat(result) {
+				result = sqltypes.Float64
+			} else {
+				result = sqltypes.Decimal
+			}
+		case sqltypes.IsBinary(typ) || sqltypes.IsBinary(result):
+			result = sqltypes.VarBinary
+		default:
+			result = sqltypes.VarChar
+		}
+	}
+	return result
+}
+
+// castTo evaluates expr and converts the result to typ.
+func castTo(expr Expr, env ExpressionEnv, typ querypb.Type) (sqltypes.Value, error) {
+	v, err := expr.Evaluate(env)
+	if err != nil || v.IsNull() || v.Type() == typ || typ == sqltypes.Null {
+		return v, err
+	}
+	if isNumber(typ) && !isNumber(v.Type()) {
+		// Strings are converted to numbers on a best effort basis.
+		f, err := ToFloat64(v)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		return castFloat(f, typ)
+	}
+	return sqltypes.Cast(v, typ)
+}
+
+func isNumber(typ querypb.Type) bool {
+	return sqltypes.IsIntegral(typ) || sqltypes.IsFloat(typ) || typ == sqltypes.Decimal
+}
+
+// isTrue returns true if v is considered true by mysql.
+// NULL is false.
+func isTrue(v sqltypes.Value) (bool, error) {
+	if v.IsNull() {
+		return false, nil
+	}
+	f, err := ToFloat64(v)
+	if err != nil {
+		return false, err
+	}
+	return f != 0, nil
+}
+
+// boolValue returns the mysql representation of b.
+func boolValue(b bool) sqltypes.Value {
+	if b {
+		return sqltypes.NewInt64(1)
+	}
+	return sqltypes.NewInt64(0)
+}
+
+// paren returns the description of expr, in parenthesis
+// if it's an operation.
+func paren(expr Expr) string {
+	switch expr.(type) {
+	case *Literal, *BindVariable, *Column, *Function:
+		return expr.String()
+	}
+	return "(" + expr.String() + ")"
+}
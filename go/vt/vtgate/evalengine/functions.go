@@ -0,0 +1,549 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Expr = (*Function)(nil)
+
+// Function is a call to a builtin function.
+type Function struct {
+	Name string
+	Args []Expr
+	impl *builtin
+}
+
+// NewFunction returns a call to the named builtin function.
+// It fails if the function doesn't exist or if the number
+// of arguments is wrong.
+func NewFunction(name string, args []Expr) (*Function, error) {
+	name = strings.ToLower(name)
+	impl, ok := builtins[name]
+	if !ok {
+		return nil, fmt.Errorf("unsupported function: %s", name)
+	}
+	if len(args) < impl.minArgs || (impl.maxArgs >= 0 && len(args) > impl.maxArgs) {
+		return nil, fmt.Errorf("incorrect parameter count in the call to native function '%s'", name)
+	}
+	return &Function{Name: name, Args: args, impl: impl}, nil
+}
+
+// Evaluate satisfies the Expr interface.
+func (f *Function) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	args := make([]sqltypes.Value, 0, len(f.Args))
+	for _, arg := range f.Args {
+		v, err := arg.Evaluate(env)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if v.IsNull() && f.impl.nullIfNullArg {
+			return sqltypes.NULL, nil
+		}
+		args = append(args, v)
+	}
+	typ := f.Type(env)
+	v, err := f.impl.eval(args, typ, collationOf(f.Args...))
+	if err != nil || v.IsNull() || v.Type() == typ {
+		return v, err
+	}
+	return sqltypes.Cast(v, typ)
+}
+
+// Type satisfies the Expr interface.
+func (f *Function) Type(env ExpressionEnv) querypb.Type {
+	return f.impl.typ(env, f.Args)
+}
+
+// String satisfies the Expr interface.
+func (f *Function) String() string {
+	args := make([]string, 0, len(f.Args))
+	for _, arg := range f.Args {
+		args = append(args, arg.String())
+	}
+	return fmt.Sprintf("%s(%s)", f.Name, strings.Join(args, ", "))
+}
+
+// builtin is the implementation of a function.
+type builtin struct {
+	minArgs, maxArgs int
+	// nullIfNullArg is set if the result is NULL
+	// whenever one of the arguments is NULL.
+	nullIfNullArg bool
+	eval          func(args []sqltypes.Value, typ querypb.Type, collation Collation) (sqltypes.Value, error)
+	typ           func(env ExpressionEnv, args []Expr) querypb.Type
+}
+
+var builtins map[string]*builtin
+
+func init() {
+	ceil := &builtin{minArgs: 1, maxArgs: 1, nullIfNullArg: true, eval: evalRounding(math.Ceil, decimal.ceil), typ: roundedType}
+	floor := &builtin{minArgs: 1, maxArgs: 1, nullIfNullArg: true, eval: evalRounding(math.Floor, decimal.floor), typ: roundedType}
+	lower := &builtin{minArgs: 1, maxArgs: 1, nullIfNullArg: true, eval: evalString(strings.ToLower), typ: stringType}
+	upper := &builtin{minArgs: 1, maxArgs: 1, nullIfNullArg: true, eval: evalString(strings.ToUpper), typ: stringType}
+	length := &builtin{minArgs: 1, maxArgs: 1, nullIfNullArg: true, eval: evalLength, typ: int64Type}
+	charLength := &builtin{minArgs: 1, maxArgs: 1, nullIfNullArg: true, eval: evalCharLength, typ: int64Type}
+	day := &builtin{minArgs: 1, maxArgs: 1, nullIfNullArg: true, eval: evalDatePart(2), typ: int64Type}
+
+	builtins = map[string]*builtin{
+		"abs":              {minArgs: 1, maxArgs: 1, nullIfNullArg: true, eval: evalAbs, typ: numericType},
+		"ceil":             ceil,
+		"ceiling":          ceil,
+		"floor":            floor,
+		"round":            {minArgs: 1, maxArgs: 2, nullIfNullArg: true, eval: evalRound, typ: numericType},
+		"concat":           {minArgs: 1, maxArgs: -1, nullIfNullArg: true, eval: evalConcat, typ: stringType},
+		"concat_ws":        {minArgs: 2, maxArgs: -1, eval: evalConcatWs, typ: stringType},
+		"lower":            lower,
+		"lcase":            lower,
+		"upper":            upper,
+		"ucase":            upper,
+		"length":           length,
+		"octet_length":     length,
+		"char_length":      charLength,
+		"character_length": charLength,
+		"left":             {minArgs: 2, maxArgs: 2, nullIfNullArg: true, eval: evalLeft, typ: stringType},
+		"right":            {minArgs: 2, maxArgs: 2, nullIfNullArg: true, eval: evalRight, typ: stringType},
+		"trim":             {minArgs: 1, maxArgs: 1, nullIfNullArg: true, eval: evalString(func(s string) string { return strings.Trim(s, " ") }), typ: stringType},
+		"ltrim":            {minArgs: 1, maxArgs: 1, nullIfNullArg: true, eval: evalString(func(s string) string { return strings.TrimLeft(s, " ") }), typ: stringType},
+		"rtrim":            {minArgs: 1, maxArgs: 1, nullIfNullArg: true, eval: evalString(func(s string) string { return strings.TrimRight(s, " ") }), typ: stringType},
+		"replace":          {minArgs: 3, maxArgs: 3, nullIfNullArg: true, eval: evalReplace, typ: stringType},
+		"substr":           {minArgs: 2, maxArgs: 3, nullIfNullArg: true, eval: evalSubstr, typ: stringType},
+		"substring":        {minArgs: 2, maxArgs: 3, nullIfNullArg: true, eval: evalSubstr, typ: stringType},
+		"if":               {minArgs: 3, maxArgs: 3, eval: evalIf, typ: ifType},
+		"ifnull":           {minArgs: 2, maxArgs: 2, eval: evalCoalesce, typ: mergedType},
+		"coalesce":         {minArgs: 1, maxArgs: -1, eval: evalCoalesce, typ: mergedType},
+		"nullif":           {minArgs: 2, maxArgs: 2, eval: evalNullif, typ: argType},
+		"isnull":           {minArgs: 1, maxArgs: 1, eval: evalIsnull, typ: int64Type},
+		"greatest":         {minArgs: 2, maxArgs: -1, nullIfNullArg: true, eval: evalGreatest(1), typ: mergedType},
+		"least":            {minArgs: 2, maxArgs: -1, nullIfNullArg: true, eval: evalGreatest(-1), typ: mergedType},
+		"date":             {minArgs: 1, maxArgs: 1, nullIfNullArg: true, eval: evalDate, typ: dateType},
+		"year":             {minArgs: 1, maxArgs: 1, nullIfNullArg: true, eval: evalDatePart(0), typ: int64Type},
+		"month":            {minArgs: 1, maxArgs: 1, nullIfNullArg: true, eval: evalDatePart(1), typ: int64Type},
+		"day":              day,
+		"dayofmonth":       day,
+		"hour":             {minArgs: 1, maxArgs: 1, nullIfNullArg: true, eval: evalTimePart(0), typ: int64Type},
+		"minute":           {minArgs: 1, maxArgs: 1, nullIfNullArg: true, eval: evalTimePart(1), typ: int64Type},
+		"second":           {minArgs: 1, maxArgs: 1, nullIfNullArg: true, eval: evalTimePart(2), typ: int64Type},
+	}
+}
+
+// Type functions.
+
+func argType(env ExpressionEnv, args []Expr) querypb.Type {
+	return args[0].Type(env)
+}
+
+func numericType(env ExpressionEnv, args []Expr) querypb.Type {
+	typ := args[0].Type(env)
+	if !isNumber(typ) {
+		return sqltypes.Float64
+	}
+	return typ
+}
+
+func roundedType(env ExpressionEnv, args []Expr) querypb.Type {
+	if typ := args[0].Type(env); sqltypes.IsIntegral(typ) || typ == sqltypes.Decimal {
+		return sqltypes.Int64
+	}
+	return sqltypes.Float64
+}
+
+func stringType(env ExpressionEnv, args []Expr) querypb.Type {
+	for _, arg := range args {
+		if sqltypes.IsBinary(arg.Type(env)) {
+			return sqltypes.VarBinary
+		}
+	}
+	return sqltypes.VarChar
+}
+
+func int64Type(env ExpressionEnv, args []Expr) querypb.Type {
+	return sqltypes.Int64
+}
+
+func dateType(env ExpressionEnv, args []Expr) querypb.Type {
+	return sqltypes.Date
+}
+
+func mergedType(env ExpressionEnv, args []Expr) querypb.Type {
+	return mergeTypes(env, args)
+}
+
+func ifType(env ExpressionEnv, args []Expr) querypb.Type {
+	return mergeTypes(env, args[1:])
+}
+
+// Numeric functions.
+
+func evalAbs(args []sqltypes.Value, typ querypb.Type, _ Collation) (sqltypes.Value, error) {
+	v := args[0]
+	switch {
+	case sqltypes.IsUnsigned(typ):
+		return v, nil
+	case sqltypes.IsSigned(typ):
+		i, err := sqltypes.ToInt64(v)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if i == math.MinInt64 {
+			return sqltypes.NULL, fmt.Errorf("BIGINT value is out of range in abs(%d)", i)
+		}
+		if i < 0 {
+			i = -i
+		}
+		return sqltypes.NewInt64(i), nil
+	case typ == sqltypes.Decimal:
+		d, err := newDecimal(v)
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		return d.abs().toValue(), nil
+	}
+	f, err := ToFloat64(v)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	return castFloat(math.Abs(f), typ)
+}
+
+func evalRounding(round func(float64) float64, roundDecimal func(decimal) *big.Int) func([]sqltypes.Value, querypb.Type, Collation) (sqltypes.Value, error) {
+	return func(args []sqltypes.Value, typ querypb.Type, _ Collation) (sqltypes.Value, error) {
+		if args[0].IsIntegral() {
+			return sqltypes.Cast(args[0], typ)
+		}
+		if args[0].Type() == sqltypes.Decimal {
+			d, err := newDecimal(args[0])
+			if err != nil {
+				return sqltypes.NULL, err
+			}
+			return sqltypes.Cast(sqltypes.MakeTrusted(sqltypes.Decimal, []byte(roundDecimal(d).String())), typ)
+		}
+		f, err := ToFloat64(args[0])
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		return castFloat(round(f), typ)
+	}
+}
+
+func evalRound(args []sqltypes.Value, typ querypb.Type, _ Collation) (sqltypes.Value, error) {
+	var decimals int64
+	if len(args) == 2 {
+		var err error
+		if decimals, err = toInt64(args[1]); err != nil {
+			return sqltypes.NULL, err
+		}
+	}
+	if args[0].IsIntegral() && decimals >= 0 {
+		return args[0], nil
+	}
+	// Like mysql, halves are rounded away from zero.
+	if typ == sqltypes.Decimal {
+		d, err := newDecimal(args[0])
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		if decimals > maxDecimalScale {
+			decimals = maxDecimalScale
+		}
+		return d.round(int(decimals)).toValue(), nil
+	}
+	f, err := ToFloat64(args[0])
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	scale := math.Pow(10, float64(decimals))
+	return castFloat(math.Round(f*scale)/scale, typ)
+}
+
+// String functions.
+
+func evalString(fn func(string) string) func([]sqltypes.Value, querypb.Type, Collation) (sqltypes.Value, error) {
+	return func(args []sqltypes.Value, typ querypb.Type, _ Collation) (sqltypes.Value, error) {
+		return sqltypes.MakeTrusted(typ, []byte(fn(args[0].ToString()))), nil
+	}
+}
+
+func evalConcat(args []sqltypes.Value, typ querypb.Type, _ Collation) (sqltypes.Value, error) {
+	var buf []byte
+	for _, arg := range args {
+		buf = append(buf, arg.ToBytes()...)
+	}
+	return sqltypes.MakeTrusted(typ, buf), nil
+}
+
+func evalConcatWs(args []sqltypes.Value, typ querypb.Type, _ Collation) (sqltypes.Value, error) {
+	if args[0].IsNull() {
+		return sqltypes.NULL, nil
+	}
+	var parts []string
+	for _, arg := range args[1:] {
+		if arg.IsNull() {
+			continue
+		}
+		parts = append(parts, arg.ToString())
+	}
+	return sqltypes.MakeTrusted(typ, []byte(strings.Join(parts, args[0].ToString()))), nil
+}
+
+func evalLength(args []sqltypes.Value, _ querypb.Type, _ Collation) (sqltypes.Value, error) {
+	return sqltypes.NewInt64(int64(len(args[0].ToBytes()))), nil
+}
+
+func evalCharLength(args []sqltypes.Value, _ querypb.Type, _ Collation) (sqltypes.Value, error) {
+	if args[0].IsBinary() {
+		return evalLength(args, sqltypes.Int64, CollationBinary)
+	}
+	return sqltypes.NewInt64(int64(utf8.RuneCount(args[0].ToBytes()))), nil
+}
+
+func evalLeft(args []sqltypes.Value, typ querypb.Type, _ Collation) (sqltypes.Value, error) {
+	n, err := toInt64(args[1])
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	runes := []rune(args[0].ToString())
+	n = clamp(n, 0, int64(len(runes)))
+	return sqltypes.MakeTrusted(typ, []byte(string(runes[:n]))), nil
+}
+
+func evalRight(args []sqltypes.Value, typ querypb.Type, _ Collation) (sqltypes.Value, error) {
+	n, err := toInt64(args[1])
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	runes := []rune(args[0].ToString())
+	n = clamp(n, 0, int64(len(runes)))
+	return sqltypes.MakeTrusted(typ, []byte(string(runes[int64(len(runes))-n:]))), nil
+}
+
+func evalReplace(args []sqltypes.Value, typ querypb.Type, _ Collation) (sqltypes.Value, error) {
+	from := args[1].ToString()
+	if from == "" {
+		return sqltypes.MakeTrusted(typ, args[0].ToBytes()), nil
+	}
+	return sqltypes.MakeTrusted(typ, []byte(strings.Replace(args[0].ToString(), from, args[2].ToString(), -1))), nil
+}
+
+// evalSubstr implements substring(str, pos[, len]). Positions
+// start at 1, and negative positions count from the end.
+func evalSubstr(args []sqltypes.Value, typ querypb.Type, _ Collation) (sqltypes.Value, error) {
+	runes := []rune(args[0].ToString())
+	size := int64(len(runes))
+	pos, err := toInt64(args[1])
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch {
+	case pos > 0:
+		pos--
+	case pos < 0:
+		pos += size
+	default:
+		return sqltypes.MakeTrusted(typ, nil), nil
+	}
+	if pos < 0 || pos >= size {
+		return sqltypes.MakeTrusted(typ, nil), nil
+	}
+	end := size
+	if len(args) == 3 {
+		n, err := toInt64(args[2])
+		if err != nil {
+			return sqltypes.NULL, err
+		}
+		end = clamp(pos+n, pos, size)
+	}
+	return sqltypes.MakeTrusted(typ, []byte(string(runes[pos:end]))), nil
+}
+
+// Control flow functions.
+
+func evalIf(args []sqltypes.Value, typ querypb.Type, _ Collation) (sqltypes.Value, error) {
+	truth, err := isTrue(args[0])
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if truth {
+		return castValue(args[1], typ)
+	}
+	return castValue(args[2], typ)
+}
+
+func evalCoalesce(args []sqltypes.Value, typ querypb.Type, _ Collation) (sqltypes.Value, error) {
+	for _, arg := range args {
+		if !arg.IsNull() {
+			return castValue(arg, typ)
+		}
+	}
+	return sqltypes.NULL, nil
+}
+
+func evalNullif(args []sqltypes.Value, typ querypb.Type, collation Collation) (sqltypes.Value, error) {
+	if args[0].IsNull() || args[1].IsNull() {
+		return args[0], nil
+	}
+	cmp, err := Compare(args[0], args[1], collation)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if cmp == 0 {
+		return sqltypes.NULL, nil
+	}
+	return castValue(args[0], typ)
+}
+
+func evalIsnull(args []sqltypes.Value, _ querypb.Type, _ Collation) (sqltypes.Value, error) {
+	return boolValue(args[0].IsNull()), nil
+}
+
+// evalGreatest returns greatest if sign is 1, and least if sign is -1.
+func evalGreatest(sign int) func([]sqltypes.Value, querypb.Type, Collation) (sqltypes.Value, error) {
+	return func(args []sqltypes.Value, typ querypb.Type, collation Collation) (sqltypes.Value, error) {
+		result := args[0]
+		for _, arg := range args[1:] {
+			cmp, err := Compare(arg, result, collation)
+			if err != nil {
+				return sqltypes.NULL, err
+			}
+			if cmp*sign > 0 {
+				result = arg
+			}
+		}
+		return castValue(result, typ)
+	}
+}
+
+// Date functions. Values that cannot be parsed as dates return NULL.
+
+func evalDate(args []sqltypes.Value, _ querypb.Type, _ Collation) (sqltypes.Value, error) {
+	date, _, ok := parseDatetime(args[0])
+	if !ok || date == nil {
+		return sqltypes.NULL, nil
+	}
+	return sqltypes.MakeTrusted(sqltypes.Date, []byte(fmt.Sprintf("%04d-%02d-%02d", date[0], date[1], date[2]))), nil
+}
+
+func evalDatePart(part int) func([]sqltypes.Value, querypb.Type, Collation) (sqltypes.Value, error) {
+	return func(args []sqltypes.Value, _ querypb.Type, _ Collation) (sqltypes.Value, error) {
+		date, _, ok := parseDatetime(args[0])
+		if !ok || date == nil {
+			return sqltypes.NULL, nil
+		}
+		return sqltypes.NewInt64(date[part]), nil
+	}
+}
+
+func evalTimePart(part int) func([]sqltypes.Value, querypb.Type, Collation) (sqltypes.Value, error) {
+	return func(args []sqltypes.Value, _ querypb.Type, _ Collation) (sqltypes.Value, error) {
+		_, clock, ok := parseDatetime(args[0])
+		if !ok {
+			return sqltypes.NULL, nil
+		}
+		if clock == nil {
+			return sqltypes.NewInt64(0), nil
+		}
+		return sqltypes.NewInt64(clock[part]), nil
+	}
+}
+
+// parseDatetime parses a DATE, DATETIME, TIMESTAMP or TIME value.
+// It returns the year, month and day, and the hour, minute and second.
+// The date is nil for a TIME, and the clock is nil for a DATE.
+func parseDatetime(v sqltypes.Value) (date, clock []int64, ok bool) {
+	str := strings.TrimSpace(v.ToString())
+	if v.Type() == sqltypes.Time {
+		clock, ok = parseParts(str, ":", 3)
+		return nil, clock, ok
+	}
+	datePart, clockPart := str, ""
+	if i := strings.IndexAny(str, " T"); i >= 0 {
+		datePart, clockPart = str[:i], str[i+1:]
+	}
+	if date, ok = parseParts(datePart, "-", 3); !ok {
+		return nil, nil, false
+	}
+	if date[1] < 0 || date[1] > 12 || date[2] < 0 || date[2] > 31 {
+		return nil, nil, false
+	}
+	if clockPart == "" {
+		return date, nil, true
+	}
+	if clock, ok = parseParts(clockPart, ":", 3); !ok {
+		return nil, nil, false
+	}
+	return date, clock, true
+}
+
+// parseParts parses count integers separated by sep.
+// Fractional seconds of the last part are ignored.
+func parseParts(str, sep string, count int) ([]int64, bool) {
+	parts := strings.Split(str, sep)
+	if len(parts) != count {
+		return nil, false
+	}
+	if i := strings.IndexByte(parts[count-1], '.'); i >= 0 {
+		parts[count-1] = parts[count-1][:i]
+	}
+	result := make([]int64, 0, count)
+	for _, part := range parts {
+		n, err := strconv.ParseInt(part, 10, 64)
+		if err != nil {
+			return nil, false
+		}
+		result = append(result, n)
+	}
+	return result, true
+}
+
+// Helpers.
+
+// castValue converts v to typ. Strings are
+// converted to numbers on a best effort basis.
+func castValue(v sqltypes.Value, typ querypb.Type) (sqltypes.Value, error) {
+	return castTo(&Literal{Val: v}, ExpressionEnv{}, typ)
+}
+
+func toInt64(v sqltypes.Value) (int64, error) {
+	if v.IsIntegral() {
+		return sqltypes.ToInt64(v)
+	}
+	f, err := ToFloat64(v)
+	if err != nil {
+		return 0, err
+	}
+	return int64(math.Round(f)), nil
+}
+
+func clamp(n, min, max int64) int64 {
+	switch {
+	case n < min:
+		return min
+	case n > max:
+		return max
+	}
+	return n
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var (
+	_ Expr = (*And)(nil)
+	_ Expr = (*Or)(nil)
+	_ Expr = (*Not)(nil)
+	_ Expr = (*Is)(nil)
+)
+
+// And is the logical AND. It follows the mysql three-valued logic:
+// the result is 0 if either side is false, NULL if either side
+// is NULL, and 1 otherwise.
+type And struct {
+	Left, Right Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (a *And) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	lv, ltruth, err := evaluateBool(a.Left, env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if !lv.IsNull() && !ltruth {
+		return boolValue(false), nil
+	}
+	rv, rtruth, err := evaluateBool(a.Right, env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch {
+	case !rv.IsNull() && !rtruth:
+		return boolValue(false), nil
+	case lv.IsNull() || rv.IsNull():
+		return sqltypes.NULL, nil
+	}
+	return boolValue(true), nil
+}
+
+// Type satisfies the Expr interface.
+func (a *And) Type(env ExpressionEnv) querypb.Type {
+	return sqltypes.Int64
+}
+
+// String satisfies the Expr interface.
+func (a *And) String() string {
+	return fmt.Sprintf("%s and %s", paren(a.Left), paren(a.Right))
+}
+
+// Or is the logical OR. It follows the mysql three-valued logic:
+// the result is 1 if either side is true, NULL if either side
+// is NULL, and 0 otherwise.
+type Or struct {
+	Left, Right Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (o *Or) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	lv, ltruth, err := evaluateBool(o.Left, env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	if ltruth {
+		return boolValue(true), nil
+	}
+	rv, rtruth, err := evaluateBool(o.Right, env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	switch {
+	case rtruth:
+		return boolValue(true), nil
+	case lv.IsNull() || rv.IsNull():
+		return sqltypes.NULL, nil
+	}
+	return boolValue(false), nil
+}
+
+// Type satisfies the Expr interface.
+func (o *Or) Type(env ExpressionEnv) querypb.Type {
+	return sqltypes.Int64
+}
+
+// String satisfies the Expr interface.
+func (o *Or) String() string {
+	return fmt.Sprintf("%s or %s", paren(o.Left), paren(o.Right))
+}
+
+// Not is the logical NOT. NOT NULL is NULL.
+type Not struct {
+	Expr Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (n *Not) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	v, truth, err := evaluateBool(n.Expr, env)
+	if err != nil || v.IsNull() {
+		return sqltypes.NULL, err
+	}
+	return boolValue(!truth), nil
+}
+
+// Type satisfies the Expr interface.
+func (n *Not) Type(env ExpressionEnv) querypb.Type {
+	return sqltypes.Int64
+}
+
+// String satisfies the Expr interface.
+func (n *Not) String() string {
+	return "not " + paren(n.Expr)
+}
+
+// IsOp is the operator of an IS expression.
+type IsOp int
+
+// These are the supported IS operators.
+const (
+	IsNull = IsOp(iota)
+	IsNotNull
+	IsTrue
+	IsNotTrue
+	IsFalse
+	IsNotFalse
+)
+
+var isOpNames = map[IsOp]string{
+	IsNull:     "is null",
+	IsNotNull:  "is not null",
+	IsTrue:     "is true",
+	IsNotTrue:  "is not true",
+	IsFalse:    "is false",
+	IsNotFalse: "is not false",
+}
+
+func (op IsOp) String() string {
+	return isOpNames[op]
+}
+
+// Is is an IS ... expression. The result is never NULL.
+type Is struct {
+	Op   IsOp
+	Expr Expr
+}
+
+// Evaluate satisfies the Expr interface.
+func (i *Is) Evaluate(env ExpressionEnv) (sqltypes.Value, error) {
+	v, truth, err := evaluateBool(i.Expr, env)
+	if err != nil {
+		return sqltypes.NULL, err
+	}
+	isFalse := !v.IsNull() && !truth
+	switch i.Op {
+	case IsNull:
+		return boolValue(v.IsNull()), nil
+	case IsNotNull:
+		return boolValue(!v.IsNull()), nil
+	case IsTrue:
+		return boolValue(truth), nil
+	case IsNotTrue:
+		return boolValue(!truth), nil
+	case IsFalse:
+		return boolValue(isFalse), nil
+	case IsNotFalse:
+		return boolValue(!isFalse), nil
+	}
+	return sqltypes.NULL, fmt.Errorf("BUG: unexpected is operator: %v", i.Op)
+}
+
+// Type satisfies the Expr interface.
+func (i *Is) Type(env ExpressionEnv) querypb.Type {
+	return sqltypes.Int64
+}
+
+// String satisfies the Expr interface.
+func (i *Is) String() string {
+	return fmt.Sprintf("%s %s", paren(i.Expr), i.Op)
+}
+
+// IsSatisfied evaluates the condition and returns true if the
+// result is true. Like in a WHERE clause, NULL is not true.
+func IsSatisfied(cond Expr, env ExpressionEnv) (bool, error) {
+	_, truth, err := evaluateBool(cond, env)
+	return truth, err
+}
+
+// evaluateBool evaluates expr and also returns
+// whether the result is true.
+func evaluateBool(expr Expr, env ExpressionEnv) (sqltypes.Value, bool, error) {
+	v, err := expr.Evaluate(env)
+	if err != nil {
+		return sqltypes.NULL, false, err
+	}
+	truth, err := isTrue(v)
+	return v, truth, err
+}
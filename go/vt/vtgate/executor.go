@@ -24,6 +24,7 @@ import (
 	"net/http"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -46,6 +47,7 @@ import (
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vtgate/vschemaacl"
@@ -96,6 +98,9 @@ type Executor struct {
 	vschemaStats *VSchemaStats
 
 	vm VSchemaManager
+
+	// plist tracks the connections of the MySQL server.
+	plist *processList
 }
 
 var executorOnce sync.Once
@@ -115,6 +120,7 @@ func NewExecutor(ctx context.Context, serv srvtopo.Server, cell, statsName strin
 		plans:       cache.NewLRUCache(queryPlanCacheSize),
 		normalize:   normalize,
 		streamSize:  streamSize,
+		plist:       newProcessList(*processListAuthorizedUsers),
 	}
 
 	vschemaacl.Init()
@@ -178,6 +184,9 @@ func (e *Executor) execute(ctx context.Context, safeSession *SafeSession, sql st
 	if safeSession.InTransaction() && destTabletType != topodatapb.TabletType_MASTER {
 		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "transactions are supported only for master tablet types, current type: %v", destTabletType)
 	}
+	if safeSession.InReservedConn() && destTabletType != topodatapb.TabletType_MASTER {
+		return nil, errReservedConnNotMaster(destTabletType)
+	}
 	if bindVars == nil {
 		bindVars = make(map[string]*querypb.BindVariable)
 	}
@@ -244,8 +253,10 @@ func (e *Executor) execute(ctx context.Context, safeSession *SafeSession, sql st
 		return e.handleCommit(ctx, safeSession, sql, bindVars, logStats)
 	case sqlparser.StmtRollback:
 		return e.handleRollback(ctx, safeSession, sql, bindVars, logStats)
+	case sqlparser.StmtSavepoint, sqlparser.StmtSRollback, sqlparser.StmtRelease:
+		return e.handleSavepoint(ctx, safeSession, sql, logStats)
 	case sqlparser.StmtSet:
-		return e.handleSet(ctx, safeSession, sql, bindVars, logStats)
+		return e.handleSet(ctx, safeSession, sql, bindVars, destTabletType, logStats)
 	case sqlparser.StmtShow:
 		return e.handleShow(ctx, safeSession, sql, bindVars, dest, destKeyspace, destTabletType, logStats)
 	case sqlparser.StmtUse:
@@ -254,6 +265,8 @@ func (e *Executor) execute(ctx context.Context, safeSession *SafeSession, sql st
 		return e.handleOther(ctx, safeSession, sql, bindVars, dest, destKeyspace, destTabletType, logStats)
 	case sqlparser.StmtComment:
 		return e.handleComment(sql)
+	case sqlparser.StmtKill:
+		return e.handleKill(ctx, sql, logStats)
 	}
 	return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unrecognized statement: %s", sql)
 }
@@ -279,11 +292,14 @@ func (e *Executor) handleExec(ctx context.Context, safeSession *SafeSession, sql
 
 		execStart := time.Now()
 		sql = sqlannotation.AnnotateIfDML(sql, nil)
+		// Queries that vtgate can't parse are passed through as is,
+		// and the error is left to vttablet, unless they have to be
+		// normalized first.
+		query, comments := sqlparser.SplitMarginComments(sql)
+		stmt, parseErr := sqlparser.Parse(query)
 		if e.normalize {
-			query, comments := sqlparser.SplitMarginComments(sql)
-			stmt, err := sqlparser.Parse(query)
-			if err != nil {
-				return nil, err
+			if parseErr != nil {
+				return nil, parseErr
 			}
 			rewriteResult, err := sqlparser.PrepareAST(stmt, bindVars, "vtg")
 			if err != nil {
@@ -302,6 +318,14 @@ func (e *Executor) handleExec(ctx context.Context, safeSession *SafeSession, sql
 			if rewriteResult.NeedLastInsertID {
 				bindVars[sqlparser.LastInsertIDName] = sqltypes.Uint64BindVariable(safeSession.GetLastInsertId())
 			}
+			for _, name := range rewriteResult.NeedUserDefinedVariables {
+				bindVars[sqlparser.UserDefinedVariableName+name] = safeSession.UserDefinedVariable(name)
+			}
+		}
+		if parseErr == nil && sqlparser.NeedsReservedConn(stmt) {
+			if err := setUnreplayableState(safeSession, destTabletType); err != nil {
+				return nil, err
+			}
 		}
 		logStats.PlanTime = execStart.Sub(logStats.StartTime)
 		logStats.SQL = sql
@@ -330,6 +354,11 @@ func (e *Executor) handleExec(ctx context.Context, safeSession *SafeSession, sql
 		logStats.Error = err
 		return nil, err
 	}
+	if plan.NeedsReservedConn {
+		if err := setUnreplayableState(safeSession, destTabletType); err != nil {
+			return nil, err
+		}
+	}
 
 	bindVarNeeds := plan.BindVarNeeds
 	if bindVarNeeds.NeedLastInsertID {
@@ -346,6 +375,9 @@ func (e *Executor) handleExec(ctx context.Context, safeSession *SafeSession, sql
 	if bindVarNeeds.NeedFoundRows {
 		bindVars[sqlparser.FoundRowsName] = sqltypes.Uint64BindVariable(safeSession.FoundRows)
 	}
+	for _, name := range bindVarNeeds.NeedUserDefinedVariables {
+		bindVars[sqlparser.UserDefinedVariableName+name] = safeSession.UserDefinedVariable(name)
+	}
 
 	qr, err := plan.Instructions.Execute(vcursor, bindVars, true)
 	logStats.ExecuteTime = time.Since(execStart)
@@ -374,6 +406,31 @@ func (e *Executor) handleExec(ctx context.Context, safeSession *SafeSession, sql
 	return qr, err
 }
 
+// setReservedConn marks the session as needing reserved connections.
+// Like transactions, they're only supported for master tablet types.
+func setReservedConn(safeSession *SafeSession, destTabletType topodatapb.TabletType) error {
+	if destTabletType != topodatapb.TabletType_MASTER {
+		return errReservedConnNotMaster(destTabletType)
+	}
+	safeSession.SetReservedConn()
+	return nil
+}
+
+// setUnreplayableState marks the session as needing reserved connections
+// for state that can't be restored on new connections, like temporary
+// tables and named locks.
+func setUnreplayableState(safeSession *SafeSession, destTabletType topodatapb.TabletType) error {
+	if err := setReservedConn(safeSession, destTabletType); err != nil {
+		return err
+	}
+	safeSession.SetUnreplayableState()
+	return nil
+}
+
+func errReservedConnNotMaster(destTabletType topodatapb.TabletType) error {
+	return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "reserved connections are supported only for master tablet types, current type: %v", destTabletType)
+}
+
 func (e *Executor) destinationExec(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, dest key.Destination, destKeyspace string, destTabletType topodatapb.TabletType, logStats *LogStats) (*sqltypes.Result, error) {
 	return e.resolver.Execute(ctx, sql, bindVars, destKeyspace, destTabletType, dest, safeSession.Session, false /* notInTransaction */, safeSession.Options, logStats)
 }
@@ -402,6 +459,11 @@ func (e *Executor) handleDDL(ctx context.Context, safeSession *SafeSession, sql
 		default:
 			// fallthrough to broadcast the ddl to all shards
 		}
+		if ddl.Temporary {
+			if err := setUnreplayableState(safeSession, destTabletType); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	if destKeyspace == "" {
@@ -493,7 +555,38 @@ func (e *Executor) handleRollback(ctx context.Context, safeSession *SafeSession,
 	return &sqltypes.Result{}, err
 }
 
-func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, logStats *LogStats) (*sqltypes.Result, error) {
+func (e *Executor) handleSavepoint(ctx context.Context, safeSession *SafeSession, sql string, logStats *LogStats) (*sqltypes.Result, error) {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	execStart := time.Now()
+	logStats.PlanTime = execStart.Sub(logStats.StartTime)
+	logStats.ShardQueries = uint32(len(safeSession.ShardSessions))
+	defer func() {
+		logStats.ExecuteTime = time.Since(execStart)
+	}()
+
+	switch stmt := stmt.(type) {
+	case *sqlparser.Savepoint:
+		e.updateQueryCounts("Savepoint", "", "", int64(logStats.ShardQueries))
+		err = e.txConn.Savepoint(ctx, safeSession, stmt.Name.String())
+	case *sqlparser.SRollback:
+		e.updateQueryCounts("SavepointRollback", "", "", int64(logStats.ShardQueries))
+		err = e.txConn.RollbackToSavepoint(ctx, safeSession, stmt.Name.String())
+	case *sqlparser.Release:
+		e.updateQueryCounts("Release", "", "", int64(logStats.ShardQueries))
+		err = e.txConn.ReleaseSavepoint(ctx, safeSession, stmt.Name.String())
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unrecognized statement: %s", sql)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{}, nil
+}
+
+func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, destTabletType topodatapb.TabletType, logStats *LogStats) (*sqltypes.Result, error) {
 	vals, scope, err := sqlparser.ExtractSetValues(sql)
 	execStart := time.Now()
 	logStats.PlanTime = execStart.Sub(logStats.StartTime)
@@ -509,12 +602,18 @@ func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql
 		return &sqltypes.Result{}, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported in set: global")
 	}
 
+	sysVars := make(map[string]string)
 	for k, v := range vals {
 		switch k.Scope {
 		case sqlparser.GlobalStr:
 			return &sqltypes.Result{}, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported in set: global")
 		case sqlparser.VitessMetadataStr:
 			return e.handleSetVitessMetadata(ctx, safeSession, k, v)
+		case sqlparser.UserDefinedStr:
+			if err := setUserDefinedVariable(safeSession, k.Key, v.(sqlparser.Expr), bindVars); err != nil {
+				return nil, err
+			}
+			continue
 		}
 
 		switch k.Key {
@@ -691,12 +790,107 @@ func (e *Executor) handleSet(ctx context.Context, safeSession *SafeSession, sql
 				return nil, fmt.Errorf("unexpected value for charset/names: %v", val)
 			}
 		default:
-			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported construct: %s", sql)
+			// The variables that vtgate doesn't know about are set
+			// by MySQL, which requires reserved connections.
+			expr, err := systemVariableExpr(v)
+			if err != nil {
+				return nil, err
+			}
+			sysVars[k.Key] = expr
+		}
+	}
+	if len(sysVars) != 0 {
+		if err := e.setSystemVariables(ctx, safeSession, destTabletType, sysVars); err != nil {
+			return nil, err
 		}
 	}
 	return &sqltypes.Result{}, nil
 }
 
+// setSystemVariables sets the system variables on the reserved connections
+// of the session, and records them in the session, so that they're also set
+// on the connections that are reserved later.
+func (e *Executor) setSystemVariables(ctx context.Context, safeSession *SafeSession, destTabletType topodatapb.TabletType, sysVars map[string]string) error {
+	if err := setReservedConn(safeSession, destTabletType); err != nil {
+		return err
+	}
+	if err := e.txConn.ExecuteReserved(ctx, safeSession, setSystemVariablesQuery(sysVars)); err != nil {
+		return err
+	}
+	for name, expr := range sysVars {
+		safeSession.SetSystemVariable(name, expr)
+	}
+	return nil
+}
+
+// setSystemVariablesQuery returns the SET statement
+// that sets the system variables, sorted by name.
+func setSystemVariablesQuery(sysVars map[string]string) string {
+	names := make([]string, 0, len(sysVars))
+	for name := range sysVars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.WriteString("set ")
+	for i, name := range names {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.Myprintf("@@%v = %s", sqlparser.NewColIdent(name), sysVars[name])
+	}
+	return buf.String()
+}
+
+// systemVariableExpr returns the value of a system variable,
+// as returned by sqlparser.ExtractSetValues, as a SQL expression.
+func systemVariableExpr(v interface{}) (string, error) {
+	switch v := v.(type) {
+	case nil:
+		return "null", nil
+	case int64:
+		return strconv.FormatInt(v, 10), nil
+	case string:
+		if v == "default" {
+			return v, nil
+		}
+		buf := &bytes.Buffer{}
+		sqltypes.NewVarChar(v).EncodeSQL(buf)
+		return buf.String(), nil
+	}
+	return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unexpected value type for system variable: %T", v)
+}
+
+// setUserDefinedVariable evaluates the expression assigned to a
+// user-defined variable, and stores the value in the session.
+// The expression can reference other user-defined variables.
+func setUserDefinedVariable(safeSession *SafeSession, name string, expr sqlparser.Expr, bindVars map[string]*querypb.BindVariable) error {
+	var row []sqltypes.Value
+	var fields []*querypb.Field
+	eexpr, err := evalengine.Convert(expr, func(col *sqlparser.ColName) (int, error) {
+		name, ok := sqlparser.UserDefinedVariable(col)
+		if !ok {
+			return 0, fmt.Errorf("column reference: %s", sqlparser.String(col))
+		}
+		val, err := sqltypes.BindVariableToValue(safeSession.UserDefinedVariable(name))
+		if err != nil {
+			return 0, err
+		}
+		row = append(row, val)
+		fields = append(fields, &querypb.Field{Type: val.Type()})
+		return len(row) - 1, nil
+	})
+	if err != nil {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported value for @%s: %v", name, err)
+	}
+	val, err := eexpr.Evaluate(evalengine.ExpressionEnv{BindVars: bindVars, Row: row, Fields: fields})
+	if err != nil {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid value for @%s: %v", name, err)
+	}
+	safeSession.SetUserDefinedVariable(name, sqltypes.ValueBindVariable(val))
+	return nil
+}
+
 func (e *Executor) handleSetVitessMetadata(ctx context.Context, session *SafeSession, k sqlparser.SetKey, v interface{}) (*sqltypes.Result, error) {
 	//TODO(kalfonso): move to its own acl check and consolidate into an acl component that can handle multiple operations (vschema, metadata)
 	allowed := vschemaacl.Authorized(callerid.ImmediateCallerIDFromContext(ctx))
@@ -1083,6 +1277,9 @@ func (e *Executor) handleShow(ctx context.Context, safeSession *SafeSession, sql
 			Rows:         rows,
 			RowsAffected: uint64(len(rows)),
 		}, nil
+	case sqlparser.KeywordString(sqlparser.PROCESSLIST):
+		full := show.ShowTablesOpt != nil && show.ShowTablesOpt.Full != ""
+		return e.plist.result(callerid.ImmediateCallerIDFromContext(ctx).GetUsername(), full), nil
 	case sqlparser.KeywordString(sqlparser.WARNINGS):
 		fields := []*querypb.Field{
 			{Name: "Level", Type: sqltypes.VarChar},
@@ -1137,6 +1334,17 @@ func (e *Executor) handleUse(ctx context.Context, safeSession *SafeSession, sql
 }
 
 func (e *Executor) handleOther(ctx context.Context, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, dest key.Destination, destKeyspace string, destTabletType topodatapb.TabletType, logStats *LogStats) (*sqltypes.Result, error) {
+	// Statements that can't be parsed are sent as is.
+	if stmt, err := sqlparser.Parse(sql); err == nil {
+		if explain, ok := stmt.(*sqlparser.Explain); ok {
+			switch explain.Type {
+			case sqlparser.ExplainVitessStr:
+				return e.handleExplainVitess(ctx, safeSession, explain, bindVars, destKeyspace, destTabletType, logStats)
+			case sqlparser.ExplainVTExplainStr:
+				return e.handleExplainVTExplain(ctx, safeSession, explain, bindVars, logStats)
+			}
+		}
+	}
 	if destKeyspace == "" {
 		return nil, errNoKeyspace
 	}
@@ -1167,12 +1375,97 @@ func (e *Executor) handleOther(ctx context.Context, safeSession *SafeSession, sq
 	return result, err
 }
 
+// handleExplainVitess returns the plan of the statement without executing it.
+// Like EXPLAIN FORMAT=JSON in MySQL, the plan is returned as a single row.
+func (e *Executor) handleExplainVitess(ctx context.Context, safeSession *SafeSession, explain *sqlparser.Explain, bindVars map[string]*querypb.BindVariable, destKeyspace string, destTabletType topodatapb.TabletType, logStats *LogStats) (*sqltypes.Result, error) {
+	vcursor := newVCursorImpl(ctx, safeSession, destKeyspace, destTabletType, sqlparser.MarginComments{}, e, logStats)
+	plan, err := e.getPlan(
+		vcursor,
+		sqlparser.String(explain.Statement),
+		sqlparser.MarginComments{},
+		bindVars,
+		skipQueryPlanCache(safeSession),
+		logStats,
+	)
+	if err != nil {
+		return nil, err
+	}
+	// The execution stats of the plan are left out.
+	buf, err := json.MarshalIndent(&engine.Plan{Original: plan.Original, Instructions: plan.Instructions}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{
+		Fields:       buildVarCharFields("EXPLAIN"),
+		Rows:         [][]sqltypes.Value{buildVarCharRow(string(buf))},
+		RowsAffected: 1,
+	}, nil
+}
+
+// handleExplainVTExplain executes the statement and returns
+// the queries that were sent to each shard, in the order in which
+// they were sent. Queries sent in the same round trip share the same #.
+func (e *Executor) handleExplainVTExplain(ctx context.Context, safeSession *SafeSession, explain *sqlparser.Explain, bindVars map[string]*querypb.BindVariable, logStats *LogStats) (*sqltypes.Result, error) {
+	safeSession.EnableLogging()
+	defer safeSession.DisableLogging()
+	if _, err := e.execute(ctx, safeSession, sqlparser.String(explain.Statement), bindVars, logStats); err != nil {
+		return nil, err
+	}
+
+	fields := []*querypb.Field{{
+		Name:  "#",
+		Type:  sqltypes.Int32,
+		Flags: uint32(querypb.MySqlFlag_NOT_NULL_FLAG),
+	}}
+	fields = append(fields, buildVarCharFields("keyspace", "shard", "query")...)
+	entries := safeSession.logEntries()
+	rows := make([][]sqltypes.Value, 0, len(entries))
+	for _, entry := range entries {
+		row := append([]sqltypes.Value{sqltypes.NewInt32(int32(entry.ID))}, buildVarCharRow(entry.Keyspace, entry.Shard, entry.Query)...)
+		rows = append(rows, row)
+	}
+	return &sqltypes.Result{
+		Fields:       fields,
+		Rows:         rows,
+		RowsAffected: uint64(len(rows)),
+	}, nil
+}
+
 func (e *Executor) handleComment(sql string) (*sqltypes.Result, error) {
 	_, _ = sqlparser.ExtractMysqlComment(sql)
 	// Not sure if this is a good idea.
 	return &sqltypes.Result{}, nil
 }
 
+// handleKill kills a connection of the MySQL server, or the statement
+// it executes. Killing the statement cancels its context, which also
+// kills the queries it sent to the tablets. The caller must own the
+// connection, unless it's authorized by -processlist_authorized_users.
+func (e *Executor) handleKill(ctx context.Context, sql string, logStats *LogStats) (*sqltypes.Result, error) {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	kill, ok := stmt.(*sqlparser.Kill)
+	if !ok {
+		// This code is unreachable.
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unrecognized KILL statement: %v", sql)
+	}
+	execStart := time.Now()
+	logStats.PlanTime = execStart.Sub(logStats.StartTime)
+	defer func() { logStats.ExecuteTime = time.Since(execStart) }()
+
+	id, err := strconv.ParseUint(string(kill.ID.(*sqlparser.SQLVal).Val), 10, 32)
+	if err != nil {
+		return nil, mysql.NewSQLError(mysql.ERNoSuchThread, mysql.SSUnknownSQLState, "Unknown thread id: %s", sqlparser.String(kill.ID))
+	}
+	caller := callerid.ImmediateCallerIDFromContext(ctx).GetUsername()
+	if err := e.plist.kill(caller, uint32(id), kill.Type == sqlparser.KillConnectionStr); err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{}, nil
+}
+
 // StreamExecute executes a streaming query.
 func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable, target querypb.Target, callback func(*sqltypes.Result) error) (err error) {
 	logStats := NewLogStats(ctx, method, sql, bindVars)
@@ -1203,6 +1496,15 @@ func (e *Executor) StreamExecute(ctx context.Context, method string, safeSession
 		logStats.Error = err
 		return err
 	}
+	if plan.NeedsReservedConn || safeSession.InReservedConn() {
+		err = vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "streaming queries are not supported on reserved connections")
+		logStats.Error = err
+		return err
+	}
+
+	for _, name := range plan.BindVarNeeds.NeedUserDefinedVariables {
+		bindVars[sqlparser.UserDefinedVariableName+name] = safeSession.UserDefinedVariable(name)
+	}
 
 	execStart := time.Now()
 	logStats.PlanTime = execStart.Sub(logStats.StartTime)
@@ -1688,7 +1990,8 @@ func (e *Executor) prepare(ctx context.Context, safeSession *SafeSession, sql st
 	case sqlparser.StmtSelect:
 		return e.handlePrepare(ctx, safeSession, sql, bindVars, destKeyspace, destTabletType, logStats)
 	case sqlparser.StmtDDL, sqlparser.StmtBegin, sqlparser.StmtCommit, sqlparser.StmtRollback, sqlparser.StmtSet, sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete,
-		sqlparser.StmtUse, sqlparser.StmtOther, sqlparser.StmtComment:
+		sqlparser.StmtUse, sqlparser.StmtOther, sqlparser.StmtComment, sqlparser.StmtSavepoint, sqlparser.StmtSRollback, sqlparser.StmtRelease,
+		sqlparser.StmtKill:
 		return nil, nil
 	case sqlparser.StmtShow:
 		res, err := e.handleShow(ctx, safeSession, sql, bindVars, dest, destKeyspace, destTabletType, logStats)
@@ -41,6 +41,7 @@ import (
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vtgate/vschemaacl"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -283,6 +284,69 @@ func TestExecutorTransactionsAutoCommit(t *testing.T) {
 	}
 }
 
+func TestExecutorSavepoints(t *testing.T) {
+	executor, sbc1, sbc2, _ := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+	exec := func(sql string) error {
+		_, err := executor.Execute(context.Background(), "TestExecute", session, sql, nil)
+		return err
+	}
+	sqls := func(sbc *sandboxconn.SandboxConn) []string {
+		var sqls []string
+		for _, query := range sbc.Queries {
+			sqls = append(sqls, query.Sql)
+		}
+		sbc.Queries = nil
+		return sqls
+	}
+
+	// Savepoints are ignored outside a transaction.
+	require.NoError(t, exec("savepoint a"))
+	require.Empty(t, session.Savepoints)
+	require.Empty(t, sqls(sbc1))
+
+	require.NoError(t, exec("begin"))
+	require.NoError(t, exec("update user set a = 1 where id = 1"))
+	require.NoError(t, exec("savepoint a"))
+	require.Equal(t, []string{"a"}, session.Savepoints)
+	require.Equal(t, []string{
+		"update user set a = 1 where id = 1 /* vtgate:: keyspace_id:166b40b44aba4bd6 */",
+		"savepoint a",
+	}, sqls(sbc1))
+
+	// The savepoint is set on a shard that joins the
+	// transaction later, before it executes anything else.
+	require.NoError(t, exec("update user set a = 2 where id = 3"))
+	require.Equal(t, []string{
+		"savepoint a",
+		"update user set a = 2 where id = 3 /* vtgate:: keyspace_id:4eb190c9a2fa169c */",
+	}, sqls(sbc2))
+
+	require.NoError(t, exec("savepoint b"))
+	require.NoError(t, exec("rollback to savepoint a"))
+	require.Equal(t, []string{"a"}, session.Savepoints)
+	require.Equal(t, []string{"savepoint b", "rollback to a"}, sqls(sbc1))
+	require.Equal(t, []string{"savepoint b", "rollback to a"}, sqls(sbc2))
+
+	err := exec("rollback to b")
+	require.EqualError(t, err, "SAVEPOINT b does not exist")
+	require.NoError(t, exec("release savepoint A"))
+	require.Empty(t, session.Savepoints)
+	require.Equal(t, []string{"release savepoint A"}, sqls(sbc1))
+	require.True(t, session.InTransaction())
+
+	// If rolling back to a savepoint fails on any shard,
+	// the whole transaction is rolled back.
+	require.NoError(t, exec("savepoint c"))
+	sbc2.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
+	err = exec("rollback to c")
+	require.EqualError(t, err, "target: TestExecutor.40-60.master, used tablet: aa-0 (40-60): INVALID_ARGUMENT error")
+	require.False(t, session.InTransaction())
+	require.Empty(t, session.Savepoints)
+	require.EqualValues(t, 1, sbc1.RollbackCount.Get())
+	require.EqualValues(t, 1, sbc2.RollbackCount.Get())
+}
+
 func TestExecutorSet(t *testing.T) {
 	executor, _, _, _ := createExecutorEnv()
 
@@ -433,7 +497,10 @@ func TestExecutorSet(t *testing.T) {
 		err: "disallowed value for character_set_results: abcd",
 	}, {
 		in:  "set foo = 1",
-		err: "unsupported construct: set foo = 1",
+		out: &vtgatepb.Session{Autocommit: true, InReservedConn: true, SystemVariables: map[string]string{"foo": "1"}},
+	}, {
+		in:  "set @@session.time_zone = '+00:00', sql_log_bin = default",
+		out: &vtgatepb.Session{Autocommit: true, InReservedConn: true, SystemVariables: map[string]string{"time_zone": "'+00:00'", "sql_log_bin": "default"}},
 	}, {
 		in:  "set names utf8",
 		out: &vtgatepb.Session{Autocommit: true},
@@ -491,6 +558,25 @@ func TestExecutorSet(t *testing.T) {
 	}, {
 		in:  "set sql_safe_updates = 2",
 		err: "unexpected value for sql_safe_updates: 2",
+	}, {
+		in: "set @Foo = 'Bar'",
+		out: &vtgatepb.Session{Autocommit: true, UserDefinedVariables: map[string]*querypb.BindVariable{
+			"foo": sqltypes.StringBindVariable("Bar"),
+		}},
+	}, {
+		in: "set @foo = 1 + 2, @bar = null",
+		out: &vtgatepb.Session{Autocommit: true, UserDefinedVariables: map[string]*querypb.BindVariable{
+			"foo": sqltypes.Int64BindVariable(3),
+			"bar": sqltypes.NullBindVariable,
+		}},
+	}, {
+		in: "set @foo = @unset",
+		out: &vtgatepb.Session{Autocommit: true, UserDefinedVariables: map[string]*querypb.BindVariable{
+			"foo": sqltypes.NullBindVariable,
+		}},
+	}, {
+		in:  "set @foo = id",
+		err: "unsupported value for @foo: column reference: id",
 	}}
 	for _, tcase := range testcases {
 		session := NewSafeSession(&vtgatepb.Session{Autocommit: true})
@@ -507,6 +593,104 @@ func TestExecutorSet(t *testing.T) {
 	}
 }
 
+func TestExecutorUserDefinedVariables(t *testing.T) {
+	executor, sbc1, _, sbclookup := createExecutorEnv()
+	executor.normalize = true
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+
+	_, err := executor.Execute(context.Background(), "TestExecute", session, "set @a = 1, @b = 'x'", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "set @c = @a + 1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.Int64BindVariable(2), session.UserDefinedVariable("c"))
+
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select id from user where id = @a and name = @B", nil)
+	require.NoError(t, err)
+	wantQueries := []*querypb.BoundQuery{{
+		Sql: "select id from user where id = :__vtudva and name = :__vtudvb",
+		BindVariables: map[string]*querypb.BindVariable{
+			"__vtudva": sqltypes.Int64BindVariable(1),
+			"__vtudvb": sqltypes.StringBindVariable("x"),
+		},
+	}}
+	assert.Equal(t, wantQueries, sbc1.Queries)
+
+	session.TargetString = KsTestUnsharded
+	_, err = executor.Execute(context.Background(), "TestExecute", session, "select @c, @unset from dual", nil)
+	require.NoError(t, err)
+	wantQueries = []*querypb.BoundQuery{{
+		Sql: "select :__vtudvc as `@c`, :__vtudvunset as `@unset` from dual",
+		BindVariables: map[string]*querypb.BindVariable{
+			"__vtudvc":     sqltypes.Int64BindVariable(2),
+			"__vtudvunset": sqltypes.NullBindVariable,
+		},
+	}}
+	assert.Equal(t, wantQueries, sbclookup.Queries)
+}
+
+func TestExecutorReservedConn(t *testing.T) {
+	executor, _, _, sbclookup := createExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+	exec := func(sql string) error {
+		_, err := executor.Execute(context.Background(), "TestExecute", session, sql, nil)
+		return err
+	}
+	sqls := func() []string {
+		var sqls []string
+		for _, query := range sbclookup.Queries {
+			sqls = append(sqls, query.Sql)
+		}
+		sbclookup.Queries = nil
+		return sqls
+	}
+
+	// Unknown system variables are only sent once a connection
+	// is reserved.
+	require.NoError(t, exec("set time_zone = '+00:00'"))
+	require.True(t, session.InReservedConn())
+	require.False(t, session.HasUnreplayableState())
+	require.Empty(t, sqls())
+
+	// Named locks can't be restored on a new connection.
+	require.NoError(t, exec("select get_lock('a', 10) from main1"))
+	require.Equal(t, []string{
+		"set @@time_zone = '+00:00'",
+		"select get_lock('a', 10) from main1",
+	}, sqls())
+	require.True(t, session.HasUnreplayableState())
+	require.EqualValues(t, 1, sbclookup.ReserveCount.Get())
+	require.Len(t, session.ShardSessions, 1)
+	require.EqualValues(t, 1, session.ShardSessions[0].ReservedId)
+
+	require.NoError(t, exec("select id from main1"))
+	require.Equal(t, []string{"select id from main1"}, sqls())
+	require.EqualValues(t, 1, sbclookup.ReserveCount.Get())
+
+	// DMLs run in a transaction on the reserved connection,
+	// which is kept after the commit.
+	require.NoError(t, exec("update main1 set id = 1"))
+	require.Equal(t, []string{"update main1 set id = 1"}, sqls())
+	require.EqualValues(t, 1, sbclookup.BeginCount.Get())
+	require.EqualValues(t, 1, sbclookup.CommitCount.Get())
+	require.Len(t, session.ShardSessions, 1)
+	require.EqualValues(t, 0, session.ShardSessions[0].TransactionId)
+	require.EqualValues(t, 1, session.ShardSessions[0].ReservedId)
+
+	require.NoError(t, exec("set lock_wait_timeout = 10"))
+	require.Equal(t, []string{"set @@lock_wait_timeout = 10"}, sqls())
+	require.Equal(t, map[string]string{"time_zone": "'+00:00'", "lock_wait_timeout": "10"}, session.SystemVariables)
+
+	require.NoError(t, executor.txConn.Release(context.Background(), session))
+	require.EqualValues(t, 1, sbclookup.ReleaseCount.Get())
+	require.False(t, session.InReservedConn())
+	require.False(t, session.HasUnreplayableState())
+	require.Empty(t, session.ShardSessions)
+
+	session = NewSafeSession(&vtgatepb.Session{TargetString: "@replica", Autocommit: true})
+	err := exec("select get_lock('a', 10) from main1")
+	require.EqualError(t, err, "reserved connections are supported only for master tablet types, current type: REPLICA")
+}
+
 func TestExecutorSetMetadata(t *testing.T) {
 	executor, _, _, _ := createExecutorEnv()
 	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
@@ -1308,6 +1492,40 @@ func TestExecutorOther(t *testing.T) {
 	}
 }
 
+func TestExecutorExplain(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createExecutorEnv()
+
+	qr, err := executorExec(executor, "explain format=vitess select id from user where id = 1", nil)
+	require.NoError(t, err)
+	require.Equal(t, buildVarCharFields("EXPLAIN"), qr.Fields)
+	require.Len(t, qr.Rows, 1)
+	assert.Contains(t, qr.Rows[0][0].ToString(), `"Opcode": "SelectEqualUnique"`)
+	assert.Contains(t, qr.Rows[0][0].ToString(), `"Query": "select id from user where id = 1"`)
+	assert.EqualValues(t, 0, sbc1.ExecCount.Get()+sbc2.ExecCount.Get()+sbclookup.ExecCount.Get())
+
+	qr, err = executorExec(executor, "explain format=vtexplain select id from user where id = 1", nil)
+	require.NoError(t, err)
+	wantResult := &sqltypes.Result{
+		Fields: append([]*querypb.Field{{
+			Name:  "#",
+			Type:  sqltypes.Int32,
+			Flags: uint32(querypb.MySqlFlag_NOT_NULL_FLAG),
+		}}, buildVarCharFields("keyspace", "shard", "query")...),
+		Rows: [][]sqltypes.Value{
+			append([]sqltypes.Value{sqltypes.NewInt32(1)}, buildVarCharRow("TestExecutor", "-20", "select id from user where id = 1")...),
+		},
+		RowsAffected: 1,
+	}
+	assert.Equal(t, wantResult, qr)
+	assert.EqualValues(t, 1, sbc1.ExecCount.Get())
+
+	// Other formats are sent to the tablet.
+	_, err = executor.Execute(context.Background(), "TestExecute", NewSafeSession(&vtgatepb.Session{TargetString: KsTestUnsharded}), "explain format=json select id from user", nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbc1.ExecCount.Get())
+	assert.EqualValues(t, 1, sbclookup.ExecCount.Get())
+}
+
 func TestExecutorDDL(t *testing.T) {
 	logChan := QueryLogger.Subscribe("Test")
 	defer QueryLogger.Unsubscribe(logChan)
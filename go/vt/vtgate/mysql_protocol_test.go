@@ -17,9 +17,13 @@ limitations under the License.
 package vtgate
 
 import (
+	"fmt"
+	"io/ioutil"
 	"net"
+	"os"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -175,6 +179,143 @@ func TestMySQLProtocolClientFoundRows(t *testing.T) {
 	}
 }
 
+func TestMySQLProtocolProcessList(t *testing.T) {
+	createSandbox(KsTestUnsharded)
+	hcVTGateTest.Reset()
+	hcVTGateTest.AddTestTablet("aa", "1.1.1.1", 1001, KsTestUnsharded, "0", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	c1, err := mysqlConnect(&mysql.ConnParams{DbName: KsTestUnsharded})
+	require.NoError(t, err)
+	defer c1.Close()
+	c2, err := mysqlConnect(&mysql.ConnParams{})
+	require.NoError(t, err)
+	defer c2.Close()
+
+	// processes returns the Command, db and Info columns
+	// of the connections, by connection id.
+	processes := func(query string) map[uint32][]string {
+		qr, err := c2.ExecuteFetch(query, 100, false)
+		require.NoError(t, err)
+		procs := make(map[uint32][]string)
+		for _, row := range qr.Rows {
+			id, err := strconv.ParseUint(row[0].ToString(), 10, 32)
+			require.NoError(t, err)
+			procs[uint32(id)] = []string{row[4].ToString(), row[3].ToString(), row[7].ToString()}
+		}
+		return procs
+	}
+	procs := processes("show processlist")
+	assert.Equal(t, []string{"Sleep", KsTestUnsharded, ""}, procs[c1.ConnectionID])
+	assert.Equal(t, []string{"Query", "", "show processlist"}, procs[c2.ConnectionID])
+
+	// Simulate a long statement on c1.
+	var conn *mysql.Conn
+	vtgateHandle.mu.Lock()
+	for c := range vtgateHandle.connections {
+		if c.ConnectionID == c1.ConnectionID {
+			conn = c
+		}
+	}
+	vtgateHandle.mu.Unlock()
+	require.NotNil(t, conn)
+	long := "select id from t1 where " + strings.Repeat("id = 1 or ", 20) + "id = 2"
+	ctx, end := vtgateHandle.beginProcess(context.Background(), conn, processQuery, long)
+
+	procs = processes("show processlist")
+	assert.Equal(t, []string{"Query", KsTestUnsharded, long[:processInfoLen]}, procs[c1.ConnectionID])
+	procs = processes("show full processlist")
+	assert.Equal(t, []string{"Query", KsTestUnsharded, long}, procs[c1.ConnectionID])
+
+	_, err = c2.ExecuteFetch(fmt.Sprintf("kill query %d", c1.ConnectionID), 1, false)
+	require.NoError(t, err)
+	<-ctx.Done()
+	end()
+	procs = processes("show processlist")
+	assert.Equal(t, []string{"Sleep", KsTestUnsharded, ""}, procs[c1.ConnectionID])
+
+	// Killing the query doesn't close the connection.
+	_, err = c1.ExecuteFetch("select id from t1", 10, false)
+	require.NoError(t, err)
+
+	_, err = c2.ExecuteFetch(fmt.Sprintf("kill %d", c1.ConnectionID), 1, false)
+	require.NoError(t, err)
+	_, err = c1.ExecuteFetch("select id from t1", 10, false)
+	require.Error(t, err)
+
+	_, err = c2.ExecuteFetch("kill query 4294967296", 1, false)
+	require.Error(t, err)
+	assert.Equal(t, mysql.ERNoSuchThread, err.(*mysql.SQLError).Number())
+	assert.Contains(t, err.Error(), "Unknown thread id: 4294967296")
+}
+
+func TestMySQLProtocolKillOtherUser(t *testing.T) {
+	createSandbox(KsTestUnsharded)
+	hcVTGateTest.Reset()
+	hcVTGateTest.AddTestTablet("aa", "1.1.1.1", 1001, KsTestUnsharded, "0", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	authServer := mysql.NewAuthServerStatic("", `{
+		"user1": [{"Password": "password1", "UserData": "userData1"}],
+		"user2": [{"Password": "password2", "UserData": "userData2"}]
+	}`, 0)
+	unixSocket, err := ioutil.TempFile("", "mysql_vitess_test.sock")
+	require.NoError(t, err)
+	os.Remove(unixSocket.Name())
+	l, err := newMysqlUnixSocket(unixSocket.Name(), authServer, vtgateHandle)
+	require.NoError(t, err)
+	defer l.Close()
+	go l.Accept()
+
+	c1, err := mysql.Connect(context.Background(), &mysql.ConnParams{
+		UnixSocket: unixSocket.Name(),
+		Uname:      "user1",
+		Pass:       "password1",
+		DbName:     KsTestUnsharded,
+	})
+	require.NoError(t, err)
+	defer c1.Close()
+	c2, err := mysql.Connect(context.Background(), &mysql.ConnParams{
+		UnixSocket: unixSocket.Name(),
+		Uname:      "user2",
+		Pass:       "password2",
+	})
+	require.NoError(t, err)
+	defer c2.Close()
+
+	// ids returns the connection ids listed by SHOW PROCESSLIST.
+	ids := func(c *mysql.Conn) []uint32 {
+		qr, err := c.ExecuteFetch("show processlist", 100, false)
+		require.NoError(t, err)
+		var ids []uint32
+		for _, row := range qr.Rows {
+			id, err := strconv.ParseUint(row[0].ToString(), 10, 32)
+			require.NoError(t, err)
+			ids = append(ids, uint32(id))
+		}
+		return ids
+	}
+	assert.Equal(t, []uint32{c1.ConnectionID}, ids(c1))
+	assert.Equal(t, []uint32{c2.ConnectionID}, ids(c2))
+
+	_, err = c2.ExecuteFetch(fmt.Sprintf("kill %d", c1.ConnectionID), 1, false)
+	require.Error(t, err)
+	assert.Equal(t, mysql.ERKillDenied, err.(*mysql.SQLError).Number())
+	assert.Contains(t, err.Error(), fmt.Sprintf("You are not owner of thread %d", c1.ConnectionID))
+	_, err = c1.ExecuteFetch("select id from t1", 10, false)
+	require.NoError(t, err)
+
+	// An authorized user can see and kill the connections of all users.
+	plist := vtgateHandle.vtg.executor.plist
+	plist.authorized["userData2"] = true
+	defer delete(plist.authorized, "userData2")
+	assert.Contains(t, ids(c2), c1.ConnectionID)
+	assert.Equal(t, []uint32{c1.ConnectionID}, ids(c1))
+
+	_, err = c2.ExecuteFetch(fmt.Sprintf("kill %d", c1.ConnectionID), 1, false)
+	require.NoError(t, err)
+	_, err = c1.ExecuteFetch("select id from t1", 10, false)
+	require.Error(t, err)
+}
+
 // mysqlConnect fills the host & port into params and connects
 // to the mysql protocol port.
 func mysqlConnect(params *mysql.ConnParams) (*mysql.Conn, error) {
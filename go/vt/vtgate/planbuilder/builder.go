@@ -206,9 +206,9 @@ func (rsb *resultsBuilder) ResultColumns() []*resultColumn {
 	return rsb.resultColumns
 }
 
-// SupplyCol is currently unreachable because the builders using resultsBuilder
-// are currently above a join, which is the only builder that uses it for now.
-// This can change if we start supporting correlated subqueries.
+// SupplyCol is reachable only if the builder using resultsBuilder is
+// below a join or a semiJoin, which request additional columns during
+// wireup. This can happen if the builder is the input of a semiJoin.
 func (rsb *resultsBuilder) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNumber int) {
 	c := col.Metadata.(*column)
 	for i, rc := range rsb.resultColumns {
@@ -292,7 +292,7 @@ func BuildFromStmt(query string, stmt sqlparser.Statement, vschema ContextVSchem
 		return nil, errors.New("unsupported construct: ddl")
 	case *sqlparser.DBDDL:
 		return nil, errors.New("unsupported construct: ddl on database")
-	case *sqlparser.OtherRead:
+	case *sqlparser.OtherRead, *sqlparser.Explain:
 		return nil, errors.New("unsupported construct: other read")
 	case *sqlparser.OtherAdmin:
 		return nil, errors.New("unsupported construct: other admin")
@@ -309,8 +309,9 @@ func BuildFromStmt(query string, stmt sqlparser.Statement, vschema ContextVSchem
 		return nil, err
 	}
 	plan := &engine.Plan{
-		Original:     query,
-		Instructions: instruction,
+		Original:          query,
+		Instructions:      instruction,
+		NeedsReservedConn: sqlparser.NeedsReservedConn(stmt),
 	}
 	plan.BindVarNeeds = bindVarNeeds
 	return plan, nil
@@ -0,0 +1,219 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var _ builder = (*concatenate)(nil)
+
+// concatenate is the builder for engine.Concatenate.
+// It gets built for a UNION whose parts cannot be merged
+// into a single route. Each source is planned independently,
+// and the result columns are those of the first source.
+// Since a UNION can only be followed by ORDER BY and LIMIT,
+// most pushes are not applicable.
+type concatenate struct {
+	order   int
+	sources []builder
+}
+
+// newConcatenate builds a new concatenate. If lhs is itself
+// a concatenate, rhs is added to its sources instead.
+func newConcatenate(lhs, rhs builder) *concatenate {
+	if c, ok := lhs.(*concatenate); ok {
+		c.sources = append(c.sources, rhs)
+		return c
+	}
+	return &concatenate{
+		sources: []builder{lhs, rhs},
+	}
+}
+
+// Order satisfies the builder interface.
+func (c *concatenate) Order() int {
+	return c.order
+}
+
+// Reorder satisfies the builder interface.
+func (c *concatenate) Reorder(order int) {
+	for _, source := range c.sources {
+		source.Reorder(order)
+		order = source.Order()
+	}
+	c.order = order + 1
+}
+
+// Primitive satisfies the builder interface.
+func (c *concatenate) Primitive() engine.Primitive {
+	sources := make([]engine.Primitive, 0, len(c.sources))
+	for _, source := range c.sources {
+		sources = append(sources, source.Primitive())
+	}
+	return &engine.Concatenate{Sources: sources}
+}
+
+// First satisfies the builder interface.
+func (c *concatenate) First() builder {
+	return c
+}
+
+// ResultColumns satisfies the builder interface.
+func (c *concatenate) ResultColumns() []*resultColumn {
+	return c.sources[0].ResultColumns()
+}
+
+// PushFilter satisfies the builder interface.
+func (c *concatenate) PushFilter(_ *primitiveBuilder, _ sqlparser.Expr, whereType string, _ builder) error {
+	return errors.New("concatenate.PushFilter: unreachable")
+}
+
+// PushSelect satisfies the builder interface.
+func (c *concatenate) PushSelect(_ *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	return nil, 0, errors.New("concatenate.PushSelect: unreachable")
+}
+
+// MakeDistinct satisfies the builder interface.
+func (c *concatenate) MakeDistinct() error {
+	return errors.New("concatenate.MakeDistinct: unreachable")
+}
+
+// PushGroupBy satisfies the builder interface.
+func (c *concatenate) PushGroupBy(_ sqlparser.GroupBy) error {
+	return errors.New("concatenate.PushGroupBy: unreachable")
+}
+
+// PushOrderBy satisfies the builder interface.
+// The results of the sources are sorted in memory.
+func (c *concatenate) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	if len(orderBy) == 0 {
+		return c, nil
+	}
+	return newMemorySort(c, orderBy)
+}
+
+// SetUpperLimit satisfies the builder interface.
+// The call is ignored because a source may already
+// have a limit of its own that must not be overridden.
+func (c *concatenate) SetUpperLimit(_ *sqlparser.SQLVal) {
+}
+
+// PushMisc satisfies the builder interface.
+func (c *concatenate) PushMisc(sel *sqlparser.Select) {
+	for _, source := range c.sources {
+		source.PushMisc(sel)
+	}
+}
+
+// Wireup satisfies the builder interface.
+func (c *concatenate) Wireup(bldr builder, jt *jointab) error {
+	for i := len(c.sources) - 1; i >= 0; i-- {
+		if err := c.sources[i].Wireup(bldr, jt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SupplyVar satisfies the builder interface.
+func (c *concatenate) SupplyVar(from, to int, col *sqlparser.ColName, varname string) {
+	for _, source := range c.sources {
+		if from <= source.Order() {
+			source.SupplyVar(from, to, col, varname)
+			return
+		}
+	}
+}
+
+// SupplyCol satisfies the builder interface.
+// Only columns that are already in the result can be supplied,
+// because the column would otherwise have to be added to every
+// source.
+func (c *concatenate) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNumber int) {
+	cm := col.Metadata.(*column)
+	for i, rc := range c.ResultColumns() {
+		if rc.column == cm {
+			return rc, i
+		}
+	}
+	panic("BUG: concatenate cannot supply new columns.")
+}
+
+// SupplyWeightString satisfies the builder interface.
+// The weight string is requested from every source, and
+// they all have to return it in the same column.
+func (c *concatenate) SupplyWeightString(colNumber int) (weightcolNumber int, err error) {
+	for i, source := range c.sources {
+		if rb, ok := source.(*route); ok {
+			if _, ok := rb.Select.(*sqlparser.Select); !ok {
+				return 0, errors.New("unsupported: ordering a cross-shard UNION by a text column of a merged UNION")
+			}
+		}
+		num, err := source.SupplyWeightString(colNumber)
+		if err != nil {
+			return 0, err
+		}
+		if i != 0 && num != weightcolNumber {
+			return 0, errors.New("unsupported: UNION with mismatched column counts and text ordering")
+		}
+		weightcolNumber = num
+	}
+	return weightcolNumber, nil
+}
+
+// selectsStar returns true if bldr is a concatenate with a source
+// that selects an unexpanded '*'. The result columns of such a
+// concatenate don't match the columns it actually returns.
+func selectsStar(bldr builder) bool {
+	switch bldr := bldr.(type) {
+	case *route:
+		return statementSelectsStar(bldr.Select)
+	case *concatenate:
+		for _, source := range bldr.sources {
+			if selectsStar(source) {
+				return true
+			}
+		}
+	case *distinct:
+		return selectsStar(bldr.input)
+	case *memorySort:
+		return selectsStar(bldr.input)
+	case *limit:
+		return selectsStar(bldr.input)
+	}
+	return false
+}
+
+func statementSelectsStar(stmt sqlparser.SelectStatement) bool {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		for _, expr := range stmt.SelectExprs {
+			if _, ok := expr.(*sqlparser.StarExpr); ok {
+				return true
+			}
+		}
+	case *sqlparser.Union:
+		return statementSelectsStar(stmt.Left) || statementSelectsStar(stmt.Right)
+	case *sqlparser.ParenSelect:
+		return statementSelectsStar(stmt.Select)
+	}
+	return false
+}
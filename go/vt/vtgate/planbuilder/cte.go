@@ -0,0 +1,229 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+	"fmt"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+// cteScope maps the names of the common table expressions
+// that are visible to a statement to their definitions.
+type cteScope map[string]sqlparser.SelectStatement
+
+// inlineCTEs replaces every reference to a common table expression
+// in the statement with a derived table built from its definition,
+// and removes the WITH clauses. The result can be planned like any
+// other statement.
+//
+// A recursive common table expression is replaced by a derived table
+// in which the recursive member reads the rows of the anchor member.
+// This is not equivalent to the original query. It's only used for
+// finding out where the query must be routed.
+func inlineCTEs(stmt sqlparser.SQLNode, scope cteScope) error {
+	return sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.Select:
+			if node.With != nil {
+				return false, inlineWith(node, node.With, scope)
+			}
+		case *sqlparser.Union:
+			if node.With != nil {
+				return false, inlineWith(node, node.With, scope)
+			}
+		case *sqlparser.AliasedTableExpr:
+			tableName, ok := node.Expr.(sqlparser.TableName)
+			if !ok || !tableName.Qualifier.IsEmpty() {
+				return true, nil
+			}
+			def, ok := scope[tableName.Name.String()]
+			if !ok {
+				return true, nil
+			}
+			clone, err := cloneSelectStatement(def)
+			if err != nil {
+				return false, err
+			}
+			node.Expr = &sqlparser.Subquery{Select: clone}
+			if node.As.IsEmpty() {
+				node.As = tableName.Name
+			}
+			return false, nil
+		}
+		return true, nil
+	}, stmt)
+}
+
+// inlineWith inlines the common table expressions of the WITH clause
+// into the statement. Each definition can reference the ones that
+// precede it.
+func inlineWith(stmt sqlparser.SelectStatement, with *sqlparser.With, outer cteScope) error {
+	scope := make(cteScope, len(outer)+len(with.CTEs))
+	for name, def := range outer {
+		scope[name] = def
+	}
+	for _, cte := range with.CTEs {
+		name := cte.Name.String()
+		def := cte.Subquery.Select
+		union, ok := def.(*sqlparser.Union)
+		if !with.Recursive || !ok {
+			if err := inlineCTEs(def, scope); err != nil {
+				return err
+			}
+			if err := renameColumns(def, cte.Columns); err != nil {
+				return err
+			}
+			scope[name] = def
+			continue
+		}
+		if err := inlineCTEs(union.Left, scope); err != nil {
+			return err
+		}
+		if err := renameColumns(def, cte.Columns); err != nil {
+			return err
+		}
+		inner := make(cteScope, len(scope)+1)
+		for name, def := range scope {
+			inner[name] = def
+		}
+		inner[name] = union.Left
+		if err := inlineCTEs(union.Right, inner); err != nil {
+			return err
+		}
+		scope[name] = def
+	}
+	stmt.SetWith(nil)
+	return inlineCTEs(stmt, scope)
+}
+
+// renameColumns applies the column list of a common table expression
+// to the select expressions of its definition.
+func renameColumns(def sqlparser.SelectStatement, columns sqlparser.Columns) error {
+	if len(columns) == 0 {
+		return nil
+	}
+	sel := firstSelect(def)
+	if len(sel.SelectExprs) != len(columns) {
+		return errors.New("select list and column names list of common table expression have different column counts")
+	}
+	for i, selectExpr := range sel.SelectExprs {
+		expr, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return fmt.Errorf("unsupported: '%s' in common table expression with a column list", sqlparser.String(selectExpr))
+		}
+		expr.As = columns[i]
+	}
+	return nil
+}
+
+// firstSelect returns the leftmost Select of the statement,
+// which determines the column names of the result.
+func firstSelect(stmt sqlparser.SelectStatement) *sqlparser.Select {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Union:
+		return firstSelect(stmt.Left)
+	case *sqlparser.ParenSelect:
+		return firstSelect(stmt.Select)
+	}
+	return stmt.(*sqlparser.Select)
+}
+
+// cloneSelectStatement returns a deep copy of the statement.
+func cloneSelectStatement(stmt sqlparser.SelectStatement) (sqlparser.SelectStatement, error) {
+	clone, err := sqlparser.Parse(sqlparser.String(stmt))
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := clone.(sqlparser.SelectStatement)
+	if !ok {
+		return nil, fmt.Errorf("BUG: unexpected statement type in clone: %T", clone)
+	}
+	return sel, nil
+}
+
+// hasRecursiveCTE returns true if the statement or any of
+// its subqueries has a WITH RECURSIVE clause.
+func hasRecursiveCTE(stmt sqlparser.SelectStatement) bool {
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if with, ok := node.(*sqlparser.With); ok && with.Recursive {
+			found = true
+		}
+		return !found, nil
+	}, stmt)
+	return found
+}
+
+// buildRecursiveCTEPlan builds the plan for a statement that has a
+// recursive common table expression. Such a statement can only be sent
+// as is to a single shard. The route is found by planning the statement
+// with the common table expressions inlined, and its queries are then
+// replaced by the original statement.
+func buildRecursiveCTEPlan(stmt sqlparser.SelectStatement, vschema ContextVSchema) (engine.Primitive, error) {
+	original, err := cloneSelectStatement(stmt)
+	if err != nil {
+		return nil, err
+	}
+	if err := inlineCTEs(stmt, nil); err != nil {
+		return nil, err
+	}
+	var instruction engine.Primitive
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		instruction, err = buildSelectPlan(stmt, vschema)
+	case *sqlparser.Union:
+		instruction, err = buildUnionPlan(stmt, vschema)
+	default:
+		return nil, fmt.Errorf("BUG: unexpected statement type: %T", stmt)
+	}
+	if err != nil {
+		return nil, err
+	}
+	route, ok := instruction.(*engine.Route)
+	if !ok {
+		return nil, errors.New("unsupported: recursive common table expression in a cross-shard query")
+	}
+	switch route.Opcode {
+	case engine.SelectUnsharded, engine.SelectEqualUnique, engine.SelectDBA, engine.SelectReference:
+	default:
+		return nil, errors.New("unsupported: recursive common table expression in a cross-shard query")
+	}
+	route.Query = formatRouteQuery(original, false)
+	route.FieldQuery = formatRouteQuery(original, true)
+	return route, nil
+}
+
+// formatRouteQuery formats the statement the way a route sends it
+// to the tablets: the keyspace qualifiers of tables are dropped.
+// If impossible is set, the field query is generated instead.
+func formatRouteQuery(stmt sqlparser.SelectStatement, impossible bool) string {
+	formatter := func(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
+		if tableName, ok := node.(sqlparser.TableName); ok && !systemTable(tableName.Qualifier.String()) {
+			tableName.Name.Format(buf)
+			return
+		}
+		if impossible {
+			sqlparser.FormatImpossibleQuery(buf, node)
+			return
+		}
+		node.Format(buf)
+	}
+	return sqlparser.NewTrackedBuffer(formatter).WriteNode(stmt).ParsedQuery().Query
+}
@@ -24,8 +24,17 @@ import (
 )
 
 // buildDeletePlan builds the instructions for a DELETE statement.
-func buildDeletePlan(del *sqlparser.Delete, vschema ContextVSchema) (*engine.Delete, error) {
-	dml, ksidVindex, ksidCol, err := buildDMLPlan(vschema, "delete", del, del.TableExprs, del.Where, del.OrderBy, del.Limit, del.Comments, del.Targets)
+func buildDeletePlan(del *sqlparser.Delete, vschema ContextVSchema) (engine.Primitive, error) {
+	if isMultiTable(del.TableExprs) {
+		mt, err := newMultiTableDML(vschema, "delete", del, del.TableExprs, del.Where, del.OrderBy, del.Limit)
+		if err != nil {
+			return nil, err
+		}
+		if mt != nil {
+			return buildMultiTableDeletePlan(del, mt)
+		}
+	}
+	dml, ksidVindex, ksidCol, pullouts, err := buildDMLPlan(vschema, "delete", del, del.TableExprs, del.Where, del.OrderBy, del.Limit, del.Comments, del.Targets)
 	if err != nil {
 		return nil, err
 	}
@@ -34,7 +43,7 @@ func buildDeletePlan(del *sqlparser.Delete, vschema ContextVSchema) (*engine.Del
 	}
 
 	if dml.Opcode == engine.Unsharded {
-		return edel, nil
+		return wrapPullouts(edel, pullouts), nil
 	}
 
 	if len(del.Targets) > 1 {
@@ -50,5 +59,44 @@ func buildDeletePlan(del *sqlparser.Delete, vschema ContextVSchema) (*engine.Del
 		edel.KsidVindex = ksidVindex
 	}
 
-	return edel, nil
+	return wrapPullouts(edel, pullouts), nil
+}
+
+// buildMultiTableDeletePlan builds the instructions for a DELETE
+// that references multiple tables in a sharded keyspace. The DELETE
+// is split if the tables of its targets own lookup vindexes, because
+// their entries have to be deleted too.
+func buildMultiTableDeletePlan(del *sqlparser.Delete, mt *multiTableDML) (engine.Primitive, error) {
+	if len(del.Targets) == 0 {
+		return nil, vterrors.New(vtrpc.Code_UNIMPLEMENTED, "unsupported: multi-table delete statement in sharded keyspace without target tables")
+	}
+	_, isRoute := mt.pb.bldr.(*route)
+	split := !isRoute
+	targets := make([]int, 0, len(del.Targets))
+	for _, name := range del.Targets {
+		target := mt.findTable(name)
+		if target == -1 {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "Unknown table '%s' in MULTI DELETE", name.Name.String())
+		}
+		vst, err := mt.vschemaTable(target)
+		if err != nil {
+			return nil, err
+		}
+		if len(vst.Owned) > 0 {
+			split = true
+		}
+		targets = append(targets, target)
+	}
+	if !split {
+		dml, err := mt.buildRoutePlan(del, del.Comments, targets[0])
+		if err != nil {
+			return nil, err
+		}
+		return &engine.Delete{DML: *dml}, nil
+	}
+	return mt.buildSplitPlan(targets, func(target int, cond sqlparser.Expr) string {
+		buf := sqlparser.NewTrackedBuffer(unqualifiedFormatter)
+		buf.Myprintf("delete %vfrom %v where %v", del.Comments, mt.tables[target].Expr, cond)
+		return buf.String()
+	})
 }
@@ -0,0 +1,88 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var _ builder = (*distinct)(nil)
+
+// distinct is the builder for engine.Distinct.
+// This gets built for a UNION that cannot be executed
+// as a single route. It removes the duplicate rows
+// returned by the underlying concatenate.
+type distinct struct {
+	builderCommon
+	edistinct *engine.Distinct
+}
+
+// newDistinct builds a new distinct.
+func newDistinct(bldr builder) *distinct {
+	return &distinct{
+		builderCommon: newBuilderCommon(bldr),
+		edistinct:     &engine.Distinct{},
+	}
+}
+
+// Primitive satisfies the builder interface.
+func (d *distinct) Primitive() engine.Primitive {
+	d.edistinct.Source = d.input.Primitive()
+	return d.edistinct
+}
+
+// First satisfies the builder interface.
+func (d *distinct) First() builder {
+	return d
+}
+
+// PushFilter satisfies the builder interface.
+func (d *distinct) PushFilter(_ *primitiveBuilder, _ sqlparser.Expr, whereType string, _ builder) error {
+	return errors.New("distinct.PushFilter: unreachable")
+}
+
+// PushSelect satisfies the builder interface.
+func (d *distinct) PushSelect(_ *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	return nil, 0, errors.New("distinct.PushSelect: unreachable")
+}
+
+// MakeDistinct satisfies the builder interface.
+func (d *distinct) MakeDistinct() error {
+	return nil
+}
+
+// PushGroupBy satisfies the builder interface.
+func (d *distinct) PushGroupBy(_ sqlparser.GroupBy) error {
+	return errors.New("distinct.PushGroupBy: unreachable")
+}
+
+// PushOrderBy satisfies the builder interface.
+func (d *distinct) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	if len(orderBy) == 0 {
+		return d, nil
+	}
+	return newMemorySort(d, orderBy)
+}
+
+// SetUpperLimit satisfies the builder interface.
+// The call is ignored because the underlying primitive
+// has to return all rows for the duplicates to be removed.
+func (d *distinct) SetUpperLimit(_ *sqlparser.SQLVal) {
+}
@@ -48,7 +48,11 @@ func getDMLRouting(where *sqlparser.Where, table *vindexes.Table) (engine.DMLOpc
 		}
 
 		if pv, ok := getMatch(where.Expr, index.Columns[0]); ok {
-			return engine.Equal, ksidVindex, ksidCol, single, []sqltypes.PlanValue{pv}, nil
+			opcode := engine.Equal
+			if pv.IsList() {
+				opcode = engine.In
+			}
+			return opcode, ksidVindex, ksidCol, single, []sqltypes.PlanValue{pv}, nil
 		}
 	}
 	if ksidVindex == nil {
@@ -59,7 +63,9 @@ func getDMLRouting(where *sqlparser.Where, table *vindexes.Table) (engine.DMLOpc
 
 // getMatch returns the matched value if there is an equality
 // constraint on the specified column that can be used to
-// decide on a route.
+// decide on a route. An IN clause with a list bind variable,
+// like the one generated for a pulled out subquery, is also
+// matched. The value returned for it is a list.
 func getMatch(node sqlparser.Expr, col sqlparser.ColIdent) (pv sqltypes.PlanValue, ok bool) {
 	filters := splitAndExpression(nil, node)
 	for _, filter := range filters {
@@ -82,6 +88,10 @@ func getMatch(node sqlparser.Expr, col sqlparser.ColIdent) (pv sqltypes.PlanValu
 			if !sqlparser.IsValue(comparison.Right) {
 				continue
 			}
+		case sqlparser.InStr:
+			if _, ok := comparison.Right.(sqlparser.ListArg); !ok {
+				continue
+			}
 		default:
 			continue
 		}
@@ -99,35 +109,48 @@ func nameMatch(node sqlparser.Expr, col sqlparser.ColIdent) bool {
 	return ok && colname.Name.Equal(col)
 }
 
-func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Statement, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, comments sqlparser.Comments, nodes ...sqlparser.SQLNode) (*engine.DML, vindexes.SingleColumn, string, error) {
+// buildDMLPlan builds the instructions that are common to UPDATE and DELETE.
+// Subqueries that cannot be sent along with the DML are returned as pullouts.
+// They have to be executed first, and their results are passed to the DML.
+func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Statement, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, comments sqlparser.Comments, nodes ...sqlparser.SQLNode) (*engine.DML, vindexes.SingleColumn, string, []*pulloutSubquery, error) {
 	eupd := &engine.DML{}
 	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(stmt)))
 	ro, err := pb.processDMLTable(tableExprs)
 	if err != nil {
-		return nil, nil, "", err
+		return nil, nil, "", nil, err
 	}
 	eupd.Keyspace = ro.eroute.Keyspace
+	// The rows of a multi-shard DML with a LIMIT are selected
+	// using the original WHERE clause, which gets changed when
+	// its subqueries are pulled out.
+	var limitFrom string
+	if limit != nil {
+		buf := sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf(" from %v%v%v%v", tableExprs, where, orderBy, limit)
+		limitFrom = buf.String()
+	}
+	pullouts, err := pb.pulloutDMLSubqueries(where, nodes)
+	if err != nil {
+		return nil, nil, "", nil, err
+	}
 	if !eupd.Keyspace.Sharded {
-		// We only validate non-table subexpressions because the previous analysis has already validated them.
-		var subqueryArgs []sqlparser.SQLNode
-		subqueryArgs = append(subqueryArgs, nodes...)
-		subqueryArgs = append(subqueryArgs, where, orderBy, limit)
-		if !pb.finalizeUnshardedDMLSubqueries(subqueryArgs...) {
-			return nil, nil, "", vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: sharded subqueries in DML")
+		// The subqueries of the ORDER BY and LIMIT clauses cannot be pulled out.
+		if !pb.finalizeUnshardedDMLSubqueries(orderBy, limit) {
+			return nil, nil, "", nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: sharded subqueries in DML")
 		}
 		eupd.Opcode = engine.Unsharded
 		// Generate query after all the analysis. Otherwise table name substitutions for
 		// routed tables won't happen.
 		eupd.Query = generateQuery(stmt)
-		return eupd, nil, "", nil
+		return eupd, nil, "", pullouts, nil
 	}
 
-	if hasSubquery(stmt) {
-		return nil, nil, "", vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
+	if hasSubquery(tableExprs) || hasSubquery(orderBy) || (limit != nil && hasSubquery(limit)) {
+		return nil, nil, "", nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
 	}
 
 	if len(pb.st.tables) != 1 {
-		return nil, nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table %s statement in sharded keyspace", dmlType)
+		return nil, nil, "", nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table %s statement in sharded keyspace", dmlType)
 	}
 
 	// Generate query after all the analysis. Otherwise table name substitutions for
@@ -142,33 +165,143 @@ func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Stateme
 	eupd.QueryTimeout = queryTimeout(directives)
 	eupd.Table = ro.vschemaTable
 	if eupd.Table == nil {
-		return nil, nil, "", vterrors.New(vtrpcpb.Code_INTERNAL, "internal error: table.vindexTable is mysteriously nil")
+		return nil, nil, "", nil, vterrors.New(vtrpcpb.Code_INTERNAL, "internal error: table.vindexTable is mysteriously nil")
 	}
 
 	if ro.eroute.TargetDestination != nil {
 		if ro.eroute.TargetTabletType != topodatapb.TabletType_MASTER {
-			return nil, nil, "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported: %s statement with a replica target", dmlType)
+			return nil, nil, "", nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported: %s statement with a replica target", dmlType)
 		}
 		eupd.Opcode = engine.ByDestination
 		eupd.TargetDestination = ro.eroute.TargetDestination
-		return eupd, nil, "", nil
+		return eupd, nil, "", pullouts, nil
 	}
 
 	routingType, ksidVindex, ksidCol, vindex, values, err := getDMLRouting(where, eupd.Table)
 	if err != nil {
-		return nil, nil, "", err
+		return nil, nil, "", nil, err
 	}
 	eupd.Opcode = routingType
-	if routingType == engine.Scatter {
-		if limit != nil {
-			return nil, nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi shard %s with limit", dmlType)
+	if (routingType == engine.Scatter || routingType == engine.In) && limit != nil {
+		if limit.Offset != nil {
+			return nil, nil, "", nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi shard %s with limit offset", dmlType)
+		}
+		// The ORDER BY expressions are also selected, for the results
+		// to be merge-sorted. The vindex column comes first, and is
+		// selected only once.
+		buf := sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf("select %s", ksidCol)
+		for _, order := range orderBy {
+			if nameMatch(order.Expr, sqlparser.NewColIdent(ksidCol)) {
+				continue
+			}
+			buf.Myprintf(", %v", order.Expr)
+		}
+		input, err := buildDMLInput(vschema, buf.String()+limitFrom)
+		if err != nil {
+			return nil, nil, "", nil, err
 		}
-	} else {
+		// Each shard receives the number of its selected rows as the limit.
+		limit.Rowcount = sqlparser.NewValArg([]byte(":" + engine.DMLLimitVarName))
+		eupd.Query = generateQuery(stmt)
+		eupd.Opcode = engine.ScatterLimit
+		eupd.Vindex = ksidVindex
+		eupd.Input = input
+		return eupd, ksidVindex, ksidCol, pullouts, nil
+	}
+	if routingType != engine.Scatter {
 		eupd.Vindex = vindex
 		eupd.Values = values
 	}
 
-	return eupd, ksidVindex, ksidCol, nil
+	return eupd, ksidVindex, ksidCol, pullouts, nil
+}
+
+// buildDMLInput builds the primitive for a select that's generated
+// to find the rows to be changed by a DML.
+func buildDMLInput(vschema ContextVSchema, query string) (engine.Primitive, error) {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unexpected statement type: %T", stmt)
+	}
+	return buildSelectPlan(sel, vschema)
+}
+
+// pulloutDMLSubqueries analyzes the subqueries of the WHERE clause and
+// of the SET expressions of a DML. The ones that can be sent along with
+// the DML are merged into its route. The rest are pulled out, and replaced
+// by the bind variables that will receive their results.
+func (pb *primitiveBuilder) pulloutDMLSubqueries(where *sqlparser.Where, nodes []sqlparser.SQLNode) ([]*pulloutSubquery, error) {
+	var pullouts []*pulloutSubquery
+	pullout := func(expr sqlparser.Expr) (sqlparser.Expr, error) {
+		if !hasSubquery(expr) {
+			return expr, nil
+		}
+		exprPullouts, _, expr, err := pb.findOrigin(expr)
+		if err != nil {
+			return nil, err
+		}
+		pullouts = append(pullouts, exprPullouts...)
+		return expr, nil
+	}
+
+	rb := pb.bldr.(*route)
+	if where != nil && hasSubquery(where) {
+		// The filters that have no subqueries can improve the
+		// route, which then allows more subqueries to be merged.
+		for _, filter := range splitAndExpression(nil, where.Expr) {
+			if hasSubquery(filter) {
+				continue
+			}
+			if _, _, _, err := pb.findOrigin(filter); err != nil {
+				return nil, err
+			}
+			rb.UpdatePlans(pb, filter)
+		}
+		expr, err := pullout(where.Expr)
+		if err != nil {
+			return nil, err
+		}
+		where.Expr = expr
+	}
+	for _, node := range nodes {
+		exprs, ok := node.(sqlparser.UpdateExprs)
+		if !ok {
+			continue
+		}
+		for _, updExpr := range exprs {
+			expr, err := pullout(updExpr.Expr)
+			if err != nil {
+				return nil, err
+			}
+			updExpr.Expr = expr
+		}
+	}
+	for _, ps := range pullouts {
+		if err := ps.subquery.Wireup(ps.subquery, pb.jt); err != nil {
+			return nil, err
+		}
+	}
+	// Substitute the table names of the subqueries that were merged.
+	for _, sub := range rb.routeOptions[0].substitutions {
+		*sub.oldExpr = *sub.newExpr
+	}
+	return pullouts, nil
+}
+
+// wrapPullouts returns the DML primitive wrapped by the
+// subqueries that were pulled out of it.
+func wrapPullouts(dml engine.Primitive, pullouts []*pulloutSubquery) engine.Primitive {
+	for _, ps := range pullouts {
+		ps.eSubquery.Subquery = ps.subquery.Primitive()
+		ps.eSubquery.Underlying = dml
+		dml = ps.eSubquery
+	}
+	return dml
 }
 
 func generateDMLSubquery(where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, table *vindexes.Table, ksidCol string) string {
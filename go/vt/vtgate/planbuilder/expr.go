@@ -55,6 +55,19 @@ func skipParenthesis(node sqlparser.Expr) sqlparser.Expr {
 	return node
 }
 
+// correlatedSubqueryError is returned by findOrigin if a correlated
+// subquery cannot be merged with the route of the outer query. It
+// retains the plan of the subquery so that the caller can attempt
+// to build a semi-join instead.
+type correlatedSubqueryError struct {
+	subquery *sqlparser.Subquery
+	bldr     builder
+}
+
+func (err *correlatedSubqueryError) Error() string {
+	return "unsupported: cross-shard correlated subquery"
+}
+
 type subqueryInfo struct {
 	ast    *sqlparser.Subquery
 	bldr   builder
@@ -164,7 +177,7 @@ func (pb *primitiveBuilder) findOrigin(expr sqlparser.Expr) (pullouts []*pullout
 			continue
 		}
 		if sqi.origin != nil {
-			return nil, nil, nil, errors.New("unsupported: cross-shard correlated subquery")
+			return nil, nil, nil, &correlatedSubqueryError{subquery: sqi.ast, bldr: sqi.bldr}
 		}
 
 		sqName, hasValues := pb.jt.GenerateSubqueryVars()
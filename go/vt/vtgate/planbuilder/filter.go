@@ -0,0 +1,164 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ builder = (*filter)(nil)
+
+// filter is the builder for engine.Filter.
+// It evaluates the HAVING clause of a query whose
+// aggregates are computed by vtgate. Aggregates that
+// are referenced only by the HAVING clause are added
+// to the input as hidden columns.
+// It also evaluates the WHERE conditions that reference
+// the RHS of a cross-shard left join, because they must
+// be applied after the join has produced its rows, and the
+// WHERE conditions on the results of a cross-shard subquery.
+type filter struct {
+	resultsBuilder
+	efilter *engine.Filter
+	// whereExprs are the WHERE conditions that could not be
+	// pushed to the input. They're converted during Wireup,
+	// after all the other columns have been requested.
+	whereExprs []sqlparser.Expr
+	// whereErr is the error returned by the input
+	// for the WHERE conditions that could not be pushed.
+	whereErr error
+}
+
+// newFilter builds a new filter.
+func newFilter(input builder) *filter {
+	efilter := &engine.Filter{}
+	f := &filter{
+		resultsBuilder: newResultsBuilder(input, efilter),
+		efilter:        efilter,
+	}
+	f.Reorder(0)
+	return f
+}
+
+// Primitive satisfies the builder interface.
+func (f *filter) Primitive() engine.Primitive {
+	f.efilter.Input = f.input.Primitive()
+	return f.efilter
+}
+
+// PushFilter satisfies the builder interface.
+// The predicate is ANDed with the existing ones.
+// WHERE conditions are pushed to the input if possible.
+func (f *filter) PushFilter(pb *primitiveBuilder, expr sqlparser.Expr, whereType string, origin builder) error {
+	if whereType == sqlparser.WhereStr {
+		err := f.input.PushFilter(pb, expr, whereType, origin)
+		if err != errLeftJoinFilter && err != errSubqueryFilter {
+			return err
+		}
+		f.whereExprs = append(f.whereExprs, expr)
+		f.whereErr = err
+		return nil
+	}
+	expr, err := pushAggregates(pb, f.input, expr, origin)
+	if err != nil {
+		return err
+	}
+	if err := f.addPredicate(expr); err != nil {
+		return fmt.Errorf("unsupported: filtering on results of aggregates: %v", err)
+	}
+	return nil
+}
+
+// addPredicate converts the expression and ANDs it with
+// the existing predicate. The columns it references are
+// supplied by the input.
+func (f *filter) addPredicate(expr sqlparser.Expr) error {
+	predicate, err := evalengine.Convert(expr, func(col *sqlparser.ColName) (int, error) {
+		_, colNumber := f.input.SupplyCol(col)
+		return colNumber, nil
+	})
+	if err != nil {
+		return err
+	}
+	if f.efilter.Predicate == nil {
+		f.efilter.Predicate = predicate
+	} else {
+		f.efilter.Predicate = &evalengine.And{Left: f.efilter.Predicate, Right: predicate}
+	}
+	if len(f.input.ResultColumns()) > len(f.resultColumns) {
+		f.efilter.TruncateColumnCount = len(f.resultColumns)
+	}
+	return nil
+}
+
+// PushSelect satisfies the builder interface.
+// The rows are passed through. So, the expression
+// is pushed to the input.
+func (f *filter) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	rc, _, err = f.input.PushSelect(pb, expr, origin)
+	if err != nil {
+		return nil, 0, err
+	}
+	f.resultColumns = append(f.resultColumns, rc)
+	return rc, len(f.resultColumns) - 1, nil
+}
+
+// MakeDistinct satisfies the builder interface.
+func (f *filter) MakeDistinct() error {
+	return f.input.MakeDistinct()
+}
+
+// PushGroupBy satisfies the builder interface.
+func (f *filter) PushGroupBy(groupBy sqlparser.GroupBy) error {
+	return f.input.PushGroupBy(groupBy)
+}
+
+// PushOrderBy satisfies the builder interface.
+// Filtering doesn't change the order of the rows.
+// So, the ORDER BY is pushed to the input.
+func (f *filter) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	bldr, err := f.input.PushOrderBy(orderBy)
+	if err != nil {
+		return nil, err
+	}
+	f.input = bldr
+	f.Reorder(0)
+	return f, nil
+}
+
+// SetUpperLimit satisfies the builder interface.
+// The input cannot be limited because the filter may
+// discard some of its rows.
+func (f *filter) SetUpperLimit(_ *sqlparser.SQLVal) {
+}
+
+// Wireup satisfies the builder interface.
+// The WHERE conditions are converted at this stage. This
+// way, the columns they need are added after the ones
+// requested by the other builders, and can be truncated.
+func (f *filter) Wireup(bldr builder, jt *jointab) error {
+	for _, expr := range f.whereExprs {
+		if err := f.addPredicate(expr); err != nil {
+			return fmt.Errorf("%v: %v", f.whereErr, err)
+		}
+	}
+	return f.input.Wireup(bldr, jt)
+}
@@ -55,6 +55,7 @@ func (pb *primitiveBuilder) processTableExprs(tableExprs sqlparser.TableExprs) e
 		return err
 	}
 	rpb := newPrimitiveBuilder(pb.vschema, pb.jt)
+	rpb.directives = pb.directives
 	if err := rpb.processTableExprs(tableExprs[1:]); err != nil {
 		return err
 	}
@@ -110,6 +111,9 @@ func (pb *primitiveBuilder) processAliasedTable(tableExpr *sqlparser.AliasedTabl
 
 		subroute, ok := spb.bldr.(*route)
 		if !ok {
+			if selectsStar(spb.bldr) {
+				return errors.New("unsupported: '*' expression in cross-shard query")
+			}
 			var err error
 			pb.bldr, pb.st, err = newSubquery(tableExpr.As, spb.bldr)
 			if err != nil {
@@ -268,10 +272,17 @@ func (pb *primitiveBuilder) buildTablePrimitive(tableExpr *sqlparser.AliasedTabl
 // If the left and right nodes can be part of the same route,
 // then it's a route. Otherwise, it's a join.
 func (pb *primitiveBuilder) processJoin(ajoin *sqlparser.JoinTableExpr) error {
+	natural := false
 	switch ajoin.Join {
 	case sqlparser.JoinStr, sqlparser.StraightJoinStr, sqlparser.LeftJoinStr:
 	case sqlparser.RightJoinStr:
 		convertToLeftJoin(ajoin)
+	case sqlparser.NaturalJoinStr, sqlparser.NaturalLeftJoinStr:
+		natural = true
+	case sqlparser.NaturalRightJoinStr:
+		natural = true
+		convertToLeftJoin(ajoin)
+		ajoin.Join = sqlparser.NaturalLeftJoinStr
 	default:
 		return fmt.Errorf("unsupported: %s", ajoin.Join)
 	}
@@ -279,10 +290,105 @@ func (pb *primitiveBuilder) processJoin(ajoin *sqlparser.JoinTableExpr) error {
 		return err
 	}
 	rpb := newPrimitiveBuilder(pb.vschema, pb.jt)
+	rpb.directives = pb.directives
 	if err := rpb.processTableExpr(ajoin.RightExpr); err != nil {
 		return err
 	}
-	return pb.join(rpb, ajoin)
+	if !natural && ajoin.Condition.Using == nil {
+		return pb.join(rpb, ajoin)
+	}
+	return pb.joinUsing(rpb, ajoin, natural)
+}
+
+// joinUsing joins the two builders for a NATURAL join or a join with
+// a USING clause. The columns they compare are converted into the
+// equivalent ON clause, which is used to build the join. If the two
+// sides get merged into a route, the original join is sent to the
+// keyspace because it also affects the columns returned by '*'.
+func (pb *primitiveBuilder) joinUsing(rpb *primitiveBuilder, ajoin *sqlparser.JoinTableExpr, natural bool) error {
+	cols := ajoin.Condition.Using
+	if natural {
+		var err error
+		if cols, err = commonColumns(pb.st, rpb.st); err != nil {
+			return err
+		}
+	}
+	var on sqlparser.Expr
+	for _, col := range cols {
+		ltable, err := pb.st.findUsingTable(col)
+		if err != nil {
+			return err
+		}
+		rtable, err := rpb.st.findUsingTable(col)
+		if err != nil {
+			return err
+		}
+		cond := &sqlparser.ComparisonExpr{
+			Operator: sqlparser.EqualStr,
+			Left:     &sqlparser.ColName{Name: col, Qualifier: ltable.alias},
+			Right:    &sqlparser.ColName{Name: col, Qualifier: rtable.alias},
+		}
+		if on == nil {
+			on = cond
+		} else {
+			on = &sqlparser.AndExpr{Left: on, Right: cond}
+		}
+	}
+
+	onJoin := &sqlparser.JoinTableExpr{
+		LeftExpr:  ajoin.LeftExpr,
+		Join:      sqlparser.JoinStr,
+		RightExpr: ajoin.RightExpr,
+		Condition: sqlparser.JoinCondition{On: on},
+	}
+	if ajoin.Join == sqlparser.LeftJoinStr || ajoin.Join == sqlparser.NaturalLeftJoinStr {
+		onJoin.Join = sqlparser.LeftJoinStr
+	}
+	if err := pb.join(rpb, onJoin); err != nil {
+		return err
+	}
+	if rb, ok := pb.bldr.(*route); ok {
+		sel := rb.Select.(*sqlparser.Select)
+		if len(sel.From) == 1 && sel.From[0] == sqlparser.TableExpr(onJoin) {
+			sel.From[0] = ajoin
+		}
+	}
+	pb.st.joinsUsing = true
+	return nil
+}
+
+// commonColumns returns the columns that have the same name in
+// the two symtabs, in the order of the left one. They're the
+// columns compared by a NATURAL join. This requires all the
+// tables to have authoritative column lists.
+func commonColumns(lst, rst *symtab) (sqlparser.Columns, error) {
+	ltables, rtables := lst.AllTables(), rst.AllTables()
+	if ltables == nil || rtables == nil {
+		return nil, errors.New("unsupported: natural join with a table that has no authoritative column list")
+	}
+	for _, t := range append(ltables, rtables...) {
+		if !t.isAuthoritative {
+			return nil, fmt.Errorf("unsupported: natural join with a table that has no authoritative column list: %s", sqlparser.String(t.alias))
+		}
+	}
+	rnames := make(map[string]bool)
+	for _, t := range rtables {
+		for _, name := range t.columnNames {
+			rnames[name.Lowered()] = true
+		}
+	}
+	var cols sqlparser.Columns
+	for _, t := range ltables {
+		for _, name := range t.columnNames {
+			if rnames[name.Lowered()] {
+				// Don't add the column again if
+				// it's in another table.
+				delete(rnames, name.Lowered())
+				cols = append(cols, name)
+			}
+		}
+	}
+	return cols, nil
 }
 
 // convertToLeftJoin converts a right join into a left join.
@@ -26,6 +26,8 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 // buildInsertPlan builds the route for an INSERT statement.
@@ -47,10 +49,7 @@ func buildInsertPlan(ins *sqlparser.Insert, vschema ContextVSchema) (engine.Prim
 		}
 		return buildInsertUnshardedPlan(ins, ro.vschemaTable)
 	}
-	if ins.Action == sqlparser.ReplaceStr {
-		return nil, errors.New("unsupported: REPLACE INTO with sharded schema")
-	}
-	return buildInsertShardedPlan(ins, ro.vschemaTable)
+	return buildInsertShardedPlan(ins, ro.vschemaTable, vschema)
 }
 
 func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engine.Primitive, error) {
@@ -97,7 +96,7 @@ func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (eng
 	return eins, nil
 }
 
-func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engine.Primitive, error) {
+func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table, vschema ContextVSchema) (engine.Primitive, error) {
 	eins := engine.NewSimpleInsert(
 		engine.InsertSharded,
 		table,
@@ -106,6 +105,9 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 	if ins.Ignore != "" {
 		eins.Opcode = engine.InsertShardedIgnore
 	}
+	if ins.Action == sqlparser.ReplaceStr {
+		eins.Opcode = engine.InsertShardedReplace
+	}
 	if ins.OnDup != nil {
 		if isVindexChanging(sqlparser.UpdateExprs(ins.OnDup), eins.Table.ColumnVindexes) {
 			return nil, errors.New("unsupported: DML cannot change vindex column")
@@ -115,6 +117,8 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 	if len(ins.Columns) == 0 {
 		if table.ColumnListAuthoritative {
 			populateInsertColumnlist(ins, table)
+		} else if _, ok := ins.Rows.(sqlparser.SelectStatement); ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: insert into %s with a select requires a column list, because the vschema column list of the table is not authoritative", table.Name.String())
 		} else {
 			return nil, errors.New("no column list")
 		}
@@ -130,7 +134,7 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 	var rows sqlparser.Values
 	switch insertValues := ins.Rows.(type) {
 	case *sqlparser.Select, *sqlparser.Union:
-		return nil, errors.New("unsupported: insert into select")
+		return buildInsertSelectPlan(ins, ins.Rows.(sqlparser.SelectStatement), eins, vschema)
 	case sqlparser.Values:
 		rows = insertValues
 		if hasSubquery(rows) {
@@ -167,6 +171,19 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 			}
 		}
 	}
+	if eins.Opcode == engine.InsertShardedReplace && len(eins.Table.Owned) != 0 {
+		eins.ReplaceColumns = columnNames(ins.Columns)
+		eins.ReplaceValues = make([]sqltypes.PlanValue, len(rows))
+		for rowNum, row := range rows {
+			for _, expr := range row {
+				pv, err := sqlparser.NewPlanValue(expr)
+				if err != nil {
+					return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: replace into %s with a value that's not a literal: %s", table.Name.String(), sqlparser.String(expr))
+				}
+				eins.ReplaceValues[rowNum].Values = append(eins.ReplaceValues[rowNum].Values, pv)
+			}
+		}
+	}
 	for _, colVindex := range eins.Table.ColumnVindexes {
 		for _, col := range colVindex.Columns {
 			colNum := findOrAddColumn(ins, col)
@@ -183,6 +200,73 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 	return eins, nil
 }
 
+// buildInsertSelectPlan builds the plan for an INSERT ... SELECT into a
+// sharded table. The SELECT is planned like a standalone query. It becomes
+// the input of the insert, which routes each of its rows to the right shard.
+func buildInsertSelectPlan(ins *sqlparser.Insert, sel sqlparser.SelectStatement, eins *engine.Insert, vschema ContextVSchema) (engine.Primitive, error) {
+	if count, ok := selectExprCount(sel); ok && count != len(ins.Columns) {
+		return nil, errors.New("column list doesn't match values")
+	}
+	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(ins)))
+	if err := pb.processPart(sel, nil); err != nil {
+		return nil, err
+	}
+	if err := pb.bldr.Wireup(pb.bldr, pb.jt); err != nil {
+		return nil, err
+	}
+	eins.Input = pb.bldr.Primitive()
+
+	// The columns that are absent from the column list are appended.
+	// The insert fills them with NULL values, or with generated ones.
+	if eins.Table.AutoIncrement != nil {
+		eins.Generate = &engine.Generate{
+			Keyspace: eins.Table.AutoIncrement.Sequence.Keyspace,
+			Query:    fmt.Sprintf("select next :n values from %s", sqlparser.String(eins.Table.AutoIncrement.Sequence.Name)),
+			Offset:   findOrAppendColumn(ins, eins.Table.AutoIncrement.Column),
+		}
+	}
+	eins.VindexValueOffset = make([][]int, len(eins.Table.ColumnVindexes))
+	for vIdx, colVindex := range eins.Table.ColumnVindexes {
+		for _, col := range colVindex.Columns {
+			eins.VindexValueOffset[vIdx] = append(eins.VindexValueOffset[vIdx], findOrAppendColumn(ins, col))
+		}
+	}
+	if eins.Opcode == engine.InsertShardedReplace && len(eins.Table.Owned) != 0 {
+		eins.ReplaceColumns = columnNames(ins.Columns)
+	}
+	eins.Query = generateQuery(ins)
+	generateInsertShardedQuery(ins, eins, nil)
+	return eins, nil
+}
+
+// selectExprCount returns the number of columns returned by the
+// SELECT. It returns false if the number is unknown because of a '*'.
+func selectExprCount(sel sqlparser.SelectStatement) (int, bool) {
+	switch sel := sel.(type) {
+	case *sqlparser.Union:
+		return selectExprCount(sel.Left)
+	case *sqlparser.ParenSelect:
+		return selectExprCount(sel.Select)
+	case *sqlparser.Select:
+		for _, expr := range sel.SelectExprs {
+			if _, ok := expr.(*sqlparser.StarExpr); ok {
+				return 0, false
+			}
+		}
+		return len(sel.SelectExprs), true
+	}
+	return 0, false
+}
+
+// columnNames returns the names of the columns.
+func columnNames(cols sqlparser.Columns) []string {
+	names := make([]string, len(cols))
+	for i, col := range cols {
+		names[i] = col.String()
+	}
+	return names
+}
+
 func populateInsertColumnlist(ins *sqlparser.Insert, table *vindexes.Table) {
 	cols := make(sqlparser.Columns, 0, len(table.Columns))
 	for _, c := range table.Columns {
@@ -196,8 +280,8 @@ func generateInsertShardedQuery(node *sqlparser.Insert, eins *engine.Insert, val
 	midBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
 	suffixBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
 	eins.Mid = make([]string, len(valueTuples))
-	prefixBuf.Myprintf("insert %v%sinto %v%v values ",
-		node.Comments, node.Ignore,
+	prefixBuf.Myprintf("%s %v%sinto %v%v values ",
+		node.Action, node.Comments, node.Ignore,
 		node.Table, node.Columns)
 	eins.Prefix = prefixBuf.String()
 	for rowNum, val := range valueTuples {
@@ -252,6 +336,18 @@ func findOrAddColumn(ins *sqlparser.Insert, col sqlparser.ColIdent) int {
 	return len(ins.Columns) - 1
 }
 
+// findOrAppendColumn is the findOrAddColumn for an insert with
+// a select. The column is only appended to the column list.
+func findOrAppendColumn(ins *sqlparser.Insert, col sqlparser.ColIdent) int {
+	for i, column := range ins.Columns {
+		if col.Equal(column) {
+			return i
+		}
+	}
+	ins.Columns = append(ins.Columns, col)
+	return len(ins.Columns) - 1
+}
+
 // isVindexChanging returns true if any of the update
 // expressions modify a vindex column.
 func isVindexChanging(setClauses sqlparser.UpdateExprs, colVindexes []*vindexes.ColumnVindex) bool {
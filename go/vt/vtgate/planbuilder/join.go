@@ -19,12 +19,25 @@ package planbuilder
 import (
 	"errors"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 )
 
 var _ builder = (*join)(nil)
 
+var (
+	// errLeftJoinFilter is returned by PushFilter if the condition
+	// references the RHS of a left join. Such conditions must be
+	// evaluated on the results of the join.
+	errLeftJoinFilter = errors.New("unsupported: cross-shard left join and where clause")
+
+	// errLeftJoinExpr is returned by PushSelect if the expression
+	// is not a plain column of the RHS of a left join. Such
+	// expressions must be computed on the results of the join.
+	errLeftJoinExpr = errors.New("unsupported: cross-shard left join and column expressions")
+)
+
 // join is used to build a Join primitive.
 // It's used to build a normal join or a left join
 // operation.
@@ -64,6 +77,32 @@ type join struct {
 	Left, Right builder
 
 	ejoin *engine.Join
+
+	// forceHashJoin and noHashJoin are set by the
+	// comment directives of the query.
+	forceHashJoin, noHashJoin bool
+
+	// needsJoinVars is set if the RHS needs values from the
+	// LHS. If so, only a nested loop join can be used.
+	needsJoinVars bool
+
+	// hashKeys are the equalities between the LHS and the RHS
+	// that can be used as keys for a hash join. They're held back
+	// until Wireup, where the choice between a hash join and
+	// a nested loop join is made.
+	hashKeys []*hashKey
+
+	// ehashJoin is set if a hash join was chosen.
+	ehashJoin *engine.HashJoin
+}
+
+// hashKey is an equality between a column of the LHS
+// and a column of the RHS of a join.
+type hashKey struct {
+	pb          *primitiveBuilder
+	expr        sqlparser.Expr
+	origin      builder
+	left, right *sqlparser.ColName
 }
 
 // newJoin makes a new join using the two planBuilder. ajoin can be nil
@@ -77,25 +116,20 @@ func newJoin(lpb, rpb *primitiveBuilder, ajoin *sqlparser.JoinTableExpr) error {
 	// it's safe to perform this conversion and still expect the same behavior.
 
 	opcode := engine.NormalJoin
-	if ajoin != nil {
-		switch {
-		case ajoin.Join == sqlparser.LeftJoinStr:
-			opcode = engine.LeftJoin
-
-			// For left joins, we have to push the ON clause into the RHS.
-			// We do this before creating the join primitive.
-			// However, variables of LHS need to be visible. To allow this,
-			// we mark the LHS symtab as outer scope to the RHS, just like
-			// a subquery. This make the RHS treat the LHS symbols as external.
-			// This will prevent constructs from escaping out of the rpb scope.
-			// At this point, the LHS symtab also contains symbols of the RHS.
-			// But the RHS will hide those, as intended.
-			rpb.st.Outer = lpb.st
-			if err := rpb.pushFilter(ajoin.Condition.On, sqlparser.WhereStr); err != nil {
-				return err
-			}
-		case ajoin.Condition.Using != nil:
-			return errors.New("unsupported: join with USING(column_list) clause")
+	if ajoin != nil && ajoin.Join == sqlparser.LeftJoinStr {
+		opcode = engine.LeftJoin
+
+		// For left joins, we have to push the ON clause into the RHS.
+		// We do this before creating the join primitive.
+		// However, variables of LHS need to be visible. To allow this,
+		// we mark the LHS symtab as outer scope to the RHS, just like
+		// a subquery. This make the RHS treat the LHS symbols as external.
+		// This will prevent constructs from escaping out of the rpb scope.
+		// At this point, the LHS symtab also contains symbols of the RHS.
+		// But the RHS will hide those, as intended.
+		rpb.st.Outer = lpb.st
+		if err := rpb.pushFilter(ajoin.Condition.On, sqlparser.WhereStr); err != nil {
+			return err
 		}
 	}
 	lpb.bldr = &join{
@@ -106,6 +140,8 @@ func newJoin(lpb, rpb *primitiveBuilder, ajoin *sqlparser.JoinTableExpr) error {
 			Opcode: opcode,
 			Vars:   make(map[string]int),
 		},
+		forceHashJoin: lpb.directives.IsSet(sqlparser.DirectiveHashJoin),
+		noHashJoin:    lpb.directives.IsSet(sqlparser.DirectiveNoHashJoin),
 	}
 	lpb.bldr.Reorder(0)
 	if ajoin == nil || opcode == engine.LeftJoin {
@@ -129,6 +165,12 @@ func (jb *join) Reorder(order int) {
 
 // Primitive satisfies the builder interface.
 func (jb *join) Primitive() engine.Primitive {
+	if jb.ehashJoin != nil {
+		jb.ehashJoin.Left = jb.Left.Primitive()
+		jb.ehashJoin.Right = jb.Right.Primitive()
+		jb.ehashJoin.Cols = jb.ejoin.Cols
+		return jb.ehashJoin
+	}
 	jb.ejoin.Left = jb.Left.Primitive()
 	jb.ejoin.Right = jb.Right.Primitive()
 	return jb.ejoin
@@ -145,13 +187,37 @@ func (jb *join) ResultColumns() []*resultColumn {
 }
 
 // PushFilter satisfies the builder interface.
+// An equality between the LHS and the RHS is held back if
+// it can be used as the key of a hash join.
 func (jb *join) PushFilter(pb *primitiveBuilder, filter sqlparser.Expr, whereType string, origin builder) error {
 	if jb.isOnLeft(origin.Order()) {
+		if err := jb.releaseHashKeys(pb, jb.Left, filter); err != nil {
+			return err
+		}
 		return jb.Left.PushFilter(pb, filter, whereType, origin)
 	}
 	if jb.ejoin.Opcode == engine.LeftJoin {
-		return errors.New("unsupported: cross-shard left join and where clause")
+		return errLeftJoinFilter
+	}
+	if !jb.referencesLeft(filter) {
+		if err := jb.releaseHashKeys(pb, jb.Right, filter); err != nil {
+			return err
+		}
+		return jb.Right.PushFilter(pb, filter, whereType, origin)
 	}
+	if whereType == sqlparser.WhereStr {
+		key := jb.newHashKey(pb, filter, origin)
+		if key != nil && jb.canHashJoin(append(jb.hashKeys, key)) {
+			jb.hashKeys = append(jb.hashKeys, key)
+			return nil
+		}
+	}
+	// The join needs to pass values from the LHS to the RHS.
+	// So, the equalities held back can also be pushed.
+	if err := jb.pushHashKeys(); err != nil {
+		return err
+	}
+	jb.needsJoinVars = true
 	return jb.Right.PushFilter(pb, filter, whereType, origin)
 }
 
@@ -166,9 +232,12 @@ func (jb *join) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, or
 	} else {
 		// Pushing of non-trivial expressions not allowed for RHS of left joins.
 		if _, ok := expr.Expr.(*sqlparser.ColName); !ok && jb.ejoin.Opcode == engine.LeftJoin {
-			return nil, 0, errors.New("unsupported: cross-shard left join and column expressions")
+			return nil, 0, errLeftJoinExpr
 		}
 
+		if jb.referencesLeft(expr.Expr) {
+			jb.needsJoinVars = true
+		}
 		rc, colNumber, err = jb.Right.PushSelect(pb, expr, origin)
 		if err != nil {
 			return nil, 0, err
@@ -283,6 +352,9 @@ func (jb *join) PushMisc(sel *sqlparser.Select) {
 
 // Wireup satisfies the builder interface.
 func (jb *join) Wireup(bldr builder, jt *jointab) error {
+	if err := jb.chooseJoin(); err != nil {
+		return err
+	}
 	err := jb.Right.Wireup(bldr, jt)
 	if err != nil {
 		return err
@@ -370,3 +442,168 @@ func (jb *join) SupplyWeightString(colNumber int) (weightcolNumber int, err erro
 func (jb *join) isOnLeft(nodeNum int) bool {
 	return nodeNum <= jb.leftOrder
 }
+
+// referencesLeft returns true if the expression
+// references columns of the LHS.
+func (jb *join) referencesLeft(expr sqlparser.Expr) bool {
+	first := jb.Left.First().Order()
+	references := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		col, ok := node.(*sqlparser.ColName)
+		if !ok {
+			return true, nil
+		}
+		if c, ok := col.Metadata.(*column); ok {
+			order := c.Origin().Order()
+			if order >= first && jb.isOnLeft(order) {
+				references = true
+				return false, nil
+			}
+		}
+		return true, nil
+	}, expr)
+	return references
+}
+
+// newHashKey returns a hashKey if the filter is an equality
+// between a column of the LHS and a column of the RHS.
+func (jb *join) newHashKey(pb *primitiveBuilder, filter sqlparser.Expr, origin builder) *hashKey {
+	cmp, ok := filter.(*sqlparser.ComparisonExpr)
+	if !ok || cmp.Operator != sqlparser.EqualStr {
+		return nil
+	}
+	left, ok := cmp.Left.(*sqlparser.ColName)
+	if !ok {
+		return nil
+	}
+	right, ok := cmp.Right.(*sqlparser.ColName)
+	if !ok {
+		return nil
+	}
+	if !jb.isOnLeft(left.Metadata.(*column).Origin().Order()) {
+		left, right = right, left
+	}
+	if !jb.referencesLeft(left) || jb.isOnLeft(right.Metadata.(*column).Origin().Order()) {
+		return nil
+	}
+	return &hashKey{pb: pb, expr: filter, origin: origin, left: left, right: right}
+}
+
+// canHashJoin returns true if a hash join can be used with the
+// specified keys. Unless forced by a directive, a hash join is used
+// only if both sides are scatter routes, the keys don't allow the
+// RHS to be routed to specific shards, and the keys are binary safe.
+func (jb *join) canHashJoin(keys []*hashKey) bool {
+	if jb.noHashJoin || jb.needsJoinVars || jb.ejoin.Opcode != engine.NormalJoin {
+		return false
+	}
+	if jb.forceHashJoin {
+		return true
+	}
+	if !isScatter(jb.Left) || !isScatter(jb.Right) {
+		return false
+	}
+	for _, key := range keys {
+		if !key.isBinarySafe() {
+			return false
+		}
+	}
+	for _, ro := range jb.Right.(*route).routeOptions {
+		for _, key := range keys {
+			if opcode, _, _ := ro.computePlan(key.pb, key.expr); opcode != engine.SelectScatter {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// isBinarySafe returns true if the values of the key columns are
+// equal only if they're identical, which is the case if both columns
+// are known to be integral, or both are known to be binary. The
+// values of other columns may be equal under a collation, or after
+// a conversion, which a hash join can only approximate.
+func (key *hashKey) isBinarySafe() bool {
+	ltyp, rtyp := key.left.Metadata.(*column).typ, key.right.Metadata.(*column).typ
+	return (sqltypes.IsIntegral(ltyp) && sqltypes.IsIntegral(rtyp)) || (sqltypes.IsBinary(ltyp) && sqltypes.IsBinary(rtyp))
+}
+
+// releaseHashKeys pushes the equalities held back for a hash
+// join into the RHS if the filter is going to limit one of the
+// sides to specific shards. In that case, a nested loop join is
+// preferred. The equalities are pushed before the filter to keep
+// the conditions in the order in which they were written.
+func (jb *join) releaseHashKeys(pb *primitiveBuilder, side builder, filter sqlparser.Expr) error {
+	if len(jb.hashKeys) == 0 || jb.forceHashJoin {
+		return nil
+	}
+	rb, ok := side.(*route)
+	if !ok {
+		return nil
+	}
+	for _, ro := range rb.routeOptions {
+		if opcode, _, _ := ro.computePlan(pb, filter); opcode != engine.SelectScatter {
+			return jb.pushHashKeys()
+		}
+	}
+	return nil
+}
+
+// pushHashKeys pushes the equalities held back
+// for a hash join into the RHS.
+func (jb *join) pushHashKeys() error {
+	for _, key := range jb.hashKeys {
+		if err := jb.Right.PushFilter(key.pb, key.expr, sqlparser.WhereStr, key.origin); err != nil {
+			return err
+		}
+	}
+	jb.hashKeys = nil
+	return nil
+}
+
+// chooseJoin chooses between a hash join and a nested loop join.
+// If a nested loop join is chosen, the equalities held back for
+// the hash join are pushed into the RHS like other filters.
+// Otherwise, the key columns are requested from both sides. If
+// both keys are text columns, their weight_string is used instead,
+// because we cannot fully mimic mysql's collation behavior yet.
+func (jb *join) chooseJoin() error {
+	if len(jb.hashKeys) == 0 {
+		return nil
+	}
+	if !jb.canHashJoin(jb.hashKeys) {
+		return jb.pushHashKeys()
+	}
+	jb.ehashJoin = &engine.HashJoin{}
+	for _, key := range jb.hashKeys {
+		_, lcol := jb.Left.SupplyCol(key.left)
+		_, rcol := jb.Right.SupplyCol(key.right)
+		if sqltypes.IsText(key.left.Metadata.(*column).typ) && sqltypes.IsText(key.right.Metadata.(*column).typ) {
+			var err error
+			if lcol, err = jb.Left.SupplyWeightString(lcol); err != nil {
+				return err
+			}
+			if rcol, err = jb.Right.SupplyWeightString(rcol); err != nil {
+				return err
+			}
+		}
+		jb.ehashJoin.LHSKeys = append(jb.ehashJoin.LHSKeys, lcol)
+		jb.ehashJoin.RHSKeys = append(jb.ehashJoin.RHSKeys, rcol)
+	}
+	return nil
+}
+
+// isScatter returns true if the builder is a route
+// that sends its query to all shards.
+func isScatter(bldr builder) bool {
+	rb, ok := bldr.(*route)
+	if !ok {
+		return false
+	}
+	for _, ro := range rb.routeOptions {
+		if ro.eroute.Opcode != engine.SelectScatter {
+			return false
+		}
+	}
+	return true
+}
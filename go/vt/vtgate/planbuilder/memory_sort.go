@@ -61,7 +61,12 @@ func newMemorySort(bldr builder, orderBy sqlparser.OrderBy) (*memorySort, error)
 				}
 			}
 		default:
-			return nil, fmt.Errorf("unsupported: memory sort: complex order by expression: %s", sqlparser.String(expr))
+			// The value of a complex expression is computed
+			// by a projection and sorted as a hidden column.
+			var err error
+			if colNumber, err = ms.supplyExpr(expr); err != nil {
+				return nil, fmt.Errorf("unsupported: memory sort: complex order by expression: %s", sqlparser.String(expr))
+			}
 		}
 		// If column is not found, then the order by is referencing
 		// a column that's not on the select list.
@@ -77,6 +82,24 @@ func newMemorySort(bldr builder, orderBy sqlparser.OrderBy) (*memorySort, error)
 	return ms, nil
 }
 
+// supplyExpr requests the input to compute the expression,
+// and returns the column number of the result. If the input
+// is not a projection, one is created. The new column is
+// truncated from the final result.
+func (ms *memorySort) supplyExpr(expr sqlparser.Expr) (int, error) {
+	p, ok := ms.input.(*projection)
+	if !ok {
+		p = newProjection(ms.input)
+	}
+	colNumber, err := p.SupplyExpr(expr)
+	if err != nil {
+		return 0, err
+	}
+	ms.input = p
+	ms.eMemorySort.TruncateColumnCount = len(ms.resultColumns)
+	return colNumber, nil
+}
+
 // Primitive satisfies the builder interface.
 func (ms *memorySort) Primitive() engine.Primitive {
 	ms.eMemorySort.Input = ms.input.Primitive()
@@ -120,6 +143,11 @@ func (ms *memorySort) SetLimit(limit *sqlparser.Limit) error {
 // ability to mimic mysql's collation behavior.
 func (ms *memorySort) Wireup(bldr builder, jt *jointab) error {
 	for i, orderby := range ms.eMemorySort.OrderBy {
+		if orderby.Col >= len(ms.resultColumns) {
+			// Columns computed for the sort have no known
+			// type, and cannot be replaced by a weight_string.
+			continue
+		}
 		rc := ms.resultColumns[orderby.Col]
 		if sqltypes.IsText(rc.column.typ) {
 			// If a weight string was previously requested, reuse it.
@@ -96,6 +96,11 @@ func (ms *mergeSort) Wireup(bldr builder, jt *jointab) error {
 	rb.finalizeOptions()
 	ro := rb.routeOptions[0]
 	for i, orderby := range ro.eroute.OrderBy {
+		if orderby.Col >= len(ms.resultColumns) {
+			// Columns added for sorting complex expressions
+			// have no known type.
+			continue
+		}
 		rc := ms.resultColumns[orderby.Col]
 		if sqltypes.IsText(rc.column.typ) {
 			// If a weight string was previously requested, reuse it.
@@ -0,0 +1,401 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// multiTableDML contains the analysis of a DELETE or UPDATE
+// that references multiple tables in a sharded keyspace.
+//
+// If all the tables can be sent to the same shards, the statement
+// is sent as is, like any other DML. Otherwise, or if lookup vindex
+// entries have to be changed, it's split into single-table DMLs.
+// The rows to be changed are first selected with the FROM and WHERE
+// clauses of the statement. This select returns, for each table, the
+// values of the one column that joins it to the other tables. These
+// values are then used to restrict the single-table DMLs, along with
+// the conditions that reference only that table. The split is only
+// possible for inner joins.
+type multiTableDML struct {
+	pb      *primitiveBuilder
+	dmlType string
+
+	// tables contains the expressions of the
+	// tables, in the order of the FROM clause.
+	tables []*sqlparser.AliasedTableExpr
+
+	// aliases contains the alias of each table.
+	aliases []sqlparser.TableName
+
+	// filters contains the conditions of the ON and WHERE clauses.
+	filters []sqlparser.Expr
+
+	// joinErr is set if the tables are joined in a way
+	// that prevents the statement from being split.
+	joinErr error
+}
+
+// isMultiTable returns true if the FROM clause
+// of a DML isn't a single table expression.
+func isMultiTable(tableExprs sqlparser.TableExprs) bool {
+	if len(tableExprs) != 1 {
+		return true
+	}
+	_, ok := tableExprs[0].(*sqlparser.AliasedTableExpr)
+	return !ok
+}
+
+// newMultiTableDML analyzes the tables of a multi-table DML. It returns
+// nil if the tables are not in a sharded keyspace, or if the FROM clause
+// has only one table. The regular DML plan is built for those.
+func newMultiTableDML(vschema ContextVSchema, dmlType string, stmt sqlparser.Statement, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit) (*multiTableDML, error) {
+	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(stmt)))
+	if err := pb.processTableExprs(tableExprs); err != nil {
+		return nil, err
+	}
+	if rb, ok := pb.bldr.(*route); ok && (!rb.routeOptions[0].eroute.Keyspace.Sharded || len(pb.st.tables) == 1) {
+		return nil, nil
+	}
+	if hasSubquery(stmt) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in multi-table %s statement in sharded keyspace", dmlType)
+	}
+	if orderBy != nil || limit != nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: order by or limit in multi-table %s statement in sharded keyspace", dmlType)
+	}
+
+	mt := &multiTableDML{
+		pb:      pb,
+		dmlType: dmlType,
+	}
+	if err := mt.addTables(tableExprs); err != nil {
+		return nil, err
+	}
+	if where != nil {
+		mt.filters = splitAndExpression(mt.filters, where.Expr)
+	}
+	for _, filter := range mt.filters {
+		if err := pb.st.ResolveSymbols(filter); err != nil {
+			return nil, err
+		}
+	}
+	return mt, nil
+}
+
+// addTables adds the tables and the join conditions of the table expressions.
+func (mt *multiTableDML) addTables(tableExprs sqlparser.TableExprs) error {
+	for _, tableExpr := range tableExprs {
+		switch tableExpr := tableExpr.(type) {
+		case *sqlparser.AliasedTableExpr:
+			tableName, ok := tableExpr.Expr.(sqlparser.TableName)
+			if !ok {
+				return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: derived table in multi-table %s statement in sharded keyspace", mt.dmlType)
+			}
+			alias := tableName
+			if !tableExpr.As.IsEmpty() {
+				alias = sqlparser.TableName{Name: tableExpr.As}
+			}
+			mt.tables = append(mt.tables, tableExpr)
+			mt.aliases = append(mt.aliases, alias)
+		case *sqlparser.ParenTableExpr:
+			if err := mt.addTables(tableExpr.Exprs); err != nil {
+				return err
+			}
+		case *sqlparser.JoinTableExpr:
+			switch {
+			case tableExpr.Join != sqlparser.JoinStr && tableExpr.Join != sqlparser.StraightJoinStr:
+				mt.setJoinErr("%s", tableExpr.Join)
+			case tableExpr.Condition.Using != nil:
+				mt.setJoinErr("join with a USING clause")
+			}
+			if err := mt.addTables(sqlparser.TableExprs{tableExpr.LeftExpr, tableExpr.RightExpr}); err != nil {
+				return err
+			}
+			mt.filters = splitAndExpression(mt.filters, tableExpr.Condition.On)
+		}
+	}
+	return nil
+}
+
+func (mt *multiTableDML) setJoinErr(format string, args ...interface{}) {
+	if mt.joinErr == nil {
+		mt.joinErr = vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: "+format+" in cross-shard multi-table "+mt.dmlType+" statement", args...)
+	}
+}
+
+// findTable returns the index of the table with the specified alias.
+// A name without a qualifier matches the alias of a qualified table.
+func (mt *multiTableDML) findTable(name sqlparser.TableName) int {
+	for i, alias := range mt.aliases {
+		if alias.Name == name.Name && (name.Qualifier.IsEmpty() || alias.Qualifier == name.Qualifier) {
+			return i
+		}
+	}
+	return -1
+}
+
+// tableOf returns the index of the table that the
+// resolved column belongs to, or -1 if it's unknown.
+func (mt *multiTableDML) tableOf(col *sqlparser.ColName) int {
+	c, ok := col.Metadata.(*column)
+	if !ok {
+		return -1
+	}
+	for i, alias := range mt.aliases {
+		t, err := mt.pb.st.FindTable(alias)
+		if err != nil {
+			continue
+		}
+		for _, tc := range t.columns {
+			if tc == c {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// columnsOf returns the columns of the specified table that the
+// expression references, and whether it also references other tables.
+func (mt *multiTableDML) columnsOf(node sqlparser.SQLNode, table int) (cols []*sqlparser.ColName, others bool) {
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if col, ok := node.(*sqlparser.ColName); ok {
+			if mt.tableOf(col) == table {
+				cols = append(cols, col)
+			} else {
+				others = true
+			}
+		}
+		return true, nil
+	}, node)
+	return cols, others
+}
+
+// vschemaTable returns the vschema table of the specified table.
+func (mt *multiTableDML) vschemaTable(table int) (*vindexes.Table, error) {
+	vst, _, _, _, err := mt.pb.vschema.FindTable(mt.tables[table].Expr.(sqlparser.TableName))
+	return vst, err
+}
+
+// buildRoutePlan builds the DML plan for a statement whose
+// tables are all in the same route. The statement is sent
+// as is, to the shards that the route resolves to.
+func (mt *multiTableDML) buildRoutePlan(stmt sqlparser.Statement, comments sqlparser.Comments, target int) (*engine.DML, error) {
+	rb := mt.pb.bldr.(*route)
+	for _, filter := range mt.filters {
+		rb.UpdatePlans(mt.pb, filter)
+	}
+	rb.finalizeOptions()
+	ro := rb.routeOptions[0]
+	for _, sub := range ro.substitutions {
+		*sub.oldExpr = *sub.newExpr
+	}
+
+	edml := &engine.DML{
+		Keyspace: ro.eroute.Keyspace,
+		// Generate query after the substitutions.
+		Query: generateQuery(stmt),
+	}
+	directives := sqlparser.ExtractCommentDirectives(comments)
+	if directives.IsSet(sqlparser.DirectiveMultiShardAutocommit) {
+		edml.MultiShardAutocommit = true
+	}
+	edml.QueryTimeout = queryTimeout(directives)
+	vst, err := mt.vschemaTable(target)
+	if err != nil {
+		return nil, err
+	}
+	edml.Table = vst
+
+	switch ro.eroute.Opcode {
+	case engine.SelectEqualUnique:
+		edml.Opcode = engine.Equal
+	case engine.SelectIN:
+		edml.Opcode = engine.In
+	default:
+		edml.Opcode = engine.Scatter
+		return edml, nil
+	}
+	edml.Vindex = ro.eroute.Vindex
+	edml.Values = ro.eroute.Values
+	if edml.Values == nil {
+		// The value is the condition itself for an equality,
+		// and its right side for an IN clause.
+		cond := ro.condition
+		if comparison, ok := cond.(*sqlparser.ComparisonExpr); ok {
+			cond = comparison.Right
+		}
+		pv, err := sqlparser.NewPlanValue(cond)
+		if err != nil {
+			return nil, err
+		}
+		edml.Values = []sqltypes.PlanValue{pv}
+	}
+	return edml, nil
+}
+
+// buildSplitPlan builds the plan that splits the statement into
+// single-table DMLs for the specified tables. The generate function
+// returns the SQL of the DML for a table, given the condition that
+// restricts its rows.
+func (mt *multiTableDML) buildSplitPlan(targets []int, generate func(target int, cond sqlparser.Expr) string) (engine.Primitive, error) {
+	if mt.joinErr != nil {
+		return nil, mt.joinErr
+	}
+	emt := &engine.MultiTableDML{}
+	var selectExprs sqlparser.SelectExprs
+	for _, target := range targets {
+		link, cond, err := mt.analyzeTarget(target)
+		if err != nil {
+			return nil, err
+		}
+		listVar, _ := mt.pb.jt.GenerateSubqueryVars()
+		cond = andExpr(cond, &sqlparser.ComparisonExpr{
+			Operator: sqlparser.InStr,
+			Left:     &sqlparser.ColName{Name: link.Name},
+			Right:    sqlparser.ListArg("::" + listVar),
+		})
+		stmt, err := sqlparser.Parse(generate(target, cond))
+		if err != nil {
+			return nil, err
+		}
+		var dml engine.Primitive
+		switch stmt := stmt.(type) {
+		case *sqlparser.Delete:
+			dml, err = buildDeletePlan(stmt, mt.pb.vschema)
+		case *sqlparser.Update:
+			dml, err = buildUpdatePlan(stmt, mt.pb.vschema)
+		default:
+			return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unexpected statement type: %T", stmt)
+		}
+		if err != nil {
+			return nil, err
+		}
+		emt.DMLs = append(emt.DMLs, dml)
+		emt.ListVars = append(emt.ListVars, listVar)
+		selectExprs = append(selectExprs, &sqlparser.AliasedExpr{
+			Expr: &sqlparser.ColName{Name: link.Name, Qualifier: mt.aliases[target]},
+		})
+	}
+
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select %v from %v", selectExprs, mt.tableExprs())
+	var where sqlparser.Expr
+	for _, filter := range mt.filters {
+		where = andExpr(where, filter)
+	}
+	if where != nil {
+		buf.Myprintf(" where %v", where)
+	}
+	input, err := buildDMLInput(mt.pb.vschema, buf.String())
+	if err != nil {
+		return nil, err
+	}
+	emt.Input = input
+	return emt, nil
+}
+
+// tableExprs returns the tables as a comma-separated list. Since
+// they're inner joins, their conditions can be in the WHERE clause.
+func (mt *multiTableDML) tableExprs() sqlparser.TableExprs {
+	tableExprs := make(sqlparser.TableExprs, 0, len(mt.tables))
+	for _, table := range mt.tables {
+		tableExprs = append(tableExprs, table)
+	}
+	return tableExprs
+}
+
+// analyzeTarget returns the column that joins the specified table to
+// the other tables, and the conditions that reference only that table.
+// The table must be joined on exactly one of its columns. If so, the
+// rows of the table that are joined with the rows of the other tables
+// are exactly the ones that satisfy its own conditions, and whose
+// column values are among the ones that were selected. Since NULL
+// values cannot be in that list, the table cannot be joined with <=>.
+func (mt *multiTableDML) analyzeTarget(target int) (link *sqlparser.ColName, cond sqlparser.Expr, err error) {
+	for _, filter := range mt.filters {
+		cols, others := mt.columnsOf(filter, target)
+		if len(cols) == 0 {
+			continue
+		}
+		if !others {
+			cond = andExpr(cond, filter)
+			continue
+		}
+		if hasNullSafeEqual(filter) {
+			return nil, nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard multi-table %s statement where %s is joined with <=>", mt.dmlType, sqlparser.String(mt.aliases[target]))
+		}
+		for _, col := range cols {
+			if link != nil && !link.Name.Equal(col.Name) {
+				return nil, nil, mt.notJoinedErr(target)
+			}
+			link = col
+		}
+	}
+	if link == nil {
+		return nil, nil, mt.notJoinedErr(target)
+	}
+	return link, cond, nil
+}
+
+func (mt *multiTableDML) notJoinedErr(target int) error {
+	return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard multi-table %s statement where %s is not joined on exactly one column", mt.dmlType, sqlparser.String(mt.aliases[target]))
+}
+
+// hasNullSafeEqual returns true if the expression contains a <=> comparison.
+func hasNullSafeEqual(expr sqlparser.Expr) bool {
+	has := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if cmp, ok := node.(*sqlparser.ComparisonExpr); ok && cmp.Operator == sqlparser.NullSafeEqualStr {
+			has = true
+			return false, errors.New("dummy")
+		}
+		return true, nil
+	}, expr)
+	return has
+}
+
+// andExpr returns the AND of the two expressions. OR
+// expressions are parenthesized to preserve their meaning.
+func andExpr(left, right sqlparser.Expr) sqlparser.Expr {
+	if or, ok := right.(*sqlparser.OrExpr); ok {
+		right = &sqlparser.ParenExpr{Expr: or}
+	}
+	if left == nil {
+		return right
+	}
+	return &sqlparser.AndExpr{Left: left, Right: right}
+}
+
+// unqualifiedFormatter strips the qualifiers of column names.
+// It's used for the conditions of the single-table DMLs.
+func unqualifiedFormatter(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
+	if col, ok := node.(*sqlparser.ColName); ok {
+		col.Name.Format(buf)
+		return
+	}
+	node.Format(buf)
+}
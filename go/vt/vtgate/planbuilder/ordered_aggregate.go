@@ -53,10 +53,30 @@ var _ builder = (*orderedAggregate)(nil)
 //      Keys: []int{0, 1},
 //      Input: (Scatter Route with the order by request),
 //    }
+// An orderedAggregate can also be built on top of a cross-shard
+// subquery. In that case, the input rows are not aggregated by
+// the shards. So, the values to aggregate are computed for each
+// row by a projection, and the rows are sorted in memory.
 type orderedAggregate struct {
 	resultsBuilder
-	extraDistinct *sqlparser.ColName
-	eaggr         *engine.OrderedAggregate
+	// extraDistinct contains the columns of the distinct
+	// aggregates. They're added to the group by and order by.
+	extraDistinct []*sqlparser.ColName
+	distinctArgs  []distinctArg
+	// aggrExprs maps the result columns supplied by oa to
+	// their aggregate, for ORDER BY clauses that repeat it.
+	aggrExprs map[int]*sqlparser.FuncExpr
+	// rawInput is set if the input is a cross-shard subquery.
+	rawInput bool
+	eaggr    *engine.OrderedAggregate
+}
+
+// distinctArg is an additional expression of a distinct aggregate,
+// like b in 'count(distinct a, b)'. Such expressions are pushed down
+// after the select list to avoid shifting the columns that follow.
+type distinctArg struct {
+	aggr int
+	expr *sqlparser.AliasedExpr
 }
 
 // checkAggregates analyzes the select expression for aggregates. If it determines
@@ -86,9 +106,23 @@ func (pb *primitiveBuilder) checkAggregates(sel *sqlparser.Select) error {
 	// The query has aggregates. We can proceed only
 	// if the underlying primitive is a route because
 	// we need the ability to push down group by and
-	// order by clauses.
+	// order by clauses, or if it's a cross-shard subquery
+	// whose rows can be sorted and aggregated by vtgate.
 	if !isRoute {
-		return errors.New("unsupported: cross-shard query with aggregates")
+		if !isSubqueryInput(pb.bldr) {
+			return errors.New("unsupported: cross-shard query with aggregates")
+		}
+		eaggr := &engine.OrderedAggregate{}
+		pb.bldr = &orderedAggregate{
+			resultsBuilder: newResultsBuilder(newProjection(pb.bldr), eaggr),
+			rawInput:       true,
+			eaggr:          eaggr,
+		}
+		if hasComplexAggregates(sel.SelectExprs) {
+			pb.bldr = newProjection(pb.bldr)
+		}
+		pb.bldr.Reorder(0)
+		return nil
 	}
 
 	// If there is a distinct clause, we can check the select list
@@ -135,10 +169,28 @@ func (pb *primitiveBuilder) checkAggregates(sel *sqlparser.Select) error {
 		resultsBuilder: newResultsBuilder(rb, eaggr),
 		eaggr:          eaggr,
 	}
+	// Aggregates that are part of a larger expression, like
+	// 'sum(a)/count(*)', are computed by a projection on top
+	// of the aggregator.
+	if hasComplexAggregates(sel.SelectExprs) {
+		pb.bldr = newProjection(pb.bldr)
+	}
 	pb.bldr.Reorder(0)
 	return nil
 }
 
+// isSubqueryInput returns true if the rows of the builder
+// are those of a cross-shard subquery, possibly filtered.
+func isSubqueryInput(bldr builder) bool {
+	switch bldr := bldr.(type) {
+	case *subquery:
+		return true
+	case *filter:
+		return isSubqueryInput(bldr.input)
+	}
+	return false
+}
+
 func nodeHasAggregates(node sqlparser.SQLNode) bool {
 	hasAggregates := false
 	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
@@ -261,10 +313,8 @@ func (oa *orderedAggregate) PushFilter(_ *primitiveBuilder, _ sqlparser.Expr, wh
 // others. This functionality depends on the PushOrderBy to request that
 // the rows be correctly ordered.
 func (oa *orderedAggregate) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
-	if inner, ok := expr.Expr.(*sqlparser.FuncExpr); ok {
-		if _, ok := engine.SupportedAggregates[inner.Name.Lowered()]; ok {
-			return oa.pushAggr(pb, expr, origin)
-		}
+	if isAggregate(expr.Expr) {
+		return oa.pushAggr(pb, expr, origin)
 	}
 
 	// Ensure that there are no aggregates in the expression.
@@ -280,7 +330,8 @@ func (oa *orderedAggregate) PushSelect(pb *primitiveBuilder, expr *sqlparser.Ali
 func (oa *orderedAggregate) pushAggr(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
 	funcExpr := expr.Expr.(*sqlparser.FuncExpr)
 	opcode := engine.SupportedAggregates[funcExpr.Name.Lowered()]
-	if len(funcExpr.Exprs) != 1 {
+	// Only count(distinct ...) accepts multiple expressions.
+	if len(funcExpr.Exprs) != 1 && !(funcExpr.Distinct && opcode == engine.AggregateCount) {
 		return nil, 0, fmt.Errorf("unsupported: only one expression allowed inside aggregates: %s", sqlparser.String(funcExpr))
 	}
 	var innerCol int
@@ -289,18 +340,16 @@ func (oa *orderedAggregate) pushAggr(pb *primitiveBuilder, expr *sqlparser.Alias
 		return nil, 0, err
 	}
 	if handleDistinct {
-		if oa.extraDistinct != nil {
-			return nil, 0, fmt.Errorf("unsupported: only one distinct aggregation allowed in a select: %s", sqlparser.String(funcExpr))
-		}
-		// Push the expression that's inside the aggregate.
-		// The column will eventually get added to the group by and order by clauses.
-		_, innerCol, _ = oa.input.PushSelect(pb, innerAliased, origin)
-		col, err := BuildColName(oa.input.ResultColumns(), innerCol)
-		if err != nil {
-			return nil, 0, err
-		}
-		oa.extraDistinct = col
+		// Push the first expression that's inside the aggregate. The rest are
+		// pushed later. The columns will eventually get added to the group by
+		// and order by clauses. Only the values of the first distinct aggregate
+		// will be sorted within a group. The others must be tracked in memory.
+		_, innerCol, _ = oa.input.PushSelect(pb, innerAliased[0], origin)
+		unordered := oa.eaggr.HasDistinct
 		oa.eaggr.HasDistinct = true
+		for _, arg := range innerAliased[1:] {
+			oa.distinctArgs = append(oa.distinctArgs, distinctArg{aggr: len(oa.eaggr.Aggregates), expr: arg})
+		}
 		var alias string
 		if expr.As.IsEmpty() {
 			alias = sqlparser.String(expr.Expr)
@@ -313,10 +362,20 @@ func (oa *orderedAggregate) pushAggr(pb *primitiveBuilder, expr *sqlparser.Alias
 		case engine.AggregateSum:
 			opcode = engine.AggregateSumDistinct
 		}
+		oa.eaggr.Aggregates = append(oa.eaggr.Aggregates, engine.AggregateParams{
+			Opcode:    opcode,
+			Col:       innerCol,
+			Alias:     alias,
+			Unordered: unordered,
+		})
+	} else if oa.rawInput {
+		innerCol, err = oa.pushRawAggr(pb, funcExpr, origin)
+		if err != nil {
+			return nil, 0, err
+		}
 		oa.eaggr.Aggregates = append(oa.eaggr.Aggregates, engine.AggregateParams{
 			Opcode: opcode,
 			Col:    innerCol,
-			Alias:  alias,
 		})
 	} else {
 		_, innerCol, _ = oa.input.PushSelect(pb, expr, origin)
@@ -330,32 +389,82 @@ func (oa *orderedAggregate) pushAggr(pb *primitiveBuilder, expr *sqlparser.Alias
 	// from the expression we pushed down.
 	rc = newResultColumn(expr, oa)
 	oa.resultColumns = append(oa.resultColumns, rc)
+	if oa.aggrExprs == nil {
+		oa.aggrExprs = make(map[int]*sqlparser.FuncExpr)
+	}
+	oa.aggrExprs[len(oa.resultColumns)-1] = funcExpr
 	return rc, len(oa.resultColumns) - 1, nil
 }
 
+// findAggr returns the number of the result column that
+// computes the aggregate, or -1 if there is none.
+func (oa *orderedAggregate) findAggr(funcExpr *sqlparser.FuncExpr) int {
+	for colNumber, aggr := range oa.aggrExprs {
+		if aggr.Name.Lowered() == funcExpr.Name.Lowered() && aggr.Distinct == funcExpr.Distinct && sqlparser.String(aggr.Exprs) == sqlparser.String(funcExpr.Exprs) {
+			return colNumber
+		}
+	}
+	return -1
+}
+
+// pushRawAggr pushes the value that each input row contributes to
+// the aggregate. The rows of a cross-shard subquery are not aggregated.
+// So, count(*) counts 1 for every row, and count(a) counts 1 for every
+// non-NULL a. The other aggregates use the value of their argument.
+func (oa *orderedAggregate) pushRawAggr(pb *primitiveBuilder, funcExpr *sqlparser.FuncExpr, origin builder) (int, error) {
+	var value sqlparser.Expr
+	switch arg := funcExpr.Exprs[0].(type) {
+	case *sqlparser.StarExpr:
+		if funcExpr.Name.Lowered() != "count" {
+			return 0, fmt.Errorf("syntax error: %s", sqlparser.String(funcExpr))
+		}
+		value = sqlparser.NewIntVal([]byte("1"))
+	case *sqlparser.AliasedExpr:
+		value = arg.Expr
+		if funcExpr.Name.Lowered() == "count" {
+			value = &sqlparser.IsExpr{Operator: sqlparser.IsNotNullStr, Expr: arg.Expr}
+		}
+	default:
+		return 0, fmt.Errorf("syntax error: %s", sqlparser.String(funcExpr))
+	}
+	_, innerCol, err := oa.input.PushSelect(pb, &sqlparser.AliasedExpr{Expr: value}, origin)
+	if err != nil {
+		return 0, fmt.Errorf("unsupported: aggregate on results of a cross-shard subquery: %v", err)
+	}
+	return innerCol, nil
+}
+
 // needDistinctHandling returns true if oa needs to handle the distinct clause.
-// If true, it will also return the aliased expression that needs to be pushed
-// down into the underlying route.
-func (oa *orderedAggregate) needDistinctHandling(pb *primitiveBuilder, funcExpr *sqlparser.FuncExpr, opcode engine.AggregateOpcode) (bool, *sqlparser.AliasedExpr, error) {
+// If true, it will also return the aliased expressions that need to be pushed
+// down into the underlying route. If one of the expressions is a unique vindex,
+// the distinct values can't be in more than one shard. So, the aggregate can be
+// pushed down.
+func (oa *orderedAggregate) needDistinctHandling(pb *primitiveBuilder, funcExpr *sqlparser.FuncExpr, opcode engine.AggregateOpcode) (bool, []*sqlparser.AliasedExpr, error) {
 	if !funcExpr.Distinct {
 		return false, nil, nil
 	}
 	if opcode != engine.AggregateCount && opcode != engine.AggregateSum {
 		return false, nil, nil
 	}
-	innerAliased, ok := funcExpr.Exprs[0].(*sqlparser.AliasedExpr)
-	if !ok {
-		return false, nil, fmt.Errorf("syntax error: %s", sqlparser.String(funcExpr))
+	innerAliased := make([]*sqlparser.AliasedExpr, 0, len(funcExpr.Exprs))
+	for _, expr := range funcExpr.Exprs {
+		aliased, ok := expr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return false, nil, fmt.Errorf("syntax error: %s", sqlparser.String(funcExpr))
+		}
+		innerAliased = append(innerAliased, aliased)
 	}
 	rb, ok := oa.input.(*route)
 	if !ok {
-		// Unreachable
+		// The input is a cross-shard subquery.
 		return true, innerAliased, nil
 	}
 	success := rb.removeOptions(func(ro *routeOption) bool {
-		vindex := ro.FindVindex(pb, innerAliased.Expr)
-		if vindex != nil && vindex.IsUnique() {
-			return true
+		for _, aliased := range innerAliased {
+			vindex := ro.FindVindex(pb, aliased.Expr)
+			if vindex != nil && vindex.IsUnique() {
+				return true
+			}
 		}
 		return false
 	})
@@ -373,6 +482,9 @@ func (oa *orderedAggregate) MakeDistinct() error {
 		}
 		oa.eaggr.Keys = append(oa.eaggr.Keys, i)
 	}
+	if oa.rawInput {
+		return nil
+	}
 	return oa.input.MakeDistinct()
 }
 
@@ -406,9 +518,12 @@ func (oa *orderedAggregate) PushGroupBy(groupBy sqlparser.GroupBy) error {
 		}
 		oa.eaggr.Keys = append(oa.eaggr.Keys, colNumber)
 	}
-	// Append the distinct aggregate if any.
-	if oa.extraDistinct != nil {
-		groupBy = append(groupBy, oa.extraDistinct)
+	// Append the distinct aggregates if any.
+	if err := oa.pushDistinctArgs(); err != nil {
+		return err
+	}
+	for _, col := range oa.extraDistinct {
+		groupBy = append(groupBy, col)
 	}
 
 	_ = oa.input.PushGroupBy(groupBy)
@@ -416,6 +531,35 @@ func (oa *orderedAggregate) PushGroupBy(groupBy sqlparser.GroupBy) error {
 	return nil
 }
 
+// pushDistinctArgs pushes the additional expressions of the distinct
+// aggregates to the input, which are truncated from the result. It then
+// builds the list of columns of all the distinct aggregates, in order.
+func (oa *orderedAggregate) pushDistinctArgs() error {
+	for _, arg := range oa.distinctArgs {
+		// It's ok to pass nil for pb and builder because the input
+		// is a route or a subquery, whose PushSelect doesn't use them.
+		_, innerCol, _ := oa.input.PushSelect(nil, arg.expr, nil)
+		aggr := &oa.eaggr.Aggregates[arg.aggr]
+		aggr.DistinctCols = append(aggr.DistinctCols, innerCol)
+	}
+	if len(oa.input.ResultColumns()) > len(oa.resultColumns) {
+		oa.eaggr.TruncateColumnCount = len(oa.resultColumns)
+	}
+	for _, aggr := range oa.eaggr.Aggregates {
+		if aggr.Opcode != engine.AggregateCountDistinct && aggr.Opcode != engine.AggregateSumDistinct {
+			continue
+		}
+		for _, innerCol := range append([]int{aggr.Col}, aggr.DistinctCols...) {
+			col, err := BuildColName(oa.input.ResultColumns(), innerCol)
+			if err != nil {
+				return err
+			}
+			oa.extraDistinct = append(oa.extraDistinct, col)
+		}
+	}
+	return nil
+}
+
 // PushOrderBy pushes the order by expression into the primitive.
 // The requested order must be such that the ordering can be done
 // before the group by, which will allow us to push it down to the
@@ -426,8 +570,11 @@ func (oa *orderedAggregate) PushGroupBy(groupBy sqlparser.GroupBy) error {
 // constructs are allowed:
 // 'select a, b, count(*) from t group by a, b order by a desc, b asc'
 // 'select a, b, count(*) from t group by a, b order by b'
-// The following construct is not allowed:
+// Other constructs, like ordering by an aggregate of the select
+// list or by a complex expression, are handled by a memorySort
+// on top of oa:
 // 'select a, count(*) from t group by a order by count(*)'
+// 'select a, count(*) from t group by a order by a+1'
 func (oa *orderedAggregate) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
 	// Treat order by null as nil order by.
 	if len(orderBy) == 1 {
@@ -440,10 +587,25 @@ func (oa *orderedAggregate) PushOrderBy(orderBy sqlparser.OrderBy) (builder, err
 	referenced := make([]bool, len(oa.eaggr.Keys))
 	postSort := false
 	selOrderBy := make(sqlparser.OrderBy, 0, len(orderBy))
+	// postOrderBy is the order by of the memorySort. Aggregates
+	// are replaced by the number of their result column.
+	postOrderBy := make(sqlparser.OrderBy, 0, len(orderBy))
 	for _, order := range orderBy {
+		postOrderBy = append(postOrderBy, order)
 		// Identify the order by column.
 		var orderByCol *column
 		switch expr := order.Expr.(type) {
+		case *sqlparser.FuncExpr:
+			if colNumber := oa.findAggr(expr); colNumber != -1 {
+				postOrderBy[len(postOrderBy)-1] = &sqlparser.Order{
+					Expr:      sqlparser.NewIntVal([]byte(strconv.Itoa(colNumber + 1))),
+					Direction: order.Direction,
+				}
+			}
+			// Aggregates and other functions are
+			// sorted after the aggregation.
+			postSort = true
+			continue
 		case *sqlparser.SQLVal:
 			num, err := ResultFromNumber(oa.resultColumns, expr)
 			if err != nil {
@@ -453,7 +615,10 @@ func (oa *orderedAggregate) PushOrderBy(orderBy sqlparser.OrderBy) (builder, err
 		case *sqlparser.ColName:
 			orderByCol = expr.Metadata.(*column)
 		default:
-			return nil, fmt.Errorf("unsupported: in scatter query: complex order by expression: %v", sqlparser.String(expr))
+			// Complex expressions are computed and
+			// sorted after the aggregation.
+			postSort = true
+			continue
 		}
 
 		// Match orderByCol against the group by columns.
@@ -486,9 +651,9 @@ func (oa *orderedAggregate) PushOrderBy(orderBy sqlparser.OrderBy) (builder, err
 		selOrderBy = append(selOrderBy, &sqlparser.Order{Expr: col, Direction: sqlparser.AscScr})
 	}
 
-	// Append the distinct aggregate if any.
-	if oa.extraDistinct != nil {
-		selOrderBy = append(selOrderBy, &sqlparser.Order{Expr: oa.extraDistinct, Direction: sqlparser.AscScr})
+	// Append the distinct aggregates if any.
+	for _, col := range oa.extraDistinct {
+		selOrderBy = append(selOrderBy, &sqlparser.Order{Expr: col, Direction: sqlparser.AscScr})
 	}
 
 	// Push down the order by.
@@ -501,13 +666,18 @@ func (oa *orderedAggregate) PushOrderBy(orderBy sqlparser.OrderBy) (builder, err
 	}
 	oa.input = bldr
 	if postSort {
-		return newMemorySort(oa, orderBy)
+		return newMemorySort(oa, postOrderBy)
 	}
 	return oa, nil
 }
 
 // SetUpperLimit satisfies the builder interface.
+// The rows of a cross-shard subquery can't be limited
+// because they're aggregated by oa.
 func (oa *orderedAggregate) SetUpperLimit(count *sqlparser.SQLVal) {
+	if oa.rawInput {
+		return
+	}
 	oa.input.SetUpperLimit(count)
 }
 
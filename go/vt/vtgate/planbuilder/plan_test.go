@@ -162,6 +162,7 @@ func TestPlan(t *testing.T) {
 	testFile(t, "vindex_func_cases.txt", testOutputTempDir, vschema)
 	testFile(t, "wireup_cases.txt", testOutputTempDir, vschema)
 	testFile(t, "memory_sort_cases.txt", testOutputTempDir, vschema)
+	testFile(t, "union_cases.txt", testOutputTempDir, vschema)
 }
 
 func TestOne(t *testing.T) {
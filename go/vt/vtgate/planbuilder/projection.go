@@ -0,0 +1,366 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+	"fmt"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ builder = (*projection)(nil)
+
+// projection is the builder for engine.Projection.
+// It computes expressions that cannot be pushed down,
+// like 'sum(a)/count(*)' on top of an orderedAggregate,
+// or the value of a complex ORDER BY expression for a
+// memorySort. Columns that don't need computation are
+// passed through from the input.
+type projection struct {
+	builderCommon
+	resultColumns []*resultColumn
+	// computed contains the expressions of the columns computed by
+	// the projection. Aggregates in them have been replaced by
+	// references to the input. They're used to substitute
+	// references to the computed columns in ORDER BY expressions.
+	computed map[*column]sqlparser.Expr
+	eproj    *engine.Projection
+}
+
+// newProjection builds a new projection that initially
+// passes through all the result columns of the input.
+func newProjection(input builder) *projection {
+	p := &projection{
+		builderCommon: newBuilderCommon(input),
+		computed:      make(map[*column]sqlparser.Expr),
+		eproj:         &engine.Projection{},
+	}
+	for i, rc := range input.ResultColumns() {
+		p.addPassthrough(rc, i)
+	}
+	return p
+}
+
+// hasComplexAggregates returns true if the select list
+// contains aggregates that are part of a larger expression.
+func hasComplexAggregates(selectExprs sqlparser.SelectExprs) bool {
+	for _, selectExpr := range selectExprs {
+		expr, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			continue
+		}
+		if isAggregate(expr.Expr) {
+			continue
+		}
+		if nodeHasAggregates(expr.Expr) {
+			return true
+		}
+	}
+	return false
+}
+
+// isAggregate returns true if the expression is an aggregate
+// function that can be handled by orderedAggregate.
+func isAggregate(expr sqlparser.Expr) bool {
+	funcExpr, ok := expr.(*sqlparser.FuncExpr)
+	if !ok || funcExpr.IsWindowFunction() {
+		return false
+	}
+	_, ok = engine.SupportedAggregates[funcExpr.Name.Lowered()]
+	return ok
+}
+
+// ResultColumns satisfies the builder interface.
+func (p *projection) ResultColumns() []*resultColumn {
+	return p.resultColumns
+}
+
+// Primitive satisfies the builder interface.
+func (p *projection) Primitive() engine.Primitive {
+	p.eproj.Input = p.input.Primitive()
+	return p.eproj
+}
+
+// PushFilter satisfies the builder interface.
+func (p *projection) PushFilter(pb *primitiveBuilder, filter sqlparser.Expr, whereType string, origin builder) error {
+	return p.input.PushFilter(pb, filter, whereType, origin)
+}
+
+// PushSelect satisfies the builder interface.
+// Expressions that don't have aggregates, or are plain aggregates,
+// are pushed to the input and passed through. For expressions that
+// contain aggregates, the aggregates are pushed to the input and the
+// rest of the expression is computed by the projection. Expressions
+// that reference the RHS of a cross-shard left join, or the columns
+// of a cross-shard subquery, are also computed by the projection.
+func (p *projection) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	if isAggregate(expr.Expr) || !nodeHasAggregates(expr.Expr) {
+		innerRC, innerCol, err := p.input.PushSelect(pb, expr, origin)
+		if err == errLeftJoinExpr || err == errSubqueryExpr {
+			pushErr := err
+			rc, colNumber, err = p.addComputed(expr, columnName(expr), expr.Expr)
+			if err != nil {
+				return nil, 0, fmt.Errorf("%v: %v", pushErr, err)
+			}
+			return rc, colNumber, nil
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		p.addPassthrough(innerRC, innerCol)
+		p.eproj.Cols[len(p.eproj.Cols)-1] = columnName(expr)
+		return innerRC, len(p.resultColumns) - 1, nil
+	}
+
+	// The name must be computed before the aggregates are replaced.
+	name := columnName(expr)
+	computed, err := pushAggregates(pb, p.input, expr.Expr, origin)
+	if err != nil {
+		return nil, 0, err
+	}
+	rc, colNumber, err = p.addComputed(expr, name, computed)
+	if err != nil {
+		return nil, 0, fmt.Errorf("unsupported: in scatter query: complex aggregate expression: %v", err)
+	}
+	return rc, colNumber, nil
+}
+
+// addComputed adds a column that computes the expression.
+// The expression must not contain aggregates.
+func (p *projection) addComputed(expr *sqlparser.AliasedExpr, name string, computed sqlparser.Expr) (rc *resultColumn, colNumber int, err error) {
+	evalExpr, err := p.convert(computed)
+	if err != nil {
+		return nil, 0, err
+	}
+	rc = newResultColumn(expr, p)
+	p.computed[rc.column] = computed
+	p.resultColumns = append(p.resultColumns, rc)
+	p.eproj.Cols = append(p.eproj.Cols, name)
+	p.eproj.Exprs = append(p.eproj.Exprs, evalExpr)
+	return rc, len(p.resultColumns) - 1, nil
+}
+
+// pushAggregates pushes the aggregates contained in the expression
+// to the input as separate columns, and replaces them with references
+// to those columns. The rest of the expression is expected to be
+// evaluated by vtgate.
+func pushAggregates(pb *primitiveBuilder, input builder, expr sqlparser.Expr, origin builder) (sqlparser.Expr, error) {
+	var pushErr error
+	expr = sqlparser.Rewrite(expr, func(cursor *sqlparser.Cursor) bool {
+		if pushErr != nil {
+			return false
+		}
+		switch node := cursor.Node().(type) {
+		case *sqlparser.FuncExpr:
+			if !isAggregate(node) {
+				return true
+			}
+			innerRC, _, err := input.PushSelect(pb, &sqlparser.AliasedExpr{Expr: node}, origin)
+			if err != nil {
+				pushErr = err
+				return false
+			}
+			cursor.Replace(&sqlparser.ColName{
+				Metadata: innerRC.column,
+				Name:     sqlparser.NewColIdent(sqlparser.String(node)),
+			})
+			return false
+		case *sqlparser.Subquery:
+			pushErr = errors.New("unsupported: subquery in complex aggregate expression")
+			return false
+		}
+		return true
+	}, nil).(sqlparser.Expr)
+	return expr, pushErr
+}
+
+// MakeDistinct satisfies the builder interface.
+func (p *projection) MakeDistinct() error {
+	for _, rc := range p.resultColumns {
+		if rc.column.Origin() == p {
+			return errors.New("unsupported: distinct cannot be combined with aggregate functions")
+		}
+	}
+	return p.input.MakeDistinct()
+}
+
+// PushGroupBy satisfies the builder interface.
+// Column numbers are translated to the numbers of the input.
+func (p *projection) PushGroupBy(groupBy sqlparser.GroupBy) error {
+	var innerGroupBy sqlparser.GroupBy
+	for _, expr := range groupBy {
+		switch expr.(type) {
+		case *sqlparser.SQLVal, *sqlparser.ColName:
+			innerExpr, err := p.translate(expr)
+			if err != nil {
+				return err
+			}
+			if innerExpr == nil {
+				return fmt.Errorf("group by expression cannot reference an aggregate function: %v", sqlparser.String(expr))
+			}
+			expr = innerExpr
+		}
+		innerGroupBy = append(innerGroupBy, expr)
+	}
+	return p.input.PushGroupBy(innerGroupBy)
+}
+
+// PushOrderBy satisfies the builder interface.
+// If the ORDER BY only references columns that are passed through,
+// it's pushed to the input. Otherwise, the ordering is performed
+// by a memorySort on top of the projection.
+func (p *projection) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	innerOrderBy := make(sqlparser.OrderBy, 0, len(orderBy))
+	for _, order := range orderBy {
+		innerExpr, err := p.translate(order.Expr)
+		if err != nil {
+			return nil, err
+		}
+		if innerExpr == nil {
+			bldr, err := p.input.PushOrderBy(nil)
+			if err != nil {
+				return nil, err
+			}
+			p.input = bldr
+			return newMemorySort(p, orderBy)
+		}
+		innerOrderBy = append(innerOrderBy, &sqlparser.Order{Expr: innerExpr, Direction: order.Direction})
+	}
+	bldr, err := p.input.PushOrderBy(innerOrderBy)
+	if err != nil {
+		return nil, err
+	}
+	p.input = bldr
+	return p, nil
+}
+
+// SupplyCol satisfies the builder interface.
+func (p *projection) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNumber int) {
+	c := col.Metadata.(*column)
+	for i, rc := range p.resultColumns {
+		if rc.column == c {
+			return rc, i
+		}
+	}
+	innerRC, innerCol := p.input.SupplyCol(col)
+	p.addPassthrough(innerRC, innerCol)
+	return innerRC, len(p.resultColumns) - 1
+}
+
+// SupplyWeightString satisfies the builder interface.
+func (p *projection) SupplyWeightString(colNumber int) (weightcolNumber int, err error) {
+	col, ok := p.eproj.Exprs[colNumber].(*evalengine.Column)
+	if !ok {
+		return 0, errors.New("unsupported: cannot generate weight_string for a computed column")
+	}
+	innerCol, err := p.input.SupplyWeightString(col.Offset)
+	if err != nil {
+		return 0, err
+	}
+	for i, expr := range p.eproj.Exprs {
+		if col, ok := expr.(*evalengine.Column); ok && col.Offset == innerCol {
+			return i, nil
+		}
+	}
+	p.addPassthrough(p.input.ResultColumns()[innerCol], innerCol)
+	return len(p.resultColumns) - 1, nil
+}
+
+// SupplyExpr adds a column that computes the expression
+// and returns its number. It's used for ORDER BY expressions
+// that need to be computed before sorting.
+func (p *projection) SupplyExpr(expr sqlparser.Expr) (colNumber int, err error) {
+	name := sqlparser.String(expr)
+	evalExpr, err := p.convert(expr)
+	if err != nil {
+		return 0, err
+	}
+	p.resultColumns = append(p.resultColumns, &resultColumn{column: &column{origin: p}})
+	p.eproj.Cols = append(p.eproj.Cols, name)
+	p.eproj.Exprs = append(p.eproj.Exprs, evalExpr)
+	return len(p.resultColumns) - 1, nil
+}
+
+// addPassthrough adds a column that passes through
+// the specified column of the input.
+func (p *projection) addPassthrough(rc *resultColumn, innerCol int) {
+	p.resultColumns = append(p.resultColumns, rc)
+	p.eproj.Cols = append(p.eproj.Cols, rc.alias.String())
+	p.eproj.Exprs = append(p.eproj.Exprs, &evalengine.Column{Offset: innerCol})
+}
+
+// translate translates an expression that references the
+// result columns of the projection into one that references
+// the input. It returns nil if the expression requires
+// the values computed by the projection.
+func (p *projection) translate(expr sqlparser.Expr) (sqlparser.Expr, error) {
+	switch node := expr.(type) {
+	case *sqlparser.SQLVal:
+		num, err := ResultFromNumber(p.resultColumns, node)
+		if err != nil {
+			return nil, err
+		}
+		col, ok := p.eproj.Exprs[num].(*evalengine.Column)
+		if !ok {
+			return nil, nil
+		}
+		return sqlparser.NewIntVal([]byte(fmt.Sprintf("%d", col.Offset+1))), nil
+	case *sqlparser.ColName:
+		if node.Metadata.(*column).Origin() == p {
+			return nil, nil
+		}
+		return node, nil
+	case *sqlparser.NullVal:
+		return node, nil
+	}
+	return nil, nil
+}
+
+// convert converts the expression into an evalengine.Expr. Columns
+// computed by the projection are replaced by their expressions, and
+// other columns are supplied by the input.
+func (p *projection) convert(expr sqlparser.Expr) (evalengine.Expr, error) {
+	expr = sqlparser.Rewrite(expr, func(cursor *sqlparser.Cursor) bool {
+		col, ok := cursor.Node().(*sqlparser.ColName)
+		if !ok {
+			return true
+		}
+		if computed, ok := p.computed[col.Metadata.(*column)]; ok {
+			cursor.Replace(computed)
+		}
+		return false
+	}, nil).(sqlparser.Expr)
+	return evalengine.Convert(expr, func(col *sqlparser.ColName) (int, error) {
+		_, colNumber := p.input.SupplyCol(col)
+		return colNumber, nil
+	})
+}
+
+// columnName returns the name of the result column
+// for the select expression.
+func columnName(expr *sqlparser.AliasedExpr) string {
+	if !expr.As.IsEmpty() {
+		return expr.As.String()
+	}
+	if col, ok := expr.Expr.(*sqlparser.ColName); ok {
+		return col.Name.String()
+	}
+	return sqlparser.String(expr.Expr)
+}
@@ -188,6 +188,8 @@ func (rb *route) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
 	}
 
 	// If it's a scatter, we have to populate the OrderBy field.
+	// hiddenStart is the first column added for sorting.
+	hiddenStart := -1
 	for _, order := range orderBy {
 		colNumber := -1
 		switch expr := order.Expr.(type) {
@@ -205,7 +207,17 @@ func (rb *route) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
 				}
 			}
 		default:
-			return nil, fmt.Errorf("unsupported: in scatter query: complex order by expression: %s", sqlparser.String(expr))
+			// A complex expression is added to the select list
+			// as a hidden column for the merge sort.
+			if statementSelectsStar(rb.Select) {
+				return nil, fmt.Errorf("unsupported: in scatter query: complex order by expression: %s", sqlparser.String(expr))
+			}
+			if hiddenStart == -1 {
+				hiddenStart = len(rb.resultColumns)
+			}
+			order = &sqlparser.Order{Expr: rb.expandAliases(expr), Direction: order.Direction}
+			rb.PushAnonymous(&sqlparser.AliasedExpr{Expr: order.Expr})
+			colNumber = len(rb.resultColumns) - 1
 		}
 		// If column is not found, then the order by is referencing
 		// a column that's not on the select list.
@@ -222,7 +234,29 @@ func (rb *route) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
 
 		rb.Select.AddOrder(order)
 	}
-	return newMergeSort(rb), nil
+	ms := newMergeSort(rb)
+	if hiddenStart != -1 {
+		ms.resultColumns = ms.resultColumns[:hiddenStart]
+		ms.truncateColumnCount = hiddenStart
+	}
+	return ms, nil
+}
+
+// expandAliases replaces references to aliases of the select list
+// by the aliased expressions, because mysql doesn't allow aliases
+// to be referenced from within the select list.
+func (rb *route) expandAliases(expr sqlparser.Expr) sqlparser.Expr {
+	sel := rb.Select.(*sqlparser.Select)
+	return sqlparser.Rewrite(expr, func(cursor *sqlparser.Cursor) bool {
+		col, ok := cursor.Node().(*sqlparser.ColName)
+		if !ok {
+			return true
+		}
+		if aliased := findAlias(col, sel.SelectExprs); aliased != nil {
+			cursor.Replace(aliased)
+		}
+		return false
+	}, nil).(sqlparser.Expr)
 }
 
 // SetLimit adds a LIMIT clause to the route.
@@ -243,6 +277,7 @@ func (rb *route) SetUpperLimit(count *sqlparser.SQLVal) {
 func (rb *route) PushMisc(sel *sqlparser.Select) {
 	rb.Select.(*sqlparser.Select).Comments = sel.Comments
 	rb.Select.(*sqlparser.Select).Lock = sel.Lock
+	rb.Select.(*sqlparser.Select).Windows = sel.Windows
 }
 
 // Wireup satisfies the builder interface.
@@ -26,6 +26,12 @@ import (
 
 // buildSelectPlan is the new function to build a Select plan.
 func buildSelectPlan(sel *sqlparser.Select, vschema ContextVSchema) (engine.Primitive, error) {
+	if hasRecursiveCTE(sel) {
+		return buildRecursiveCTEPlan(sel, vschema)
+	}
+	if err := inlineCTEs(sel, nil); err != nil {
+		return nil, err
+	}
 	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(sel)))
 	if err := pb.processSelect(sel, nil); err != nil {
 		return nil, err
@@ -72,6 +78,7 @@ func buildSelectPlan(sel *sqlparser.Select, vschema ContextVSchema) (engine.Prim
 // pushed into a route, then a primitive is created on top of any
 // of the above trees to make it discard unwanted rows.
 func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab) error {
+	pb.directives = sqlparser.ExtractCommentDirectives(sel.Comments)
 	if err := pb.processTableExprs(sel.From); err != nil {
 		return err
 	}
@@ -79,13 +86,12 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab)
 	if rb, ok := pb.bldr.(*route); ok {
 		// TODO(sougou): this can probably be improved.
 		for _, ro := range rb.routeOptions {
-			directives := sqlparser.ExtractCommentDirectives(sel.Comments)
-			ro.eroute.QueryTimeout = queryTimeout(directives)
+			ro.eroute.QueryTimeout = queryTimeout(pb.directives)
 			if ro.eroute.TargetDestination != nil {
 				return errors.New("unsupported: SELECT with a target destination")
 			}
 
-			if directives.IsSet(sqlparser.DirectiveScatterErrorsAsWarnings) {
+			if pb.directives.IsSet(sqlparser.DirectiveScatterErrorsAsWarnings) {
 				ro.eroute.ScatterErrorsAsWarnings = true
 			}
 		}
@@ -99,6 +105,9 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab)
 			return err
 		}
 	}
+	if err := pb.checkWindowFunctions(sel); err != nil {
+		return err
+	}
 	if err := pb.checkAggregates(sel); err != nil {
 		return err
 	}
@@ -106,6 +115,12 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab)
 		return err
 	}
 	if sel.Having != nil {
+		switch pb.bldr.(type) {
+		case *orderedAggregate, *projection:
+			// The aggregates are computed by vtgate. So,
+			// the HAVING clause must also be evaluated here.
+			pb.bldr = newFilter(pb.bldr)
+		}
 		if err := pb.pushFilter(sel.Having.Expr, sqlparser.HavingStr); err != nil {
 			return err
 		}
@@ -128,12 +143,25 @@ func (pb *primitiveBuilder) pushFilter(in sqlparser.Expr, whereType string) erro
 	reorderBySubquery(filters)
 	for _, filter := range filters {
 		pullouts, origin, expr, err := pb.findOrigin(filter)
+		if cerr, ok := err.(*correlatedSubqueryError); ok && whereType == sqlparser.WhereStr {
+			if err := pb.pushSemiJoin(filter, cerr); err != nil {
+				return err
+			}
+			continue
+		}
 		if err != nil {
 			return err
 		}
 		// The returned expression may be complex. Resplit before pushing.
 		for _, subexpr := range splitAndExpression(nil, expr) {
-			if err := pb.bldr.PushFilter(pb, subexpr, whereType, origin); err != nil {
+			err := pb.bldr.PushFilter(pb, subexpr, whereType, origin)
+			if err == errLeftJoinFilter || err == errSubqueryFilter {
+				// The condition can only be evaluated on the
+				// results of the left join or the subquery.
+				pb.bldr = newFilter(pb.bldr)
+				err = pb.bldr.PushFilter(pb, subexpr, whereType, origin)
+			}
+			if err != nil {
 				return err
 			}
 		}
@@ -195,6 +223,13 @@ func (pb *primitiveBuilder) pushSelectRoutes(selectExprs sqlparser.SelectExprs)
 			}
 			node.Expr = expr
 			rc, _, err := pb.bldr.PushSelect(pb, node, origin)
+			if err == errLeftJoinExpr || err == errSubqueryExpr {
+				// The expression can only be computed on the
+				// results of the left join or the subquery.
+				pb.bldr = newProjection(pb.bldr)
+				pb.bldr.Reorder(0)
+				rc, _, err = pb.bldr.PushSelect(pb, node, origin)
+			}
 			if err != nil {
 				return nil, err
 			}
@@ -255,6 +290,9 @@ func (pb *primitiveBuilder) expandStar(inrcs []*resultColumn, expr *sqlparser.St
 		return inrcs, false, nil
 	}
 	if expr.TableName.IsEmpty() {
+		if pb.st.joinsUsing {
+			return inrcs, false, nil
+		}
 		for _, t := range tables {
 			// All tables must have authoritative column lists.
 			if !t.isAuthoritative {
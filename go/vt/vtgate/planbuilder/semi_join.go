@@ -0,0 +1,290 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var _ builder = (*semiJoin)(nil)
+
+// semiJoin is the builder for engine.SemiJoin.
+// This gets built for a correlated [NOT] EXISTS subquery
+// that cannot be merged with the outer query. The outer
+// query is executed first, and the values of the column
+// it's correlated on are fed to the subquery as a list.
+type semiJoin struct {
+	order int
+	outer builder
+	inner builder
+	// outerCol is the column of the outer query that
+	// was referenced by the subquery.
+	outerCol *sqlparser.ColName
+	// compareWeightStrings is set if the columns must be
+	// matched using their weight_string, which is the case if
+	// both are text columns. Other columns are compared by
+	// the engine, based on the fields of their results.
+	compareWeightStrings bool
+	esemiJoin            *engine.SemiJoin
+}
+
+// pushSemiJoin builds a semiJoin for a filter of the form [NOT] EXISTS (subquery),
+// if the subquery is correlated to the outer query through a single equality.
+// The equality is converted into an IN clause that receives the list of values
+// from the outer query. The select list of the subquery is irrelevant for EXISTS.
+// So, it's replaced by the column that's compared.
+// If the filter doesn't match these requirements, the original error is returned.
+func (pb *primitiveBuilder) pushSemiJoin(filter sqlparser.Expr, cerr *correlatedSubqueryError) error {
+	opcode := engine.SemiJoinExists
+	expr := skipParenthesis(filter)
+	if notExpr, ok := expr.(*sqlparser.NotExpr); ok {
+		opcode = engine.SemiJoinNotExists
+		expr = skipParenthesis(notExpr.Expr)
+	}
+	if exists, ok := expr.(*sqlparser.ExistsExpr); !ok || exists.Subquery != cerr.subquery {
+		return cerr
+	}
+	inner, ok := cerr.bldr.(*route)
+	if !ok {
+		return cerr
+	}
+	sel, ok := inner.Select.(*sqlparser.Select)
+	if !ok || sel.GroupBy != nil || sel.Having != nil || sel.OrderBy != nil || nodeHasAggregates(sel.SelectExprs) {
+		return cerr
+	}
+	if sel.Limit != nil {
+		// A limit on an EXISTS subquery doesn't change its result,
+		// unless it's zero. But it can't be applied to the values
+		// of multiple outer rows.
+		if sel.Limit.Offset != nil || !isPositiveInt(sel.Limit.Rowcount) {
+			return cerr
+		}
+		sel.Limit = nil
+	}
+	comparison, outerCol, innerCol := pb.findCorrelation(inner, sel)
+	if comparison == nil {
+		return cerr
+	}
+
+	listVar, _ := pb.jt.GenerateSubqueryVars()
+	comparison.Left = innerCol
+	comparison.Operator = sqlparser.InStr
+	comparison.Right = sqlparser.ListArg("::" + listVar)
+	for _, ro := range inner.routeOptions {
+		if ro.condition == sqlparser.Expr(outerCol) {
+			// The route was chosen using the value of the
+			// outer column, which is no longer available.
+			ro.updateRoute(engine.SelectScatter, nil, nil)
+		}
+		ro.UpdatePlan(pb, comparison)
+	}
+
+	sel.SelectExprs = nil
+	inner.resultColumns = nil
+	_, rhsKey := inner.SupplyCol(innerCol)
+	sj := &semiJoin{
+		outer:    pb.bldr,
+		inner:    inner,
+		outerCol: outerCol,
+		esemiJoin: &engine.SemiJoin{
+			Opcode:  opcode,
+			ListVar: listVar,
+		},
+	}
+	if sqltypes.IsText(outerCol.Metadata.(*column).typ) && sqltypes.IsText(innerCol.Metadata.(*column).typ) {
+		sj.compareWeightStrings = true
+		// The route doesn't fail SupplyWeightString.
+		rhsKey, _ = inner.SupplyWeightString(rhsKey)
+	}
+	sj.esemiJoin.RHSKey = rhsKey
+	pb.bldr = sj
+	pb.bldr.Reorder(0)
+	return nil
+}
+
+// findCorrelation returns the equality through which the subquery
+// route is correlated with the current query, along with the outer
+// and inner columns that it compares. It returns nil if the route
+// has no such equality, or if it references the outer query in
+// any other way.
+func (pb *primitiveBuilder) findCorrelation(inner *route, sel *sqlparser.Select) (comparison *sqlparser.ComparisonExpr, outerCol, innerCol *sqlparser.ColName) {
+	var externs []*sqlparser.ColName
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if col, ok := node.(*sqlparser.ColName); ok {
+			if c, ok := col.Metadata.(*column); ok && c.Origin() != inner {
+				externs = append(externs, col)
+			}
+		}
+		return true, nil
+	}, sel)
+	if len(externs) != 1 || sel.Where == nil {
+		return nil, nil, nil
+	}
+	if _, isLocal, err := pb.st.Find(externs[0]); err != nil || !isLocal {
+		return nil, nil, nil
+	}
+	for _, filter := range splitAndExpression(nil, sel.Where.Expr) {
+		comparison, ok := filter.(*sqlparser.ComparisonExpr)
+		if !ok || comparison.Operator != sqlparser.EqualStr {
+			continue
+		}
+		left, lok := comparison.Left.(*sqlparser.ColName)
+		right, rok := comparison.Right.(*sqlparser.ColName)
+		if !lok || !rok {
+			continue
+		}
+		switch externs[0] {
+		case left:
+			return comparison, left, right
+		case right:
+			return comparison, right, left
+		}
+	}
+	return nil, nil, nil
+}
+
+// isPositiveInt returns true if the expression
+// is an integer literal greater than zero.
+func isPositiveInt(expr sqlparser.Expr) bool {
+	val, ok := expr.(*sqlparser.SQLVal)
+	if !ok || val.Type != sqlparser.IntVal {
+		return false
+	}
+	for _, b := range val.Val {
+		if b != '0' {
+			return true
+		}
+	}
+	return false
+}
+
+// Order satisfies the builder interface.
+func (sj *semiJoin) Order() int {
+	return sj.order
+}
+
+// Reorder satisfies the builder interface.
+func (sj *semiJoin) Reorder(order int) {
+	sj.outer.Reorder(order)
+	sj.inner.Reorder(sj.outer.Order())
+	sj.order = sj.inner.Order() + 1
+}
+
+// Primitive satisfies the builder interface.
+func (sj *semiJoin) Primitive() engine.Primitive {
+	sj.esemiJoin.Left = sj.outer.Primitive()
+	sj.esemiJoin.Right = sj.inner.Primitive()
+	return sj.esemiJoin
+}
+
+// First satisfies the builder interface.
+func (sj *semiJoin) First() builder {
+	return sj.outer.First()
+}
+
+// ResultColumns satisfies the builder interface.
+func (sj *semiJoin) ResultColumns() []*resultColumn {
+	return sj.outer.ResultColumns()
+}
+
+// PushFilter satisfies the builder interface.
+func (sj *semiJoin) PushFilter(pb *primitiveBuilder, filter sqlparser.Expr, whereType string, origin builder) error {
+	return sj.outer.PushFilter(pb, filter, whereType, origin)
+}
+
+// PushSelect satisfies the builder interface.
+func (sj *semiJoin) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	return sj.outer.PushSelect(pb, expr, origin)
+}
+
+// MakeDistinct satisfies the builder interface.
+func (sj *semiJoin) MakeDistinct() error {
+	return sj.outer.MakeDistinct()
+}
+
+// PushGroupBy satisfies the builder interface.
+func (sj *semiJoin) PushGroupBy(groupBy sqlparser.GroupBy) error {
+	return sj.outer.PushGroupBy(groupBy)
+}
+
+// PushOrderBy satisfies the builder interface.
+// The semiJoin preserves the order of the outer rows.
+func (sj *semiJoin) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	bldr, err := sj.outer.PushOrderBy(orderBy)
+	if err != nil {
+		return nil, err
+	}
+	sj.outer = bldr
+	return sj, nil
+}
+
+// SetUpperLimit satisfies the builder interface.
+// The outer query cannot be limited because the
+// semiJoin may discard some of its rows.
+func (sj *semiJoin) SetUpperLimit(_ *sqlparser.SQLVal) {
+}
+
+// PushMisc satisfies the builder interface.
+func (sj *semiJoin) PushMisc(sel *sqlparser.Select) {
+	sj.outer.PushMisc(sel)
+	sj.inner.PushMisc(sel)
+}
+
+// Wireup satisfies the builder interface.
+// The outer column is added to the outer query at this
+// stage, after all the other columns were requested.
+func (sj *semiJoin) Wireup(bldr builder, jt *jointab) error {
+	count := len(sj.outer.ResultColumns())
+	_, lhsValue := sj.outer.SupplyCol(sj.outerCol)
+	lhsKey := lhsValue
+	if sj.compareWeightStrings {
+		var err error
+		if lhsKey, err = sj.outer.SupplyWeightString(lhsValue); err != nil {
+			return err
+		}
+	}
+	if len(sj.outer.ResultColumns()) > count {
+		sj.esemiJoin.TruncateColumnCount = count
+	}
+	sj.esemiJoin.LHSValue = lhsValue
+	sj.esemiJoin.LHSKey = lhsKey
+	if err := sj.outer.Wireup(bldr, jt); err != nil {
+		return err
+	}
+	return sj.inner.Wireup(bldr, jt)
+}
+
+// SupplyVar satisfies the builder interface.
+func (sj *semiJoin) SupplyVar(from, to int, col *sqlparser.ColName, varname string) {
+	if from <= sj.outer.Order() {
+		sj.outer.SupplyVar(from, to, col, varname)
+		return
+	}
+	sj.inner.SupplyVar(from, to, col, varname)
+}
+
+// SupplyCol satisfies the builder interface.
+func (sj *semiJoin) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNumber int) {
+	return sj.outer.SupplyCol(col)
+}
+
+// SupplyWeightString satisfies the builder interface.
+func (sj *semiJoin) SupplyWeightString(colNumber int) (weightcolNumber int, err error) {
+	return sj.outer.SupplyWeightString(colNumber)
+}
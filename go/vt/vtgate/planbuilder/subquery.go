@@ -26,6 +26,17 @@ import (
 
 var _ builder = (*subquery)(nil)
 
+var (
+	// errSubqueryFilter is returned by PushFilter because the
+	// condition must be evaluated on the results of the subquery.
+	errSubqueryFilter = errors.New("unsupported: filtering on results of cross-shard subquery")
+
+	// errSubqueryExpr is returned by PushSelect if the expression
+	// is not a plain column of the subquery. Such expressions must
+	// be computed on the results of the subquery.
+	errSubqueryExpr = errors.New("unsupported: expression on results of a cross-shard subquery")
+)
+
 // subquery is a builder that wraps a subquery.
 // This primitive wraps any subquery that results
 // in something that's not a route. It builds a
@@ -86,14 +97,14 @@ func (sq *subquery) ResultColumns() []*resultColumn {
 
 // PushFilter satisfies the builder interface.
 func (sq *subquery) PushFilter(_ *primitiveBuilder, _ sqlparser.Expr, whereType string, _ builder) error {
-	return errors.New("unsupported: filtering on results of cross-shard subquery")
+	return errSubqueryFilter
 }
 
 // PushSelect satisfies the builder interface.
 func (sq *subquery) PushSelect(_ *primitiveBuilder, expr *sqlparser.AliasedExpr, _ builder) (rc *resultColumn, colNumber int, err error) {
 	col, ok := expr.Expr.(*sqlparser.ColName)
 	if !ok {
-		return nil, 0, errors.New("unsupported: expression on results of a cross-shard subquery")
+		return nil, 0, errSubqueryExpr
 	}
 
 	// colNumber should already be set for subquery columns.
@@ -61,6 +61,11 @@ type symtab struct {
 	// the symbol table are part of the same route.
 	singleRoute *route
 
+	// joinsUsing is set if the tables are joined by a NATURAL
+	// join or a USING clause. The columns they compare are
+	// returned only once by '*'. So, '*' is not expanded.
+	joinsUsing bool
+
 	ResultColumns []*resultColumn
 	Outer         *symtab
 	Externs       []*sqlparser.ColName
@@ -166,6 +171,7 @@ func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTables []*vi
 // At this point, only tables and uniqueColumns are set.
 // All other fields are ignored.
 func (st *symtab) Merge(newsyms *symtab) error {
+	st.joinsUsing = st.joinsUsing || newsyms.joinsUsing
 	if st.tableNames == nil || newsyms.tableNames == nil {
 		// If any side of symtab has anonymous tables,
 		// we treat the merged symtab as having anonymous tables.
@@ -218,6 +224,33 @@ func (st *symtab) AllTables() []*table {
 	return tables
 }
 
+// findUsingTable returns the table that contains the column
+// of a USING clause. A table that doesn't have an authoritative
+// column list can only be chosen if it's the only table.
+func (st *symtab) findUsingTable(col sqlparser.ColIdent) (*table, error) {
+	tables := st.AllTables()
+	if tables == nil {
+		return nil, errors.New("unsupported: using clause with a table that has no column information")
+	}
+	var found *table
+	for _, t := range tables {
+		if _, ok := t.columns[col.Lowered()]; !ok {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("column '%s' in from clause is ambiguous", col.String())
+		}
+		found = t
+	}
+	if found != nil {
+		return found, nil
+	}
+	if len(tables) == 1 && !tables[0].isAuthoritative {
+		return tables[0], nil
+	}
+	return nil, fmt.Errorf("unknown column '%s' in 'from clause'", col.String())
+}
+
 // FindTable finds a table in symtab. This function is specifically used
 // for expanding 'select a.*' constructs. If you're in a subquery,
 // you're most likely referring to a table in the local 'from' clause.
@@ -25,6 +25,12 @@ import (
 )
 
 func buildUnionPlan(union *sqlparser.Union, vschema ContextVSchema) (engine.Primitive, error) {
+	if hasRecursiveCTE(union) {
+		return buildRecursiveCTEPlan(union, vschema)
+	}
+	if err := inlineCTEs(union, nil); err != nil {
+		return nil, err
+	}
 	// For unions, create a pb with anonymous scope.
 	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(union)))
 	if err := pb.processUnion(union, nil); err != nil {
@@ -45,9 +51,12 @@ func (pb *primitiveBuilder) processUnion(union *sqlparser.Union, outer *symtab)
 		return err
 	}
 
-	if err := unionRouteMerge(union, pb.bldr, rpb.bldr); err != nil {
+	bldr, err := unionRouteMerge(union, pb.bldr, rpb.bldr)
+	if err != nil {
 		return err
 	}
+	pb.bldr = bldr
+	pb.bldr.Reorder(0)
 	pb.st.Outer = outer
 
 	if err := pb.pushOrderBy(union.OrderBy); err != nil {
@@ -68,18 +77,29 @@ func (pb *primitiveBuilder) processPart(part sqlparser.SelectStatement, outer *s
 	return fmt.Errorf("BUG: unexpected SELECT type: %T", part)
 }
 
-func unionRouteMerge(union *sqlparser.Union, left, right builder) error {
-	lroute, ok := left.(*route)
-	if !ok {
-		return errors.New("unsupported: SELECT of UNION is non-trivial")
+// unionRouteMerge merges the two sides of the union into a single route
+// if possible. Otherwise, it builds a concatenate of the two sides. For
+// UNION DISTINCT, the concatenate is wrapped in a distinct.
+func unionRouteMerge(union *sqlparser.Union, left, right builder) (builder, error) {
+	lroute, lok := left.(*route)
+	rroute, rok := right.(*route)
+	if lok && rok && lroute.MergeUnion(rroute) {
+		lroute.Select = &sqlparser.Union{Type: union.Type, Left: union.Left, Right: union.Right, Lock: union.Lock}
+		return lroute, nil
+	}
+	if union.Lock != "" {
+		return nil, errors.New("unsupported: locking clause on a UNION that cannot be executed as a single route")
+	}
+	if union.Type == sqlparser.UnionAllStr {
+		return newConcatenate(left, right), nil
 	}
-	rroute, ok := right.(*route)
-	if !ok {
-		return errors.New("unsupported: SELECT of UNION is non-trivial")
+	// The duplicates of the inner unions will be removed
+	// by the outer distinct.
+	if d, ok := left.(*distinct); ok {
+		left = d.input
 	}
-	if !lroute.MergeUnion(rroute) {
-		return errors.New("unsupported: UNION cannot be executed as a single route")
+	if d, ok := right.(*distinct); ok {
+		right = d.input
 	}
-	lroute.Select = &sqlparser.Union{Type: union.Type, Left: union.Left, Right: union.Right, Lock: union.Lock}
-	return nil
+	return newDistinct(newConcatenate(left, right)), nil
 }
@@ -27,8 +27,17 @@ import (
 )
 
 // buildUpdatePlan builds the instructions for an UPDATE statement.
-func buildUpdatePlan(upd *sqlparser.Update, vschema ContextVSchema) (*engine.Update, error) {
-	dml, ksidVindex, ksidCol, err := buildDMLPlan(vschema, "update", upd, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit, upd.Comments, upd.Exprs)
+func buildUpdatePlan(upd *sqlparser.Update, vschema ContextVSchema) (engine.Primitive, error) {
+	if isMultiTable(upd.TableExprs) {
+		mt, err := newMultiTableDML(vschema, "update", upd, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit)
+		if err != nil {
+			return nil, err
+		}
+		if mt != nil {
+			return buildMultiTableUpdatePlan(upd, mt)
+		}
+	}
+	dml, ksidVindex, ksidCol, pullouts, err := buildDMLPlan(vschema, "update", upd, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit, upd.Comments, upd.Exprs)
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +47,7 @@ func buildUpdatePlan(upd *sqlparser.Update, vschema ContextVSchema) (*engine.Upd
 	}
 
 	if dml.Opcode == engine.Unsharded {
-		return eupd, nil
+		return wrapPullouts(eupd, pullouts), nil
 	}
 
 	if eupd.ChangedVindexValues, err = buildChangedVindexesValues(upd, eupd.Table.ColumnVindexes); err != nil {
@@ -48,12 +57,114 @@ func buildUpdatePlan(upd *sqlparser.Update, vschema ContextVSchema) (*engine.Upd
 		eupd.OwnedVindexQuery = generateDMLSubquery(upd.Where, upd.OrderBy, upd.Limit, eupd.Table, ksidCol)
 		eupd.KsidVindex = ksidVindex
 	}
-	return eupd, nil
+	if _, ok := eupd.ChangedVindexValues[eupd.Table.ColumnVindexes[0].Name]; ok {
+		if err := buildMoveRows(upd, eupd); err != nil {
+			return nil, err
+		}
+	}
+	return wrapPullouts(eupd, pullouts), nil
 }
 
-// buildChangedVindexesValues adds to the plan all the lookup vindexes that are changing.
-// Updates can only be performed to secondary lookup vindexes with no complex expressions
-// in the set clause.
+// buildMoveRows builds the instructions for an update that changes
+// the primary vindex columns. The rows have to be moved to their new
+// shards, which is done by deleting and re-inserting them. So, the
+// OwnedVindexQuery selects all their columns, which requires the
+// column list of the table to be authoritative, and the new values
+// of all the updated columns must be computable by vtgate.
+func buildMoveRows(upd *sqlparser.Update, eupd *engine.Update) error {
+	values := make(map[string]sqltypes.PlanValue)
+	for _, assignment := range upd.Exprs {
+		pv, err := extractValueFromUpdate(assignment)
+		if err != nil {
+			return err
+		}
+		values[assignment.Name.Name.String()] = pv
+	}
+	if !eupd.Table.ColumnListAuthoritative {
+		return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: changing the primary vindex of %s requires the vschema column list of the table to be authoritative", eupd.Table.Name.String())
+	}
+	del := &sqlparser.Delete{
+		Comments:   upd.Comments,
+		TableExprs: upd.TableExprs,
+		Where:      upd.Where,
+		OrderBy:    upd.OrderBy,
+		Limit:      upd.Limit,
+	}
+	eupd.MoveRows = &engine.MoveRows{
+		DeleteQuery: generateQuery(del),
+		Values:      values,
+	}
+	sel := &sqlparser.Select{
+		From:    upd.TableExprs,
+		Where:   upd.Where,
+		OrderBy: upd.OrderBy,
+		Limit:   upd.Limit,
+		Lock:    sqlparser.ForUpdateStr,
+	}
+	for _, col := range eupd.Table.Columns {
+		sel.SelectExprs = append(sel.SelectExprs, &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: col.Name}})
+	}
+	eupd.OwnedVindexQuery = generateQuery(sel)
+	return nil
+}
+
+// buildMultiTableUpdatePlan builds the instructions for an UPDATE
+// that references multiple tables in a sharded keyspace. The UPDATE
+// is split if it changes vindex columns, so that it goes through the
+// same validations and lookup vindex changes as a single-table UPDATE.
+func buildMultiTableUpdatePlan(upd *sqlparser.Update, mt *multiTableDML) (engine.Primitive, error) {
+	_, isRoute := mt.pb.bldr.(*route)
+	split := !isRoute
+	var targets []int
+	exprs := make(map[int]sqlparser.UpdateExprs)
+	var valueErr error
+	for _, updExpr := range upd.Exprs {
+		if err := mt.pb.st.ResolveSymbols(updExpr); err != nil {
+			return nil, err
+		}
+		target := mt.tableOf(updExpr.Name)
+		if target == -1 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: unqualified column %s in multi-table update statement in sharded keyspace", sqlparser.String(updExpr.Name))
+		}
+		if _, ok := exprs[target]; !ok {
+			targets = append(targets, target)
+		}
+		exprs[target] = append(exprs[target], updExpr)
+		if _, others := mt.columnsOf(updExpr.Expr, target); others && valueErr == nil {
+			valueErr = vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: cross-shard multi-table update with a value from another table: %s", sqlparser.String(updExpr))
+		}
+		vst, err := mt.vschemaTable(target)
+		if err != nil {
+			return nil, err
+		}
+		for _, colVindex := range vst.ColumnVindexes {
+			for _, col := range colVindex.Columns {
+				if col.Equal(updExpr.Name.Name) {
+					split = true
+				}
+			}
+		}
+	}
+	if !split {
+		dml, err := mt.buildRoutePlan(upd, upd.Comments, targets[0])
+		if err != nil {
+			return nil, err
+		}
+		return &engine.Update{DML: *dml}, nil
+	}
+	if valueErr != nil {
+		return nil, valueErr
+	}
+	return mt.buildSplitPlan(targets, func(target int, cond sqlparser.Expr) string {
+		buf := sqlparser.NewTrackedBuffer(unqualifiedFormatter)
+		buf.Myprintf("update %v%v set %v where %v", upd.Comments, mt.tables[target].Expr, exprs[target], cond)
+		return buf.String()
+	})
+}
+
+// buildChangedVindexesValues adds to the plan all the vindexes that are changing.
+// Updates can only be performed to the primary vindex or to secondary lookup vindexes
+// with no complex expressions in the set clause.
 func buildChangedVindexesValues(update *sqlparser.Update, colVindexes []*vindexes.ColumnVindex) (map[string]engine.VindexValues, error) {
 	changedVindexes := make(map[string]engine.VindexValues)
 	for i, vindex := range colVindexes {
@@ -85,7 +196,9 @@ func buildChangedVindexesValues(update *sqlparser.Update, colVindexes []*vindexe
 			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: Need to provide order by clause when using limit. Invalid update on vindex: %v", vindex.Name)
 		}
 		if i == 0 {
-			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You can't update primary vindex columns. Invalid update on vindex: %v", vindex.Name)
+			// The rows will be moved to their new shards.
+			changedVindexes[vindex.Name] = vindexValueMap
+			continue
 		}
 		if _, ok := vindex.Vindex.(vindexes.Lookup); !ok {
 			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: You can only update lookup vindexes. Invalid update on vindex: %v", vindex.Name)
@@ -0,0 +1,414 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var _ builder = (*window)(nil)
+
+// window is the builder for engine.Window. It gets built if
+// the window functions of a scatter query can't be computed
+// by the individual shards. The window primitive requests the
+// underlying route to order the results by the PARTITION BY
+// columns followed by the ORDER BY columns of the window. The
+// rows of a partition can then be processed as they come.
+// For example: 'select a, row_number() over (partition by b order by c) from t'
+// will be sent to the scatter route as:
+// 'select a, b, c from t order by b asc, c asc'
+// and the window primitive computes the row numbers.
+type window struct {
+	builderCommon
+	resultColumns []*resultColumn
+	spec          *sqlparser.WindowSpec
+	weightStrings map[*resultColumn]int
+	ewindow       *engine.Window
+}
+
+// newWindow builds a new window for the window specification,
+// which is shared by all the window functions of the query.
+func newWindow(input builder, spec *sqlparser.WindowSpec) *window {
+	return &window{
+		builderCommon: newBuilderCommon(input),
+		spec:          spec,
+		weightStrings: make(map[*resultColumn]int),
+		ewindow:       &engine.Window{},
+	}
+}
+
+// checkWindowFunctions analyzes the window functions of the select list.
+// They're pushed down unchanged if the query goes to a single shard,
+// or if every window is partitioned by a unique vindex, which keeps
+// the rows of a partition within a shard. Otherwise, a window primitive
+// is built to compute them on top of the route.
+func (pb *primitiveBuilder) checkWindowFunctions(sel *sqlparser.Select) error {
+	if err := pb.st.ResolveSymbols(sel.Windows); err != nil {
+		return err
+	}
+	funcs := findWindowFunctions(sel.SelectExprs)
+	orderFuncs := findWindowFunctions(sel.OrderBy)
+	if len(funcs) == 0 && len(orderFuncs) == 0 {
+		return nil
+	}
+	specs := make([]*sqlparser.WindowSpec, 0, len(funcs)+len(orderFuncs))
+	for _, funcExpr := range append(funcs, orderFuncs...) {
+		spec, err := resolveWindow(funcExpr.Over, sel.Windows, len(sel.Windows))
+		if err != nil {
+			return err
+		}
+		specs = append(specs, spec)
+	}
+
+	rb, isRoute := pb.bldr.(*route)
+	if isRoute && rb.removeMultishardOptions() {
+		return nil
+	}
+	if !isRoute {
+		return errors.New("unsupported: window functions in cross-shard join")
+	}
+	// The groups of a scatter aggregation span shards. So, their
+	// windows can't be computed by the shards.
+	if sel.Distinct != "" || len(sel.GroupBy) > 0 || sel.Having != nil || nodeHasAggregates(sel.SelectExprs) {
+		return errors.New("unsupported: in scatter query: window functions with aggregates")
+	}
+	if pb.windowsHaveUniqueVindex(rb, specs) {
+		return nil
+	}
+
+	// The window functions must be computed by vtgate.
+	if len(orderFuncs) != 0 {
+		return errors.New("unsupported: in scatter query: window function in order by")
+	}
+	for _, spec := range specs[1:] {
+		if sqlparser.String(spec) != sqlparser.String(specs[0]) {
+			return errors.New("unsupported: in scatter query: window functions over different windows")
+		}
+	}
+	for _, selectExpr := range sel.SelectExprs {
+		expr, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			continue
+		}
+		if funcExpr, ok := expr.Expr.(*sqlparser.FuncExpr); ok && funcExpr.IsWindowFunction() {
+			if _, ok := engine.SupportedWindowFunctions[funcExpr.Name.Lowered()]; !ok {
+				return fmt.Errorf("unsupported: in scatter query: window function %s", funcExpr.Name.String())
+			}
+			continue
+		}
+		if len(findWindowFunctions(expr.Expr)) != 0 {
+			return fmt.Errorf("unsupported: in scatter query: complex window function expression: %s", sqlparser.String(expr.Expr))
+		}
+	}
+	if err := pb.st.ResolveSymbols(specs[0]); err != nil {
+		return err
+	}
+	pb.bldr = newWindow(rb, specs[0])
+	pb.bldr.Reorder(0)
+	return nil
+}
+
+// windowsHaveUniqueVindex returns true if the PARTITION BY
+// clause of every window references a unique vindex.
+func (pb *primitiveBuilder) windowsHaveUniqueVindex(rb *route, specs []*sqlparser.WindowSpec) bool {
+	return rb.removeOptions(func(ro *routeOption) bool {
+		for _, spec := range specs {
+			found := false
+			for _, expr := range spec.PartitionBy {
+				vindex := ro.FindVindex(pb, expr)
+				if vindex != nil && vindex.IsUnique() {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// findWindowFunctions returns the window functions of the node.
+// Subqueries are not searched because they're analyzed by themselves.
+func findWindowFunctions(node sqlparser.SQLNode) []*sqlparser.FuncExpr {
+	var funcs []*sqlparser.FuncExpr
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			if node.IsWindowFunction() {
+				funcs = append(funcs, node)
+			}
+		case *sqlparser.Subquery:
+			return false, nil
+		}
+		return true, nil
+	}, node)
+	return funcs
+}
+
+// resolveWindow returns the window of an OVER clause. If the clause
+// refers to a named window, the definition of that window is merged in.
+// depth limits the number of references that are followed, which
+// prevents cycles.
+func resolveWindow(spec *sqlparser.WindowSpec, windows sqlparser.NamedWindows, depth int) (*sqlparser.WindowSpec, error) {
+	if spec.Name.IsEmpty() {
+		return spec, nil
+	}
+	for _, named := range windows {
+		if named.Name.Lowered() != spec.Name.Lowered() {
+			continue
+		}
+		if depth == 0 {
+			return nil, fmt.Errorf("circular reference to window %s", spec.Name.String())
+		}
+		base, err := resolveWindow(named.Spec, windows, depth-1)
+		if err != nil {
+			return nil, err
+		}
+		if len(spec.PartitionBy) != 0 {
+			return nil, fmt.Errorf("window %s cannot override the partition by of window %s", sqlparser.String(spec), spec.Name.String())
+		}
+		resolved := &sqlparser.WindowSpec{PartitionBy: base.PartitionBy, OrderBy: base.OrderBy}
+		if len(spec.OrderBy) != 0 {
+			if len(base.OrderBy) != 0 {
+				return nil, fmt.Errorf("window %s cannot override the order by of window %s", sqlparser.String(spec), spec.Name.String())
+			}
+			resolved.OrderBy = spec.OrderBy
+		}
+		return resolved, nil
+	}
+	return nil, fmt.Errorf("window %s is not defined", spec.Name.String())
+}
+
+// ResultColumns satisfies the builder interface.
+func (w *window) ResultColumns() []*resultColumn {
+	return w.resultColumns
+}
+
+// Primitive satisfies the builder interface.
+func (w *window) Primitive() engine.Primitive {
+	w.ewindow.Input = w.input.Primitive()
+	return w.ewindow
+}
+
+// PushFilter satisfies the builder interface.
+func (w *window) PushFilter(pb *primitiveBuilder, filter sqlparser.Expr, whereType string, origin builder) error {
+	return errors.New("unsupported: filtering on results of cross-shard window functions")
+}
+
+// PushSelect satisfies the builder interface.
+// Window functions are computed by the primitive. For LAG and
+// LEAD, the argument is pushed to the input. All other expressions
+// are pushed to the input and passed through.
+func (w *window) PushSelect(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin builder) (rc *resultColumn, colNumber int, err error) {
+	funcExpr, ok := expr.Expr.(*sqlparser.FuncExpr)
+	if !ok || !funcExpr.IsWindowFunction() {
+		innerRC, innerCol, err := w.input.PushSelect(pb, expr, origin)
+		if err != nil {
+			return nil, 0, err
+		}
+		w.resultColumns = append(w.resultColumns, innerRC)
+		w.ewindow.Cols = append(w.ewindow.Cols, innerCol)
+		return innerRC, len(w.resultColumns) - 1, nil
+	}
+
+	wf := &engine.WindowFunction{
+		Opcode: engine.SupportedWindowFunctions[funcExpr.Name.Lowered()],
+		Alias:  columnName(expr),
+	}
+	switch wf.Opcode {
+	case engine.WindowLag, engine.WindowLead:
+		if err := w.pushOffsetArgs(pb, wf, funcExpr, origin); err != nil {
+			return nil, 0, err
+		}
+	}
+	w.ewindow.Functions = append(w.ewindow.Functions, wf)
+	rc = newResultColumn(expr, w)
+	w.resultColumns = append(w.resultColumns, rc)
+	w.ewindow.Cols = append(w.ewindow.Cols, -len(w.ewindow.Functions))
+	return rc, len(w.resultColumns) - 1, nil
+}
+
+// pushOffsetArgs pushes the argument of LAG or LEAD to the input,
+// and sets the offset and default value, which must be literals.
+func (w *window) pushOffsetArgs(pb *primitiveBuilder, wf *engine.WindowFunction, funcExpr *sqlparser.FuncExpr, origin builder) error {
+	var args []sqlparser.Expr
+	for _, selectExpr := range funcExpr.Exprs {
+		arg, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return fmt.Errorf("unsupported: in scatter query: '%s' in %s", sqlparser.String(selectExpr), funcExpr.Name.String())
+		}
+		args = append(args, arg.Expr)
+	}
+	if len(args) == 0 || len(args) > 3 {
+		return fmt.Errorf("incorrect parameter count in the call to window function %s", funcExpr.Name.String())
+	}
+	_, innerCol, err := w.input.PushSelect(pb, &sqlparser.AliasedExpr{Expr: args[0]}, origin)
+	if err != nil {
+		return err
+	}
+	wf.Col = innerCol
+	wf.Offset = 1
+	if len(args) > 1 {
+		val, ok := args[1].(*sqlparser.SQLVal)
+		if !ok || val.Type != sqlparser.IntVal {
+			return fmt.Errorf("unsupported: in scatter query: offset of %s must be an integer: %s", funcExpr.Name.String(), sqlparser.String(args[1]))
+		}
+		offset, err := strconv.Atoi(string(val.Val))
+		if err != nil {
+			return err
+		}
+		wf.Offset = offset
+	}
+	if len(args) > 2 {
+		pv, err := sqlparser.NewPlanValue(args[2])
+		if err != nil || pv.Key != "" || pv.ListKey != "" {
+			return fmt.Errorf("unsupported: in scatter query: default of %s must be a literal: %s", funcExpr.Name.String(), sqlparser.String(args[2]))
+		}
+		wf.Default = pv.Value
+	}
+	return nil
+}
+
+// MakeDistinct satisfies the builder interface.
+func (w *window) MakeDistinct() error {
+	return errors.New("unsupported: distinct on cross-shard window functions")
+}
+
+// PushGroupBy satisfies the builder interface.
+func (w *window) PushGroupBy(groupBy sqlparser.GroupBy) error {
+	if len(groupBy) != 0 {
+		return errors.New("unsupported: group by on cross-shard window functions")
+	}
+	return nil
+}
+
+// PushOrderBy satisfies the builder interface.
+// The input is first ordered by the window. If the query
+// has an ORDER BY, the results are then sorted in memory.
+func (w *window) PushOrderBy(orderBy sqlparser.OrderBy) (builder, error) {
+	windowOrder := make(sqlparser.OrderBy, 0, len(w.spec.PartitionBy)+len(w.spec.OrderBy))
+	for _, expr := range w.spec.PartitionBy {
+		windowOrder = append(windowOrder, &sqlparser.Order{Expr: expr, Direction: sqlparser.AscScr})
+	}
+	windowOrder = append(windowOrder, w.spec.OrderBy...)
+	for i, order := range windowOrder {
+		col, ok := order.Expr.(*sqlparser.ColName)
+		if !ok {
+			return nil, fmt.Errorf("unsupported: in scatter query: complex window expression: %s", sqlparser.String(order.Expr))
+		}
+		_, colNumber := w.input.SupplyCol(col)
+		if i < len(w.spec.PartitionBy) {
+			w.ewindow.PartitionBy = append(w.ewindow.PartitionBy, colNumber)
+		} else {
+			w.ewindow.OrderBy = append(w.ewindow.OrderBy, colNumber)
+		}
+	}
+	bldr, err := w.input.PushOrderBy(windowOrder)
+	if err != nil {
+		return nil, err
+	}
+	w.input = bldr
+	if len(orderBy) == 0 {
+		return w, nil
+	}
+	return newMemorySort(w, orderBy)
+}
+
+// Wireup satisfies the builder interface.
+// If text columns are detected in the window, then the function
+// modifies the primitive to compare the corresponding weight_string
+// instead, because mysql's collation behavior can't be mimicked.
+func (w *window) Wireup(bldr builder, jt *jointab) error {
+	if err := w.useWeightStrings(w.ewindow.PartitionBy); err != nil {
+		return err
+	}
+	if err := w.useWeightStrings(w.ewindow.OrderBy); err != nil {
+		return err
+	}
+	return w.input.Wireup(bldr, jt)
+}
+
+func (w *window) useWeightStrings(cols []int) error {
+	for i, colNumber := range cols {
+		rc := w.input.ResultColumns()[colNumber]
+		if !sqltypes.IsText(rc.column.typ) {
+			continue
+		}
+		if weightcolNumber, ok := w.weightStrings[rc]; ok {
+			cols[i] = weightcolNumber
+			continue
+		}
+		weightcolNumber, err := w.input.SupplyWeightString(colNumber)
+		if err != nil {
+			return err
+		}
+		w.weightStrings[rc] = weightcolNumber
+		cols[i] = weightcolNumber
+	}
+	return nil
+}
+
+// SupplyCol satisfies the builder interface.
+func (w *window) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNumber int) {
+	c := col.Metadata.(*column)
+	for i, rc := range w.resultColumns {
+		if rc.column == c {
+			return rc, i
+		}
+	}
+	innerRC, innerCol := w.input.SupplyCol(col)
+	w.resultColumns = append(w.resultColumns, innerRC)
+	w.ewindow.Cols = append(w.ewindow.Cols, innerCol)
+	return innerRC, len(w.resultColumns) - 1
+}
+
+// SupplyWeightString satisfies the builder interface.
+func (w *window) SupplyWeightString(colNumber int) (weightcolNumber int, err error) {
+	innerCol := w.ewindow.Cols[colNumber]
+	if innerCol < 0 {
+		return 0, errors.New("unsupported: cannot generate weight_string for a window function")
+	}
+	innerWeightCol, err := w.input.SupplyWeightString(innerCol)
+	if err != nil {
+		return 0, err
+	}
+	for i, col := range w.ewindow.Cols {
+		if col == innerWeightCol {
+			return i, nil
+		}
+	}
+	w.resultColumns = append(w.resultColumns, w.input.ResultColumns()[innerWeightCol])
+	w.ewindow.Cols = append(w.ewindow.Cols, innerWeightCol)
+	return len(w.resultColumns) - 1, nil
+}
+
+// PushMisc satisfies the builder interface.
+// The WINDOW clause is not pushed because
+// the window functions are computed here.
+func (w *window) PushMisc(sel *sqlparser.Select) {
+	misc := *sel
+	misc.Windows = nil
+	w.input.PushMisc(&misc)
+}
@@ -91,6 +91,8 @@ func newVtgateHandler(vtg *VTGate) *vtgateHandler {
 }
 
 func (vh *vtgateHandler) NewConnection(c *mysql.Conn) {
+	vh.vtg.executor.plist.add(c)
+
 	vh.mu.Lock()
 	defer vh.mu.Unlock()
 	vh.connections[c] = true
@@ -117,6 +119,8 @@ func (vh *vtgateHandler) ComResetConnection(c *mysql.Conn) {
 func (vh *vtgateHandler) ConnectionClosed(c *mysql.Conn) {
 	// Rollback if there is an ongoing transaction. Ignore error.
 	defer func() {
+		vh.vtg.executor.plist.remove(c)
+
 		vh.mu.Lock()
 		defer vh.mu.Unlock()
 		delete(vh.connections, c)
@@ -134,6 +138,10 @@ func (vh *vtgateHandler) ConnectionClosed(c *mysql.Conn) {
 	if session.InTransaction {
 		defer atomic.AddInt32(&busyConnections, -1)
 	}
+	if session.InReservedConn {
+		_ = vh.vtg.CloseSession(ctx, session)
+		return
+	}
 	_, _, _ = vh.vtg.Execute(ctx, session, "rollback", make(map[string]*querypb.BindVariable))
 }
 
@@ -168,6 +176,19 @@ func startSpan(ctx context.Context, query, label string) (trace.Span, context.Co
 
 func (vh *vtgateHandler) ComInitDB(c *mysql.Conn, schemaName string) {
 	vh.session(c).TargetString = schemaName
+	vh.vtg.executor.plist.setDB(c, schemaName)
+}
+
+// beginProcess records the statement in the process list, so that
+// SHOW PROCESSLIST lists it and KILL can cancel the returned context.
+// The returned function must be called once the statement returns.
+func (vh *vtgateHandler) beginProcess(ctx context.Context, c *mysql.Conn, command, query string) (context.Context, func()) {
+	plist := vh.vtg.executor.plist
+	ctx, cancel := plist.begin(ctx, c, command, query)
+	return ctx, func() {
+		cancel()
+		plist.end(c, vh.session(c).TargetString)
+	}
 }
 
 func (vh *vtgateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sqltypes.Result) error) error {
@@ -198,6 +219,9 @@ func (vh *vtgateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sq
 		"VTGate MySQL Connector" /* subcomponent: part of the client */)
 	ctx = callerid.NewContext(ctx, ef, im)
 
+	ctx, end := vh.beginProcess(ctx, c, processQuery, query)
+	defer end()
+
 	session := vh.session(c)
 	if !session.InTransaction {
 		atomic.AddInt32(&busyConnections, 1)
@@ -287,6 +311,9 @@ func (vh *vtgateHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareDat
 		"VTGate MySQL Connector" /* subcomponent: part of the client */)
 	ctx = callerid.NewContext(ctx, ef, im)
 
+	ctx, end := vh.beginProcess(ctx, c, processExecute, prepare.PrepareStmt)
+	defer end()
+
 	session := vh.session(c)
 	if !session.InTransaction {
 		atomic.AddInt32(&busyConnections, 1)
@@ -297,7 +324,7 @@ func (vh *vtgateHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareDat
 		}
 	}()
 
-	if session.Options.Workload == querypb.ExecuteOptions_OLAP {
+	if session.Options.Workload == querypb.ExecuteOptions_OLAP || streamCursor(session, prepare) {
 		err := vh.vtg.StreamExecute(ctx, session, prepare.PrepareStmt, prepare.BindVars, callback)
 		return mysql.NewSQLErrorFromError(err)
 	}
@@ -310,6 +337,18 @@ func (vh *vtgateHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareDat
 	return callback(qr)
 }
 
+// streamCursor returns true if the rows of the statement are fetched
+// with a cursor, and can be streamed. Streaming queries don't see
+// transactions and reserved connections, so the statement is
+// executed as usual in that case, and the cursor fetches its rows
+// from the result.
+func streamCursor(session *vtgatepb.Session, prepare *mysql.PrepareData) bool {
+	return prepare.CursorType&mysql.CursorTypeReadOnly != 0 &&
+		!session.InTransaction &&
+		!session.InReservedConn &&
+		sqlparser.Preview(prepare.PrepareStmt) == sqlparser.StmtSelect
+}
+
 func (vh *vtgateHandler) WarningCount(c *mysql.Conn) uint16 {
 	return uint16(len(vh.session(c).GetWarnings()))
 }
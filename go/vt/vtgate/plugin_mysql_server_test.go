@@ -23,13 +23,18 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"vitess.io/vitess/go/trace"
 
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
+
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 )
 
 type testHandler struct {
@@ -226,3 +231,79 @@ func TestDefaultWorkloadOLAP(t *testing.T) {
 		t.Fatalf("Expected default workload OLAP")
 	}
 }
+
+func TestStreamCursor(t *testing.T) {
+	prepare := &mysql.PrepareData{PrepareStmt: "select id from t", CursorType: mysql.CursorTypeReadOnly}
+	assert.True(t, streamCursor(&vtgatepb.Session{}, prepare))
+	assert.False(t, streamCursor(&vtgatepb.Session{InTransaction: true}, prepare))
+	assert.False(t, streamCursor(&vtgatepb.Session{InReservedConn: true}, prepare))
+
+	prepare.PrepareStmt = "update t set id = 1"
+	assert.False(t, streamCursor(&vtgatepb.Session{}, prepare))
+
+	prepare = &mysql.PrepareData{PrepareStmt: "select id from t", CursorType: mysql.CursorTypeNoCursor}
+	assert.False(t, streamCursor(&vtgatepb.Session{}, prepare))
+}
+
+func TestMultiStatementSession(t *testing.T) {
+	save := mysqlDefaultWorkload
+	defer func() { mysqlDefaultWorkload = save }()
+	mysqlDefaultWorkload = int32(querypb.ExecuteOptions_OLTP)
+
+	createSandbox(KsTestUnsharded)
+	hcVTGateTest.Reset()
+	sbc := hcVTGateTest.AddTestTablet("aa", "1.1.1.1", 1001, KsTestUnsharded, "0", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	unixSocket, err := ioutil.TempFile("", "mysql_vitess_test.sock")
+	require.NoError(t, err)
+	os.Remove(unixSocket.Name())
+	l, err := newMysqlUnixSocket(unixSocket.Name(), newTestAuthServerStatic(), newVtgateHandler(rpcVTGate))
+	require.NoError(t, err)
+	defer l.Close()
+	go l.Accept()
+
+	c, err := mysql.Connect(context.Background(), &mysql.ConnParams{
+		UnixSocket: unixSocket.Name(),
+		Uname:      "user1",
+		Pass:       "password1",
+		DbName:     KsTestUnsharded,
+	})
+	require.NoError(t, err)
+	defer c.Close()
+
+	// The statements are executed in the same session:
+	// the selects are part of the transaction.
+	qr, more, err := c.ExecuteFetchMulti("begin; select id from t1; select id from t1; commit", 10, false)
+	require.NoError(t, err)
+	require.True(t, more)
+	require.Zero(t, qr.RowsAffected)
+	for i := 0; i < 2; i++ {
+		qr, more, _, err = c.ReadQueryResult(10, false)
+		require.NoError(t, err)
+		require.True(t, more)
+		require.Equal(t, sandboxconn.SingleRowResult.Rows, qr.Rows)
+	}
+	_, more, _, err = c.ReadQueryResult(10, false)
+	require.NoError(t, err)
+	require.False(t, more)
+
+	require.EqualValues(t, 1, sbc.BeginCount.Get())
+	require.EqualValues(t, 2, sbc.ExecCount.Get())
+	require.EqualValues(t, 1, sbc.CommitCount.Get())
+
+	// The statements that follow a failing one are not executed,
+	// so the transaction is still open.
+	_, more, err = c.ExecuteFetchMulti("begin; select id from t1; select bad syntax from; rollback", 10, false)
+	require.NoError(t, err)
+	require.True(t, more)
+	_, more, _, err = c.ReadQueryResult(10, false)
+	require.NoError(t, err)
+	require.True(t, more)
+	_, _, _, err = c.ReadQueryResult(10, false)
+	require.Error(t, err)
+	require.EqualValues(t, 0, sbc.RollbackCount.Get())
+
+	_, err = c.ExecuteFetch("rollback", 10, false)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, sbc.RollbackCount.Get())
+}
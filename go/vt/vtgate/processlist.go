@@ -0,0 +1,253 @@
+/*
+Copyright 2020 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"flag"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// Command values of SHOW PROCESSLIST.
+const (
+	processSleep   = "Sleep"
+	processQuery   = "Query"
+	processExecute = "Execute"
+)
+
+var processListAuthorizedUsers = flag.String("processlist_authorized_users", "", "List of users authorized to see and kill the connections of all users with SHOW PROCESSLIST and KILL, or '%' to allow all users. The other users can only see and kill their own connections.")
+
+// processInfoLen is the length of the Info column of
+// SHOW PROCESSLIST, unless FULL is specified.
+const processInfoLen = 100
+
+// processList tracks the connections of the MySQL server and the
+// statement they execute, for SHOW PROCESSLIST and KILL. A caller
+// can only see and kill its own connections, unless it's authorized.
+type processList struct {
+	// allowAll and authorized are the callers authorized
+	// to see and kill the connections of all callers.
+	// They're set at creation.
+	allowAll   bool
+	authorized map[string]bool
+
+	mu    sync.Mutex
+	procs map[uint32]*process
+}
+
+// process is a connection of the processList.
+type process struct {
+	conn *mysql.Conn
+	user string
+	host string
+	db   string
+	// caller is the username of the immediate caller ID
+	// of the connection, which identifies its owner.
+	caller string
+
+	command string
+	info    string
+	start   time.Time
+	// cancel cancels the context of the current statement.
+	// It's nil while the connection is idle.
+	cancel context.CancelFunc
+}
+
+// newProcessList creates a processList. authorizedUsers is a comma
+// separated list of the callers authorized to see and kill the
+// connections of all callers, or '%' to authorize all callers.
+func newProcessList(authorizedUsers string) *processList {
+	pl := &processList{
+		authorized: make(map[string]bool),
+		procs:      make(map[uint32]*process),
+	}
+	if authorizedUsers == "%" {
+		pl.allowAll = true
+		return pl
+	}
+	for _, user := range strings.Split(authorizedUsers, ",") {
+		if user = strings.TrimSpace(user); user != "" {
+			pl.authorized[user] = true
+		}
+	}
+	return pl
+}
+
+// owns returns true if the caller can see and kill the process.
+func (pl *processList) owns(caller string, p *process) bool {
+	return pl.allowAll || pl.authorized[caller] || p.caller == caller
+}
+
+// add starts tracking the connection. It's not authenticated yet:
+// its user is recorded by setDB.
+func (pl *processList) add(c *mysql.Conn) {
+	p := &process{
+		conn:    c,
+		host:    c.RemoteAddr().String(),
+		command: processSleep,
+		start:   time.Now(),
+	}
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	pl.procs[c.ConnectionID] = p
+}
+
+// remove stops tracking the connection.
+func (pl *processList) remove(c *mysql.Conn) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	delete(pl.procs, c.ConnectionID)
+}
+
+// setDB changes the default database of the connection. It's first
+// called once the connection is authenticated, so it also records
+// the user of the connection.
+func (pl *processList) setDB(c *mysql.Conn, db string) {
+	var caller string
+	if c.UserData != nil {
+		caller = c.UserData.Get().GetUsername()
+	}
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	if p, ok := pl.procs[c.ConnectionID]; ok {
+		p.user = c.User
+		p.caller = caller
+		p.db = db
+	}
+}
+
+// begin records the statement executed by the connection. Like
+// context.WithCancel, it returns a context that the statement must be
+// executed with, which is canceled if the statement is killed. end must
+// be called once the statement returns.
+func (pl *processList) begin(ctx context.Context, c *mysql.Conn, command, query string) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	if p, ok := pl.procs[c.ConnectionID]; ok {
+		p.command = command
+		p.info = query
+		p.start = time.Now()
+		p.cancel = cancel
+	}
+	return ctx, cancel
+}
+
+// end records that the connection is idle again. db is the default
+// database of the connection, which the statement may have changed.
+func (pl *processList) end(c *mysql.Conn, db string) {
+	pl.mu.Lock()
+	defer pl.mu.Unlock()
+	if p, ok := pl.procs[c.ConnectionID]; ok {
+		p.db = db
+		p.command = processSleep
+		p.info = ""
+		p.start = time.Now()
+		p.cancel = nil
+	}
+}
+
+// kill cancels the statement executed by the connection, if any.
+// If connection is true, the connection is also closed. Like mysql,
+// it fails if the caller doesn't own the connection.
+func (pl *processList) kill(caller string, id uint32, connection bool) error {
+	pl.mu.Lock()
+	p, ok := pl.procs[id]
+	owned := ok && pl.owns(caller, p)
+	if owned && p.cancel != nil {
+		p.cancel()
+	}
+	pl.mu.Unlock()
+	if !ok {
+		return mysql.NewSQLError(mysql.ERNoSuchThread, mysql.SSUnknownSQLState, "Unknown thread id: %v", id)
+	}
+	if !owned {
+		return mysql.NewSQLError(mysql.ERKillDenied, mysql.SSUnknownSQLState, "You are not owner of thread %v", id)
+	}
+	if connection {
+		// This makes the connection fail its next read or write,
+		// and the server then calls ConnectionClosed.
+		p.conn.Close()
+	}
+	return nil
+}
+
+// result returns the result of SHOW PROCESSLIST, which only lists
+// the connections the caller owns. The Info column is truncated,
+// unless full is true.
+func (pl *processList) result(caller string, full bool) *sqltypes.Result {
+	now := time.Now()
+	pl.mu.Lock()
+	ids := make([]uint32, 0, len(pl.procs))
+	for id, p := range pl.procs {
+		if pl.owns(caller, p) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	rows := make([][]sqltypes.Value, 0, len(ids))
+	for _, id := range ids {
+		p := pl.procs[id]
+		info := sqltypes.NULL
+		if p.info != "" {
+			text := p.info
+			if !full && len(text) > processInfoLen {
+				text = text[:processInfoLen]
+			}
+			info = sqltypes.NewVarChar(text)
+		}
+		db := sqltypes.NULL
+		if p.db != "" {
+			db = sqltypes.NewVarChar(p.db)
+		}
+		rows = append(rows, []sqltypes.Value{
+			sqltypes.NewUint64(uint64(id)),
+			sqltypes.NewVarChar(p.user),
+			sqltypes.NewVarChar(p.host),
+			db,
+			sqltypes.NewVarChar(p.command),
+			sqltypes.NewInt64(int64(now.Sub(p.start) / time.Second)),
+			sqltypes.NewVarChar(""),
+			info,
+		})
+	}
+	pl.mu.Unlock()
+
+	return &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "Id", Type: sqltypes.Uint64},
+			{Name: "User", Type: sqltypes.VarChar},
+			{Name: "Host", Type: sqltypes.VarChar},
+			{Name: "db", Type: sqltypes.VarChar},
+			{Name: "Command", Type: sqltypes.VarChar},
+			{Name: "Time", Type: sqltypes.Int64},
+			{Name: "State", Type: sqltypes.VarChar},
+			{Name: "Info", Type: sqltypes.VarChar},
+		},
+		Rows:         rows,
+		RowsAffected: uint64(len(rows)),
+	}
+}
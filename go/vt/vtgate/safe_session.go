@@ -17,9 +17,13 @@ limitations under the License.
 package vtgate
 
 import (
+	"strings"
 	"sync"
 
 	"github.com/golang/protobuf/proto"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -37,9 +41,27 @@ type SafeSession struct {
 	mustRollback    bool
 	autocommitState autocommitState
 	commitOrder     vtgatepb.CommitOrder
+	logging         *executeLogger
 	*vtgatepb.Session
 }
 
+// executeLogger records the queries that are sent to the
+// tablets. It's used by EXPLAIN FORMAT=VTEXPLAIN.
+type executeLogger struct {
+	mu      sync.Mutex
+	entries []executeEntry
+	lastID  int
+}
+
+// executeEntry is a query that was sent to a shard. Queries
+// that were sent in the same round trip share the same ID.
+type executeEntry struct {
+	ID       int
+	Keyspace string
+	Shard    string
+	Query    string
+}
+
 // autocommitState keeps track of whether a single round-trip
 // commit to vttablet is possible. It starts as autocommitable
 // if we started a transaction because of the autocommit flag
@@ -82,10 +104,14 @@ func NewAutocommitSession(sessn *vtgatepb.Session) *SafeSession {
 	newSession.PostSessions = nil
 	newSession.Autocommit = true
 	newSession.Warnings = nil
+	newSession.Savepoints = nil
+	newSession.InReservedConn = false
+	newSession.UnreplayableState = false
 	return NewSafeSession(newSession)
 }
 
-// Reset clears the session
+// Reset clears the transaction state of the session.
+// The reserved connections are kept.
 func (session *SafeSession) Reset() {
 	session.mu.Lock()
 	defer session.mu.Unlock()
@@ -93,12 +119,36 @@ func (session *SafeSession) Reset() {
 	session.autocommitState = notAutocommittable
 	session.Session.InTransaction = false
 	session.SingleDb = false
-	session.ShardSessions = nil
+	session.ShardSessions = reservedShardSessions(session.ShardSessions)
 	session.PreSessions = nil
 	session.PostSessions = nil
+	session.Savepoints = nil
 	session.commitOrder = vtgatepb.CommitOrder_NORMAL
 }
 
+// ResetAll clears the session, including its reserved connections.
+func (session *SafeSession) ResetAll() {
+	session.Reset()
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.ShardSessions = nil
+	session.Session.InReservedConn = false
+	session.Session.UnreplayableState = false
+}
+
+// reservedShardSessions returns the shard sessions
+// that have a reserved connection, outside of the transaction.
+func reservedShardSessions(shardSessions []*vtgatepb.Session_ShardSession) []*vtgatepb.Session_ShardSession {
+	var reserved []*vtgatepb.Session_ShardSession
+	for _, shardSession := range shardSessions {
+		if shardSession.ReservedId != 0 {
+			shardSession.TransactionId = 0
+			reserved = append(reserved, shardSession)
+		}
+	}
+	return reserved
+}
+
 // SetAutocommittable sets the state to autocommitable if true.
 // Otherwise, it's notAutocommitable.
 func (session *SafeSession) SetAutocommittable(flag bool) {
@@ -129,7 +179,8 @@ func (session *SafeSession) AutocommitApproval() bool {
 		return false
 	}
 
-	if session.autocommitState == autocommittable {
+	// A single round-trip commit can't use a reserved connection.
+	if session.autocommitState == autocommittable && !session.Session.InReservedConn {
 		session.autocommitState = autocommitted
 		return true
 	}
@@ -150,8 +201,72 @@ func (session *SafeSession) InTransaction() bool {
 	return session.Session.InTransaction
 }
 
-// Find returns the transactionId, if any, for a session
-func (session *SafeSession) Find(keyspace, shard string, tabletType topodatapb.TabletType) int64 {
+// InMultiShardTransaction returns true if the session is in a
+// transaction that is allowed to span multiple shards, given the
+// transaction mode of vtgate.
+func (session *SafeSession) InMultiShardTransaction(txMode vtgatepb.TransactionMode) bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.Session.InTransaction && !session.isSingleDB(txMode)
+}
+
+// InReservedConn returns true if the queries of the session are executed
+// on reserved connections. This is never the case for the pre and post
+// sessions, which only exist for the duration of the transaction.
+func (session *SafeSession) InReservedConn() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.Session.InReservedConn && session.commitOrder == vtgatepb.CommitOrder_NORMAL
+}
+
+// SetReservedConn marks the session as needing reserved connections.
+// It stays so until the reserved connections are released.
+func (session *SafeSession) SetReservedConn() {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.Session.InReservedConn = true
+}
+
+// SetUnreplayableState marks the reserved connections of the session
+// as holding state that can't be restored on new connections.
+func (session *SafeSession) SetUnreplayableState() {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.Session.UnreplayableState = true
+}
+
+// HasUnreplayableState returns true if the state of the session
+// would be lost with one of its reserved connections.
+func (session *SafeSession) HasUnreplayableState() bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.Session.UnreplayableState
+}
+
+// SetSystemVariable records the value of a system variable, which is
+// a SQL expression, so that it can be set on new reserved connections.
+func (session *SafeSession) SetSystemVariable(name, value string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.SystemVariables == nil {
+		session.SystemVariables = make(map[string]string)
+	}
+	session.SystemVariables[name] = value
+}
+
+// PreQueries returns the queries that restore the state of
+// the session on a new reserved connection.
+func (session *SafeSession) PreQueries() []string {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if len(session.SystemVariables) == 0 {
+		return nil
+	}
+	return []string{setSystemVariablesQuery(session.SystemVariables)}
+}
+
+// Find returns the transactionId and the reservedId, if any, for a session
+func (session *SafeSession) Find(keyspace, shard string, tabletType topodatapb.TabletType) (transactionID, reservedID int64) {
 	session.mu.Lock()
 	defer session.mu.Unlock()
 	sessions := session.ShardSessions
@@ -163,13 +278,15 @@ func (session *SafeSession) Find(keyspace, shard string, tabletType topodatapb.T
 	}
 	for _, shardSession := range sessions {
 		if keyspace == shardSession.Target.Keyspace && tabletType == shardSession.Target.TabletType && shard == shardSession.Target.Shard {
-			return shardSession.TransactionId
+			return shardSession.TransactionId, shardSession.ReservedId
 		}
 	}
-	return 0
+	return 0, 0
 }
 
-// Append adds a new ShardSession
+// Append adds a new ShardSession. If the session has reserved
+// connections, the ShardSession of the same target is updated
+// instead, if there's one.
 func (session *SafeSession) Append(shardSession *vtgatepb.Session_ShardSession, txMode vtgatepb.TransactionMode) error {
 	session.mu.Lock()
 	defer session.mu.Unlock()
@@ -178,7 +295,7 @@ func (session *SafeSession) Append(shardSession *vtgatepb.Session_ShardSession,
 		// Unreachable.
 		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: SafeSession.Append: unexpected autocommit state")
 	}
-	if !session.Session.InTransaction {
+	if !session.Session.InTransaction && !session.Session.InReservedConn {
 		// Unreachable.
 		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: SafeSession.Append: not in transaction")
 	}
@@ -187,9 +304,14 @@ func (session *SafeSession) Append(shardSession *vtgatepb.Session_ShardSession,
 	// Always append, in order for rollback to succeed.
 	switch session.commitOrder {
 	case vtgatepb.CommitOrder_NORMAL:
-		session.ShardSessions = append(session.ShardSessions, shardSession)
+		if existing := session.findReserved(shardSession.Target); existing != nil {
+			existing.TransactionId = shardSession.TransactionId
+			existing.ReservedId = shardSession.ReservedId
+		} else {
+			session.ShardSessions = append(session.ShardSessions, shardSession)
+		}
 		// isSingle is enforced only for normmal commit order operations.
-		if session.isSingleDB(txMode) && len(session.ShardSessions) > 1 {
+		if session.isSingleDB(txMode) && session.transactionShards() > 1 {
 			session.mustRollback = true
 			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "multi-db transaction attempted: %v", session.ShardSessions)
 		}
@@ -201,6 +323,32 @@ func (session *SafeSession) Append(shardSession *vtgatepb.Session_ShardSession,
 	return nil
 }
 
+// findReserved returns the ShardSession of the target, if the session
+// has reserved connections. It must be called with the lock held.
+func (session *SafeSession) findReserved(target *querypb.Target) *vtgatepb.Session_ShardSession {
+	if !session.Session.InReservedConn {
+		return nil
+	}
+	for _, shardSession := range session.ShardSessions {
+		if target.Keyspace == shardSession.Target.Keyspace && target.TabletType == shardSession.Target.TabletType && target.Shard == shardSession.Target.Shard {
+			return shardSession
+		}
+	}
+	return nil
+}
+
+// transactionShards returns the number of shards that are part of
+// the transaction. It must be called with the lock held.
+func (session *SafeSession) transactionShards() int {
+	count := 0
+	for _, shardSession := range session.ShardSessions {
+		if shardSession.TransactionId != 0 {
+			count++
+		}
+	}
+	return count
+}
+
 func (session *SafeSession) isSingleDB(txMode vtgatepb.TransactionMode) bool {
 	return session.SingleDb ||
 		session.TransactionMode == vtgatepb.TransactionMode_SINGLE ||
@@ -237,3 +385,136 @@ func (session *SafeSession) ClearWarnings() {
 	defer session.mu.Unlock()
 	session.Session.Warnings = nil
 }
+
+// SetSavepoint records a savepoint of the current transaction.
+// Like in MySQL, an existing savepoint with the same name is replaced.
+func (session *SafeSession) SetSavepoint(name string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if i := session.findSavepoint(name); i != -1 {
+		session.Savepoints = append(session.Savepoints[:i], session.Savepoints[i+1:]...)
+	}
+	session.Savepoints = append(session.Savepoints, name)
+}
+
+// HasSavepoint returns true if the savepoint was set in the current transaction.
+func (session *SafeSession) HasSavepoint(name string) bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return session.findSavepoint(name) != -1
+}
+
+// RollbackToSavepoint removes the savepoints that were set after the
+// specified one, because rolling back to it also discards them.
+func (session *SafeSession) RollbackToSavepoint(name string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if i := session.findSavepoint(name); i != -1 {
+		session.Savepoints = session.Savepoints[:i+1]
+	}
+}
+
+// ReleaseSavepoint removes the specified savepoint, and
+// the savepoints that were set after it.
+func (session *SafeSession) ReleaseSavepoint(name string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if i := session.findSavepoint(name); i != -1 {
+		session.Savepoints = session.Savepoints[:i]
+	}
+}
+
+// SavepointNames returns the savepoints of the current
+// transaction in the order they were set.
+func (session *SafeSession) SavepointNames() []string {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return append([]string(nil), session.Savepoints...)
+}
+
+// findSavepoint returns the index of the savepoint, or -1 if it was not
+// set. Savepoint names are case insensitive. It must be called with the
+// lock held.
+func (session *SafeSession) findSavepoint(name string) int {
+	for i, savepoint := range session.Savepoints {
+		if strings.EqualFold(savepoint, name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// SetUserDefinedVariable sets the value of a user-defined variable.
+func (session *SafeSession) SetUserDefinedVariable(name string, value *querypb.BindVariable) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if session.UserDefinedVariables == nil {
+		session.UserDefinedVariables = make(map[string]*querypb.BindVariable)
+	}
+	session.UserDefinedVariables[name] = value
+}
+
+// UserDefinedVariable returns the value of a user-defined
+// variable. The value of a variable that was not set is NULL.
+func (session *SafeSession) UserDefinedVariable(name string) *querypb.BindVariable {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if value, ok := session.UserDefinedVariables[name]; ok {
+		return value
+	}
+	return sqltypes.NullBindVariable
+}
+
+// EnableLogging starts recording the queries
+// that are sent to the tablets.
+func (session *SafeSession) EnableLogging() {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.logging = &executeLogger{}
+}
+
+// DisableLogging stops recording the queries.
+func (session *SafeSession) DisableLogging() {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.logging = nil
+}
+
+// log records the queries of a round trip if logging is enabled.
+// The bind variables are substituted into the queries.
+func (session *SafeSession) log(rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery) {
+	if session == nil || session.logging == nil {
+		return
+	}
+	session.logging.log(rss, queries)
+}
+
+// logEntries returns the recorded queries.
+func (session *SafeSession) logEntries() []executeEntry {
+	if session.logging == nil {
+		return nil
+	}
+	session.logging.mu.Lock()
+	defer session.logging.mu.Unlock()
+	return session.logging.entries
+}
+
+func (l *executeLogger) log(rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.lastID++
+	for i, rs := range rss {
+		query := queries[i].Sql
+		if stmt, err := sqlparser.Parse(query); err == nil {
+			if generated, err := sqlparser.NewParsedQuery(stmt).GenerateQuery(queries[i].BindVariables, nil); err == nil {
+				query = generated
+			}
+		}
+		l.entries = append(l.entries, executeEntry{
+			ID:       l.lastID,
+			Keyspace: rs.Target.Keyspace,
+			Shard:    rs.Target.Shard,
+			Query:    query,
+		})
+	}
+}
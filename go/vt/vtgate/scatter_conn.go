@@ -30,6 +30,7 @@ import (
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -70,7 +71,43 @@ type shardActionFunc func(rs *srvtopo.ResolvedShard, i int) error
 // multiGoTransaction is capable of executing multiple
 // shardActionTransactionFunc actions in parallel and consolidating
 // the results and errors for the caller.
-type shardActionTransactionFunc func(rs *srvtopo.ResolvedShard, i int, shouldBegin bool, transactionID int64) (int64, error)
+type shardActionTransactionFunc func(rs *srvtopo.ResolvedShard, i int, info shardActionInfo) (shardActionInfo, error)
+
+// shardActionInfo describes how a query is executed on a shard:
+// the transaction and reserved connection to use, and whether they
+// have to be created first.
+type shardActionInfo struct {
+	action        actionNeeded
+	transactionID int64
+	reservedID    int64
+}
+
+// actionNeeded is what has to be done before
+// a query can be executed on a shard.
+type actionNeeded int
+
+const (
+	// nothing: the query is executed as is.
+	nothing = actionNeeded(iota)
+	// begin: a transaction is started.
+	begin
+	// reserve: the connection is reserved. If transactionID
+	// is set, the connection of the transaction is reserved.
+	reserve
+	// reserveBegin: a transaction is started on the reserved
+	// connection. The connection is reserved first, unless
+	// reservedID is set.
+	reserveBegin
+)
+
+// executeID returns the id to execute the query with
+// if no action is needed.
+func (info shardActionInfo) executeID() int64 {
+	if info.transactionID != 0 {
+		return info.transactionID
+	}
+	return info.reservedID
+}
 
 // NewScatterConn creates a new ScatterConn.
 func NewScatterConn(statsName string, txConn *TxConn, gw gateway.Gateway, hc discovery.HealthCheck) *ScatterConn {
@@ -139,20 +176,10 @@ func (stc *ScatterConn) Execute(
 		tabletType,
 		session,
 		notInTransaction,
-		func(rs *srvtopo.ResolvedShard, i int, shouldBegin bool, transactionID int64) (int64, error) {
-			var innerqr *sqltypes.Result
-			if shouldBegin {
-				var err error
-				innerqr, transactionID, err = rs.QueryService.BeginExecute(ctx, rs.Target, query, bindVars, options)
-				if err != nil {
-					return transactionID, err
-				}
-			} else {
-				var err error
-				innerqr, err = rs.QueryService.Execute(ctx, rs.Target, query, bindVars, transactionID, options)
-				if err != nil {
-					return transactionID, err
-				}
+		func(rs *srvtopo.ResolvedShard, i int, info shardActionInfo) (shardActionInfo, error) {
+			innerqr, info, err := stc.executeOnShard(ctx, rs, query, bindVars, info, session, options)
+			if err != nil {
+				return info, err
 			}
 
 			mu.Lock()
@@ -161,7 +188,7 @@ func (stc *ScatterConn) Execute(
 			if len(qr.Rows) <= *maxMemoryRows {
 				qr.AppendResult(innerqr)
 			}
-			return transactionID, nil
+			return info, nil
 		},
 	)
 
@@ -191,6 +218,7 @@ func (stc *ScatterConn) ExecuteMultiShard(
 	// mu protects qr
 	var mu sync.Mutex
 	qr = new(sqltypes.Result)
+	session.log(rss, queries)
 
 	allErrors := stc.multiGoTransaction(
 		ctx,
@@ -199,7 +227,7 @@ func (stc *ScatterConn) ExecuteMultiShard(
 		tabletType,
 		session,
 		notInTransaction,
-		func(rs *srvtopo.ResolvedShard, i int, shouldBegin bool, transactionID int64) (int64, error) {
+		func(rs *srvtopo.ResolvedShard, i int, info shardActionInfo) (shardActionInfo, error) {
 			var (
 				innerqr *sqltypes.Result
 				err     error
@@ -209,16 +237,13 @@ func (stc *ScatterConn) ExecuteMultiShard(
 				opts = session.Session.Options
 			}
 
-			switch {
-			case autocommit:
+			if autocommit {
 				innerqr, err = stc.executeAutocommit(ctx, rs, queries[i].Sql, queries[i].BindVariables, opts)
-			case shouldBegin:
-				innerqr, transactionID, err = rs.QueryService.BeginExecute(ctx, rs.Target, queries[i].Sql, queries[i].BindVariables, opts)
-			default:
-				innerqr, err = rs.QueryService.Execute(ctx, rs.Target, queries[i].Sql, queries[i].BindVariables, transactionID, opts)
+			} else {
+				innerqr, info, err = stc.executeOnShard(ctx, rs, queries[i].Sql, queries[i].BindVariables, info, session, opts)
 			}
 			if err != nil {
-				return transactionID, err
+				return info, err
 			}
 
 			mu.Lock()
@@ -227,7 +252,7 @@ func (stc *ScatterConn) ExecuteMultiShard(
 			if len(qr.Rows) <= *maxMemoryRows {
 				qr.AppendResult(innerqr)
 			}
-			return transactionID, nil
+			return info, nil
 		},
 	)
 
@@ -238,6 +263,48 @@ func (stc *ScatterConn) ExecuteMultiShard(
 	return qr, allErrors.GetErrors()
 }
 
+// executeOnShard executes the query on the shard, after taking the action
+// described by info. It returns the ids of the transaction and reserved
+// connection that were used. Outside of a transaction, a reserved
+// connection that was aborted is released and replaced by a new one,
+// on which the state of the session is restored. This is not possible
+// if the session holds state that can't be restored.
+func (stc *ScatterConn) executeOnShard(ctx context.Context, rs *srvtopo.ResolvedShard, sql string, bindVariables map[string]*querypb.BindVariable, info shardActionInfo, session *SafeSession, options *querypb.ExecuteOptions) (*sqltypes.Result, shardActionInfo, error) {
+	qr, newInfo, err := stc.executeAction(ctx, rs, sql, bindVariables, info, session, options)
+	if err == nil || info.transactionID != 0 || info.reservedID == 0 || vterrors.Code(err) != vtrpcpb.Code_ABORTED {
+		return qr, newInfo, err
+	}
+	if session.HasUnreplayableState() {
+		return nil, newInfo, vterrors.Wrap(err, "reserved connection aborted, the temporary tables and locks of the session can't be restored")
+	}
+	// The connection may still exist, if only the query was aborted.
+	_ = rs.QueryService.Release(ctx, rs.Target, info.reservedID)
+	if info.action == nothing {
+		info.action = reserve
+	}
+	info.reservedID = 0
+	return stc.executeAction(ctx, rs, sql, bindVariables, info, session, options)
+}
+
+func (stc *ScatterConn) executeAction(ctx context.Context, rs *srvtopo.ResolvedShard, sql string, bindVariables map[string]*querypb.BindVariable, info shardActionInfo, session *SafeSession, options *querypb.ExecuteOptions) (qr *sqltypes.Result, _ shardActionInfo, err error) {
+	switch info.action {
+	case begin:
+		qr, info.transactionID, err = rs.QueryService.BeginExecute(ctx, rs.Target, sql, bindVariables, options)
+	case reserve:
+		qr, info.reservedID, err = rs.QueryService.ReserveExecute(ctx, rs.Target, session.PreQueries(), sql, bindVariables, info.transactionID, options)
+	case reserveBegin:
+		var reservedID int64
+		qr, reservedID, err = rs.QueryService.ReserveBeginExecute(ctx, rs.Target, session.PreQueries(), sql, bindVariables, info.reservedID, options)
+		if reservedID != 0 {
+			info.reservedID = reservedID
+			info.transactionID = reservedID
+		}
+	default:
+		qr, err = rs.QueryService.Execute(ctx, rs.Target, sql, bindVariables, info.executeID(), options)
+	}
+	return qr, info, err
+}
+
 func (stc *ScatterConn) executeAutocommit(ctx context.Context, rs *srvtopo.ResolvedShard, sql string, bindVariables map[string]*querypb.BindVariable, options *querypb.ExecuteOptions) (*sqltypes.Result, error) {
 	queries := []*querypb.BoundQuery{{
 		Sql:           sql,
@@ -275,23 +342,16 @@ func (stc *ScatterConn) ExecuteEntityIds(
 		tabletType,
 		session,
 		notInTransaction,
-		func(rs *srvtopo.ResolvedShard, i int, shouldBegin bool, transactionID int64) (int64, error) {
-			var innerqr *sqltypes.Result
-			var err error
-
-			if shouldBegin {
-				innerqr, transactionID, err = rs.QueryService.BeginExecute(ctx, rs.Target, sqls[i], bindVars[i], options)
-			} else {
-				innerqr, err = rs.QueryService.Execute(ctx, rs.Target, sqls[i], bindVars[i], transactionID, options)
-			}
+		func(rs *srvtopo.ResolvedShard, i int, info shardActionInfo) (shardActionInfo, error) {
+			innerqr, info, err := stc.executeOnShard(ctx, rs, sqls[i], bindVars[i], info, session, options)
 			if err != nil {
-				return transactionID, err
+				return info, err
 			}
 
 			mu.Lock()
 			defer mu.Unlock()
 			qr.AppendResult(innerqr)
-			return transactionID, nil
+			return info, nil
 		})
 
 	return qr, allErrors.AggrError(vterrors.Aggregate)
@@ -397,9 +457,20 @@ func (stc *ScatterConn) ExecuteBatch(
 			startTime, statsKey := stc.startAction("ExecuteBatch", req.rs.Target)
 			defer stc.endAction(startTime, allErrors, statsKey, &err, session)
 
-			shouldBegin, transactionID := transactionInfo(req.rs.Target, session, false)
+			info := actionInfo(req.rs.Target, session, false)
+			if info.action == begin && len(session.SavepointNames()) != 0 {
+				info, err = stc.beginWithSavepoints(ctx, req.rs, session, info)
+				if err != nil {
+					return
+				}
+			}
 			var innerqrs []sqltypes.Result
-			if shouldBegin {
+			switch info.action {
+			case reserve, reserveBegin:
+				err = vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "batch queries are not supported on reserved connections")
+				return
+			case begin:
+				var transactionID int64
 				innerqrs, transactionID, err = req.rs.QueryService.BeginExecuteBatch(ctx, req.rs.Target, req.queries, asTransaction, options)
 				if transactionID != 0 {
 					if appendErr := session.Append(&vtgatepb.Session_ShardSession{
@@ -412,8 +483,8 @@ func (stc *ScatterConn) ExecuteBatch(
 				if err != nil {
 					return
 				}
-			} else {
-				innerqrs, err = req.rs.QueryService.ExecuteBatch(ctx, req.rs.Target, req.queries, asTransaction, transactionID, options)
+			default:
+				innerqrs, err = req.rs.QueryService.ExecuteBatch(ctx, req.rs.Target, req.queries, asTransaction, info.executeID(), options)
 				if err != nil {
 					return
 				}
@@ -811,12 +882,21 @@ func (stc *ScatterConn) multiGoTransaction(
 		startTime, statsKey := stc.startAction(name, rs.Target)
 		defer stc.endAction(startTime, allErrors, statsKey, &err, session)
 
-		shouldBegin, transactionID := transactionInfo(rs.Target, session, notInTransaction)
-		transactionID, err = action(rs, i, shouldBegin, transactionID)
-		if shouldBegin && transactionID != 0 {
+		info := actionInfo(rs.Target, session, notInTransaction)
+		if (info.action == begin || info.action == reserveBegin) && len(session.SavepointNames()) != 0 {
+			info, err = stc.beginWithSavepoints(ctx, rs, session, info)
+			if err != nil {
+				return
+			}
+		}
+		var newInfo shardActionInfo
+		newInfo, err = action(rs, i, info)
+		changed := newInfo.transactionID != info.transactionID || newInfo.reservedID != info.reservedID
+		if changed && (newInfo.transactionID != 0 || newInfo.reservedID != 0) {
 			if appendErr := session.Append(&vtgatepb.Session_ShardSession{
 				Target:        rs.Target,
-				TransactionId: transactionID,
+				TransactionId: newInfo.transactionID,
+				ReservedId:    newInfo.reservedID,
 			}, stc.txConn.mode); appendErr != nil {
 				err = appendErr
 			}
@@ -848,31 +928,82 @@ end:
 	return allErrors
 }
 
-// transactionInfo looks at the current session, and returns:
-// - shouldBegin: if we should call 'Begin' to get a transactionID
-// - transactionID: the transactionID to use, or 0 if not in a transaction.
-func transactionInfo(
-	target *querypb.Target,
-	session *SafeSession,
-	notInTransaction bool,
-) (shouldBegin bool, transactionID int64) {
-	if !session.InTransaction() {
-		return false, 0
+// beginWithSavepoints begins a transaction on a shard that joins the
+// transaction after savepoints were set, and sets the savepoints on it
+// before it executes anything else. This allows all the shards of the
+// transaction to be rolled back to any of the savepoints. The returned
+// info needs no further action.
+func (stc *ScatterConn) beginWithSavepoints(ctx context.Context, rs *srvtopo.ResolvedShard, session *SafeSession, info shardActionInfo) (shardActionInfo, error) {
+	names := session.SavepointNames()
+	queries := make([]string, len(names))
+	for i, name := range names {
+		queries[i] = sqlparser.String(&sqlparser.Savepoint{Name: sqlparser.NewColIdent(name)})
+	}
+	var err error
+	if info.action == reserveBegin {
+		// There's no begin without a query on a reserved connection,
+		// so the transaction is started with the first savepoint.
+		_, info, err = stc.executeOnShard(ctx, rs, queries[0], nil, info, session, session.Options)
+		queries = queries[1:]
+	} else {
+		info.transactionID, err = rs.QueryService.Begin(ctx, rs.Target, session.Options)
+	}
+	if info.transactionID != 0 {
+		if appendErr := session.Append(&vtgatepb.Session_ShardSession{
+			Target:        rs.Target,
+			TransactionId: info.transactionID,
+			ReservedId:    info.reservedID,
+		}, stc.txConn.mode); appendErr != nil {
+			err = appendErr
+		}
+	}
+	if err != nil {
+		return info, err
+	}
+	for _, query := range queries {
+		if _, err := rs.QueryService.Execute(ctx, rs.Target, query, nil, info.transactionID, session.Options); err != nil {
+			return info, err
+		}
+	}
+	info.action = nothing
+	return info, nil
+}
+
+// actionInfo looks at the current session, and returns how a query is
+// executed on the shard of the target. In a transaction, the transaction
+// of the shard is used. If there's none yet, it's started, unless
+// notInTransaction is set. If the session uses reserved connections,
+// the reserved connection of the shard is used, and transactions are
+// started on it. If there's none yet, the connection is reserved first.
+func actionInfo(target *querypb.Target, session *SafeSession, notInTransaction bool) shardActionInfo {
+	inTransaction := session.InTransaction()
+	inReservedConn := session.InReservedConn()
+	if !inTransaction && !inReservedConn {
+		return shardActionInfo{}
 	}
 	// No need to protect ourselves from the race condition between
 	// Find and Append. The higher level functions ensure that no
 	// duplicate (target) tuples can execute
 	// this at the same time.
-	transactionID = session.Find(target.Keyspace, target.Shard, target.TabletType)
-	if transactionID != 0 {
-		return false, transactionID
-	}
-	// We are in a transaction at higher level,
-	// but client requires not to start a transaction for this query.
-	// If a transaction was started on this conn, we will use it (as above).
-	if notInTransaction {
-		return false, 0
+	transactionID, reservedID := session.Find(target.Keyspace, target.Shard, target.TabletType)
+	info := shardActionInfo{transactionID: transactionID, reservedID: reservedID}
+	switch {
+	case !inReservedConn:
+		// We are in a transaction at higher level,
+		// but client requires not to start a transaction for this query.
+		// If a transaction was started on this conn, we will use it (as above).
+		if transactionID == 0 && !notInTransaction {
+			info.action = begin
+		}
+	case reservedID == 0:
+		// The transaction, if there's one, is on a connection
+		// that's not reserved yet.
+		info.action = reserve
+		if inTransaction && transactionID == 0 && !notInTransaction {
+			info.action = reserveBegin
+		}
+	case inTransaction && transactionID == 0 && !notInTransaction:
+		info.action = reserveBegin
 	}
-
-	return true, 0
+	return info
 }
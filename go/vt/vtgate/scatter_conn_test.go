@@ -624,6 +624,70 @@ func TestScatterConnSingleDB(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestScatterConnReservedConnLost(t *testing.T) {
+	createSandbox("TestScatterConnReservedConnLost")
+	hc := discovery.NewFakeHealthCheck()
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	sbc := hc.AddTestTablet("aa", "0", 1, "TestScatterConnReservedConnLost", "0", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	res := srvtopo.NewResolver(&sandboxTopo{}, sc.gateway, "aa")
+	rss, err := res.ResolveDestination(context.Background(), "TestScatterConnReservedConnLost", topodatapb.TabletType_MASTER, key.DestinationShard("0"))
+	require.NoError(t, err)
+	sqls := func() []string {
+		var sqls []string
+		for _, query := range sbc.Queries {
+			sqls = append(sqls, query.Sql)
+		}
+		sbc.Queries = nil
+		return sqls
+	}
+
+	session := NewSafeSession(&vtgatepb.Session{
+		InReservedConn:  true,
+		SystemVariables: map[string]string{"time_zone": "'+00:00'"},
+	})
+	_, err = sc.Execute(context.Background(), "query1", nil, rss, topodatapb.TabletType_MASTER, session, false, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"set @@time_zone = '+00:00'", "query1"}, sqls())
+	require.EqualValues(t, 1, session.ShardSessions[0].ReservedId)
+
+	// A lost connection is released and reserved again,
+	// and the system variables are set on the new one.
+	sbc.MustFailExecuteConnLost = 1
+	_, err = sc.Execute(context.Background(), "query2", nil, rss, topodatapb.TabletType_MASTER, session, false, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"query2", "set @@time_zone = '+00:00'", "query2"}, sqls())
+	require.EqualValues(t, 1, sbc.ReleaseCount.Get())
+	require.EqualValues(t, 2, sbc.ReserveCount.Get())
+	require.Len(t, session.ShardSessions, 1)
+	require.EqualValues(t, 2, session.ShardSessions[0].ReservedId)
+
+	// The transaction is lost with the connection, so it's not retried.
+	session.Session.InTransaction = true
+	_, err = sc.Execute(context.Background(), "query3", nil, rss, topodatapb.TabletType_MASTER, session, false, nil)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, session.ShardSessions[0].TransactionId)
+	sbc.MustFailExecuteConnLost = 1
+	_, err = sc.Execute(context.Background(), "query4", nil, rss, topodatapb.TabletType_MASTER, session, false, nil)
+	require.EqualError(t, err, "target: TestScatterConnReservedConnLost.0.master, used tablet: aa-0 (0): transaction 2: not found")
+	require.EqualValues(t, 2, sbc.ReserveCount.Get())
+
+	// Temporary tables and locks are lost with the connection,
+	// so a session that holds them can't recover.
+	session = NewSafeSession(&vtgatepb.Session{
+		InReservedConn:    true,
+		UnreplayableState: true,
+	})
+	_, err = sc.Execute(context.Background(), "query5", nil, rss, topodatapb.TabletType_MASTER, session, false, nil)
+	require.NoError(t, err)
+	sbc.MustFailExecuteConnLost = 1
+	_, err = sc.Execute(context.Background(), "query6", nil, rss, topodatapb.TabletType_MASTER, session, false, nil)
+	require.EqualError(t, err, "reserved connection aborted, the temporary tables and locks of the session can't be restored: target: TestScatterConnReservedConnLost.0.master, used tablet: aa-0 (0): transaction 3: not found")
+	require.Equal(t, vtrpcpb.Code_ABORTED, vterrors.Code(err))
+	require.EqualValues(t, 3, sbc.ReserveCount.Get())
+	require.EqualValues(t, 1, sbc.ReleaseCount.Get())
+}
+
 func TestAppendResult(t *testing.T) {
 	qr := new(sqltypes.Result)
 	innerqr1 := &sqltypes.Result{
@@ -25,6 +25,7 @@ import (
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/dtids"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/gateway"
 
@@ -106,6 +107,10 @@ func (txc *TxConn) commitNormal(ctx context.Context, session *SafeSession) error
 
 	// Retain backward compatibility on commit order for the normal session.
 	for _, shardSession := range session.ShardSessions {
+		if shardSession.TransactionId == 0 {
+			// A reserved connection that's not part of the transaction.
+			continue
+		}
 		if err := txc.gateway.Commit(ctx, shardSession.Target, shardSession.TransactionId); err != nil {
 			shardSession.TransactionId = 0
 			_ = txc.Rollback(ctx, session)
@@ -131,9 +136,13 @@ func (txc *TxConn) commit2PC(ctx context.Context, session *SafeSession) error {
 	}
 
 	// If the number of participants is one or less, then it's a normal commit.
-	if len(session.ShardSessions) <= 1 {
+	if session.transactionShards() <= 1 {
 		return txc.commitNormal(ctx, session)
 	}
+	if session.InReservedConn() {
+		_ = txc.Rollback(ctx, session)
+		return vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "2pc transaction disallowed on reserved connections")
+	}
 
 	participants := make([]*querypb.Target, 0, len(session.ShardSessions)-1)
 	for _, s := range session.ShardSessions[1:] {
@@ -194,6 +203,100 @@ func (txc *TxConn) Rollback(ctx context.Context, session *SafeSession) error {
 	})
 }
 
+// Savepoint sets a savepoint in the current transaction. It's set on the
+// shards that are part of the transaction, and recorded in the session so
+// that it's also set on the shards that join the transaction later.
+// The call is a no-op if the session is not in a transaction.
+func (txc *TxConn) Savepoint(ctx context.Context, session *SafeSession, name string) error {
+	if !session.InTransaction() {
+		return nil
+	}
+	query := sqlparser.String(&sqlparser.Savepoint{Name: sqlparser.NewColIdent(name)})
+	if err := txc.runSavepointQuery(ctx, session, query); err != nil {
+		return err
+	}
+	session.SetSavepoint(name)
+	return nil
+}
+
+// RollbackToSavepoint rolls back all the shards of the current transaction
+// to the savepoint. If any of them fails, the whole transaction is rolled
+// back, because the shards would otherwise be left in different states.
+func (txc *TxConn) RollbackToSavepoint(ctx context.Context, session *SafeSession, name string) error {
+	if !session.InTransaction() || !session.HasSavepoint(name) {
+		return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "SAVEPOINT %s does not exist", name)
+	}
+	query := sqlparser.String(&sqlparser.SRollback{Name: sqlparser.NewColIdent(name)})
+	if err := txc.runSavepointQuery(ctx, session, query); err != nil {
+		_ = txc.Rollback(ctx, session)
+		return err
+	}
+	session.RollbackToSavepoint(name)
+	return nil
+}
+
+// ReleaseSavepoint releases the savepoint on all the shards
+// of the current transaction.
+func (txc *TxConn) ReleaseSavepoint(ctx context.Context, session *SafeSession, name string) error {
+	if !session.InTransaction() || !session.HasSavepoint(name) {
+		return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "SAVEPOINT %s does not exist", name)
+	}
+	query := sqlparser.String(&sqlparser.Release{Name: sqlparser.NewColIdent(name)})
+	if err := txc.runSavepointQuery(ctx, session, query); err != nil {
+		return err
+	}
+	session.ReleaseSavepoint(name)
+	return nil
+}
+
+// runSavepointQuery executes the query on the shards of the current transaction.
+func (txc *TxConn) runSavepointQuery(ctx context.Context, session *SafeSession, query string) error {
+	return txc.runSessions(session.ShardSessions, func(s *vtgatepb.Session_ShardSession) error {
+		if s.TransactionId == 0 {
+			return nil
+		}
+		_, err := txc.gateway.Execute(ctx, s.Target, query, nil, s.TransactionId, session.Options)
+		return err
+	})
+}
+
+// ExecuteReserved executes the query on the reserved connections of the
+// session. It's used for changing the state of the existing reserved
+// connections, which the new ones get from the session.
+func (txc *TxConn) ExecuteReserved(ctx context.Context, session *SafeSession, query string) error {
+	return txc.runSessions(session.ShardSessions, func(s *vtgatepb.Session_ShardSession) error {
+		if s.ReservedId == 0 {
+			return nil
+		}
+		_, err := txc.gateway.Execute(ctx, s.Target, query, nil, s.ReservedId, session.Options)
+		return err
+	})
+}
+
+// Release releases the reserved connections of the session, which also
+// rolls back the transaction. The other shards of the transaction are
+// rolled back as usual. The session no longer uses reserved connections
+// afterwards.
+func (txc *TxConn) Release(ctx context.Context, session *SafeSession) error {
+	if !session.InReservedConn() {
+		return txc.Rollback(ctx, session)
+	}
+	defer session.ResetAll()
+
+	allsessions := append(session.PreSessions, session.ShardSessions...)
+	allsessions = append(allsessions, session.PostSessions...)
+
+	return txc.runSessions(allsessions, func(s *vtgatepb.Session_ShardSession) error {
+		switch {
+		case s.ReservedId != 0:
+			return txc.gateway.Release(ctx, s.Target, s.ReservedId)
+		case s.TransactionId != 0:
+			return txc.gateway.Rollback(ctx, s.Target, s.TransactionId)
+		}
+		return nil
+	})
+}
+
 // Resolve resolves the specified 2PC transaction.
 func (txc *TxConn) Resolve(ctx context.Context, dtid string) error {
 	mmShard, err := dtids.ShardSession(dtid)
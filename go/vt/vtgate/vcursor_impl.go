@@ -80,6 +80,20 @@ func (vc *vcursorImpl) MaxMemoryRows() int {
 	return *maxMemoryRows
 }
 
+// SpillConfig returns the configuration for spilling rows to disk.
+func (vc *vcursorImpl) SpillConfig() engine.SpillConfig {
+	return engine.SpillConfig{
+		Dir:           *spillDir,
+		MaxBytes:      *maxSpillBytes,
+		MaxTotalBytes: *maxTotalSpillBytes,
+	}
+}
+
+// InsertSelectBatchSize returns the insertSelectBatchSize flag value.
+func (vc *vcursorImpl) InsertSelectBatchSize() int {
+	return *insertSelectBatchSize
+}
+
 // SetContextTimeout updates context and sets a timeout.
 func (vc *vcursorImpl) SetContextTimeout(timeout time.Duration) context.CancelFunc {
 	ctx, cancel := context.WithTimeout(vc.ctx, timeout)
@@ -150,6 +164,7 @@ func (vc *vcursorImpl) Execute(method string, query string, bindVars map[string]
 	if co == vtgatepb.CommitOrder_AUTOCOMMIT {
 		// For autocommit, we have to create an independent session.
 		session = NewAutocommitSession(vc.safeSession.Session)
+		session.logging = vc.safeSession.logging
 	} else {
 		session.SetCommitOrder(co)
 		defer session.SetCommitOrder(vtgatepb.CommitOrder_NORMAL)
@@ -178,6 +193,11 @@ func (vc *vcursorImpl) AutocommitApproval() bool {
 	return vc.safeSession.AutocommitApproval()
 }
 
+// InMultiShardTransaction is part of the engine.VCursor interface.
+func (vc *vcursorImpl) InMultiShardTransaction() bool {
+	return vc.safeSession.InMultiShardTransaction(vc.executor.txConn.mode)
+}
+
 // ExecuteStandalone is part of the engine.VCursor interface.
 func (vc *vcursorImpl) ExecuteStandalone(query string, bindVars map[string]*querypb.BindVariable, rs *srvtopo.ResolvedShard) (*sqltypes.Result, error) {
 	rss := []*srvtopo.ResolvedShard{rs}
@@ -187,9 +207,11 @@ func (vc *vcursorImpl) ExecuteStandalone(query string, bindVars map[string]*quer
 			BindVariables: bindVars,
 		},
 	}
+	session := NewAutocommitSession(vc.safeSession.Session)
+	session.logging = vc.safeSession.logging
 	// The autocommit flag is always set to false because we currently don't
 	// execute DMLs through ExecuteStandalone.
-	qr, errs := vc.executor.scatterConn.ExecuteMultiShard(vc.ctx, rss, bqs, vc.tabletType, NewAutocommitSession(vc.safeSession.Session), false, false /* autocommit */)
+	qr, errs := vc.executor.scatterConn.ExecuteMultiShard(vc.ctx, rss, bqs, vc.tabletType, session, false, false /* autocommit */)
 	return qr, vterrors.Aggregate(errs)
 }
 
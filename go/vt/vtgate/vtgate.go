@@ -43,6 +43,7 @@ import (
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 
+	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/gateway"
 	"vitess.io/vitess/go/vt/vtgate/vtgateservice"
 
@@ -62,8 +63,13 @@ var (
 	_                  = flag.Bool("disable_local_gateway", false, "deprecated: if specified, this process will not route any queries to local tablets in the local cell")
 	maxMemoryRows      = flag.Int("max_memory_rows", 300000, "Maximum number of rows that will be held in memory for intermediate results as well as the final result.")
 	warnMemoryRows     = flag.Int("warn_memory_rows", 30000, "Warning threshold for in-memory results. A row count higher than this amount will cause the VtGateWarnings.ResultsExceeded counter to be incremented.")
+	spillDir           = flag.String("spill_dir", "", "Directory for the temporary files of streaming queries whose rows sorted, hash joined or aggregated as distinct by vtgate exceed max_memory_rows. If empty, such queries fail instead of spilling rows to disk. The files left in the directory are removed at startup, so it must not be shared with another vtgate.")
+	maxSpillBytes      = flag.Int64("max_spill_bytes", 1<<30, "Maximum number of bytes a sort, hash join or distinct aggregate of a streaming query can spill to disk. 0 means no limit.")
+	maxTotalSpillBytes = flag.Int64("max_total_spill_bytes", 0, "Maximum number of bytes all the streaming queries together can spill to disk. 0 means no limit.")
 )
 
+var insertSelectBatchSize = flag.Int("insert_select_batch_size", 500, "Maximum number of rows of an INSERT ... SELECT that are inserted with one statement per shard. The rows of the SELECT are streamed, and inserted in batches of this size.")
+
 func getTxMode() vtgatepb.TransactionMode {
 	switch *transactionMode {
 	case "SINGLE":
@@ -148,6 +154,13 @@ func Init(ctx context.Context, hc discovery.HealthCheck, serv srvtopo.Server, ce
 	// catch the initial load stats.
 	vschemaCounters = stats.NewCountersWithSingleLabel("VtgateVSchemaCounts", "Vtgate vschema counts", "changes")
 
+	// Remove the spill files that a previous run may have left behind.
+	if *spillDir != "" {
+		if err := engine.RemoveSpillFiles(*spillDir); err != nil {
+			log.Fatalf("Unable to remove the spill files in %v: %v", *spillDir, err)
+		}
+	}
+
 	// Build objects from low to high level.
 	// Start with the gateway. If we can't reach the topology service,
 	// we can't go on much further, so we log.Fatal out.
@@ -814,6 +827,12 @@ func (vtg *VTGate) Rollback(ctx context.Context, session *vtgatepb.Session) erro
 	return formatError(vtg.txConn.Rollback(ctx, NewSafeSession(session)))
 }
 
+// CloseSession releases the reserved connections of the session,
+// and rolls back its transaction.
+func (vtg *VTGate) CloseSession(ctx context.Context, session *vtgatepb.Session) error {
+	return formatError(vtg.txConn.Release(ctx, NewSafeSession(session)))
+}
+
 // ResolveTransaction resolves the specified 2PC transaction.
 func (vtg *VTGate) ResolveTransaction(ctx context.Context, dtid string) error {
 	return formatError(vtg.txConn.Resolve(ctx, dtid))
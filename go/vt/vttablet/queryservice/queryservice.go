@@ -88,6 +88,27 @@ type QueryService interface {
 	BeginExecute(ctx context.Context, target *querypb.Target, sql string, bindVariables map[string]*querypb.BindVariable, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error)
 	BeginExecuteBatch(ctx context.Context, target *querypb.Target, queries []*querypb.BoundQuery, asTransaction bool, options *querypb.ExecuteOptions) ([]sqltypes.Result, int64, error)
 
+	// Reserved connection methods. A reserved connection is dedicated
+	// to a session, and its id is used like a transaction id for
+	// accessing it. Transactions can be started on it with
+	// ReserveBeginExecute, and their id is the reserved id.
+
+	// ReserveExecute reserves a connection and executes the query on it.
+	// If transactionID is not 0, the connection of that transaction is
+	// reserved. Otherwise, the preQueries are executed on a new connection
+	// for restoring the state of the session. If err != nil, the reserved
+	// id may still be non-zero.
+	ReserveExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error)
+
+	// ReserveBeginExecute begins a transaction on the reserved connection
+	// and executes the query in it. If reservedID is 0, a new connection is
+	// reserved first, and the preQueries are executed on it.
+	ReserveBeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error)
+
+	// Release closes the reserved connection. If a transaction is
+	// open on it, it's rolled back.
+	Release(ctx context.Context, target *querypb.Target, reservedID int64) error
+
 	// Messaging methods.
 	MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) error
 	MessageAck(ctx context.Context, target *querypb.Target, name string, ids []*querypb.Value) (count int64, err error)
@@ -41,7 +41,10 @@ type SandboxConn struct {
 	MustFailCodes map[vtrpcpb.Code]int
 
 	// These errors are triggered only for specific functions.
-	// For now these are just for the 2PC functions.
+	// For now these are just for the 2PC functions, and for
+	// Execute with a transaction or reserved connection, which
+	// fails as if the connection no longer existed.
+	MustFailExecuteConnLost     int
 	MustFailPrepare             int
 	MustFailCommitPrepared      int
 	MustFailRollbackPrepared    int
@@ -65,6 +68,8 @@ type SandboxConn struct {
 	SetRollbackCount         sync2.AtomicInt64
 	ConcludeTransactionCount sync2.AtomicInt64
 	ReadTransactionCount     sync2.AtomicInt64
+	ReserveCount             sync2.AtomicInt64
+	ReleaseCount             sync2.AtomicInt64
 
 	// Queries stores the non-batch requests received.
 	Queries []*querypb.BoundQuery
@@ -133,6 +138,10 @@ func (sbc *SandboxConn) Execute(ctx context.Context, target *querypb.Target, que
 		BindVariables: bv,
 	})
 	sbc.Options = append(sbc.Options, options)
+	if transactionID != 0 && sbc.MustFailExecuteConnLost > 0 {
+		sbc.MustFailExecuteConnLost--
+		return nil, vterrors.Errorf(vtrpcpb.Code_ABORTED, "transaction %d: not found", transactionID)
+	}
 	if err := sbc.getError(); err != nil {
 		return nil, err
 	}
@@ -305,6 +314,57 @@ func (sbc *SandboxConn) BeginExecuteBatch(ctx context.Context, target *querypb.T
 	return results, transactionID, err
 }
 
+// ReserveExecute is part of the QueryService interface.
+// The preQueries of a new reservation are stored in Queries.
+func (sbc *SandboxConn) ReserveExecute(ctx context.Context, target *querypb.Target, preQueries []string, query string, bindVars map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error) {
+	reservedID, err := sbc.reserve(preQueries, transactionID)
+	if err != nil {
+		return nil, 0, err
+	}
+	result, err := sbc.Execute(ctx, target, query, bindVars, reservedID, options)
+	return result, reservedID, err
+}
+
+// ReserveBeginExecute is part of the QueryService interface.
+// The preQueries of a new reservation are stored in Queries.
+func (sbc *SandboxConn) ReserveBeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, query string, bindVars map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error) {
+	if reservedID == 0 {
+		var err error
+		if reservedID, err = sbc.reserve(preQueries, 0); err != nil {
+			return nil, 0, err
+		}
+	}
+	sbc.BeginCount.Add(1)
+	if err := sbc.getError(); err != nil {
+		return nil, reservedID, err
+	}
+	result, err := sbc.Execute(ctx, target, query, bindVars, reservedID, options)
+	return result, reservedID, err
+}
+
+func (sbc *SandboxConn) reserve(preQueries []string, transactionID int64) (int64, error) {
+	sbc.ReserveCount.Add(1)
+	if err := sbc.getError(); err != nil {
+		return 0, err
+	}
+	if transactionID != 0 {
+		return transactionID, nil
+	}
+	for _, query := range preQueries {
+		sbc.Queries = append(sbc.Queries, &querypb.BoundQuery{
+			Sql:           query,
+			BindVariables: map[string]*querypb.BindVariable{},
+		})
+	}
+	return sbc.TransactionID.Add(1), nil
+}
+
+// Release is part of the QueryService interface.
+func (sbc *SandboxConn) Release(ctx context.Context, target *querypb.Target, reservedID int64) error {
+	sbc.ReleaseCount.Add(1)
+	return sbc.getError()
+}
+
 // MessageStream is part of the QueryService interface.
 func (sbc *SandboxConn) MessageStream(ctx context.Context, target *querypb.Target, name string, callback func(*sqltypes.Result) error) (err error) {
 	if err := sbc.getError(); err != nil {
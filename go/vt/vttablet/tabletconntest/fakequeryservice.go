@@ -643,6 +643,38 @@ func (f *FakeQueryService) BeginExecuteBatch(ctx context.Context, target *queryp
 	return results, transactionID, err
 }
 
+// ReserveExecute combines Reserve and Execute.
+func (f *FakeQueryService) ReserveExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error) {
+	if transactionID == 0 {
+		transactionID = ExecuteTransactionID
+	}
+	result, err := f.Execute(ctx, target, sql, bindVariables, transactionID, options)
+	return result, transactionID, err
+}
+
+// ReserveBeginExecute combines Reserve, Begin and Execute.
+func (f *FakeQueryService) ReserveBeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error) {
+	transactionID, err := f.Begin(ctx, target, options)
+	if err != nil {
+		return nil, reservedID, err
+	}
+
+	result, err := f.Execute(ctx, target, sql, bindVariables, transactionID, options)
+	return result, transactionID, err
+}
+
+// Release is part of the queryservice.QueryService interface
+func (f *FakeQueryService) Release(ctx context.Context, target *querypb.Target, reservedID int64) error {
+	if f.HasError {
+		return f.TabletError
+	}
+	if f.Panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	f.checkTargetCallerID(ctx, "Release", target)
+	return nil
+}
+
 var (
 	// MessageName is a test message name.
 	MessageName = "vitess_message"
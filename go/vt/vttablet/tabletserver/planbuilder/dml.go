@@ -204,7 +204,7 @@ func analyzeSelect(sel *sqlparser.Select, tables map[string]*schema.Table) (plan
 	}
 
 	tableName := analyzeFrom(sel.From)
-	if tableName.IsEmpty() {
+	if tableName.IsEmpty() || isCTEName(sel.With, tableName) {
 		return plan, nil
 	}
 	table, err := plan.setTable(tableName, tables)
@@ -242,6 +242,20 @@ func analyzeSelect(sel *sqlparser.Select, tables map[string]*schema.Table) (plan
 	return plan, nil
 }
 
+// isCTEName returns true if the name refers to
+// one of the common table expressions of the WITH clause.
+func isCTEName(with *sqlparser.With, name sqlparser.TableIdent) bool {
+	if with == nil {
+		return false
+	}
+	for _, cte := range with.CTEs {
+		if cte.Name.String() == name.String() {
+			return true
+		}
+	}
+	return false
+}
+
 func analyzeFrom(tableExprs sqlparser.TableExprs) sqlparser.TableIdent {
 	if len(tableExprs) > 1 {
 		return sqlparser.NewTableIdent("")
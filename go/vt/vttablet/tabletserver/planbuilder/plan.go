@@ -87,6 +87,9 @@ const (
 	PlanMessageStream
 	// PlanSelectImpossible is used for where or having clauses that can never be true.
 	PlanSelectImpossible
+	// PlanSavepoint is for SAVEPOINT, ROLLBACK TO SAVEPOINT
+	// and RELEASE SAVEPOINT statements.
+	PlanSavepoint
 	// NumPlans stores the total number of plans
 	NumPlans
 )
@@ -111,6 +114,7 @@ var planName = [NumPlans]string{
 	"OTHER_ADMIN",
 	"MESSAGE_STREAM",
 	"SELECT_IMPOSSIBLE",
+	"SAVEPOINT",
 }
 
 func (pt PlanType) String() string {
@@ -229,6 +233,11 @@ type Plan struct {
 
 	// For PlanInsertSubquery: pk columns in the subquery result.
 	SubqueryPKColumns []int
+
+	// NeedsReservedConn is set if the query uses state that belongs
+	// to the MySQL connection, like named locks or temporary tables.
+	// Such queries can only be executed on a reserved connection.
+	NeedsReservedConn bool
 }
 
 // TableName returns the table name for the plan.
@@ -250,11 +259,7 @@ func (plan *Plan) setTable(tableName sqlparser.TableIdent, tables map[string]*sc
 // Build builds a plan based on the schema.
 func Build(statement sqlparser.Statement, tables map[string]*schema.Table) (*Plan, error) {
 	var plan *Plan
-
-	err := checkForPoolingUnsafeConstructs(statement)
-	if err != nil {
-		return nil, err
-	}
+	var err error
 
 	switch stmt := statement.(type) {
 	case *sqlparser.Union:
@@ -277,10 +282,15 @@ func Build(statement sqlparser.Statement, tables map[string]*schema.Table) (*Pla
 		plan, err = analyzeDDL(stmt, tables), nil
 	case *sqlparser.Show:
 		plan, err = &Plan{PlanID: PlanOtherRead}, nil
-	case *sqlparser.OtherRead:
+	case *sqlparser.OtherRead, *sqlparser.Explain:
 		plan, err = &Plan{PlanID: PlanOtherRead}, nil
 	case *sqlparser.OtherAdmin:
 		plan, err = &Plan{PlanID: PlanOtherAdmin}, nil
+	case *sqlparser.Savepoint, *sqlparser.SRollback, *sqlparser.Release:
+		plan, err = &Plan{
+			PlanID:    PlanSavepoint,
+			FullQuery: GenerateFullQuery(stmt),
+		}, nil
 	default:
 		return nil, vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "invalid SQL")
 	}
@@ -288,6 +298,7 @@ func Build(statement sqlparser.Statement, tables map[string]*schema.Table) (*Pla
 		return nil, err
 	}
 	plan.Permissions = BuildPermissions(statement)
+	plan.NeedsReservedConn = sqlparser.NeedsReservedConn(statement)
 	return plan, nil
 }
 
@@ -298,15 +309,11 @@ func BuildStreaming(sql string, tables map[string]*schema.Table) (*Plan, error)
 		return nil, err
 	}
 
-	err = checkForPoolingUnsafeConstructs(statement)
-	if err != nil {
-		return nil, err
-	}
-
 	plan := &Plan{
-		PlanID:      PlanSelectStream,
-		FullQuery:   GenerateFullQuery(statement),
-		Permissions: BuildPermissions(statement),
+		PlanID:            PlanSelectStream,
+		FullQuery:         GenerateFullQuery(statement),
+		Permissions:       BuildPermissions(statement),
+		NeedsReservedConn: sqlparser.NeedsReservedConn(statement),
 	}
 
 	switch stmt := statement.(type) {
@@ -317,7 +324,7 @@ func BuildStreaming(sql string, tables map[string]*schema.Table) (*Plan, error)
 		if tableName := analyzeFrom(stmt.From); !tableName.IsEmpty() {
 			plan.setTable(tableName, tables)
 		}
-	case *sqlparser.OtherRead, *sqlparser.Show, *sqlparser.Union:
+	case *sqlparser.OtherRead, *sqlparser.Explain, *sqlparser.Show, *sqlparser.Union:
 		// pass
 	default:
 		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "'%v' not allowed for streaming", sqlparser.String(stmt))
@@ -344,20 +351,3 @@ func BuildMessageStreaming(name string, tables map[string]*schema.Table) (*Plan,
 	}}
 	return plan, nil
 }
-
-// checkForPoolingUnsafeConstructs returns an error if the SQL expression contains
-// a call to GET_LOCK(), which is unsafe with server-side connection pooling.
-// For more background, see https://github.com/vitessio/vitess/issues/3631.
-func checkForPoolingUnsafeConstructs(expr sqlparser.SQLNode) error {
-	return sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
-		if f, ok := node.(*sqlparser.FuncExpr); ok {
-			if f.Name.Lowered() == "get_lock" {
-				return false, vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "get_lock() not allowed")
-			}
-		}
-
-		// TODO: This could be smarter about not walking down parts of the AST that can't contain
-		// function calls.
-		return true, nil
-	}, expr)
-}
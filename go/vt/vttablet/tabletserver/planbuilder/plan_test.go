@@ -54,6 +54,7 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		SecondaryPKValues []sqltypes.PlanValue   `json:",omitempty"`
 		WhereClause       *sqlparser.ParsedQuery `json:",omitempty"`
 		SubqueryPKColumns []int                  `json:",omitempty"`
+		NeedsReservedConn bool                   `json:",omitempty"`
 	}{
 		PlanID:            p.PlanID,
 		Reason:            p.Reason,
@@ -69,6 +70,7 @@ func (p *Plan) MarshalJSON() ([]byte, error) {
 		SecondaryPKValues: p.SecondaryPKValues,
 		WhereClause:       p.WhereClause,
 		SubqueryPKColumns: p.SubqueryPKColumns,
+		NeedsReservedConn: p.NeedsReservedConn,
 	}
 	return json.Marshal(&mplan)
 }
@@ -98,6 +98,10 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		return nil, err
 	}
 
+	if qre.plan.NeedsReservedConn {
+		return qre.execReserved()
+	}
+
 	switch qre.plan.PlanID {
 	case planbuilder.PlanDDL:
 		return qre.execDDL()
@@ -142,7 +146,7 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 			return qre.execSQL(conn, qre.query, true)
 		case planbuilder.PlanUpsertPK:
 			return qre.execUpsertPK(conn)
-		case planbuilder.PlanSet:
+		case planbuilder.PlanSet, planbuilder.PlanSavepoint:
 			return qre.txFetch(conn, qre.plan.FullQuery, qre.bindVars, nil, "", true, true)
 		case planbuilder.PlanPassSelect, planbuilder.PlanSelectLock, planbuilder.PlanSelectImpossible:
 			return qre.execDirect(conn)
@@ -160,7 +164,7 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		switch qre.plan.PlanID {
 		case planbuilder.PlanPassSelect, planbuilder.PlanSelectImpossible:
 			return qre.execSelect()
-		case planbuilder.PlanSelectLock:
+		case planbuilder.PlanSelectLock, planbuilder.PlanSavepoint:
 			return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "%s disallowed outside transaction", qre.plan.PlanID.String())
 		case planbuilder.PlanSet:
 			return qre.execSet()
@@ -224,8 +228,14 @@ func (qre *QueryExecutor) Stream(callback func(*sqltypes.Result) error) error {
 			return err
 		}
 		defer txConn.Recycle()
+		if qre.plan.NeedsReservedConn && !txConn.Reserved {
+			return errNeedsReservedConn
+		}
 		conn = txConn.DBConn
 	} else {
+		if qre.plan.NeedsReservedConn {
+			return errNeedsReservedConn
+		}
 		dbConn, err := qre.getStreamConn()
 		if err != nil {
 			return err
@@ -528,6 +538,29 @@ func (qre *QueryExecutor) execNextval() (*sqltypes.Result, error) {
 	}, nil
 }
 
+var errNeedsReservedConn = vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "named locks and temporary tables are only allowed on a reserved connection")
+
+// execReserved executes a query that uses the state of the
+// connection, like a named lock or a temporary table.
+// It's only allowed on a reserved connection.
+func (qre *QueryExecutor) execReserved() (*sqltypes.Result, error) {
+	if qre.transactionID == 0 {
+		return nil, errNeedsReservedConn
+	}
+	conn, err := qre.tsv.te.txPool.Get(qre.transactionID, "for query")
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Recycle()
+	if !conn.Reserved {
+		return nil, errNeedsReservedConn
+	}
+	if qre.plan.FullQuery == nil {
+		return qre.execSQL(conn, qre.query, true)
+	}
+	return qre.txFetch(conn, qre.plan.FullQuery, qre.bindVars, nil, "", true, true)
+}
+
 // execDirect is for reads inside transactions. Always send to MySQL.
 func (qre *QueryExecutor) execDirect(conn *TxConnection) (*sqltypes.Result, error) {
 	if qre.tsv.qe.enableQueryPlanFieldCaching && qre.plan.Fields != nil {
@@ -1337,6 +1337,41 @@ func TestQueryExecutorPlanSet(t *testing.T) {
 	tsv.StopService()
 }
 
+func TestQueryExecutorPlanSavepoint(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	db.AddQuery("savepoint a", &sqltypes.Result{})
+	db.AddQuery("rollback to a", &sqltypes.Result{})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	// Savepoints are not allowed outside a transaction.
+	qre := newTestQueryExecutor(ctx, tsv, "savepoint a", 0)
+	checkPlanID(t, planbuilder.PlanSavepoint, qre.plan.PlanID)
+	_, err := qre.Execute()
+	if code := vterrors.Code(err); code != vtrpcpb.Code_FAILED_PRECONDITION {
+		t.Fatalf("qre.Execute: %v, want %v", code, vtrpcpb.Code_FAILED_PRECONDITION)
+	}
+
+	txid := newTransaction(tsv, nil)
+	qre = newTestQueryExecutor(ctx, tsv, "savepoint a", txid)
+	if _, err := qre.Execute(); err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	qre = newTestQueryExecutor(ctx, tsv, "rollback to savepoint a", txid)
+	checkPlanID(t, planbuilder.PlanSavepoint, qre.plan.PlanID)
+	if _, err := qre.Execute(); err != nil {
+		t.Fatalf("qre.Execute() = %v, want nil", err)
+	}
+	wantqueries := []string{"savepoint a", "rollback to a"}
+	gotqueries := fetchRecordedQueries(qre)
+	if !reflect.DeepEqual(gotqueries, wantqueries) {
+		t.Errorf("queries: %v, want %v", gotqueries, wantqueries)
+	}
+	testCommitHelper(t, tsv, qre)
+}
+
 func TestQueryExecutorPlanOther(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
 	defer db.Close()
@@ -249,6 +249,18 @@ type TxPoolController interface {
 
 	// Rollback rolls back the specified transaction.
 	Rollback(ctx context.Context, transactionID int64) error
+
+	// Reserve reserves a connection for a session, and returns its id.
+	// If transactionID is not 0, the connection of that transaction is reserved.
+	// Otherwise, the preQueries are executed on a new connection.
+	Reserve(ctx context.Context, options *querypb.ExecuteOptions, preQueries []string, transactionID int64) (int64, error)
+
+	// BeginReserved begins a transaction on the reserved connection, returning
+	// the statement(s) used to execute the begin (if any).
+	BeginReserved(ctx context.Context, reservedID int64, options *querypb.ExecuteOptions) (string, error)
+
+	// Release closes the reserved connection.
+	Release(ctx context.Context, reservedID int64) error
 }
 
 var tsOnce sync.Once
@@ -1205,6 +1217,92 @@ func (tsv *TabletServer) BeginExecute(ctx context.Context, target *querypb.Targe
 	return result, transactionID, err
 }
 
+// ReserveExecute reserves a connection and executes the query on it.
+// If transactionID is not 0, the connection of that transaction is
+// reserved. Otherwise, a new connection is reserved, and the preQueries
+// are executed on it for restoring the state of the session. The returned
+// id is used like a transaction id for accessing the connection, until
+// it's released.
+func (tsv *TabletServer) ReserveExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, transactionID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error) {
+	reservedID, err := tsv.reserve(ctx, target, preQueries, transactionID, options)
+	if err != nil {
+		return nil, 0, err
+	}
+	result, err := tsv.Execute(ctx, target, sql, bindVariables, reservedID, options)
+	return result, reservedID, err
+}
+
+// ReserveBeginExecute begins a transaction on a reserved connection, and
+// executes the query in it. If reservedID is 0, a new connection is reserved
+// first, and the preQueries are executed on it. The returned id is the id
+// of the reserved connection, which is also the transaction id.
+func (tsv *TabletServer) ReserveBeginExecute(ctx context.Context, target *querypb.Target, preQueries []string, sql string, bindVariables map[string]*querypb.BindVariable, reservedID int64, options *querypb.ExecuteOptions) (*sqltypes.Result, int64, error) {
+	if reservedID == 0 {
+		var err error
+		reservedID, err = tsv.reserve(ctx, target, preQueries, 0, options)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+	err := tsv.execRequest(
+		ctx, tsv.QueryTimeout.Get(),
+		"Begin", "begin", nil,
+		target, options, true /* isBegin */, false, /* allowOnShutdown */
+		func(ctx context.Context, logStats *tabletenv.LogStats) error {
+			startTime := time.Now()
+			if tsv.txThrottler.Throttle() {
+				return vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "Transaction throttled")
+			}
+			logStats.TransactionID = reservedID
+			beginSQL, err := tsv.teCtrl.BeginReserved(ctx, reservedID, options)
+			logStats.OriginalSQL = beginSQL
+			if beginSQL != "" {
+				tabletenv.QueryStats.Record("BEGIN", startTime)
+			} else {
+				logStats.Method = ""
+			}
+			return err
+		},
+	)
+	if err != nil {
+		// The connection is still reserved.
+		return nil, reservedID, err
+	}
+	result, err := tsv.Execute(ctx, target, sql, bindVariables, reservedID, options)
+	return result, reservedID, err
+}
+
+// Release releases the reserved connection. If a transaction
+// is open on it, the transaction is rolled back.
+func (tsv *TabletServer) Release(ctx context.Context, target *querypb.Target, reservedID int64) error {
+	return tsv.execRequest(
+		ctx, tsv.QueryTimeout.Get(),
+		"Release", "release", nil,
+		target, nil, false /* isBegin */, true, /* allowOnShutdown */
+		func(ctx context.Context, logStats *tabletenv.LogStats) error {
+			defer tabletenv.QueryStats.Record("RELEASE", time.Now())
+			logStats.TransactionID = reservedID
+			return tsv.teCtrl.Release(ctx, reservedID)
+		},
+	)
+}
+
+func (tsv *TabletServer) reserve(ctx context.Context, target *querypb.Target, preQueries []string, transactionID int64, options *querypb.ExecuteOptions) (reservedID int64, err error) {
+	err = tsv.execRequest(
+		ctx, tsv.QueryTimeout.Get(),
+		"Reserve", "reserve", nil,
+		target, options, true /* isBegin */, false, /* allowOnShutdown */
+		func(ctx context.Context, logStats *tabletenv.LogStats) error {
+			defer tabletenv.QueryStats.Record("RESERVE", time.Now())
+			reservedID, err = tsv.teCtrl.Reserve(ctx, options, preQueries, transactionID)
+			logStats.TransactionID = reservedID
+			logStats.OriginalSQL = strings.Join(preQueries, "; ")
+			return err
+		},
+	)
+	return reservedID, err
+}
+
 func (tsv *TabletServer) beginWaitForSameRangeTransactions(ctx context.Context, target *querypb.Target, options *querypb.ExecuteOptions, sql string, bindVariables map[string]*querypb.BindVariable) (txserializer.DoneFunc, error) {
 	// Serialize the creation of new transactions *if* the first
 	// UPDATE or DELETE query has the same WHERE clause as a query which is
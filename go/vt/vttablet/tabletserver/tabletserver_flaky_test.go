@@ -949,6 +949,46 @@ func TestTabletServerCommitTransaction(t *testing.T) {
 	}
 }
 
+func TestTabletServerReservedConn(t *testing.T) {
+	db := setUpTabletServerTest(t)
+	defer db.Close()
+	testUtils := newTestUtils()
+	lockSQL := "select get_lock('a', 10) from dual"
+	db.AddQuery(lockSQL+" limit 10001", &sqltypes.Result{})
+	db.AddQuery(lockSQL+" where 1 != 1", &sqltypes.Result{})
+	db.AddQuery("set @@time_zone = '+00:00'", &sqltypes.Result{})
+	config := testUtils.newQueryServiceConfig()
+	tsv := NewTabletServerWithNilTopoServer(config)
+	dbcfgs := testUtils.newDBConfigs(db)
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	err := tsv.StartService(target, dbcfgs)
+	require.NoError(t, err)
+	defer tsv.StopService()
+	ctx := context.Background()
+
+	_, err = tsv.Execute(ctx, &target, lockSQL, nil, 0, nil)
+	require.EqualError(t, err, "named locks and temporary tables are only allowed on a reserved connection")
+
+	_, reservedID, err := tsv.ReserveExecute(ctx, &target, []string{"set @@time_zone = '+00:00'"}, lockSQL, nil, 0, nil)
+	require.NoError(t, err)
+	require.NotZero(t, reservedID)
+	_, err = tsv.Execute(ctx, &target, lockSQL, nil, reservedID, nil)
+	require.NoError(t, err)
+	require.Equal(t, 2, db.GetQueryCalledNum(lockSQL+" limit 10001"))
+
+	// The connection is kept after the transaction ends.
+	_, transactionID, err := tsv.ReserveBeginExecute(ctx, &target, nil, lockSQL, nil, reservedID, nil)
+	require.NoError(t, err)
+	require.Equal(t, reservedID, transactionID)
+	require.NoError(t, tsv.Commit(ctx, &target, transactionID))
+	_, err = tsv.Execute(ctx, &target, lockSQL, nil, reservedID, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, tsv.Release(ctx, &target, reservedID))
+	_, err = tsv.Execute(ctx, &target, lockSQL, nil, reservedID, nil)
+	require.Error(t, err)
+}
+
 func TestTabletServerCommiRollbacktFail(t *testing.T) {
 	db := setUpTabletServerTest(t)
 	defer db.Close()
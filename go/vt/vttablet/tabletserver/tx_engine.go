@@ -274,28 +274,74 @@ func (te *TxEngine) AcceptReadOnly() error {
 func (te *TxEngine) Begin(ctx context.Context, options *querypb.ExecuteOptions) (int64, string, error) {
 	span, ctx := trace.NewSpan(ctx, "TxEngine.Begin")
 	defer span.Finish()
+	if err := te.startBegin(isWriteTransaction(options)); err != nil {
+		return 0, "", err
+	}
+	defer te.beginRequests.Done()
+	return te.txPool.Begin(ctx, options)
+}
+
+// Reserve reserves a connection for a session, and returns its id.
+// If transactionID is not 0, the connection of that transaction is
+// reserved. Otherwise, a new connection is reserved, and the preQueries
+// are executed on it for restoring the state of the session.
+func (te *TxEngine) Reserve(ctx context.Context, options *querypb.ExecuteOptions, preQueries []string, transactionID int64) (int64, error) {
+	span, ctx := trace.NewSpan(ctx, "TxEngine.Reserve")
+	defer span.Finish()
+	if transactionID != 0 {
+		return te.txPool.Reserve(ctx, options, nil, transactionID)
+	}
+	if err := te.startBegin(false); err != nil {
+		return 0, err
+	}
+	defer te.beginRequests.Done()
+	return te.txPool.Reserve(ctx, options, preQueries, 0)
+}
+
+// BeginReserved begins a transaction on a reserved connection, and
+// returns the statement(s) used to execute the begin (if any).
+func (te *TxEngine) BeginReserved(ctx context.Context, reservedID int64, options *querypb.ExecuteOptions) (string, error) {
+	span, ctx := trace.NewSpan(ctx, "TxEngine.BeginReserved")
+	defer span.Finish()
+	if err := te.startBegin(isWriteTransaction(options)); err != nil {
+		return "", err
+	}
+	defer te.beginRequests.Done()
+	return te.txPool.BeginReserved(ctx, reservedID, options)
+}
+
+// Release closes a reserved connection.
+func (te *TxEngine) Release(ctx context.Context, reservedID int64) error {
+	span, ctx := trace.NewSpan(ctx, "TxEngine.Release")
+	defer span.Finish()
+	return te.txPool.Release(ctx, reservedID)
+}
+
+// startBegin returns an error if the current state doesn't allow
+// starting a transaction of the specified kind. Otherwise, it
+// blocks state changes until beginRequests.Done is called.
+func (te *TxEngine) startBegin(isWriteTransaction bool) error {
 	te.stateLock.Lock()
+	defer te.stateLock.Unlock()
 
 	canOpenTransactions := te.state == AcceptingReadOnly || te.state == AcceptingReadAndWrite
 	if !canOpenTransactions {
 		// We are not in a state where we can start new transactions. Abort.
-		te.stateLock.Unlock()
-		return 0, "", vterrors.Errorf(vtrpc.Code_UNAVAILABLE, "tx engine can't accept new transactions in state %v", te.state)
+		return vterrors.Errorf(vtrpc.Code_UNAVAILABLE, "tx engine can't accept new transactions in state %v", te.state)
 	}
 
-	isWriteTransaction := options == nil || options.TransactionIsolation != querypb.ExecuteOptions_CONSISTENT_SNAPSHOT_READ_ONLY
 	if te.state == AcceptingReadOnly && isWriteTransaction {
-		te.stateLock.Unlock()
-		return 0, "", vterrors.Errorf(vtrpc.Code_UNAVAILABLE, "tx engine can only accept read-only transactions in current state")
+		return vterrors.Errorf(vtrpc.Code_UNAVAILABLE, "tx engine can only accept read-only transactions in current state")
 	}
 
 	// By Add() to beginRequests, we block others from initiating state
 	// changes until we have finished adding this transaction
 	te.beginRequests.Add(1)
-	te.stateLock.Unlock()
+	return nil
+}
 
-	defer te.beginRequests.Done()
-	return te.txPool.Begin(ctx, options)
+func isWriteTransaction(options *querypb.ExecuteOptions) bool {
+	return options == nil || options.TransactionIsolation != querypb.ExecuteOptions_CONSISTENT_SNAPSHOT_READ_ONLY
 }
 
 // Commit commits the specified transaction.
@@ -446,6 +492,8 @@ func (te *TxEngine) close(immediate bool) {
 			log.Info("Transactions completed before grace period: shutting down.")
 		}
 	}()
+	// Reserved connections would otherwise keep the pool from becoming empty.
+	te.txPool.Drain()
 	te.txPool.WaitForEmpty()
 	// If the goroutine is still running, signal that it can exit.
 	close(poolEmpty)
@@ -52,6 +52,7 @@ const (
 	TxRollback = "rollback"
 	TxPrepare  = "prepare"
 	TxKill     = "kill"
+	TxRelease  = "release"
 )
 
 const txLogInterval = time.Duration(1 * time.Minute)
@@ -94,9 +95,15 @@ type TxPool struct {
 	lastID                 sync2.AtomicInt64
 	transactionTimeout     sync2.AtomicDuration
 	transactionPoolTimeout sync2.AtomicDuration
-	ticks                  *timer.Timer
-	checker                connpool.MySQLChecker
-	limiter                txlimiter.TxLimiter
+	// idleTimeout is the time after which idle
+	// reserved connections are released.
+	idleTimeout time.Duration
+	// draining is set while the pool is closing. Reserved
+	// connections are released as soon as they're not in use.
+	draining sync2.AtomicBool
+	ticks    *timer.Timer
+	checker  connpool.MySQLChecker
+	limiter  txlimiter.TxLimiter
 	// Tracking culprits that cause tx pool full errors.
 	logMu     sync.Mutex
 	lastLog   time.Time
@@ -123,6 +130,7 @@ func NewTxPool(
 		lastID:                 sync2.NewAtomicInt64(time.Now().UnixNano()),
 		transactionTimeout:     sync2.NewAtomicDuration(transactionTimeout),
 		transactionPoolTimeout: sync2.NewAtomicDuration(transactionPoolTimeout),
+		idleTimeout:            idleTimeout,
 		waiterCap:              sync2.NewAtomicInt64(int64(waiterCap)),
 		waiters:                sync2.NewAtomicInt64(0),
 		ticks:                  timer.NewTimer(transactionTimeout / 10),
@@ -148,6 +156,7 @@ func (axp *TxPool) Open(appParams, dbaParams, appDebugParams dbconfigs.Connector
 	foundRowsParam.EnableClientFoundRows()
 	appParams = dbconfigs.New(foundRowsParam)
 	axp.foundRowsPool.Open(appParams, dbaParams, appDebugParams)
+	axp.draining.Set(false)
 	axp.ticks.Start(func() { axp.transactionKiller() })
 }
 
@@ -161,6 +170,7 @@ func (axp *TxPool) Close() {
 		conn.Close()
 		conn.conclude(TxClose, "pool closed")
 	}
+	axp.releaseReserved(0, "pool closed")
 	axp.conns.Close()
 	axp.foundRowsPool.Close()
 }
@@ -175,13 +185,35 @@ func (axp *TxPool) AdjustLastID(id int64) {
 	}
 }
 
-// RollbackNonBusy rolls back all transactions that are not in use.
+// RollbackNonBusy rolls back all transactions that are not in use,
+// and releases the reserved connections that are not in use.
 // Transactions can be in use for situations like executing statements
 // or in prepared state.
 func (axp *TxPool) RollbackNonBusy(ctx context.Context) {
 	for _, v := range axp.activePool.GetOutdated(time.Duration(0), "for transition") {
 		axp.LocalConclude(ctx, v.(*TxConnection))
 	}
+	axp.releaseReserved(0, "released for transition")
+}
+
+// Drain makes the pool release the reserved connections as soon as
+// they're not in use, which allows the pool to become empty.
+func (axp *TxPool) Drain() {
+	axp.draining.Set(true)
+	axp.releaseReserved(0, "pool draining")
+}
+
+// releaseReserved releases the reserved connections that are not in
+// a transaction and have not been used for the specified time.
+func (axp *TxPool) releaseReserved(idle time.Duration, reason string) {
+	for _, v := range axp.activePool.GetIdle(idle, "for release") {
+		conn := v.(*TxConnection)
+		if !conn.Reserved || conn.inTransaction {
+			axp.activePool.Put(conn.TransactionID)
+			continue
+		}
+		conn.conclude(TxRelease, reason)
+	}
 }
 
 func (axp *TxPool) transactionKiller() {
@@ -193,6 +225,9 @@ func (axp *TxPool) transactionKiller() {
 		conn.Close()
 		conn.conclude(TxKill, fmt.Sprintf("exceeded timeout: %v", axp.Timeout()))
 	}
+	if axp.idleTimeout != 0 {
+		axp.releaseReserved(axp.idleTimeout, fmt.Sprintf("exceeded idle timeout: %v", axp.idleTimeout))
+	}
 }
 
 // WaitForEmpty waits until all active transactions are completed.
@@ -208,6 +243,80 @@ func (axp *TxPool) WaitForEmpty() {
 func (axp *TxPool) Begin(ctx context.Context, options *querypb.ExecuteOptions) (int64, string, error) {
 	span, ctx := trace.NewSpan(ctx, "TxPool.Begin")
 	defer span.Finish()
+	return axp.begin(ctx, options, false, nil)
+}
+
+// Reserve reserves a connection for a session, and returns the id to
+// be used for accessing it like a transaction. If transactionID is not 0,
+// the connection of that transaction is reserved, and its id is kept.
+// Otherwise, a new connection is reserved, and the preQueries are executed
+// on it for restoring the state of the session.
+//
+// A reserved connection outlives the transactions started on it.
+// It's closed when it's released.
+func (axp *TxPool) Reserve(ctx context.Context, options *querypb.ExecuteOptions, preQueries []string, transactionID int64) (int64, error) {
+	span, ctx := trace.NewSpan(ctx, "TxPool.Reserve")
+	defer span.Finish()
+	if transactionID != 0 {
+		conn, err := axp.Get(transactionID, "for reserve")
+		if err != nil {
+			return 0, err
+		}
+		conn.Reserved = true
+		conn.Recycle()
+		return transactionID, nil
+	}
+	reservedID, _, err := axp.begin(ctx, options, true, preQueries)
+	return reservedID, err
+}
+
+// BeginReserved begins a transaction on a reserved connection, and returns
+// the statements (if any) executed to initiate the transaction.
+func (axp *TxPool) BeginReserved(ctx context.Context, reservedID int64, options *querypb.ExecuteOptions) (string, error) {
+	span, ctx := trace.NewSpan(ctx, "TxPool.BeginReserved")
+	defer span.Finish()
+	conn, err := axp.Get(reservedID, "for begin")
+	if err != nil {
+		return "", err
+	}
+	defer conn.Recycle()
+	if !conn.Reserved {
+		return "", vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "connection %d is not reserved", reservedID)
+	}
+	if conn.inTransaction {
+		return "", vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "transaction %d: already in a transaction", reservedID)
+	}
+	beginQueries, autocommit, err := openTransaction(ctx, conn.DBConn, options)
+	if err != nil {
+		return "", err
+	}
+	conn.Autocommit = autocommit
+	conn.inTransaction = true
+	conn.StartTime = time.Now()
+	axp.activePool.SetEnforceTimeout(reservedID, options.GetWorkload() != querypb.ExecuteOptions_DBA)
+	return beginQueries, nil
+}
+
+// Release closes the reserved connection. If a transaction is open,
+// it's rolled back.
+func (axp *TxPool) Release(ctx context.Context, reservedID int64) error {
+	span, _ := trace.NewSpan(ctx, "TxPool.Release")
+	defer span.Finish()
+	conn, err := axp.Get(reservedID, "for release")
+	if err != nil {
+		return err
+	}
+	if !conn.Reserved {
+		conn.Recycle()
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "connection %d is not reserved", reservedID)
+	}
+	conn.conclude(TxRelease, "connection released")
+	return nil
+}
+
+// begin registers a connection with a new id. If reserve is set, the
+// preQueries are executed instead of opening a transaction.
+func (axp *TxPool) begin(ctx context.Context, options *querypb.ExecuteOptions, reserve bool, preQueries []string) (int64, string, error) {
 	var conn *connpool.DBConn
 	var err error
 	immediateCaller := callerid.ImmediateCallerIDFromContext(ctx)
@@ -257,44 +366,69 @@ func (axp *TxPool) Begin(ctx context.Context, options *querypb.ExecuteOptions) (
 		return 0, "", err
 	}
 
-	autocommitTransaction := false
+	autocommitTransaction := true
 	beginQueries := ""
-	if queries, ok := txIsolations[options.GetTransactionIsolation()]; ok {
-		if queries.setIsolationLevel != "" {
-			if _, err := conn.Exec(ctx, "set transaction isolation level "+queries.setIsolationLevel, 1, false); err != nil {
+	if reserve {
+		for _, query := range preQueries {
+			if _, err := conn.Exec(ctx, query, 1, false); err != nil {
+				// The session state of the connection is unknown,
+				// so it must not be returned to the pool.
+				conn.Close()
 				return 0, "", err
 			}
-
-			beginQueries = queries.setIsolationLevel + "; "
 		}
-
-		if _, err := conn.Exec(ctx, queries.openTransaction, 1, false); err != nil {
+	} else {
+		beginQueries, autocommitTransaction, err = openTransaction(ctx, conn, options)
+		if err != nil {
 			return 0, "", err
 		}
-		beginQueries = beginQueries + queries.openTransaction
-	} else if options.GetTransactionIsolation() == querypb.ExecuteOptions_AUTOCOMMIT {
-		autocommitTransaction = true
-	} else {
-		return 0, "", fmt.Errorf("don't know how to open a transaction of this type: %v", options.GetTransactionIsolation())
 	}
 
 	beginSucceeded = true
 	transactionID := axp.lastID.Add(1)
+	txc := newTxConnection(
+		conn,
+		transactionID,
+		axp,
+		immediateCaller,
+		effectiveCaller,
+		autocommitTransaction,
+	)
+	txc.Reserved = reserve
+	txc.inTransaction = !reserve
 	axp.activePool.Register(
 		transactionID,
-		newTxConnection(
-			conn,
-			transactionID,
-			axp,
-			immediateCaller,
-			effectiveCaller,
-			autocommitTransaction,
-		),
-		options.GetWorkload() != querypb.ExecuteOptions_DBA,
+		txc,
+		!reserve && options.GetWorkload() != querypb.ExecuteOptions_DBA,
 	)
 	return transactionID, beginQueries, nil
 }
 
+// openTransaction opens a transaction on the connection with the isolation
+// level of the options. It returns the statements that were executed, and
+// whether the transaction is an autocommit one, in which case no statement
+// is executed.
+func openTransaction(ctx context.Context, conn *connpool.DBConn, options *querypb.ExecuteOptions) (string, bool, error) {
+	if options.GetTransactionIsolation() == querypb.ExecuteOptions_AUTOCOMMIT {
+		return "", true, nil
+	}
+	queries, ok := txIsolations[options.GetTransactionIsolation()]
+	if !ok {
+		return "", false, fmt.Errorf("don't know how to open a transaction of this type: %v", options.GetTransactionIsolation())
+	}
+	beginQueries := ""
+	if queries.setIsolationLevel != "" {
+		if _, err := conn.Exec(ctx, "set transaction isolation level "+queries.setIsolationLevel, 1, false); err != nil {
+			return "", false, err
+		}
+		beginQueries = queries.setIsolationLevel + "; "
+	}
+	if _, err := conn.Exec(ctx, queries.openTransaction, 1, false); err != nil {
+		return "", false, err
+	}
+	return beginQueries + queries.openTransaction, false, nil
+}
+
 // Commit commits the specified transaction.
 func (axp *TxPool) Commit(ctx context.Context, transactionID int64, mc messageCommitter) (string, error) {
 	span, ctx := trace.NewSpan(ctx, "TxPool.Commit")
@@ -435,6 +569,12 @@ type TxConnection struct {
 	ImmediateCallerID *querypb.VTGateCallerID
 	EffectiveCallerID *vtrpcpb.CallerID
 	Autocommit        bool
+	// Reserved is set if the connection is reserved for a session.
+	// It stays registered after its transaction ends.
+	Reserved bool
+	// inTransaction is unset for a reserved connection
+	// on which no transaction is open.
+	inTransaction bool
 }
 
 func newTxConnection(conn *connpool.DBConn, transactionID int64, pool *TxPool, immediate *querypb.VTGateCallerID, effective *vtrpcpb.CallerID, autocommit bool) *TxConnection {
@@ -483,9 +623,12 @@ func (txc *TxConnection) BeginAgain(ctx context.Context) error {
 // Recycle returns the connection to the pool. The transaction remains
 // active.
 func (txc *TxConnection) Recycle() {
-	if txc.IsClosed() {
+	switch {
+	case txc.IsClosed():
 		txc.conclude(TxClose, "closed")
-	} else {
+	case txc.Reserved && !txc.inTransaction && txc.pool.draining.Get():
+		txc.conclude(TxRelease, "pool draining")
+	default:
 		txc.pool.activePool.Put(txc.TransactionID)
 	}
 }
@@ -497,12 +640,33 @@ func (txc *TxConnection) RecordQuery(query string) {
 
 func (txc *TxConnection) conclude(conclusion, reason string) {
 	txc.pool.activePool.Unregister(txc.TransactionID, reason)
-	txc.DBConn.Recycle()
+	if txc.keepReserved(conclusion) {
+		// The transaction has ended, but the connection stays
+		// reserved for the session under the same id.
+		reserved := newTxConnection(txc.DBConn, txc.TransactionID, txc.pool, txc.ImmediateCallerID, txc.EffectiveCallerID, true)
+		reserved.Reserved = true
+		txc.pool.activePool.Register(txc.TransactionID, reserved, false)
+	} else {
+		if txc.Reserved {
+			// The session state must not leak into the pool.
+			txc.DBConn.Close()
+		}
+		txc.DBConn.Recycle()
+		txc.pool.limiter.Release(txc.ImmediateCallerID, txc.EffectiveCallerID)
+	}
 	txc.DBConn = nil
-	txc.pool.limiter.Release(txc.ImmediateCallerID, txc.EffectiveCallerID)
 	txc.log(conclusion)
 }
 
+// keepReserved returns true if the connection must remain
+// reserved after the transaction is concluded.
+func (txc *TxConnection) keepReserved(conclusion string) bool {
+	if !txc.Reserved || txc.IsClosed() || txc.pool.draining.Get() {
+		return false
+	}
+	return conclusion == TxCommit || conclusion == TxRollback
+}
+
 func (txc *TxConnection) log(conclusion string) {
 	txc.Conclusion = conclusion
 	txc.EndTime = time.Now()
@@ -25,6 +25,7 @@ import (
 
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/txlimiter"
 
+	"github.com/stretchr/testify/require"
 	"golang.org/x/net/context"
 
 	"vitess.io/vitess/go/mysql"
@@ -682,6 +683,52 @@ func TestTxPoolExecFailDueToConnFail_Errno2013(t *testing.T) {
 	}
 }
 
+func TestTxPoolReserve(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQuery("set @@time_zone = '+00:00'", &sqltypes.Result{})
+	db.AddQuery("begin", &sqltypes.Result{})
+	db.AddQuery("commit", &sqltypes.Result{})
+	db.AddQuery("rollback", &sqltypes.Result{})
+	txPool := newTxPool()
+	txPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer txPool.Close()
+	ctx := context.Background()
+
+	reservedID, err := txPool.Reserve(ctx, &querypb.ExecuteOptions{}, []string{"set @@time_zone = '+00:00'"}, 0)
+	require.NoError(t, err)
+	require.Equal(t, 1, db.GetQueryCalledNum("set @@time_zone = '+00:00'"))
+	require.Equal(t, 0, db.GetQueryCalledNum("begin"))
+
+	// The connection is kept after the transaction ends.
+	_, err = txPool.BeginReserved(ctx, reservedID, &querypb.ExecuteOptions{})
+	require.NoError(t, err)
+	_, err = txPool.BeginReserved(ctx, reservedID, &querypb.ExecuteOptions{})
+	require.EqualError(t, err, fmt.Sprintf("transaction %d: already in a transaction", reservedID))
+	_, err = txPool.Commit(ctx, reservedID, &fakeMessageCommitter{})
+	require.NoError(t, err)
+	conn, err := txPool.Get(reservedID, "for query")
+	require.NoError(t, err)
+	require.True(t, conn.Reserved)
+	conn.Recycle()
+
+	require.NoError(t, txPool.Release(ctx, reservedID))
+	_, err = txPool.Get(reservedID, "for query")
+	require.Error(t, err)
+
+	// Only reserved connections can be released, but
+	// the connection of a transaction can be reserved.
+	transactionID, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{})
+	require.NoError(t, err)
+	err = txPool.Release(ctx, transactionID)
+	require.EqualError(t, err, fmt.Sprintf("connection %d is not reserved", transactionID))
+	reservedID, err = txPool.Reserve(ctx, &querypb.ExecuteOptions{}, nil, transactionID)
+	require.NoError(t, err)
+	require.Equal(t, transactionID, reservedID)
+	require.NoError(t, txPool.Release(ctx, reservedID))
+	require.EqualValues(t, 0, txPool.activePool.Size())
+}
+
 func TestTxPoolCloseKillsStrayTransactions(t *testing.T) {
 	startingStray := tabletenv.InternalErrors.Counts()["StrayTransactions"]
 	db := fakesqldb.New(t)